@@ -0,0 +1,27 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/color"
+	"github.com/derailed/k9s/internal/view"
+)
+
+// resumeCrash reports a crash and asks whether to resume the session.
+func resumeCrash(r io.Reader, w io.Writer, c *view.Crash) bool {
+	fmt.Fprintf(w, "%s%v.\n", color.Colorize("Boom!! ", color.Red), c.Panic)
+	if c.Report != "" {
+		fmt.Fprintf(w, "A diagnostic report was written to %s\n", c.Report)
+	}
+	fmt.Fprint(w, "Resume the session? [y/N] ")
+	s, _ := bufio.NewReader(r).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/view"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResumeCrash(t *testing.T) {
+	uu := map[string]struct {
+		in, report string
+		e          bool
+	}{
+		"yes": {
+			in:     "y\n",
+			report: "/tmp/crash.yaml",
+			e:      true,
+		},
+		"full": {
+			in: " Yes \n",
+			e:  true,
+		},
+		"no": {
+			in: "n\n",
+		},
+		"default": {
+			in: "\n",
+		},
+		"eof": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var w bytes.Buffer
+			assert.Equal(t, u.e, resumeCrash(strings.NewReader(u.in), &w, &view.Crash{Panic: "boom", Report: u.report}))
+			assert.Contains(t, w.String(), "boom")
+			assert.Equal(t, u.report != "", strings.Contains(w.String(), "diagnostic report"))
+		})
+	}
+}
@@ -1,10 +1,12 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"runtime/debug"
 
+	"github.com/derailed/k9s/internal/applog"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/color"
 	"github.com/derailed/k9s/internal/config"
@@ -40,7 +42,7 @@ var (
 )
 
 func init() {
-	rootCmd.AddCommand(versionCmd(), infoCmd())
+	rootCmd.AddCommand(versionCmd(), infoCmd(), statusCmd())
 	initK9sFlags()
 	initK8sFlags()
 }
@@ -76,10 +78,35 @@ func run(cmd *cobra.Command, args []string) error {
 		}
 	}()
 
-	log.Logger = log.Output(zerolog.ConsoleWriter{Out: file})
+	log.Logger = log.Output(zerolog.MultiLevelWriter(zerolog.ConsoleWriter{Out: file}, applog.Recent()))
 
 	zerolog.SetGlobalLevel(parseLevel(*k9sFlags.LogLevel))
-	app := view.NewApp(loadConfiguration())
+	if *k9sFlags.Snapshot != "" {
+		stop, err := serveSnapshot(*k9sFlags.Snapshot)
+		if err != nil {
+			return err
+		}
+		defer stop()
+	}
+	cfg := loadConfiguration()
+	for {
+		err := runApp(cfg)
+		var crash *view.Crash
+		if !errors.As(err, &crash) {
+			return err
+		}
+		if !resumeCrash(os.Stdin, out, crash) {
+			return crash
+		}
+		cfg = loadConfiguration()
+		if crash.Command != "" {
+			cfg.K9s.OverrideCommand(crash.Command)
+		}
+	}
+}
+
+func runApp(cfg *config.Config) error {
+	app := view.NewApp(cfg)
 	if err := app.Init(version, *k9sFlags.RefreshRate); err != nil {
 		return err
 	}
@@ -99,6 +126,7 @@ func loadConfiguration() *config.Config {
 	// Load K9s config file...
 	k8sCfg := client.NewConfig(k8sFlags)
 	k9sCfg := config.NewConfig(k8sCfg)
+	k9sCfg.SetTransient(*k9sFlags.Snapshot != "")
 
 	if err := k9sCfg.Load(config.K9sConfigFile); err != nil {
 		log.Warn().Msg("Unable to locate K9s config. Generating new configuration...")
@@ -225,6 +253,12 @@ func initK9sFlags() {
 		"",
 		"Sets a path to a dir for a screen dumps",
 	)
+	rootCmd.Flags().StringVar(
+		k9sFlags.Snapshot,
+		"snapshot",
+		"",
+		"Browses a cluster dump or k9s snapshot read-only",
+	)
 	rootCmd.Flags()
 }
 
@@ -0,0 +1,56 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// serveSnapshot serves a cluster snapshot locally and points K9s to it.
+func serveSnapshot(path string) (func(), error) {
+	snap, err := snapshot.Load(path)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot load failed: %w", err)
+	}
+	srv := snapshot.NewServer(snap)
+	url, err := srv.Start()
+	if err != nil {
+		return nil, fmt.Errorf("snapshot serve failed: %w", err)
+	}
+
+	ctx := "snapshot-" + snap.Name
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[ctx] = &clientcmdapi.Cluster{Server: url}
+	cfg.AuthInfos[ctx] = &clientcmdapi.AuthInfo{}
+	cfg.Contexts[ctx] = &clientcmdapi.Context{Cluster: ctx, AuthInfo: ctx}
+	cfg.CurrentContext = ctx
+
+	f, err := os.CreateTemp("", "k9s-snapshot-*.yaml")
+	if err != nil {
+		srv.Stop()
+		return nil, err
+	}
+	kubeconfig := f.Name()
+	if err := f.Close(); err != nil {
+		log.Warn().Err(err).Msgf("Closing snapshot kubeconfig")
+	}
+	if err := clientcmd.WriteToFile(*cfg, kubeconfig); err != nil {
+		srv.Stop()
+		return nil, err
+	}
+	log.Info().Msgf("Browsing snapshot %q on %s", path, url)
+
+	*k8sFlags.KubeConfig, *k8sFlags.Context = kubeconfig, ctx
+	*k9sFlags.ReadOnly, *k9sFlags.Write = true, false
+
+	return func() {
+		srv.Stop()
+		if err := os.Remove(kubeconfig); err != nil {
+			log.Warn().Err(err).Msgf("Removing snapshot kubeconfig")
+		}
+	}, nil
+}
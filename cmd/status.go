@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/spf13/cobra"
+)
+
+func statusCmd() *cobra.Command {
+	var pane, output string
+
+	command := cobra.Command{
+		Use:   "status",
+		Short: "Print a running session location",
+		Long:  "Print the context, namespace and view of a running session ie for tmux status bars: #(k9s status --pane #{pane_id})",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			s, err := config.LoadSessionStatus(config.SessionsDir(), pane)
+			if err != nil {
+				return err
+			}
+			return printStatus(out, s, output)
+		},
+	}
+
+	command.Flags().StringVarP(&pane, "pane", "p", "", "Tmux pane id of the session. Defaults to the most recently active session")
+	command.Flags().StringVarP(&output, "output", "o", "text", "Output format. One of text or json")
+
+	return &command
+}
+
+func printStatus(w io.Writer, s config.SessionStatus, output string) error {
+	switch output {
+	case "json":
+		return json.NewEncoder(w).Encode(s)
+	case "text", "":
+		_, err := fmt.Fprintln(w, s)
+		return err
+	default:
+		return fmt.Errorf("unsupported output format %q", output)
+	}
+}
@@ -0,0 +1,39 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrintStatus(t *testing.T) {
+	s := config.SessionStatus{PID: 1, Context: "c1", Namespace: "ns1", View: "pods", UpdatedAt: time.Unix(0, 0).UTC()}
+
+	uu := map[string]struct {
+		output, e, err string
+	}{
+		"text": {output: "text", e: "c1/ns1:pods\n"},
+		"json": {
+			output: "json",
+			e:      `{"pid":1,"context":"c1","cluster":"","namespace":"ns1","view":"pods","updatedAt":"1970-01-01T00:00:00Z"}` + "\n",
+		},
+		"toast": {output: "yaml", err: `unsupported output format "yaml"`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var b bytes.Buffer
+			err := printStatus(&b, s, u.output)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, b.String())
+		})
+	}
+}
@@ -0,0 +1,132 @@
+// Package applog keeps the most recent k9s log entries in memory so they can be
+// inspected from within the app.
+package applog
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// DefaultSize tracks the number of entries kept by the session buffer.
+const DefaultSize = 2000
+
+var recent = NewBuffer(DefaultSize)
+
+// Recent returns the session log buffer.
+func Recent() *Buffer {
+	return recent
+}
+
+// Entry represents a structured log entry.
+type Entry struct {
+	Seq     int64
+	Time    time.Time
+	Level   zerolog.Level
+	Message string
+	// Fields tracks the entry context as key=value pairs sorted by key.
+	Fields []string
+}
+
+// Field returns the value of a given field or false if not set.
+func (e Entry) Field(k string) (string, bool) {
+	for _, f := range e.Fields {
+		if strings.HasPrefix(f, k+"=") {
+			return strings.TrimPrefix(f, k+"="), true
+		}
+	}
+
+	return "", false
+}
+
+// Buffer represents a ring buffer of structured log entries. It is meant to be used
+// as a zerolog writer.
+type Buffer struct {
+	entries []Entry
+	size    int
+	seq     int64
+	mx      sync.RWMutex
+}
+
+// NewBuffer returns a new buffer holding at most size entries.
+func NewBuffer(size int) *Buffer {
+	return &Buffer{size: size}
+}
+
+// Write records a zerolog json event.
+func (b *Buffer) Write(p []byte) (int, error) {
+	e, err := parseEntry(p)
+	if err != nil {
+		return 0, err
+	}
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.seq++
+	e.Seq = b.seq
+	b.entries = append(b.entries, e)
+	if over := len(b.entries) - b.size; over > 0 {
+		b.entries = append(b.entries[:0], b.entries[over:]...)
+	}
+
+	return len(p), nil
+}
+
+// Entries returns the entries at or above a given level.
+func (b *Buffer) Entries(level zerolog.Level) []Entry {
+	b.mx.RLock()
+	defer b.mx.RUnlock()
+
+	ee := make([]Entry, 0, len(b.entries))
+	for _, e := range b.entries {
+		if e.Level >= level {
+			ee = append(ee, e)
+		}
+	}
+
+	return ee
+}
+
+// Clear empties the buffer.
+func (b *Buffer) Clear() {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+	b.entries = nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseEntry(p []byte) (Entry, error) {
+	m := make(map[string]interface{})
+	if err := json.Unmarshal(p, &m); err != nil {
+		return Entry{}, err
+	}
+
+	e := Entry{Time: time.Now(), Level: zerolog.NoLevel}
+	if s, ok := m[zerolog.LevelFieldName].(string); ok {
+		if l, err := zerolog.ParseLevel(s); err == nil {
+			e.Level = l
+		}
+	}
+	if s, ok := m[zerolog.TimestampFieldName].(string); ok {
+		if t, err := time.Parse(zerolog.TimeFieldFormat, s); err == nil {
+			e.Time = t
+		}
+	}
+	e.Message, _ = m[zerolog.MessageFieldName].(string)
+	for k, v := range m {
+		switch k {
+		case zerolog.LevelFieldName, zerolog.TimestampFieldName, zerolog.MessageFieldName:
+			continue
+		}
+		e.Fields = append(e.Fields, fmt.Sprintf("%s=%v", k, v))
+	}
+	sort.Strings(e.Fields)
+
+	return e, nil
+}
@@ -0,0 +1,56 @@
+package applog_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/applog"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBufferWrite(t *testing.T) {
+	b := applog.NewBuffer(3)
+	l := zerolog.New(b).With().Timestamp().Logger()
+
+	l.Debug().Str("gvr", "v1/pods").Int("rows", 10).Msg("Table refreshed")
+	l.Info().Msg("fred")
+	l.Warn().Msg("blee")
+	l.Error().Str("error", "boom").Msg("Watch failed")
+
+	ee := b.Entries(zerolog.DebugLevel)
+	assert.Equal(t, 3, len(ee))
+	assert.Equal(t, int64(2), ee[0].Seq)
+	assert.Equal(t, "fred", ee[0].Message)
+	assert.Equal(t, zerolog.InfoLevel, ee[0].Level)
+	assert.False(t, ee[0].Time.IsZero())
+
+	ee = b.Entries(zerolog.WarnLevel)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, []string{"error=boom"}, ee[1].Fields)
+	v, ok := ee[1].Field("error")
+	assert.True(t, ok)
+	assert.Equal(t, "boom", v)
+	_, ok = ee[1].Field("gvr")
+	assert.False(t, ok)
+
+	b.Clear()
+	assert.Equal(t, 0, len(b.Entries(zerolog.TraceLevel)))
+}
+
+func TestBufferFields(t *testing.T) {
+	b := applog.NewBuffer(10)
+	l := zerolog.New(b)
+
+	l.Debug().Str("method", "GET").Int("code", 200).Msg("API call")
+
+	ee := b.Entries(zerolog.TraceLevel)
+	assert.Equal(t, 1, len(ee))
+	assert.Equal(t, []string{"code=200", "method=GET"}, ee[0].Fields)
+}
+
+func TestBufferInvalid(t *testing.T) {
+	b := applog.NewBuffer(10)
+
+	_, err := b.Write([]byte("bozo"))
+	assert.Error(t, err)
+}
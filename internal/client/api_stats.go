@@ -0,0 +1,163 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/metrics"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	apiStatsWindow     = time.Minute
+	apiStatsMaxSamples = 10000
+	// APISlowLatency tracks the p95 latency past which the api server is deemed degraded.
+	APISlowLatency = time.Second
+)
+
+var apiStats = NewAPIStats(apiStatsWindow)
+
+// APIRequestStats returns the api server requests stats for the current session.
+func APIRequestStats() *APIStats {
+	return apiStats
+}
+
+type apiSample struct {
+	at       time.Time
+	latency  time.Duration
+	code     int
+	failed   bool
+	canceled bool
+}
+
+// APIStatsSnapshot represents the api server requests stats over the rolling window.
+type APIStatsSnapshot struct {
+	Requests  int
+	P50, P95  time.Duration
+	Errors    int
+	Throttled int
+}
+
+// IsDegraded checks if the api server is slow, failing or throttling requests.
+func (s APIStatsSnapshot) IsDegraded() bool {
+	return s.P95 > APISlowLatency || s.Errors > 0 || s.Throttled > 0
+}
+
+// APIStats tracks api server requests latency and errors over a rolling window.
+type APIStats struct {
+	window  time.Duration
+	samples []apiSample
+	mx      sync.Mutex
+}
+
+// NewAPIStats returns a new instance.
+func NewAPIStats(window time.Duration) *APIStats {
+	return &APIStats{window: window}
+}
+
+// Record tracks a request outcome.
+func (a *APIStats) Record(at time.Time, latency time.Duration, code int, err error) {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.samples = append(a.samples, apiSample{
+		at:       at,
+		latency:  latency,
+		code:     code,
+		failed:   err != nil || code >= http.StatusInternalServerError,
+		canceled: errors.Is(err, context.Canceled),
+	})
+	a.prune(at)
+}
+
+// Snapshot returns the stats for the requests issued within the window.
+func (a *APIStats) Snapshot(now time.Time) APIStatsSnapshot {
+	a.mx.Lock()
+	defer a.mx.Unlock()
+
+	a.prune(now)
+	var (
+		s  APIStatsSnapshot
+		ll = make([]time.Duration, 0, len(a.samples))
+	)
+	for _, sample := range a.samples {
+		if sample.canceled {
+			continue
+		}
+		s.Requests++
+		switch {
+		case sample.code == http.StatusTooManyRequests:
+			s.Throttled++
+		case sample.failed:
+			s.Errors++
+		}
+		ll = append(ll, sample.latency)
+	}
+	if len(ll) == 0 {
+		return s
+	}
+	sort.Slice(ll, func(i, j int) bool { return ll[i] < ll[j] })
+	s.P50, s.P95 = percentile(ll, 50), percentile(ll, 95)
+
+	return s
+}
+
+// WrapTransport instruments a transport to record requests stats.
+func (a *APIStats) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &statsRoundTripper{stats: a, rt: rt}
+}
+
+func (a *APIStats) prune(now time.Time) {
+	cut := 0
+	for cut < len(a.samples) && now.Sub(a.samples[cut].at) > a.window {
+		cut++
+	}
+	if over := len(a.samples) - cut - apiStatsMaxSamples; over > 0 {
+		cut += over
+	}
+	if cut > 0 {
+		a.samples = append(a.samples[:0], a.samples[cut:]...)
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type statsRoundTripper struct {
+	stats *APIStats
+	rt    http.RoundTripper
+}
+
+// RoundTrip records the latency until the response headers are received.
+func (s *statsRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	start := time.Now()
+	resp, err := s.rt.RoundTrip(req)
+	var code int
+	if resp != nil {
+		code = resp.StatusCode
+	}
+	latency := time.Since(start)
+	s.stats.Record(time.Now(), latency, code, err)
+	metrics.ObserveAPIRequest(req.Method, code, latency)
+	log.Debug().
+		Str("method", req.Method).
+		Str("url", req.URL.Path).
+		Int("code", code).
+		Dur("latency", latency).
+		Msg("API call")
+
+	return resp, err
+}
+
+func percentile(ll []time.Duration, p int) time.Duration {
+	i := (len(ll)*p+99)/100 - 1
+	if i < 0 {
+		i = 0
+	}
+
+	return ll[i]
+}
@@ -0,0 +1,72 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIStatsSnapshot(t *testing.T) {
+	s := client.NewAPIStats(time.Minute)
+	now := time.Now()
+
+	assert.Equal(t, client.APIStatsSnapshot{}, s.Snapshot(now))
+
+	s.Record(now.Add(-2*time.Minute), 5*time.Second, http.StatusInternalServerError, nil)
+	for i := 1; i <= 20; i++ {
+		s.Record(now, time.Duration(i)*10*time.Millisecond, http.StatusOK, nil)
+	}
+	s.Record(now, 50*time.Millisecond, http.StatusTooManyRequests, nil)
+	s.Record(now, 50*time.Millisecond, 0, errors.New("boom"))
+	s.Record(now, time.Minute, 0, context.Canceled)
+
+	snap := s.Snapshot(now)
+	assert.Equal(t, 22, snap.Requests)
+	assert.Equal(t, 1, snap.Errors)
+	assert.Equal(t, 1, snap.Throttled)
+	assert.Equal(t, 90*time.Millisecond, snap.P50)
+	assert.Equal(t, 190*time.Millisecond, snap.P95)
+	assert.True(t, snap.IsDegraded())
+}
+
+func TestAPIStatsDegraded(t *testing.T) {
+	uu := map[string]struct {
+		s client.APIStatsSnapshot
+		e bool
+	}{
+		"healthy":   {s: client.APIStatsSnapshot{Requests: 10, P95: 200 * time.Millisecond}},
+		"slow":      {s: client.APIStatsSnapshot{Requests: 10, P95: 2 * time.Second}, e: true},
+		"errors":    {s: client.APIStatsSnapshot{Requests: 10, Errors: 1}, e: true},
+		"throttled": {s: client.APIStatsSnapshot{Requests: 10, Throttled: 3}, e: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.s.IsDegraded())
+		})
+	}
+}
+
+type codeRoundTripper int
+
+func (c codeRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return &http.Response{StatusCode: int(c)}, nil
+}
+
+func TestAPIStatsWrapTransport(t *testing.T) {
+	s := client.NewAPIStats(time.Minute)
+	rt := s.WrapTransport(codeRoundTripper(http.StatusServiceUnavailable))
+	req, _ := http.NewRequest(http.MethodGet, "https://localhost/api", nil)
+	_, err := rt.RoundTrip(req)
+
+	assert.Nil(t, err)
+	snap := s.Snapshot(time.Now())
+	assert.Equal(t, 1, snap.Requests)
+	assert.Equal(t, 1, snap.Errors)
+}
@@ -281,6 +281,9 @@ func (a *APIClient) Config() *Config {
 
 // HasMetrics checks if the cluster supports metrics.
 func (a *APIClient) HasMetrics() bool {
+	if activeMetricsProvider() != nil {
+		return true
+	}
 	err := a.supportsMetricsResources()
 	return err == nil
 }
@@ -49,7 +49,13 @@ func (c *Config) CallTimeout() time.Duration {
 }
 
 func (c *Config) RESTConfig() (*restclient.Config, error) {
-	return c.clientConfig().ClientConfig()
+	cfg, err := c.clientConfig().ClientConfig()
+	if err != nil {
+		return nil, err
+	}
+	cfg.Wrap(apiStats.WrapTransport)
+
+	return cfg, nil
 }
 
 // Flags returns configuration flags.
@@ -2,14 +2,12 @@ package client
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"time"
 
 	v1 "k8s.io/api/core/v1"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/cache"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
@@ -40,13 +38,20 @@ func ResetMetrics() {
 type MetricsServer struct {
 	Connection
 
-	cache *cache.LRUExpireCache
+	provider MetricsProvider
+	cache    *cache.LRUExpireCache
 }
 
 // NewMetricsServer return a metric server instance.
 func NewMetricsServer(c Connection) *MetricsServer {
+	p := activeMetricsProvider()
+	if p == nil {
+		p = &metricsServer{Connection: c}
+	}
+
 	return &MetricsServer{
 		Connection: c,
+		provider:   p,
 		cache:      cache.NewLRUExpireCache(mxCacheSize),
 	}
 }
@@ -83,21 +88,6 @@ func (m *MetricsServer) ClusterLoad(nos *v1.NodeList, nmx *mv1beta1.NodeMetricsL
 	return nil
 }
 
-func (m *MetricsServer) checkAccess(ns, gvr, msg string) error {
-	if !m.HasMetrics() {
-		return errors.New("No metrics-server detected on cluster")
-	}
-
-	auth, err := m.CanI(ns, gvr, ListAccess)
-	if err != nil {
-		return err
-	}
-	if !auth {
-		return fmt.Errorf(msg)
-	}
-	return nil
-}
-
 // NodesMetrics retrieves metrics for a given set of nodes.
 func (m *MetricsServer) NodesMetrics(nodes *v1.NodeList, metrics *mv1beta1.NodeMetricsList, mmx NodesMetrics) {
 	if nodes == nil || metrics == nil {
@@ -143,12 +133,7 @@ func (m *MetricsServer) FetchNodesMetricsMap(ctx context.Context) (NodesMetricsM
 
 // FetchNodesMetrics return all metrics for nodes.
 func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
-	const msg = "user is not authorized to list node metrics"
-
 	mx := new(mv1beta1.NodeMetricsList)
-	if err := m.checkAccess(ClusterScope, "metrics.k8s.io/v1beta1/nodes", msg); err != nil {
-		return mx, err
-	}
 
 	const key = "nodes"
 	if entry, ok := m.cache.Get(key); ok && entry != nil {
@@ -159,11 +144,7 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+	mxList, err := m.provider.ListNodesMetrics(ctx)
 	if err != nil {
 		return mx, err
 	}
@@ -174,13 +155,6 @@ func (m *MetricsServer) FetchNodesMetrics(ctx context.Context) (*mv1beta1.NodeMe
 
 // FetchNodeMetrics return all metrics for nodes.
 func (m *MetricsServer) FetchNodeMetrics(ctx context.Context, n string) (*mv1beta1.NodeMetrics, error) {
-	const msg = "user is not authorized to list node metrics"
-
-	mx := new(mv1beta1.NodeMetrics)
-	if err := m.checkAccess(ClusterScope, "metrics.k8s.io/v1beta1/nodes", msg); err != nil {
-		return mx, err
-	}
-
 	mmx, err := m.FetchNodesMetricsMap(ctx)
 	if err != nil {
 		return nil, err
@@ -212,14 +186,9 @@ func (m *MetricsServer) FetchPodsMetricsMap(ctx context.Context, ns string) (Pod
 // FetchPodsMetrics return all metrics for pods in a given namespace.
 func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
 	mx := new(mv1beta1.PodMetricsList)
-	const msg = "user is not authorized to list pods metrics"
-
 	if ns == NamespaceAll {
 		ns = AllNamespaces
 	}
-	if err := m.checkAccess(ns, "metrics.k8s.io/v1beta1/pods", msg); err != nil {
-		return mx, err
-	}
 
 	key := FQN(ns, "pods")
 	if entry, ok := m.cache.Get(key); ok {
@@ -230,11 +199,7 @@ func (m *MetricsServer) FetchPodsMetrics(ctx context.Context, ns string) (*mv1be
 		return mxList, nil
 	}
 
-	client, err := m.MXDial()
-	if err != nil {
-		return mx, err
-	}
-	mxList, err := client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+	mxList, err := m.provider.ListPodsMetrics(ctx, ns)
 	if err != nil {
 		return mx, err
 	}
@@ -261,16 +226,10 @@ func (m *MetricsServer) FetchContainersMetrics(ctx context.Context, fqn string)
 
 // FetchPodMetrics return all metrics for pods in a given namespace.
 func (m *MetricsServer) FetchPodMetrics(ctx context.Context, fqn string) (*mv1beta1.PodMetrics, error) {
-	var mx *mv1beta1.PodMetrics
-	const msg = "user is not authorized to list pod metrics"
-
 	ns, _ := Namespaced(fqn)
 	if ns == NamespaceAll {
 		ns = AllNamespaces
 	}
-	if err := m.checkAccess(ns, "metrics.k8s.io/v1beta1/pods", msg); err != nil {
-		return mx, err
-	}
 
 	mmx, err := m.FetchPodsMetricsMap(ctx, ns)
 	if err != nil {
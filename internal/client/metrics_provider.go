@@ -0,0 +1,297 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const (
+	// MetricsServerProvider sources usage metrics from the cluster metrics-server.
+	MetricsServerProvider = "metrics-server"
+
+	// PrometheusProvider sources usage metrics from a prometheus server.
+	PrometheusProvider = "prometheus"
+
+	promRateWindow = 5 * time.Minute
+
+	promPodsCPU  = `sum by (namespace, pod, container) (rate(container_cpu_usage_seconds_total{%s}[5m]))`
+	promPodsMEM  = `sum by (namespace, pod, container) (container_memory_working_set_bytes{%s})`
+	promNodesCPU = `sum by (node, instance) (rate(container_cpu_usage_seconds_total{id="/"}[5m]))`
+	promNodesMEM = `sum by (node, instance) (container_memory_working_set_bytes{id="/"})`
+)
+
+var (
+	mxProvider   MetricsProvider
+	mxProviderMx sync.RWMutex
+)
+
+// MetricsProvider serves nodes and pods usage metrics.
+type MetricsProvider interface {
+	// ListNodesMetrics returns all nodes usage metrics.
+	ListNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error)
+
+	// ListPodsMetrics returns pods usage metrics in a given namespace.
+	ListPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error)
+}
+
+// SetMetricsProvider overrides the metrics-server as the usage metrics source.
+// A nil provider reverts to the metrics-server.
+func SetMetricsProvider(p MetricsProvider) {
+	mxProviderMx.Lock()
+	mxProvider = p
+	mxProviderMx.Unlock()
+	ResetMetrics()
+}
+
+func activeMetricsProvider() MetricsProvider {
+	mxProviderMx.RLock()
+	defer mxProviderMx.RUnlock()
+
+	return mxProvider
+}
+
+// metricsServer sources usage metrics from the metrics-server api.
+type metricsServer struct {
+	Connection
+}
+
+// ListNodesMetrics returns all nodes usage metrics.
+func (m *metricsServer) ListNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	const msg = "user is not authorized to list node metrics"
+	if err := m.checkAccess(ClusterScope, "metrics.k8s.io/v1beta1/nodes", msg); err != nil {
+		return nil, err
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().NodeMetricses().List(ctx, metav1.ListOptions{})
+}
+
+// ListPodsMetrics returns pods usage metrics in a given namespace.
+func (m *metricsServer) ListPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	const msg = "user is not authorized to list pods metrics"
+	if err := m.checkAccess(ns, "metrics.k8s.io/v1beta1/pods", msg); err != nil {
+		return nil, err
+	}
+	client, err := m.MXDial()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.MetricsV1beta1().PodMetricses(ns).List(ctx, metav1.ListOptions{})
+}
+
+func (m *metricsServer) checkAccess(ns, gvr, msg string) error {
+	if !m.HasMetrics() {
+		return errors.New("No metrics-server detected on cluster")
+	}
+
+	auth, err := m.CanI(ns, gvr, ListAccess)
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf(msg)
+	}
+	return nil
+}
+
+// Prometheus sources usage metrics from cadvisor series scraped by a prometheus server.
+type Prometheus struct {
+	api promv1.API
+}
+
+// NewPrometheus returns a prometheus metrics provider given a server address ie
+// http://prometheus.monitoring:9090. Headers are added to all queries ie authentication.
+func NewPrometheus(address string, headers map[string]string) (*Prometheus, error) {
+	c, err := api.NewClient(api.Config{
+		Address:      address,
+		RoundTripper: &headerTripper{headers: headers, next: api.DefaultRoundTripper},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Prometheus{api: promv1.NewAPI(c)}, nil
+}
+
+// ListNodesMetrics returns all nodes usage metrics.
+func (p *Prometheus) ListNodesMetrics(ctx context.Context) (*mv1beta1.NodeMetricsList, error) {
+	cpu, err := p.query(ctx, promNodesCPU)
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, promNodesMEM)
+	if err != nil {
+		return nil, err
+	}
+
+	mm := make(map[string]*mv1beta1.NodeMetrics)
+	node := func(s *model.Sample) *mv1beta1.NodeMetrics {
+		n := sampleNode(s.Metric)
+		if mx, ok := mm[n]; ok {
+			return mx
+		}
+		mx := mv1beta1.NodeMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Timestamp:  metav1.NewTime(s.Timestamp.Time()),
+			Window:     metav1.Duration{Duration: promRateWindow},
+			Usage:      make(v1.ResourceList),
+		}
+		mm[n] = &mx
+		return &mx
+	}
+	for _, s := range cpu {
+		node(s).Usage[v1.ResourceCPU] = *cpuQuantity(s.Value)
+	}
+	for _, s := range mem {
+		node(s).Usage[v1.ResourceMemory] = *memQuantity(s.Value)
+	}
+
+	list := mv1beta1.NodeMetricsList{Items: make([]mv1beta1.NodeMetrics, 0, len(mm))}
+	for _, mx := range mm {
+		if mx.Name != "" {
+			list.Items = append(list.Items, *mx)
+		}
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+
+	return &list, nil
+}
+
+// ListPodsMetrics returns pods usage metrics in a given namespace.
+func (p *Prometheus) ListPodsMetrics(ctx context.Context, ns string) (*mv1beta1.PodMetricsList, error) {
+	sel := `container!="", container!="POD", pod!=""`
+	if !IsAllNamespaces(ns) {
+		sel += fmt.Sprintf(", namespace=%q", ns)
+	}
+	cpu, err := p.query(ctx, fmt.Sprintf(promPodsCPU, sel))
+	if err != nil {
+		return nil, err
+	}
+	mem, err := p.query(ctx, fmt.Sprintf(promPodsMEM, sel))
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		mm    = make(map[string]*mv1beta1.PodMetrics)
+		index = make(map[string]int)
+	)
+	container := func(s *model.Sample) *mv1beta1.ContainerMetrics {
+		fqn := FQN(string(s.Metric["namespace"]), string(s.Metric["pod"]))
+		mx, ok := mm[fqn]
+		if !ok {
+			mx = &mv1beta1.PodMetrics{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: string(s.Metric["namespace"]),
+					Name:      string(s.Metric["pod"]),
+				},
+				Timestamp: metav1.NewTime(s.Timestamp.Time()),
+				Window:    metav1.Duration{Duration: promRateWindow},
+			}
+			mm[fqn] = mx
+		}
+		co := string(s.Metric["container"])
+		key := fqn + ":" + co
+		if i, ok := index[key]; ok {
+			return &mx.Containers[i]
+		}
+		index[key] = len(mx.Containers)
+		mx.Containers = append(mx.Containers, mv1beta1.ContainerMetrics{Name: co, Usage: make(v1.ResourceList)})
+		return &mx.Containers[len(mx.Containers)-1]
+	}
+	for _, s := range cpu {
+		container(s).Usage[v1.ResourceCPU] = *cpuQuantity(s.Value)
+	}
+	for _, s := range mem {
+		container(s).Usage[v1.ResourceMemory] = *memQuantity(s.Value)
+	}
+
+	list := mv1beta1.PodMetricsList{Items: make([]mv1beta1.PodMetrics, 0, len(mm))}
+	for _, mx := range mm {
+		list.Items = append(list.Items, *mx)
+	}
+	sort.Slice(list.Items, func(i, j int) bool {
+		return FQN(list.Items[i].Namespace, list.Items[i].Name) < FQN(list.Items[j].Namespace, list.Items[j].Name)
+	})
+
+	return &list, nil
+}
+
+func (p *Prometheus) query(ctx context.Context, q string) (model.Vector, error) {
+	v, ww, err := p.api.Query(ctx, q, time.Now())
+	if err != nil {
+		return nil, fmt.Errorf("prometheus query failed: %w", err)
+	}
+	for _, w := range ww {
+		log.Warn().Msgf("Prometheus query %q: %s", q, w)
+	}
+	vec, ok := v.(model.Vector)
+	if !ok {
+		return nil, fmt.Errorf("expecting a prometheus vector but got %s", v.Type())
+	}
+
+	return vec, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// sampleNode returns the node a sample belongs to. Scrape configs not relabeling a node
+// label fallback to the scrape instance.
+func sampleNode(m model.Metric) string {
+	if n := string(m["node"]); n != "" {
+		return n
+	}
+	n := string(m["instance"])
+	if i := strings.LastIndex(n, ":"); i > 0 {
+		n = n[:i]
+	}
+
+	return n
+}
+
+func cpuQuantity(v model.SampleValue) *resource.Quantity {
+	return resource.NewMilliQuantity(int64(float64(v)*1000), resource.DecimalSI)
+}
+
+func memQuantity(v model.SampleValue) *resource.Quantity {
+	return resource.NewQuantity(int64(v), resource.BinarySI)
+}
+
+type headerTripper struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+// RoundTrip adds the configured headers to a request.
+func (h *headerTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	if len(h.headers) > 0 {
+		r = r.Clone(r.Context())
+		for k, v := range h.headers {
+			r.Header.Set(k, v)
+		}
+	}
+
+	return h.next.RoundTrip(r)
+}
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrometheusListPodsMetrics(t *testing.T) {
+	var queries []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer fred", r.Header.Get("Authorization"))
+		assert.Nil(t, r.ParseForm())
+		q := r.Form.Get("query")
+		queries = append(queries, q)
+		v := "1048576"
+		if strings.Contains(q, "rate(") {
+			v = "0.25"
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"namespace":"ns1","pod":"p1","container":"c1"},"value":[1700000000,%q]},
+			{"metric":{"namespace":"ns1","pod":"p1","container":"c2"},"value":[1700000000,%q]}
+		]}}`, v, v)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(srv.URL, map[string]string{"Authorization": "Bearer fred"})
+	assert.Nil(t, err)
+	mm, err := p.ListPodsMetrics(context.Background(), "ns1")
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, len(queries))
+	for _, q := range queries {
+		assert.Contains(t, q, `namespace="ns1"`)
+	}
+	assert.Equal(t, 1, len(mm.Items))
+	po := mm.Items[0]
+	assert.Equal(t, "ns1/p1", client.FQN(po.Namespace, po.Name))
+	assert.Equal(t, 2, len(po.Containers))
+	for _, co := range po.Containers {
+		assert.Equal(t, int64(250), co.Usage.Cpu().MilliValue())
+		assert.Equal(t, int64(client.MegaByte), co.Usage.Memory().Value())
+	}
+}
+
+func TestPrometheusListNodesMetrics(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		v := "2147483648"
+		if strings.Contains(r.FormValue("query"), "rate(") {
+			v = "1.5"
+		}
+		fmt.Fprintf(w, `{"status":"success","data":{"resultType":"vector","result":[
+			{"metric":{"node":"n1"},"value":[1700000000,%q]},
+			{"metric":{"instance":"n2:10250"},"value":[1700000000,%q]}
+		]}}`, v, v)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(srv.URL, nil)
+	assert.Nil(t, err)
+	mm, err := p.ListNodesMetrics(context.Background())
+	assert.Nil(t, err)
+
+	assert.Equal(t, 2, len(mm.Items))
+	for i, n := range []string{"n1", "n2"} {
+		assert.Equal(t, n, mm.Items[i].Name)
+		assert.Equal(t, int64(1500), mm.Items[i].Usage.Cpu().MilliValue())
+		assert.Equal(t, int64(2048*client.MegaByte), mm.Items[i].Usage.Memory().Value())
+	}
+}
+
+func TestPrometheusQueryFailed(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"status":"error","errorType":"bad_data","error":"parse error"}`)
+	}))
+	defer srv.Close()
+
+	p, err := client.NewPrometheus(srv.URL, nil)
+	assert.Nil(t, err)
+	_, err = p.ListNodesMetrics(context.Background())
+	assert.NotNil(t, err)
+}
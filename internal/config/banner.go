@@ -0,0 +1,33 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DefaultBannerConfigMap tracks the default ConfigMap holding a cluster banner.
+const DefaultBannerConfigMap = "kube-system/k9s-banner"
+
+// Banner tracks the message displayed when connecting to a cluster.
+type Banner struct {
+	// Message is displayed in addition to the cluster banner if any.
+	Message string `yaml:"message"`
+	// ConfigMap names the ns/name ConfigMap cluster admins publish a banner in.
+	ConfigMap string `yaml:"configMap"`
+	// Disable skips the banner altogether.
+	Disable bool `yaml:"disable"`
+}
+
+// NewBanner returns a new instance.
+func NewBanner() *Banner {
+	return &Banner{ConfigMap: DefaultBannerConfigMap}
+}
+
+// Validate checks the banner options.
+func (b *Banner) Validate(_ client.Connection, _ KubeSettings) {
+	b.Message = strings.TrimSpace(b.Message)
+	if b.ConfigMap == "" {
+		b.ConfigMap = DefaultBannerConfigMap
+	}
+}
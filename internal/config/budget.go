@@ -0,0 +1,49 @@
+package config
+
+import "github.com/derailed/k9s/internal/client"
+
+const (
+	// DefaultMaxRows tracks the default max number of rows a view displays.
+	DefaultMaxRows = 10000
+
+	// DefaultMaxTextBytes tracks the default max size of a yaml, describe or details view.
+	DefaultMaxTextBytes = 5 << 20
+
+	// DefaultMaxHistoryRows tracks the default max number of rows a view keeps to rewind.
+	DefaultMaxHistoryRows = 50000
+)
+
+// Budget tracks the views resources guardrails so giant clusters can not exhaust
+// the host memory.
+type Budget struct {
+	// MaxRows caps the rows a resource view displays. Extra rows are dropped with a warning.
+	MaxRows int `yaml:"maxRows"`
+
+	// MaxTextBytes caps the size of yaml, describe and details views.
+	MaxTextBytes int `yaml:"maxTextBytes"`
+
+	// MaxHistoryRows caps the rows kept across a view rewind snapshots. Oldest snapshots go first.
+	MaxHistoryRows int `yaml:"maxHistoryRows"`
+}
+
+// NewBudget returns a new instance.
+func NewBudget() *Budget {
+	return &Budget{
+		MaxRows:        DefaultMaxRows,
+		MaxTextBytes:   DefaultMaxTextBytes,
+		MaxHistoryRows: DefaultMaxHistoryRows,
+	}
+}
+
+// Validate checks the budget limits.
+func (b *Budget) Validate(_ client.Connection, _ KubeSettings) {
+	if b.MaxRows <= 0 {
+		b.MaxRows = DefaultMaxRows
+	}
+	if b.MaxTextBytes <= 0 {
+		b.MaxTextBytes = DefaultMaxTextBytes
+	}
+	if b.MaxHistoryRows <= 0 {
+		b.MaxHistoryRows = DefaultMaxHistoryRows
+	}
+}
@@ -0,0 +1,34 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBudgetValidate(t *testing.T) {
+	uu := map[string]struct {
+		b, e config.Budget
+	}{
+		"defaults": {
+			e: config.Budget{MaxRows: config.DefaultMaxRows, MaxTextBytes: config.DefaultMaxTextBytes, MaxHistoryRows: config.DefaultMaxHistoryRows},
+		},
+		"negative": {
+			b: config.Budget{MaxRows: -1, MaxTextBytes: -1, MaxHistoryRows: -1},
+			e: config.Budget{MaxRows: config.DefaultMaxRows, MaxTextBytes: config.DefaultMaxTextBytes, MaxHistoryRows: config.DefaultMaxHistoryRows},
+		},
+		"custom": {
+			b: config.Budget{MaxRows: 500, MaxTextBytes: 1024, MaxHistoryRows: 2000},
+			e: config.Budget{MaxRows: 500, MaxTextBytes: 1024, MaxHistoryRows: 2000},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.b.Validate(nil, nil)
+			assert.Equal(t, u.e, u.b)
+		})
+	}
+}
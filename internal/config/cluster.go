@@ -7,11 +7,14 @@ const DefaultPFAddress = "localhost"
 
 // Cluster tracks K9s cluster configuration.
 type Cluster struct {
-	Namespace          *Namespace    `yaml:"namespace"`
-	View               *View         `yaml:"view"`
-	FeatureGates       *FeatureGates `yaml:"featureGates"`
-	ShellPod           *ShellPod     `yaml:"shellPod"`
-	PortForwardAddress string        `yaml:"portForwardAddress"`
+	Namespace          *Namespace       `yaml:"namespace"`
+	View               *View            `yaml:"view"`
+	FeatureGates       *FeatureGates    `yaml:"featureGates"`
+	ShellPod           *ShellPod        `yaml:"shellPod"`
+	PortForwardAddress string           `yaml:"portForwardAddress"`
+	Banner             *Banner          `yaml:"banner,omitempty"`
+	CustomColumns      CustomColumns    `yaml:"customColumns,omitempty"`
+	MetricsProvider    *MetricsProvider `yaml:"metricsProvider,omitempty"`
 }
 
 // NewCluster creates a new cluster configuration.
@@ -51,4 +54,21 @@ func (c *Cluster) Validate(conn client.Connection, ks KubeSettings) {
 		c.ShellPod = NewShellPod()
 	}
 	c.ShellPod.Validate(conn, ks)
+
+	if c.Banner != nil {
+		c.Banner.Validate(conn, ks)
+	}
+	c.CustomColumns.Validate(conn, ks)
+	if c.MetricsProvider != nil {
+		c.MetricsProvider.Validate(conn, ks)
+	}
+}
+
+// ActiveBanner returns the cluster banner options.
+func (c *Cluster) ActiveBanner() *Banner {
+	if c.Banner == nil {
+		return NewBanner()
+	}
+
+	return c.Banner
 }
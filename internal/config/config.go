@@ -41,9 +41,10 @@ type (
 
 	// Config tracks K9s configuration options.
 	Config struct {
-		K9s      *K9s `yaml:"k9s"`
-		client   client.Connection
-		settings KubeSettings
+		K9s       *K9s `yaml:"k9s"`
+		client    client.Connection
+		settings  KubeSettings
+		transient bool
 	}
 )
 
@@ -61,11 +62,21 @@ func K9sHome() string {
 	return xdgK9sHome
 }
 
+// K9sLocalesDir returns the user locale bundles directory.
+func K9sLocalesDir() string {
+	return filepath.Join(K9sHome(), "locales")
+}
+
 // NewConfig creates a new default config.
 func NewConfig(ks KubeSettings) *Config {
 	return &Config{K9s: NewK9s(), settings: ks}
 }
 
+// SetTransient prevents the configuration from being saved ie browsing a snapshot.
+func (c *Config) SetTransient(b bool) {
+	c.transient = b
+}
+
 // Refine the configuration based on cli args.
 func (c *Config) Refine(flags *genericclioptions.ConfigFlags, k9sFlags *Flags, cfg *client.Config) error {
 	if isSet(flags.Context) {
@@ -232,6 +243,9 @@ func (c *Config) Load(path string) error {
 // Save configuration to disk.
 func (c *Config) Save() error {
 	c.Validate()
+	if c.transient {
+		return nil
+	}
 
 	return c.SaveFile(K9sConfigFile)
 }
@@ -287,6 +287,8 @@ var expectedConfig = `k9s:
   noExitOnCtrlC: false
   noIcons: false
   skipLatestRevCheck: false
+  namespaceOverview: false
+  noTerminalTitle: false
   logger:
     tail: 500
     buffer: 800
@@ -307,6 +309,7 @@ var expectedConfig = `k9s:
         active: po
       featureGates:
         nodeShell: false
+        sanitizer: false
       shellPod:
         image: busybox:1.35.0
         command: []
@@ -331,6 +334,7 @@ var expectedConfig = `k9s:
         active: po
       featureGates:
         nodeShell: false
+        sanitizer: false
       shellPod:
         image: busybox:1.35.0
         command: []
@@ -355,6 +359,7 @@ var expectedConfig = `k9s:
         active: ctx
       featureGates:
         nodeShell: false
+        sanitizer: false
       shellPod:
         image: busybox:1.35.0
         command: []
@@ -386,6 +391,8 @@ var resetConfig = `k9s:
   noExitOnCtrlC: false
   noIcons: false
   skipLatestRevCheck: false
+  namespaceOverview: false
+  noTerminalTitle: false
   logger:
     tail: 200
     buffer: 2000
@@ -406,6 +413,7 @@ var resetConfig = `k9s:
         active: po
       featureGates:
         nodeShell: false
+        sanitizer: false
       shellPod:
         image: busybox:1.35.0
         command: []
@@ -0,0 +1,57 @@
+package config
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+const crashTimeFmt = "20060102-150405"
+
+// CrashReport represents a diagnostic bundle for an unexpected failure.
+type CrashReport struct {
+	Time         time.Time `yaml:"time"`
+	Version      string    `yaml:"version"`
+	Platform     string    `yaml:"platform"`
+	Context      string    `yaml:"context"`
+	Cluster      string    `yaml:"cluster"`
+	Namespace    string    `yaml:"namespace"`
+	View         string    `yaml:"view"`
+	ConfigDigest string    `yaml:"configDigest"`
+	Panic        string    `yaml:"panic"`
+	Stack        string    `yaml:"stack"`
+}
+
+// CrashesDir returns the crash reports directory.
+func CrashesDir() string {
+	return filepath.Join(K9sHome(), "crashes")
+}
+
+// SaveCrashReport writes a crash report and returns its location.
+func SaveCrashReport(dir string, r CrashReport) (string, error) {
+	if err := EnsureFullPath(dir, 0700); err != nil {
+		return "", err
+	}
+	raw, err := yaml.Marshal(r)
+	if err != nil {
+		return "", err
+	}
+	file := filepath.Join(dir, fmt.Sprintf("crash-%s-%d.yaml", r.Time.Format(crashTimeFmt), os.Getpid()))
+
+	return file, os.WriteFile(file, raw, 0600)
+}
+
+// ConfigDigest returns the sha256 digest of a configuration file so reports can tell
+// configurations apart without disclosing their content.
+func ConfigDigest(path string) string {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return "n/a"
+	}
+
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(raw))
+}
@@ -0,0 +1,49 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v2"
+)
+
+func TestSaveCrashReport(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "crashes")
+	r := config.CrashReport{
+		Time:      time.Date(2023, 3, 1, 10, 20, 30, 0, time.UTC),
+		Version:   "v0.27.3",
+		Context:   "c1",
+		Namespace: "ns1",
+		View:      "v1/pods",
+		Panic:     "boom",
+		Stack:     "goroutine 1 [running]:\nmain.main()",
+	}
+
+	file, err := config.SaveCrashReport(dir, r)
+	assert.NoError(t, err)
+	assert.True(t, strings.HasPrefix(filepath.Base(file), "crash-20230301-102030-"))
+
+	raw, err := os.ReadFile(file)
+	assert.NoError(t, err)
+	var back config.CrashReport
+	assert.NoError(t, yaml.Unmarshal(raw, &back))
+	assert.Equal(t, r, back)
+}
+
+func TestConfigDigest(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "config.yml")
+	assert.Equal(t, "n/a", config.ConfigDigest(file))
+
+	assert.NoError(t, os.WriteFile(file, []byte("k9s:\n"), 0600))
+	d := config.ConfigDigest(file)
+	assert.True(t, strings.HasPrefix(d, "sha256:"))
+	assert.Len(t, d, len("sha256:")+64)
+
+	assert.NoError(t, os.WriteFile(file, []byte("k9s:\n  readOnly: true\n"), 0600))
+	assert.NotEqual(t, d, config.ConfigDigest(file))
+}
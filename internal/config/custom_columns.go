@@ -0,0 +1,48 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// CustomColumn represents a user defined column sourced from a resource label,
+// annotation or json path expression ie {.spec.serviceAccountName}.
+type CustomColumn struct {
+	Name       string `yaml:"name"`
+	Label      string `yaml:"label,omitempty"`
+	Annotation string `yaml:"annotation,omitempty"`
+	JSONPath   string `yaml:"jsonPath,omitempty"`
+	Wide       bool   `yaml:"wide,omitempty"`
+}
+
+// CustomColumns tracks user defined columns per resource view ie v1/pods.
+type CustomColumns map[string][]CustomColumn
+
+// Validate drops columns missing a name or not specifying exactly one source.
+func (c CustomColumns) Validate(_ client.Connection, _ KubeSettings) {
+	for gvr, cc := range c {
+		valid := make([]CustomColumn, 0, len(cc))
+		for _, col := range cc {
+			col.Name = strings.ToUpper(strings.TrimSpace(col.Name))
+			if col.Name == "" || col.sources() != 1 {
+				log.Warn().Msgf("Skipping invalid custom column %q on %s. Expecting a name and one of label, annotation or jsonPath", col.Name, gvr)
+				continue
+			}
+			valid = append(valid, col)
+		}
+		c[gvr] = valid
+	}
+}
+
+func (c CustomColumn) sources() int {
+	var n int
+	for _, s := range []string{c.Label, c.Annotation, c.JSONPath} {
+		if s != "" {
+			n++
+		}
+	}
+
+	return n
+}
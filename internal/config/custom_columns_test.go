@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCustomColumnsValidate(t *testing.T) {
+	cc := config.CustomColumns{
+		"v1/pods": {
+			{Name: " team ", Label: "team"},
+			{Name: "version", Annotation: "app.kubernetes.io/version", Wide: true},
+			{Name: "sa", JSONPath: ".spec.serviceAccountName"},
+			{Name: "", Label: "app"},
+			{Name: "both", Label: "app", Annotation: "app"},
+			{Name: "none"},
+		},
+	}
+	cc.Validate(nil, nil)
+
+	assert.Equal(t, []config.CustomColumn{
+		{Name: "TEAM", Label: "team"},
+		{Name: "VERSION", Annotation: "app.kubernetes.io/version", Wide: true},
+		{Name: "SA", JSONPath: ".spec.serviceAccountName"},
+	}, cc["v1/pods"])
+}
+
+func TestActiveCustomColumns(t *testing.T) {
+	k := config.NewK9s()
+	k.CustomColumns = config.CustomColumns{"v1/pods": {{Name: "TEAM", Label: "team"}}}
+	k.CurrentCluster = "c1"
+	assert.Equal(t, "TEAM", k.ActiveCustomColumns("v1/pods")[0].Name)
+	assert.Empty(t, k.ActiveCustomColumns("v1/services"))
+
+	cl := config.NewCluster()
+	cl.CustomColumns = config.CustomColumns{"v1/pods": {{Name: "OWNER", Label: "owner"}}}
+	k.Clusters = map[string]*config.Cluster{"c1": cl}
+	assert.Equal(t, "OWNER", k.ActiveCustomColumns("v1/pods")[0].Name)
+}
@@ -0,0 +1,30 @@
+package config
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// Editor tracks the resource edit options.
+type Editor struct {
+	// DiffTool previews the changes before they are submitted. The original and edited
+	// manifests paths are appended to the command ie `diff -u --color` or `delta`.
+	DiffTool string `yaml:"diffTool"`
+}
+
+// NewEditor returns a new instance.
+func NewEditor() *Editor {
+	return &Editor{}
+}
+
+// Validate checks the editor options.
+func (e *Editor) Validate(_ client.Connection, _ KubeSettings) {
+	e.DiffTool = strings.TrimSpace(e.DiffTool)
+}
+
+// DraftsDir returns the rejected edits drafts directory for a given cluster.
+func DraftsDir(cluster string) string {
+	return filepath.Join(K9sHome(), "drafts", SanitizeFilename(cluster))
+}
@@ -3,6 +3,7 @@ package config
 // FeatureGates represents K9s opt-in features.
 type FeatureGates struct {
 	NodeShell bool `yaml:"nodeShell"`
+	Sanitizer bool `yaml:"sanitizer"`
 }
 
 // NewFeatureGates returns a new feature gate.
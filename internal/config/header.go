@@ -0,0 +1,96 @@
+package config
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// HeaderContext shows the current context.
+	HeaderContext = "context"
+	// HeaderCluster shows the current cluster.
+	HeaderCluster = "cluster"
+	// HeaderUser shows the current user.
+	HeaderUser = "user"
+	// HeaderK9s shows the K9s revision.
+	HeaderK9s = "k9s"
+	// HeaderK8s shows the Kubernetes revision.
+	HeaderK8s = "k8s"
+	// HeaderCPU shows the cluster cpu gauge.
+	HeaderCPU = "cpu"
+	// HeaderMEM shows the cluster memory gauge.
+	HeaderMEM = "mem"
+	// HeaderAPI shows the api server requests latency and errors.
+	HeaderAPI = "api"
+)
+
+// HeaderFields tracks the available header cluster info fields and their labels.
+var HeaderFields = map[string]string{
+	HeaderContext: "Context",
+	HeaderCluster: "Cluster",
+	HeaderUser:    "User",
+	HeaderK9s:     "K9s Rev",
+	HeaderK8s:     "K8s Rev",
+	HeaderCPU:     "CPU",
+	HeaderMEM:     "MEM",
+	HeaderAPI:     "API",
+}
+
+// Header tracks the header cluster info options.
+type Header struct {
+	Fields []string     `yaml:"fields,omitempty"`
+	Lines  []HeaderLine `yaml:"lines,omitempty"`
+}
+
+// HeaderLine represents a user defined cluster info line.
+type HeaderLine struct {
+	// Name tracks the line label.
+	Name string `yaml:"name"`
+	// Template tracks a go template evaluated against the cluster info.
+	Template string `yaml:"template,omitempty"`
+	// Command tracks a shell command whose first output line is shown.
+	Command string `yaml:"command,omitempty"`
+}
+
+// NewHeader returns a new instance.
+func NewHeader() *Header {
+	return &Header{
+		Fields: defaultHeaderFields(),
+	}
+}
+
+// Validate checks header fields and lines. If none are valid use defaults.
+func (h *Header) Validate(_ client.Connection, _ KubeSettings) {
+	ff := make([]string, 0, len(h.Fields))
+	for _, f := range h.Fields {
+		if _, ok := HeaderFields[f]; !ok {
+			log.Warn().Msgf("Unknown header field %q. Skipping!", f)
+			continue
+		}
+		ff = append(ff, f)
+	}
+	h.Fields = ff
+
+	ll := make([]HeaderLine, 0, len(h.Lines))
+	for _, l := range h.Lines {
+		if l.Name == "" || (l.Template == "" && l.Command == "") {
+			log.Warn().Msgf("Header line %q needs a name and a template or command. Skipping!", l.Name)
+			continue
+		}
+		ll = append(ll, l)
+	}
+	h.Lines = ll
+
+	if len(h.Fields) == 0 && len(h.Lines) == 0 {
+		h.Fields = defaultHeaderFields()
+	}
+}
+
+// Rows returns the number of cluster info lines.
+func (h *Header) Rows() int {
+	return len(h.Fields) + len(h.Lines)
+}
+
+func defaultHeaderFields() []string {
+	return []string{HeaderContext, HeaderCluster, HeaderUser, HeaderK9s, HeaderK8s, HeaderCPU, HeaderMEM, HeaderAPI}
+}
@@ -0,0 +1,56 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHeaderValidate(t *testing.T) {
+	uu := map[string]struct {
+		h      config.Header
+		fields []string
+		lines  int
+	}{
+		"empty": {
+			fields: []string{"context", "cluster", "user", "k9s", "k8s", "cpu", "mem", "api"},
+		},
+		"custom": {
+			h: config.Header{
+				Fields: []string{"cpu", "blee", "context"},
+				Lines: []config.HeaderLine{
+					{Name: "Env", Template: "{{ .Context }}"},
+					{Name: "NoSource"},
+					{Command: "date"},
+				},
+			},
+			fields: []string{"cpu", "context"},
+			lines:  1,
+		},
+		"linesOnly": {
+			h: config.Header{
+				Lines: []config.HeaderLine{{Name: "Date", Command: "date"}},
+			},
+			fields: []string{},
+			lines:  1,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.h.Validate(nil, nil)
+			assert.Equal(t, u.fields, u.h.Fields)
+			assert.Len(t, u.h.Lines, u.lines)
+		})
+	}
+}
+
+func TestK9sActiveHeader(t *testing.T) {
+	k := config.NewK9s()
+	assert.Equal(t, config.NewHeader(), k.ActiveHeader())
+
+	k.Header = &config.Header{Fields: []string{"user"}}
+	assert.Equal(t, []string{"user"}, k.ActiveHeader().Fields)
+}
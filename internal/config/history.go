@@ -0,0 +1,35 @@
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DefaultHistoryRevisions tracks the default number of revisions kept per object.
+const DefaultHistoryRevisions = 20
+
+// History tracks the local objects revisions shadow store options.
+type History struct {
+	// Enable records every revision of the objects K9s observes.
+	Enable bool `yaml:"enable"`
+	// MaxRevisions tracks the number of revisions kept per object.
+	MaxRevisions int `yaml:"maxRevisions"`
+}
+
+// NewHistory returns a new instance.
+func NewHistory() *History {
+	return &History{MaxRevisions: DefaultHistoryRevisions}
+}
+
+// Validate checks the history options.
+func (h *History) Validate(_ client.Connection, _ KubeSettings) {
+	if h.MaxRevisions <= 0 {
+		h.MaxRevisions = DefaultHistoryRevisions
+	}
+}
+
+// HistoryDir returns the shadow store directory for a given cluster.
+func HistoryDir(cluster string) string {
+	return filepath.Join(K9sHome(), "history", SanitizeFilename(cluster))
+}
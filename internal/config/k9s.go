@@ -1,6 +1,8 @@
 package config
 
 import (
+	"strings"
+
 	"github.com/derailed/k9s/internal/client"
 )
 
@@ -21,11 +23,24 @@ type K9s struct {
 	NoExitOnCtrlC       bool                `yaml:"noExitOnCtrlC"`
 	NoIcons             bool                `yaml:"noIcons"`
 	SkipLatestRevCheck  bool                `yaml:"skipLatestRevCheck"`
+	NamespaceOverview   bool                `yaml:"namespaceOverview"`
+	NoTerminalTitle     bool                `yaml:"noTerminalTitle"`
 	Logger              *Logger             `yaml:"logger"`
 	CurrentContext      string              `yaml:"currentContext"`
 	CurrentCluster      string              `yaml:"currentCluster"`
 	Clusters            map[string]*Cluster `yaml:"clusters,omitempty"`
 	Thresholds          Threshold           `yaml:"thresholds"`
+	Header              *Header             `yaml:"header,omitempty"`
+	History             *History            `yaml:"history,omitempty"`
+	Trash               *Trash              `yaml:"trash,omitempty"`
+	Editor              *Editor             `yaml:"editor,omitempty"`
+	Tracing             *Tracing            `yaml:"tracing,omitempty"`
+	MetricsEndpoint     *MetricsEndpoint    `yaml:"metricsEndpoint,omitempty"`
+	Budget              *Budget             `yaml:"budget,omitempty"`
+	CustomColumns       CustomColumns       `yaml:"customColumns,omitempty"`
+	MetricsProvider     *MetricsProvider    `yaml:"metricsProvider,omitempty"`
+	Language            string              `yaml:"language,omitempty"`
+	Gauges              string              `yaml:"gauges,omitempty"`
 	ScreenDumpDir       string              `yaml:"screenDumpDir"`
 	manualRefreshRate   int
 	manualHeadless      *bool
@@ -157,6 +172,94 @@ func (k *K9s) IsReadOnly() bool {
 	return readOnly
 }
 
+// ActiveHeader returns the header cluster info options.
+func (k *K9s) ActiveHeader() *Header {
+	if k.Header == nil {
+		return NewHeader()
+	}
+
+	return k.Header
+}
+
+// ActiveHistory returns the objects history options.
+func (k *K9s) ActiveHistory() *History {
+	if k.History == nil {
+		return NewHistory()
+	}
+
+	return k.History
+}
+
+// ActiveTrash returns the deleted objects stash options.
+func (k *K9s) ActiveTrash() *Trash {
+	if k.Trash == nil {
+		return NewTrash()
+	}
+
+	return k.Trash
+}
+
+// ActiveBudget returns the views guardrails.
+func (k *K9s) ActiveBudget() *Budget {
+	if k.Budget == nil {
+		return NewBudget()
+	}
+
+	return k.Budget
+}
+
+// ActiveCustomColumns returns the user defined columns for a given resource. Cluster
+// settings take precedence over the global ones.
+func (k *K9s) ActiveCustomColumns(gvr string) []CustomColumn {
+	if c, ok := k.Clusters[k.CurrentCluster]; ok {
+		if cc, ok := c.CustomColumns[gvr]; ok {
+			return cc
+		}
+	}
+
+	return k.CustomColumns[gvr]
+}
+
+// ActiveMetricsProvider returns the usage metrics source. Cluster settings take
+// precedence over the global ones.
+func (k *K9s) ActiveMetricsProvider() *MetricsProvider {
+	if c, ok := k.Clusters[k.CurrentCluster]; ok && c.MetricsProvider != nil {
+		return c.MetricsProvider
+	}
+	if k.MetricsProvider == nil {
+		return NewMetricsProvider()
+	}
+
+	return k.MetricsProvider
+}
+
+// ActiveMetricsEndpoint returns the k9s metrics endpoint options.
+func (k *K9s) ActiveMetricsEndpoint() *MetricsEndpoint {
+	if k.MetricsEndpoint == nil {
+		return NewMetricsEndpoint()
+	}
+
+	return k.MetricsEndpoint
+}
+
+// ActiveTracing returns the tracing options.
+func (k *K9s) ActiveTracing() *Tracing {
+	if k.Tracing == nil {
+		return NewTracing()
+	}
+
+	return k.Tracing
+}
+
+// ActiveEditor returns the resource edit options.
+func (k *K9s) ActiveEditor() *Editor {
+	if k.Editor == nil {
+		return NewEditor()
+	}
+
+	return k.Editor
+}
+
 // ActiveCluster returns the currently active cluster.
 func (k *K9s) ActiveCluster() *Cluster {
 	if k.Clusters == nil {
@@ -229,6 +332,33 @@ func (k *K9s) Validate(c client.Connection, ks KubeSettings) {
 		k.Thresholds = NewThreshold()
 	}
 	k.Thresholds.Validate(c, ks)
+	if k.Header != nil {
+		k.Header.Validate(c, ks)
+	}
+	if k.History != nil {
+		k.History.Validate(c, ks)
+	}
+	if k.Trash != nil {
+		k.Trash.Validate(c, ks)
+	}
+	if k.Editor != nil {
+		k.Editor.Validate(c, ks)
+	}
+	if k.Tracing != nil {
+		k.Tracing.Validate(c, ks)
+	}
+	if k.MetricsEndpoint != nil {
+		k.MetricsEndpoint.Validate(c, ks)
+	}
+	if k.Budget != nil {
+		k.Budget.Validate(c, ks)
+	}
+	k.CustomColumns.Validate(c, ks)
+	if k.MetricsProvider != nil {
+		k.MetricsProvider.Validate(c, ks)
+	}
+	k.Language = strings.ToLower(strings.TrimSpace(k.Language))
+	k.Gauges = strings.ToLower(strings.TrimSpace(k.Gauges))
 
 	if context, err := ks.CurrentContextName(); err == nil && len(k.CurrentContext) == 0 {
 		k.CurrentContext = context
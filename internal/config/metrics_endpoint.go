@@ -0,0 +1,32 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DefaultMetricsAddress tracks the default k9s metrics endpoint address.
+const DefaultMetricsAddress = "127.0.0.1:9797"
+
+// MetricsEndpoint tracks the k9s own prometheus metrics endpoint options.
+type MetricsEndpoint struct {
+	// Enable serves the k9s metrics. Default false.
+	Enable bool `yaml:"enable"`
+
+	// Address tracks the endpoint listen address.
+	Address string `yaml:"address"`
+}
+
+// NewMetricsEndpoint returns a new instance.
+func NewMetricsEndpoint() *MetricsEndpoint {
+	return &MetricsEndpoint{Address: DefaultMetricsAddress}
+}
+
+// Validate checks the endpoint options.
+func (m *MetricsEndpoint) Validate(_ client.Connection, _ KubeSettings) {
+	m.Address = strings.TrimSpace(m.Address)
+	if m.Address == "" {
+		m.Address = DefaultMetricsAddress
+	}
+}
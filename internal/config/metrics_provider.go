@@ -0,0 +1,44 @@
+package config
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+)
+
+// MetricsProvider tracks where nodes and pods usage metrics are sourced from.
+type MetricsProvider struct {
+	// Type tracks the metrics source. One of metrics-server or prometheus. Default metrics-server.
+	Type string `yaml:"type"`
+
+	// Address tracks the prometheus server address ie http://prometheus.monitoring:9090.
+	Address string `yaml:"address,omitempty"`
+
+	// Headers tracks extra prometheus request headers ie authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// NewMetricsProvider returns a new instance.
+func NewMetricsProvider() *MetricsProvider {
+	return &MetricsProvider{Type: client.MetricsServerProvider}
+}
+
+// Validate checks the provider options. Prometheus providers missing an address
+// revert to the metrics-server.
+func (m *MetricsProvider) Validate(_ client.Connection, _ KubeSettings) {
+	m.Type = strings.ToLower(strings.TrimSpace(m.Type))
+	m.Address = strings.TrimSuffix(strings.TrimSpace(m.Address), "/")
+	if m.Type == client.PrometheusProvider && m.Address == "" {
+		log.Warn().Msg("No prometheus address specified. Using metrics-server")
+		m.Type = client.MetricsServerProvider
+	}
+	if m.Type != client.PrometheusProvider {
+		m.Type = client.MetricsServerProvider
+	}
+}
+
+// IsPrometheus checks if metrics are sourced from prometheus.
+func (m *MetricsProvider) IsPrometheus() bool {
+	return m.Type == client.PrometheusProvider
+}
@@ -0,0 +1,55 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMetricsProviderValidate(t *testing.T) {
+	uu := map[string]struct {
+		p       config.MetricsProvider
+		kind, a string
+	}{
+		"default": {
+			kind: client.MetricsServerProvider,
+		},
+		"unknown": {
+			p:    config.MetricsProvider{Type: "fred"},
+			kind: client.MetricsServerProvider,
+		},
+		"no-address": {
+			p:    config.MetricsProvider{Type: "prometheus"},
+			kind: client.MetricsServerProvider,
+		},
+		"prometheus": {
+			p:    config.MetricsProvider{Type: " Prometheus ", Address: " http://prom:9090/ "},
+			kind: client.PrometheusProvider,
+			a:    "http://prom:9090",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			u.p.Validate(nil, nil)
+			assert.Equal(t, u.kind, u.p.Type)
+			assert.Equal(t, u.a, u.p.Address)
+		})
+	}
+}
+
+func TestK9sActiveMetricsProvider(t *testing.T) {
+	k := config.NewK9s()
+	k.CurrentCluster = "c1"
+	k.Clusters["c1"] = config.NewCluster()
+	assert.False(t, k.ActiveMetricsProvider().IsPrometheus())
+
+	k.MetricsProvider = &config.MetricsProvider{Type: client.PrometheusProvider, Address: "http://prom:9090"}
+	assert.Equal(t, "http://prom:9090", k.ActiveMetricsProvider().Address)
+
+	k.Clusters["c1"].MetricsProvider = &config.MetricsProvider{Type: client.PrometheusProvider, Address: "http://c1:9090"}
+	assert.Equal(t, "http://c1:9090", k.ActiveMetricsProvider().Address)
+}
@@ -0,0 +1,112 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const sessionExt = ".json"
+
+// SessionStatus tracks the location of a running session for terminal status bars.
+type SessionStatus struct {
+	PID       int       `json:"pid"`
+	Pane      string    `json:"pane,omitempty"`
+	Context   string    `json:"context"`
+	Cluster   string    `json:"cluster"`
+	Namespace string    `json:"namespace"`
+	View      string    `json:"view"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// String returns a compact status ie context/namespace:view.
+func (s SessionStatus) String() string {
+	return fmt.Sprintf("%s/%s:%s", s.Context, s.Namespace, s.View)
+}
+
+// SessionsDir returns the running sessions status directory.
+func SessionsDir() string {
+	return filepath.Join(K9sHome(), "sessions")
+}
+
+// SessionID returns a session id. Sessions running in tmux are keyed by pane.
+func SessionID(pane string, pid int) string {
+	if pane != "" {
+		return SanitizeFilename(strings.TrimPrefix(pane, "%"))
+	}
+
+	return "pid-" + strconv.Itoa(pid)
+}
+
+// SaveSessionStatus records a session status.
+func SaveSessionStatus(dir string, s SessionStatus) error {
+	if err := EnsureFullPath(dir, 0700); err != nil {
+		return err
+	}
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(sessionFile(dir, SessionID(s.Pane, s.PID)), raw, 0600)
+}
+
+// RemoveSessionStatus clears a session status.
+func RemoveSessionStatus(dir, id string) error {
+	if err := os.Remove(sessionFile(dir, id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// LoadSessionStatus returns the status of a tmux pane session or the most recently
+// updated session when no pane is given.
+func LoadSessionStatus(dir, pane string) (SessionStatus, error) {
+	if pane != "" {
+		return readSessionStatus(sessionFile(dir, SessionID(pane, 0)))
+	}
+
+	ee, err := os.ReadDir(dir)
+	if err != nil {
+		return SessionStatus{}, err
+	}
+	var latest SessionStatus
+	for _, e := range ee {
+		if e.IsDir() || filepath.Ext(e.Name()) != sessionExt {
+			continue
+		}
+		s, err := readSessionStatus(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		if s.UpdatedAt.After(latest.UpdatedAt) {
+			latest = s
+		}
+	}
+	if latest.UpdatedAt.IsZero() {
+		return latest, errors.New("no running k9s session found")
+	}
+
+	return latest, nil
+}
+
+func readSessionStatus(path string) (SessionStatus, error) {
+	var s SessionStatus
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return s, err
+	}
+
+	return s, json.Unmarshal(raw, &s)
+}
+
+func sessionFile(dir, id string) string {
+	return filepath.Join(dir, id+sessionExt)
+}
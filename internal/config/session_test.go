@@ -0,0 +1,41 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSessionStatus(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now().Truncate(time.Second)
+	s1 := config.SessionStatus{PID: 1, Pane: "%3", Context: "c1", Namespace: "ns1", View: "pods", UpdatedAt: now.Add(-time.Minute)}
+	s2 := config.SessionStatus{PID: 2, Context: "c2", Namespace: "all", View: "deployments", UpdatedAt: now}
+
+	_, err := config.LoadSessionStatus(dir, "")
+	assert.Error(t, err)
+
+	assert.NoError(t, config.SaveSessionStatus(dir, s1))
+	assert.NoError(t, config.SaveSessionStatus(dir, s2))
+
+	s, err := config.LoadSessionStatus(dir, "%3")
+	assert.NoError(t, err)
+	assert.Equal(t, "c1/ns1:pods", s.String())
+
+	s, err = config.LoadSessionStatus(dir, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "c2/all:deployments", s.String())
+
+	assert.NoError(t, config.RemoveSessionStatus(dir, config.SessionID("", 2)))
+	assert.NoError(t, config.RemoveSessionStatus(dir, config.SessionID("", 2)))
+	s, err = config.LoadSessionStatus(dir, "")
+	assert.NoError(t, err)
+	assert.Equal(t, 1, s.PID)
+}
+
+func TestSessionID(t *testing.T) {
+	assert.Equal(t, "3", config.SessionID("%3", 10))
+	assert.Equal(t, "pid-10", config.SessionID("", 10))
+}
@@ -0,0 +1,61 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+const (
+	// DefaultTracingEndpoint tracks the default OTLP/HTTP collector endpoint.
+	DefaultTracingEndpoint = "http://localhost:4318"
+
+	// DefaultTracingService tracks the default traces service name.
+	DefaultTracingService = "k9s"
+
+	otlpEndpointEnv = "OTEL_EXPORTER_OTLP_ENDPOINT"
+)
+
+// Tracing tracks the OpenTelemetry tracing options.
+type Tracing struct {
+	// Enable exports k9s operations spans. Default false.
+	Enable bool `yaml:"enable"`
+
+	// Endpoint tracks the OTLP/HTTP collector endpoint. Defaults to $OTEL_EXPORTER_OTLP_ENDPOINT
+	// or http://localhost:4318.
+	Endpoint string `yaml:"endpoint"`
+
+	// ServiceName tracks the service name reported with the spans.
+	ServiceName string `yaml:"serviceName"`
+
+	// Headers tracks extra request headers ie collector authentication.
+	Headers map[string]string `yaml:"headers,omitempty"`
+}
+
+// NewTracing returns a new instance.
+func NewTracing() *Tracing {
+	return &Tracing{
+		Endpoint:    defaultTracingEndpoint(),
+		ServiceName: DefaultTracingService,
+	}
+}
+
+// Validate checks the tracing options.
+func (t *Tracing) Validate(_ client.Connection, _ KubeSettings) {
+	t.Endpoint = strings.TrimSuffix(strings.TrimSpace(t.Endpoint), "/")
+	if t.Endpoint == "" {
+		t.Endpoint = defaultTracingEndpoint()
+	}
+	if t.ServiceName == "" {
+		t.ServiceName = DefaultTracingService
+	}
+}
+
+func defaultTracingEndpoint() string {
+	if e := strings.TrimSpace(os.Getenv(otlpEndpointEnv)); e != "" {
+		return strings.TrimSuffix(e, "/")
+	}
+
+	return DefaultTracingEndpoint
+}
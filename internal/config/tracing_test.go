@@ -0,0 +1,38 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTracingValidate(t *testing.T) {
+	uu := map[string]struct {
+		endpoint, env, e string
+	}{
+		"default": {
+			e: config.DefaultTracingEndpoint,
+		},
+		"env": {
+			env: "http://otel:4318/",
+			e:   "http://otel:4318",
+		},
+		"custom": {
+			endpoint: " https://collector.example.com/ ",
+			env:      "http://otel:4318",
+			e:        "https://collector.example.com",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			t.Setenv("OTEL_EXPORTER_OTLP_ENDPOINT", u.env)
+			tr := config.Tracing{Endpoint: u.endpoint}
+			tr.Validate(nil, nil)
+			assert.Equal(t, u.e, tr.Endpoint)
+			assert.Equal(t, config.DefaultTracingService, tr.ServiceName)
+		})
+	}
+}
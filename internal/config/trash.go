@@ -0,0 +1,41 @@
+package config
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+)
+
+// DefaultTrashRetention tracks the default deleted objects retention in minutes.
+const DefaultTrashRetention = 60
+
+// Trash tracks the deleted objects stash options.
+type Trash struct {
+	// Disable skips stashing objects prior to deletion.
+	Disable bool `yaml:"disable"`
+	// RetentionMinutes tracks how long a deleted object can be restored.
+	RetentionMinutes int `yaml:"retentionMinutes"`
+}
+
+// NewTrash returns a new instance.
+func NewTrash() *Trash {
+	return &Trash{RetentionMinutes: DefaultTrashRetention}
+}
+
+// Validate checks the trash options.
+func (t *Trash) Validate(_ client.Connection, _ KubeSettings) {
+	if t.RetentionMinutes <= 0 {
+		t.RetentionMinutes = DefaultTrashRetention
+	}
+}
+
+// Retention returns the deleted objects retention window.
+func (t *Trash) Retention() time.Duration {
+	return time.Duration(t.RetentionMinutes) * time.Minute
+}
+
+// TrashDir returns the deleted objects stash directory for a given cluster.
+func TrashDir(cluster string) string {
+	return filepath.Join(K9sHome(), "trash", SanitizeFilename(cluster))
+}
@@ -0,0 +1,186 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// serverMetaFields tracks pod metadata generated by the api server on create.
+var serverMetaFields = []string{"name", "uid", "creationTimestamp", "resourceVersion", "managedFields", "generateName", "namespace"}
+
+// AdmissionPreview represents the changes admission controllers apply to a workload pod.
+type AdmissionPreview struct {
+	Path string
+	// Pod tracks the dry-run pod name.
+	Pod string
+	// Changes tracks the fields set or updated server side.
+	Changes []FieldChange
+}
+
+// CanPreviewAdmission checks if a resource carries a pod spec that can be dry-run.
+func CanPreviewAdmission(gvr string) bool {
+	_, ok := podSpecPaths[gvr]
+	return ok
+}
+
+// PreviewAdmission submits a server side dry-run create of a workload pod and reports
+// what mutating webhooks and api server defaults changed.
+func PreviewAdmission(ctx context.Context, f Factory, gvr, path string) (AdmissionPreview, error) {
+	r := AdmissionPreview{Path: path}
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return r, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	po, err := previewPod(gvr, u)
+	if err != nil {
+		return r, err
+	}
+
+	dyn, err := f.Client().DynDial()
+	if err != nil {
+		return r, err
+	}
+	res, err := dyn.Resource(client.NewGVR("v1/pods").GVR()).Namespace(u.GetNamespace()).Create(ctx, po, metav1.CreateOptions{
+		DryRun: []string{metav1.DryRunAll},
+	})
+	if err != nil {
+		return r, fmt.Errorf("dry-run create failed: %w", err)
+	}
+	r.Pod = res.GetName()
+	r.Changes = AdmissionChanges(po.Object, res.Object)
+
+	return r, nil
+}
+
+// AdmissionChanges lists the pod fields set or updated on admission. Server generated
+// metadata and status are skipped. Named items ie containers are matched by name.
+func AdmissionChanges(submitted, admitted map[string]interface{}) []FieldChange {
+	sub, adm := trimServerFields(submitted), trimServerFields(admitted)
+	var cc []FieldChange
+	admissionDiff("", sub, adm, &cc)
+
+	return cc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// previewPod builds a pod out of a workload pod template.
+func previewPod(gvr string, u *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	specPath := podSpecPaths[gvr]
+	if len(specPath) == 0 {
+		return nil, fmt.Errorf("no pod spec found on %s", gvr)
+	}
+	spec, ok, err := unstructured.NestedMap(u.Object, specPath...)
+	if err != nil || !ok {
+		return nil, fmt.Errorf("no pod spec found on %s", u.GetName())
+	}
+
+	meta := map[string]interface{}{}
+	metaPath := append(append([]string{}, specPath[:len(specPath)-1]...), "metadata")
+	if m, ok, _ := unstructured.NestedMap(u.Object, metaPath...); ok {
+		meta = m
+	}
+	for _, k := range serverMetaFields {
+		delete(meta, k)
+	}
+	meta["generateName"] = u.GetName() + "-"
+	meta["namespace"] = u.GetNamespace()
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   meta,
+		"spec":       spec,
+	}}, nil
+}
+
+func trimServerFields(o map[string]interface{}) map[string]interface{} {
+	c := runtime.DeepCopyJSON(o)
+	delete(c, "status")
+	if meta, ok := c["metadata"].(map[string]interface{}); ok {
+		for _, k := range serverMetaFields {
+			delete(meta, k)
+		}
+	}
+
+	return c
+}
+
+func admissionDiff(path string, sub, adm interface{}, cc *[]FieldChange) {
+	sm, sok := sub.(map[string]interface{})
+	am, aok := adm.(map[string]interface{})
+	if sok && aok {
+		kk := make(map[string]struct{}, len(sm)+len(am))
+		for k := range sm {
+			kk[k] = struct{}{}
+		}
+		for k := range am {
+			kk[k] = struct{}{}
+		}
+		for _, k := range sortedKeys(kk) {
+			admissionDiff(fieldPath(path, k), sm[k], am[k], cc)
+		}
+		return
+	}
+
+	sl, sok := sub.([]interface{})
+	al, aok := adm.([]interface{})
+	if (sok || sub == nil) && (aok || adm == nil) && (len(sl) > 0 || len(al) > 0) {
+		if (len(sl) == 0 || namedItems(sl)) && (len(al) == 0 || namedItems(al)) {
+			namedAdmissionDiff(path, sl, al, cc)
+			return
+		}
+		if len(sl) == len(al) {
+			for i := range sl {
+				admissionDiff(fmt.Sprintf("%s[%d]", path, i), sl[i], al[i], cc)
+			}
+			return
+		}
+	}
+
+	if o, n := fieldValue(sub), fieldValue(adm); o != n {
+		*cc = append(*cc, FieldChange{Path: path, Old: o, New: n})
+	}
+}
+
+// namedAdmissionDiff matches list items by name. Injected items are reported once.
+func namedAdmissionDiff(path string, sub, adm []interface{}, cc *[]FieldChange) {
+	subs := make(map[string]interface{}, len(sub))
+	for _, s := range sub {
+		subs[itemName(s)] = s
+	}
+	seen := make(map[string]struct{}, len(adm))
+	for _, a := range adm {
+		n := itemName(a)
+		seen[n] = struct{}{}
+		s, ok := subs[n]
+		if !ok {
+			*cc = append(*cc, FieldChange{Path: fmt.Sprintf("%s[name=%s]", path, n), Old: noFieldValue, New: "injected"})
+			continue
+		}
+		admissionDiff(fmt.Sprintf("%s[name=%s]", path, n), s, a, cc)
+	}
+
+	var removed []string
+	for n := range subs {
+		if _, ok := seen[n]; !ok {
+			removed = append(removed, n)
+		}
+	}
+	sort.Strings(removed)
+	for _, n := range removed {
+		*cc = append(*cc, FieldChange{Path: fmt.Sprintf("%s[name=%s]", path, n), Old: fieldValue(subs[n]), New: "removed"})
+	}
+}
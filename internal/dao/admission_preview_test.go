@@ -0,0 +1,50 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdmissionChanges(t *testing.T) {
+	sub := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"generateName": "fred-",
+			"labels":       map[string]interface{}{"app": "fred"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "fred:1.0"},
+			},
+		},
+	}
+	adm := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "fred-x1z2",
+			"generateName":      "fred-",
+			"uid":               "123",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"app": "fred", "security.istio.io/tlsMode": "istio"},
+		},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{"name": "c1", "image": "fred:1.0", "imagePullPolicy": "IfNotPresent"},
+				map[string]interface{}{"name": "istio-proxy", "image": "proxyv2:1.18"},
+			},
+			"initContainers": []interface{}{
+				map[string]interface{}{"name": "istio-init", "image": "proxyv2:1.18"},
+			},
+			"priority": int64(0),
+		},
+		"status": map[string]interface{}{"phase": "Pending"},
+	}
+
+	assert.Equal(t, []dao.FieldChange{
+		{Path: `metadata.labels["security.istio.io/tlsMode"]`, Old: "<none>", New: "istio"},
+		{Path: "spec.containers[name=c1].imagePullPolicy", Old: "<none>", New: "IfNotPresent"},
+		{Path: "spec.containers[name=istio-proxy]", Old: "<none>", New: "injected"},
+		{Path: "spec.initContainers[name=istio-init]", Old: "<none>", New: "injected"},
+		{Path: "spec.priority", Old: "<none>", New: "0"},
+	}, dao.AdmissionChanges(sub, adm))
+}
@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	apfInQueueMetric      = "apiserver_flowcontrol_current_inqueue_requests"
+	apfExecutingMetric    = "apiserver_flowcontrol_current_executing_requests"
+	apfRejectedMetric     = "apiserver_flowcontrol_rejected_requests_total"
+	apfNominalSeatsMetric = "apiserver_flowcontrol_nominal_limit_seats"
+	apfConcurrencyMetric  = "apiserver_flowcontrol_request_concurrency_limit"
+
+	apfFlowSchemaKey    = "fs:"
+	apfPriorityLevelKey = "pl:"
+	apfInQueueKey       = ":inqueue"
+	apfExecutingKey     = ":executing"
+	apfRejectedKey      = ":rejected"
+	apfLimitKey         = ":limit"
+)
+
+var (
+	_ Accessor = (*FlowSchema)(nil)
+	_ Accessor = (*PriorityLevel)(nil)
+)
+
+var apfMetrics = newScrapeCache(apiMetricsTTL)
+
+// FlowSchema represents an API Priority and Fairness FlowSchema.
+type FlowSchema struct {
+	Resource
+}
+
+// Get returns a FlowSchema along with its current load.
+func (f *FlowSchema) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := f.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return withAPFLoad(o, apfFlowSchemaKey, apfLoads(ctx, f.Client()))
+}
+
+// List returns a collection of FlowSchemas along with their current load.
+func (f *FlowSchema) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := f.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	return withAPFLoads(oo, apfFlowSchemaKey, apfLoads(ctx, f.Client()))
+}
+
+// PriorityLevel represents an API Priority and Fairness PriorityLevelConfiguration.
+type PriorityLevel struct {
+	Resource
+}
+
+// Get returns a PriorityLevelConfiguration along with its current load.
+func (p *PriorityLevel) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := p.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return withAPFLoad(o, apfPriorityLevelKey, apfLoads(ctx, p.Client()))
+}
+
+// List returns a collection of PriorityLevelConfigurations along with their current load.
+func (p *PriorityLevel) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	return withAPFLoads(oo, apfPriorityLevelKey, apfLoads(ctx, p.Client()))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// apfLoads scrapes the api server flow control metrics. It returns nil if the metrics are not accessible.
+func apfLoads(ctx context.Context, c client.Connection) map[string]int64 {
+	if c == nil {
+		return nil
+	}
+	ctxName, _ := c.Config().CurrentContextName()
+	mm, ok := apfMetrics.get(ctxName, func() (map[string]int64, error) {
+		raw, err := apiGet(ctx, c, "/metrics", "")
+		if err != nil {
+			return nil, err
+		}
+		return apfServerMetrics(raw), nil
+	})
+	if !ok {
+		return nil
+	}
+
+	return mm
+}
+
+func withAPFLoads(oo []runtime.Object, kind string, mm map[string]int64) ([]runtime.Object, error) {
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		r, err := withAPFLoad(o, kind, mm)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, r)
+	}
+
+	return res, nil
+}
+
+func withAPFLoad(o runtime.Object, kind string, mm map[string]int64) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var load *render.APFLoad
+	if mm != nil {
+		k := kind + u.GetName()
+		load = &render.APFLoad{
+			InQueue:   mm[k+apfInQueueKey],
+			Executing: mm[k+apfExecutingKey],
+			Rejected:  mm[k+apfRejectedKey],
+			Limit:     mm[k+apfLimitKey],
+		}
+	}
+	if kind == apfFlowSchemaKey {
+		return &render.FlowSchemaWithLoad{Raw: u, Load: load}, nil
+	}
+
+	return &render.PriorityLevelWithLoad{Raw: u, Load: load}, nil
+}
+
+// apfServerMetrics extracts queued, executing and rejected requests per flow schema and
+// priority level along with the priority levels concurrency limits.
+func apfServerMetrics(raw string) map[string]int64 {
+	var (
+		mm     = make(map[string]int64)
+		legacy = make(map[string]int64)
+	)
+	add := func(ll, key string, v float64) {
+		if fs, ok := promLabel(ll, "flow_schema"); ok {
+			mm[apfFlowSchemaKey+fs+key] += int64(v)
+		}
+		if pl, ok := promLabel(ll, "priority_level"); ok {
+			mm[apfPriorityLevelKey+pl+key] += int64(v)
+		}
+	}
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := scanner.Text()
+		if ll, val, ok := promSample(l, apfInQueueMetric); ok {
+			add(ll, apfInQueueKey, parseSample(val))
+			continue
+		}
+		if ll, val, ok := promSample(l, apfExecutingMetric); ok {
+			add(ll, apfExecutingKey, parseSample(val))
+			continue
+		}
+		if ll, val, ok := promSample(l, apfRejectedMetric); ok {
+			add(ll, apfRejectedKey, parseSample(val))
+			continue
+		}
+		if ll, val, ok := promSample(l, apfNominalSeatsMetric); ok {
+			if pl, ok := promLabel(ll, "priority_level"); ok {
+				mm[apfPriorityLevelKey+pl+apfLimitKey] = int64(parseSample(val))
+			}
+			continue
+		}
+		if ll, val, ok := promSample(l, apfConcurrencyMetric); ok {
+			if pl, ok := promLabel(ll, "priority_level"); ok {
+				legacy[apfPriorityLevelKey+pl+apfLimitKey] = int64(parseSample(val))
+			}
+		}
+	}
+	for k, v := range legacy {
+		if _, ok := mm[k]; !ok {
+			mm[k] = v
+		}
+	}
+
+	return mm
+}
@@ -0,0 +1,32 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPFServerMetrics(t *testing.T) {
+	raw := `# HELP apiserver_flowcontrol_current_inqueue_requests [BETA] Number of requests currently pending in queues of the API Priority and Fairness subsystem
+apiserver_flowcontrol_current_inqueue_requests{flow_schema="service-accounts",priority_level="workload-low"} 3
+apiserver_flowcontrol_current_inqueue_requests{flow_schema="kube-system-service-accounts",priority_level="workload-low"} 1
+apiserver_flowcontrol_current_executing_requests{flow_schema="service-accounts",priority_level="workload-low"} 10
+apiserver_flowcontrol_rejected_requests_total{flow_schema="service-accounts",priority_level="workload-low",reason="queue-full"} 40
+apiserver_flowcontrol_rejected_requests_total{flow_schema="service-accounts",priority_level="workload-low",reason="time-out"} 2
+apiserver_flowcontrol_nominal_limit_seats{priority_level="workload-low"} 40
+apiserver_flowcontrol_request_concurrency_limit{priority_level="workload-low"} 35
+apiserver_flowcontrol_request_concurrency_limit{priority_level="global-default"} 20
+`
+
+	assert.Equal(t, map[string]int64{
+		"fs:service-accounts:inqueue":             3,
+		"fs:service-accounts:executing":           10,
+		"fs:service-accounts:rejected":            42,
+		"fs:kube-system-service-accounts:inqueue": 1,
+		"pl:workload-low:inqueue":                 4,
+		"pl:workload-low:executing":               10,
+		"pl:workload-low:rejected":                42,
+		"pl:workload-low:limit":                   40,
+		"pl:global-default:limit":                 20,
+	}, apfServerMetrics(raw))
+}
@@ -0,0 +1,263 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	apiMetricsTTL       = 15 * time.Second
+	apiLatencyMetric    = "apiserver_request_duration_seconds"
+	apiStorageMetric    = "apiserver_storage_objects"
+	apiEtcdCountMetric  = "etcd_object_counts"
+	apiLatencyAvgKey    = "avg:"
+	apiLatencyP99Key    = "p99:"
+	apiLatencyCountKey  = "count:"
+	apiStorageKey       = "objects:"
+	apiLatencyThreshold = time.Second
+)
+
+var _ Accessor = (*APIHealth)(nil)
+
+var apiMetrics = newScrapeCache(apiMetricsTTL)
+
+// APIHealth represents the api server health checks and metrics.
+type APIHealth struct {
+	NonResource
+}
+
+// List returns the api server readyz/livez checks, request latencies and etcd object counts.
+func (a *APIHealth) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	c := a.Client()
+	if c == nil {
+		return nil, fmt.Errorf("no client connection")
+	}
+
+	var oo []runtime.Object
+	for _, ep := range []string{"readyz", "livez"} {
+		raw, err := apiGet(ctx, c, "/"+ep, "verbose")
+		cc := healthChecks(ep, raw)
+		if len(cc) == 0 && err != nil {
+			cc = append(cc, render.APICheck{Section: ep, Name: "request", Value: render.NAValue, Issue: err.Error()})
+		}
+		for _, c := range cc {
+			oo = append(oo, c)
+		}
+	}
+
+	ctxName, _ := c.Config().CurrentContextName()
+	mm, ok := apiMetrics.get(ctxName, func() (map[string]int64, error) {
+		raw, err := apiGet(ctx, c, "/metrics", "")
+		if err != nil {
+			return nil, err
+		}
+		return apiServerMetrics(raw), nil
+	})
+	if !ok {
+		return append(oo, render.APICheck{Section: "metrics", Name: "request", Value: render.NAValue, Issue: "unable to scrape api server metrics"}), nil
+	}
+	for _, c := range metricChecks(mm) {
+		oo = append(oo, c)
+	}
+
+	return oo, nil
+}
+
+// Get returns a given check.
+func (a *APIHealth) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func apiGet(ctx context.Context, c client.Connection, path, param string) (string, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Config().CallTimeout())
+	defer cancel()
+
+	req := dial.Discovery().RESTClient().Get().AbsPath(path)
+	if param != "" {
+		req = req.Param(param, "")
+	}
+	raw, err := req.DoRaw(ctx)
+
+	return string(raw), err
+}
+
+// healthChecks parses a verbose readyz/livez output ie `[+]ping ok` or `[-]etcd failed: reason withheld`.
+func healthChecks(section, raw string) []render.APICheck {
+	var cc []render.APICheck
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	for scanner.Scan() {
+		l := strings.TrimSpace(scanner.Text())
+		if len(l) < 4 || l[0] != '[' || l[2] != ']' {
+			continue
+		}
+		name, status, _ := strings.Cut(l[3:], " ")
+		c := render.APICheck{Section: section, Name: name, Value: status}
+		if l[1] != '+' {
+			c.Issue = status
+			if status == "" {
+				c.Issue = "failed"
+			}
+		}
+		cc = append(cc, c)
+	}
+
+	return cc
+}
+
+// apiServerMetrics extracts request latencies per verb and storage object counts per resource.
+func apiServerMetrics(raw string) map[string]int64 {
+	var (
+		sums    = make(map[string]float64)
+		counts  = make(map[string]float64)
+		buckets = make(map[string]map[float64]float64)
+		mm      = make(map[string]int64)
+	)
+	scanner := bufio.NewScanner(strings.NewReader(raw))
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		l := scanner.Text()
+		if ll, val, ok := promSample(l, apiLatencyMetric+"_bucket"); ok {
+			verb, _ := promLabel(ll, "verb")
+			le, _ := promLabel(ll, "le")
+			b, err := strconv.ParseFloat(le, 64)
+			if err != nil || verb == "WATCH" || verb == "CONNECT" {
+				continue
+			}
+			if buckets[verb] == nil {
+				buckets[verb] = make(map[float64]float64)
+			}
+			buckets[verb][b] += parseSample(val)
+			continue
+		}
+		if ll, val, ok := promSample(l, apiLatencyMetric+"_sum"); ok {
+			verb, _ := promLabel(ll, "verb")
+			sums[verb] += parseSample(val)
+			continue
+		}
+		if ll, val, ok := promSample(l, apiLatencyMetric+"_count"); ok {
+			verb, _ := promLabel(ll, "verb")
+			counts[verb] += parseSample(val)
+			continue
+		}
+		for _, m := range []string{apiStorageMetric, apiEtcdCountMetric} {
+			if ll, val, ok := promSample(l, m); ok {
+				if res, ok := promLabel(ll, "resource"); ok {
+					mm[apiStorageKey+res] = int64(parseSample(val))
+				}
+			}
+		}
+	}
+
+	for verb, n := range counts {
+		if n == 0 || verb == "WATCH" || verb == "CONNECT" {
+			continue
+		}
+		mm[apiLatencyCountKey+verb] = int64(n)
+		mm[apiLatencyAvgKey+verb] = int64(sums[verb] / n * float64(time.Second))
+		if p, ok := bucketQuantile(0.99, buckets[verb]); ok {
+			mm[apiLatencyP99Key+verb] = int64(p * float64(time.Second))
+		}
+	}
+
+	return mm
+}
+
+// bucketQuantile returns the upper bound of the histogram bucket holding the given quantile.
+func bucketQuantile(q float64, bb map[float64]float64) (float64, bool) {
+	if len(bb) == 0 {
+		return 0, false
+	}
+	les := make([]float64, 0, len(bb))
+	for le := range bb {
+		les = append(les, le)
+	}
+	sort.Float64s(les)
+	total := bb[les[len(les)-1]]
+	if total == 0 {
+		return 0, false
+	}
+	for _, le := range les {
+		if bb[le] >= q*total {
+			if math.IsInf(le, 1) && len(les) > 1 {
+				return les[len(les)-2], true
+			}
+			return le, true
+		}
+	}
+
+	return les[len(les)-1], true
+}
+
+func metricChecks(mm map[string]int64) []render.APICheck {
+	var (
+		cc    []render.APICheck
+		verbs []string
+		res   []string
+	)
+	for k := range mm {
+		switch {
+		case strings.HasPrefix(k, apiLatencyCountKey):
+			verbs = append(verbs, strings.TrimPrefix(k, apiLatencyCountKey))
+		case strings.HasPrefix(k, apiStorageKey):
+			res = append(res, strings.TrimPrefix(k, apiStorageKey))
+		}
+	}
+	sort.Strings(verbs)
+	for _, v := range verbs {
+		avg, p99 := time.Duration(mm[apiLatencyAvgKey+v]), time.Duration(mm[apiLatencyP99Key+v])
+		c := render.APICheck{
+			Section: "latency",
+			Name:    v,
+			Value:   fmt.Sprintf("avg %s p99<=%s (%d reqs)", roundLatency(avg), roundLatency(p99), mm[apiLatencyCountKey+v]),
+		}
+		if p99 > apiLatencyThreshold {
+			c.Issue = fmt.Sprintf("p99 latency above %s", apiLatencyThreshold)
+		}
+		cc = append(cc, c)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if mm[apiStorageKey+res[i]] != mm[apiStorageKey+res[j]] {
+			return mm[apiStorageKey+res[i]] > mm[apiStorageKey+res[j]]
+		}
+		return res[i] < res[j]
+	})
+	for _, r := range res {
+		cc = append(cc, render.APICheck{Section: "etcd", Name: r, Value: strconv.FormatInt(mm[apiStorageKey+r], 10)})
+	}
+
+	return cc
+}
+
+func roundLatency(d time.Duration) time.Duration {
+	if d > time.Second {
+		return d.Round(10 * time.Millisecond)
+	}
+
+	return d.Round(100 * time.Microsecond)
+}
+
+func parseSample(s string) float64 {
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil || math.IsNaN(v) {
+		return 0
+	}
+
+	return v
+}
@@ -0,0 +1,89 @@
+package dao
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHealthChecks(t *testing.T) {
+	raw := `[+]ping ok
+[+]log ok
+[-]etcd failed: reason withheld
+[+]poststarthook/start-informers ok
+readyz check failed
+`
+
+	assert.Equal(t, []render.APICheck{
+		{Section: "readyz", Name: "ping", Value: "ok"},
+		{Section: "readyz", Name: "log", Value: "ok"},
+		{Section: "readyz", Name: "etcd", Value: "failed: reason withheld", Issue: "failed: reason withheld"},
+		{Section: "readyz", Name: "poststarthook/start-informers", Value: "ok"},
+	}, healthChecks("readyz", raw))
+}
+
+func TestAPIServerMetrics(t *testing.T) {
+	raw := `# TYPE apiserver_request_duration_seconds histogram
+apiserver_request_duration_seconds_bucket{component="apiserver",resource="pods",verb="GET",le="0.05"} 90
+apiserver_request_duration_seconds_bucket{component="apiserver",resource="pods",verb="GET",le="0.5"} 99
+apiserver_request_duration_seconds_bucket{component="apiserver",resource="pods",verb="GET",le="2"} 100
+apiserver_request_duration_seconds_bucket{component="apiserver",resource="pods",verb="GET",le="+Inf"} 100
+apiserver_request_duration_seconds_sum{component="apiserver",resource="pods",verb="GET"} 2
+apiserver_request_duration_seconds_count{component="apiserver",resource="pods",verb="GET"} 100
+apiserver_request_duration_seconds_bucket{component="apiserver",resource="pods",verb="WATCH",le="+Inf"} 4
+apiserver_request_duration_seconds_sum{component="apiserver",resource="pods",verb="WATCH"} 3600
+apiserver_request_duration_seconds_count{component="apiserver",resource="pods",verb="WATCH"} 4
+apiserver_storage_objects{resource="pods"} 120
+apiserver_storage_objects{resource="events"} 4500
+etcd_object_counts{resource="secrets"} 30
+`
+
+	mm := apiServerMetrics(raw)
+	assert.Equal(t, map[string]int64{
+		"count:GET":       100,
+		"avg:GET":         int64(20 * time.Millisecond),
+		"p99:GET":         int64(500 * time.Millisecond),
+		"objects:pods":    120,
+		"objects:events":  4500,
+		"objects:secrets": 30,
+	}, mm)
+
+	assert.Equal(t, []render.APICheck{
+		{Section: "latency", Name: "GET", Value: "avg 20ms p99<=500ms (100 reqs)"},
+		{Section: "etcd", Name: "events", Value: "4500"},
+		{Section: "etcd", Name: "pods", Value: "120"},
+		{Section: "etcd", Name: "secrets", Value: "30"},
+	}, metricChecks(mm))
+}
+
+func TestBucketQuantile(t *testing.T) {
+	uu := map[string]struct {
+		bb map[float64]float64
+		e  float64
+		ok bool
+	}{
+		"empty": {},
+		"slow": {
+			bb: map[float64]float64{0.1: 10, 1: 50, 5: 90, math.Inf(1): 100},
+			e:  5,
+			ok: true,
+		},
+		"fast": {
+			bb: map[float64]float64{0.1: 100, 1: 100, math.Inf(1): 100},
+			e:  0.1,
+			ok: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			v, ok := bucketQuantile(0.99, u.bb)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, v)
+		})
+	}
+}
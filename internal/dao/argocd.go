@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	argoCDRefreshAnnotation = "argocd.argoproj.io/refresh"
+	argoCDInitiator         = "k9s"
+)
+
+var _ Accessor = (*Application)(nil)
+
+// Application represents an Argo CD Application.
+type Application struct {
+	Resource
+}
+
+// Sync requests a new sync operation for an Application.
+func (a *Application) Sync(ctx context.Context, path string) error {
+	app, err := a.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if op := app.Status.OperationState; op != nil && op.Phase == "Running" {
+		return fmt.Errorf("a sync operation is already running on %s", path)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"operation": map[string]interface{}{
+			"initiatedBy": map[string]interface{}{"username": argoCDInitiator},
+			"sync": map[string]interface{}{
+				"syncStrategy": map[string]interface{}{"hook": map[string]interface{}{}},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// Refresh requests the Application manifests to be compared again against the live state.
+// A hard refresh also invalidates the manifests cache.
+func (a *Application) Refresh(ctx context.Context, path string, hard bool) error {
+	kind := "normal"
+	if hard {
+		kind = "hard"
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{argoCDRefreshAnnotation: kind},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return a.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// GetInstance returns an Application instance.
+func (a *Application) GetInstance(path string) (*render.ArgoCDApplication, error) {
+	o, err := a.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	var app render.ArgoCDApplication
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &app)
+	if err != nil {
+		return nil, fmt.Errorf("expecting Application resource: %w", err)
+	}
+
+	return &app, nil
+}
@@ -0,0 +1,54 @@
+package dao
+
+import (
+	"context"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	bannerTitleKey   = "title"
+	bannerMessageKey = "message"
+	bannerTitle      = "Cluster Notice"
+)
+
+// Banner represents a message displayed when connecting to a cluster.
+type Banner struct {
+	Title, Message string
+}
+
+// IsEmpty checks if the banner has anything to say.
+func (b Banner) IsEmpty() bool {
+	return b.Message == ""
+}
+
+// ClusterBanner returns the banner published by cluster admins in a given ns/name ConfigMap
+// along with a locally configured message. A missing or unreadable ConfigMap yields no
+// cluster banner.
+func ClusterBanner(ctx context.Context, c client.Connection, path, local string) (Banner, error) {
+	b := Banner{Title: bannerTitle}
+	ns, n := client.Namespaced(path)
+	dial, err := c.Dial()
+	if err != nil {
+		return b, err
+	}
+	cm, err := dial.CoreV1().ConfigMaps(ns).Get(ctx, n, metav1.GetOptions{})
+	switch {
+	case err == nil:
+		if t := strings.TrimSpace(cm.Data[bannerTitleKey]); t != "" {
+			b.Title = t
+		}
+		b.Message = strings.TrimSpace(cm.Data[bannerMessageKey])
+	case kerrors.IsNotFound(err), kerrors.IsForbidden(err):
+	default:
+		return b, err
+	}
+	if local != "" {
+		b.Message = strings.TrimSpace(strings.Join([]string{b.Message, local}, "\n\n"))
+	}
+
+	return b, nil
+}
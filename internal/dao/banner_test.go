@@ -0,0 +1,64 @@
+package dao_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestClusterBanner(t *testing.T) {
+	cm := v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "k9s-banner"},
+		Data:       map[string]string{"title": "Freeze", "message": " Change freeze in effect \n"},
+	}
+
+	uu := map[string]struct {
+		path, local string
+		e           dao.Banner
+	}{
+		"cluster": {
+			path: "kube-system/k9s-banner",
+			e:    dao.Banner{Title: "Freeze", Message: "Change freeze in effect"},
+		},
+		"both": {
+			path:  "kube-system/k9s-banner",
+			local: "Prod cluster!",
+			e:     dao.Banner{Title: "Freeze", Message: "Change freeze in effect\n\nProd cluster!"},
+		},
+		"local": {
+			path:  "kube-system/blee",
+			local: "Prod cluster!",
+			e:     dao.Banner{Title: "Cluster Notice", Message: "Prod cluster!"},
+		},
+		"none": {
+			path: "kube-system/blee",
+			e:    dao.Banner{Title: "Cluster Notice"},
+		},
+	}
+
+	c := bannerConn{conn: makeConn(), cs: fake.NewSimpleClientset(&cm)}
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			b, err := dao.ClusterBanner(context.Background(), &c, u.path, u.local)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, b)
+			assert.Equal(t, u.e.Message == "", b.IsEmpty())
+		})
+	}
+}
+
+// Helpers...
+
+type bannerConn struct {
+	*conn
+	cs kubernetes.Interface
+}
+
+func (c *bannerConn) Dial() (kubernetes.Interface, error) { return c.cs, nil }
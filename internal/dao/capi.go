@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Machine)(nil)
+
+// Machine represents a Cluster API Machine.
+type Machine struct {
+	Resource
+}
+
+// Remediate deletes a Machine so that its owner provisions a replacement.
+func (m *Machine) Remediate(ctx context.Context, path string) error {
+	mx, err := m.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if !capiManaged(mx.OwnerReferences) {
+		return fmt.Errorf("machine %s is not owned by a MachineSet or control plane and would not be replaced", path)
+	}
+	p := metav1.DeletePropagationBackground
+
+	return m.Delete(ctx, path, &p, DefaultGrace)
+}
+
+// GetInstance returns a Machine instance.
+func (m *Machine) GetInstance(path string) (*render.CAPIMachine, error) {
+	o, err := m.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var mx render.CAPIMachine
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &mx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &mx, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func capiManaged(refs []metav1.OwnerReference) bool {
+	for _, ref := range refs {
+		if ref.Controller == nil || !*ref.Controller {
+			continue
+		}
+		if ref.Kind == "MachineSet" || ref.Kind == "MachinePool" || strings.HasSuffix(ref.Kind, "ControlPlane") {
+			return true
+		}
+	}
+
+	return false
+}
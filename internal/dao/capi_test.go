@@ -0,0 +1,39 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestCAPIManaged(t *testing.T) {
+	yes, no := true, false
+	uu := map[string]struct {
+		refs []metav1.OwnerReference
+		e    bool
+	}{
+		"none": {},
+		"machineset": {
+			refs: []metav1.OwnerReference{{Kind: "MachineSet", Controller: &yes}},
+			e:    true,
+		},
+		"control-plane": {
+			refs: []metav1.OwnerReference{{Kind: "KubeadmControlPlane", Controller: &yes}},
+			e:    true,
+		},
+		"not-controller": {
+			refs: []metav1.OwnerReference{{Kind: "MachineSet", Controller: &no}},
+		},
+		"other": {
+			refs: []metav1.OwnerReference{{Kind: "Cluster", Controller: &yes}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, capiManaged(u.refs))
+		})
+	}
+}
@@ -0,0 +1,275 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/metadata"
+)
+
+// cascadeGVRs tracks the resources commonly garbage collected along with their owners.
+var cascadeGVRs = []string{
+	"apps/v1/controllerrevisions",
+	"apps/v1/daemonsets",
+	"apps/v1/deployments",
+	"apps/v1/replicasets",
+	"apps/v1/statefulsets",
+	"batch/v1/jobs",
+	"discovery.k8s.io/v1/endpointslices",
+	"v1/configmaps",
+	"v1/persistentvolumeclaims",
+	"v1/pods",
+	"v1/secrets",
+	"v1/services",
+}
+
+// CascadeRef represents a resource removed along with a deleted object.
+type CascadeRef struct {
+	GVR, FQN string
+	Depth    int
+}
+
+// Cascade tracks the resources removed along with a deleted object.
+type Cascade struct {
+	Refs []CascadeRef
+	// Partial is set when some dependents could not be listed.
+	Partial bool
+}
+
+// metaListFn lists the metadata of all resources of a given kind in a namespace.
+type metaListFn func(ctx context.Context, gvr client.GVR, ns string) ([]metav1.Object, error)
+
+// FindCascade enumerates the resources that would disappear when deleting a given object.
+// Deleting a namespace purges all its content, deleting a crd removes all its custom
+// resources, otherwise dependents are discovered via their ownerReferences.
+// Candidates are listed straight from the api server metadata rather than via informers.
+func FindCascade(ctx context.Context, f Factory, gvr, path string) (Cascade, error) {
+	list, err := metaLister(f.Client())
+	if err != nil {
+		return Cascade{}, err
+	}
+
+	return findCascade(ctx, f, list, gvr, path)
+}
+
+func findCascade(ctx context.Context, f Factory, list metaListFn, gvr, path string) (Cascade, error) {
+	switch gvr {
+	case "v1/namespaces":
+		_, ns := client.Namespaced(path)
+		return namespaceCascade(ctx, f.Client(), list, ns)
+	case crdGVR:
+		return crdCascade(ctx, f, list, path)
+	}
+
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return Cascade{}, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return Cascade{}, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	var (
+		c  Cascade
+		oo []cascadeObj
+	)
+	for _, g := range cascadeGVRs {
+		ll, err := list(ctx, client.NewGVR(g), u.GetNamespace())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Cascade list failed for %s", g)
+			c.Partial = true
+			continue
+		}
+		for _, l := range ll {
+			oo = append(oo, cascadeObj{Object: l, gvr: g})
+		}
+	}
+	c.Refs = ownedCascade(u.GetUID(), oo)
+
+	return c, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cascadeObj represents a resource metadata along with its kind.
+type cascadeObj struct {
+	metav1.Object
+	gvr string
+}
+
+// ownedCascade walks the ownerReferences graph from a given root uid.
+func ownedCascade(root types.UID, oo []cascadeObj) []CascadeRef {
+	children := make(map[types.UID][]cascadeObj)
+	for _, o := range oo {
+		for _, ref := range o.GetOwnerReferences() {
+			children[ref.UID] = append(children[ref.UID], o)
+		}
+	}
+
+	var (
+		refs    []CascadeRef
+		visited = map[types.UID]struct{}{root: {}}
+		queue   = []types.UID{root}
+	)
+	for depth := 1; len(queue) > 0; depth++ {
+		var next []types.UID
+		for _, uid := range queue {
+			for _, c := range children[uid] {
+				if _, ok := visited[c.GetUID()]; ok {
+					continue
+				}
+				visited[c.GetUID()] = struct{}{}
+				next = append(next, c.GetUID())
+				refs = append(refs, CascadeRef{
+					GVR:   c.gvr,
+					FQN:   client.FQN(c.GetNamespace(), c.GetName()),
+					Depth: depth,
+				})
+			}
+		}
+		queue = next
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].Depth != refs[j].Depth {
+			return refs[i].Depth < refs[j].Depth
+		}
+		if refs[i].GVR != refs[j].GVR {
+			return refs[i].GVR < refs[j].GVR
+		}
+		return refs[i].FQN < refs[j].FQN
+	})
+
+	return refs
+}
+
+// metaLister lists resources metadata directly from the api server, each call
+// bounded by the connection timeout. Kinds the server does not serve have no instances.
+func metaLister(c client.Connection) (metaListFn, error) {
+	if c == nil {
+		return nil, errors.New("no connection available")
+	}
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	dial, err := metadata.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(ctx context.Context, gvr client.GVR, ns string) ([]metav1.Object, error) {
+		ctx, cancel := context.WithTimeout(ctx, c.Config().CallTimeout())
+		defer cancel()
+
+		if client.IsAllNamespace(ns) || client.IsClusterScoped(ns) {
+			ns = metav1.NamespaceAll
+		}
+		ll, err := dial.Resource(gvr.GVR()).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if kerrors.IsNotFound(err) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		oo := make([]metav1.Object, 0, len(ll.Items))
+		for i := range ll.Items {
+			oo = append(oo, &ll.Items[i])
+		}
+
+		return oo, nil
+	}, nil
+}
+
+// crdCascade lists all the custom resources defined by a given crd.
+func crdCascade(ctx context.Context, f Factory, list metaListFn, path string) (Cascade, error) {
+	o, err := f.Get(crdGVR, path, true, labels.Everything())
+	if err != nil {
+		return Cascade{}, err
+	}
+	gvr, err := crdStorageGVR(o)
+	if err != nil {
+		return Cascade{}, err
+	}
+	oo, err := list(ctx, client.NewGVR(gvr), client.AllNamespaces)
+	if err != nil {
+		return Cascade{}, err
+	}
+
+	return Cascade{Refs: topLevelCascade(gvr, oo)}, nil
+}
+
+// crdStorageGVR returns the resource served by a crd storage version.
+func crdStorageGVR(o runtime.Object) (string, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+	plural, _, _ := unstructured.NestedString(u.Object, "spec", "names", "plural")
+	vv, _, _ := unstructured.NestedSlice(u.Object, "spec", "versions")
+	for _, v := range vv {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if storage, _ := m["storage"].(bool); storage {
+			name, _ := m["name"].(string)
+			return client.FromGVAndR(group+"/"+name, plural).String(), nil
+		}
+	}
+
+	return "", fmt.Errorf("no storage version found for crd %s", u.GetName())
+}
+
+// namespaceCascade lists all the resources purged along with a namespace.
+func namespaceCascade(ctx context.Context, c client.Connection, list metaListFn, ns string) (Cascade, error) {
+	disc, err := c.CachedDiscovery()
+	if err != nil {
+		return Cascade{}, err
+	}
+
+	var cascade Cascade
+	ll, err := disc.ServerPreferredNamespacedResources()
+	if err != nil {
+		if len(ll) == 0 {
+			return Cascade{}, err
+		}
+		log.Warn().Err(err).Msgf("Cascade discovery failed")
+		cascade.Partial = true
+	}
+	for _, gvr := range deletableGVRs(ll) {
+		oo, err := list(ctx, gvr, ns)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Cascade list failed for %s", gvr)
+			cascade.Partial = true
+			continue
+		}
+		cascade.Refs = append(cascade.Refs, topLevelCascade(gvr.String(), oo)...)
+	}
+
+	return cascade, nil
+}
+
+func topLevelCascade(gvr string, oo []metav1.Object) []CascadeRef {
+	refs := make([]CascadeRef, 0, len(oo))
+	for _, o := range oo {
+		refs = append(refs, CascadeRef{GVR: gvr, FQN: client.FQN(o.GetNamespace(), o.GetName()), Depth: 1})
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].FQN < refs[j].FQN
+	})
+
+	return refs
+}
@@ -0,0 +1,107 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestFindCascade(t *testing.T) {
+	dp := ownedObj("apps/v1", "Deployment", "dp1", "u1", "")
+	rs := ownedObj("apps/v1", "ReplicaSet", "rs1", "u2", "u1")
+	po1 := ownedObj("v1", "Pod", "p1", "u3", "u2")
+	po2 := ownedObj("v1", "Pod", "p2", "u4", "u2")
+	po3 := ownedObj("v1", "Pod", "p3", "u5", "")
+	f := relFactory{rows: map[string][]runtime.Object{
+		"apps/v1/deployments": {dp},
+		"apps/v1/replicasets": {rs},
+		"v1/pods":             {po1, po2, po3},
+	}}
+
+	list := func(_ context.Context, gvr client.GVR, _ string) ([]metav1.Object, error) {
+		if gvr.String() == "v1/secrets" {
+			return nil, errors.New("forbidden")
+		}
+		oo := make([]metav1.Object, 0, len(f.rows[gvr.String()]))
+		for _, o := range f.rows[gvr.String()] {
+			oo = append(oo, o.(*unstructured.Unstructured))
+		}
+		return oo, nil
+	}
+
+	uu := map[string]struct {
+		gvr, path string
+		e         []CascadeRef
+	}{
+		"deployment": {
+			gvr:  "apps/v1/deployments",
+			path: "default/dp1",
+			e: []CascadeRef{
+				{GVR: "apps/v1/replicasets", FQN: "default/rs1", Depth: 1},
+				{GVR: "v1/pods", FQN: "default/p1", Depth: 2},
+				{GVR: "v1/pods", FQN: "default/p2", Depth: 2},
+			},
+		},
+		"replicaset": {
+			gvr:  "apps/v1/replicasets",
+			path: "default/rs1",
+			e: []CascadeRef{
+				{GVR: "v1/pods", FQN: "default/p1", Depth: 1},
+				{GVR: "v1/pods", FQN: "default/p2", Depth: 1},
+			},
+		},
+		"leaf": {
+			gvr:  "v1/pods",
+			path: "default/p3",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c, err := findCascade(context.Background(), f, list, u.gvr, u.path)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, c.Refs)
+			assert.True(t, c.Partial)
+		})
+	}
+}
+
+func TestCRDStorageGVR(t *testing.T) {
+	crd := relObj("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "widgets.acme.io", nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"group": "acme.io",
+			"names": map[string]interface{}{"plural": "widgets"},
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1beta1", "storage": false},
+				map[string]interface{}{"name": "v1", "storage": true},
+			},
+		},
+	})
+
+	gvr, err := crdStorageGVR(crd)
+	assert.NoError(t, err)
+	assert.Equal(t, "acme.io/v1/widgets", gvr)
+
+	_, err = crdStorageGVR(relObj("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "bad", nil, map[string]interface{}{}))
+	assert.Error(t, err)
+}
+
+// Helpers...
+
+func ownedObj(apiVersion, kind, n, uid, owner string) *unstructured.Unstructured {
+	o := relObj(apiVersion, kind, "default", n, nil, map[string]interface{}{})
+	o.SetUID(types.UID(uid))
+	if owner != "" {
+		o.SetOwnerReferences([]metav1.OwnerReference{{UID: types.UID(owner)}})
+	}
+
+	return o
+}
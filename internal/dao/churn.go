@@ -0,0 +1,56 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ChurnTracker represents a factory recording its watches activity.
+type ChurnTracker interface {
+	// Churn returns the watch activity per resource.
+	Churn() []watch.ChurnStat
+}
+
+// Churn represents the watch activity of all watched resources.
+type Churn struct {
+	NonResource
+}
+
+// List returns the object counts and update rates of all watched resources.
+func (c *Churn) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	t, ok := c.GetFactory().(ChurnTracker)
+	if !ok {
+		return nil, fmt.Errorf("expecting a churn tracker but got %T", c.GetFactory())
+	}
+
+	ss := t.Churn()
+	oo := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		if !client.IsClusterWide(ns) && s.Namespace != ns {
+			continue
+		}
+		oo = append(oo, render.ChurnRes{
+			GVR:       s.GVR,
+			Namespace: s.Namespace,
+			Objects:   s.Objects,
+			Adds:      s.Adds,
+			Updates:   s.Updates,
+			Deletes:   s.Deletes,
+			Rate:      s.Rate,
+			Since:     s.Since,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given watch activity.
+func (c *Churn) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
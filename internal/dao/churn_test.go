@@ -0,0 +1,48 @@
+package dao
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChurnList(t *testing.T) {
+	since := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	f := churnFactory{stats: []watch.ChurnStat{
+		{GVR: "v1/configmaps", Namespace: "default", Objects: 3, Updates: 1200, Rate: 20, Since: since},
+		{GVR: "v1/pods", Namespace: client.AllNamespaces, Objects: 10, Adds: 2, Since: since},
+	}}
+	var c Churn
+	c.Init(f, client.NewGVR("churn"))
+
+	oo, err := c.List(context.Background(), client.AllNamespaces)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(oo))
+	assert.Equal(t, render.ChurnRes{GVR: "v1/configmaps", Namespace: "default", Objects: 3, Updates: 1200, Rate: 20, Since: since}, oo[0])
+
+	oo, err = c.List(context.Background(), "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	c.Init(relFactory{}, client.NewGVR("churn"))
+	_, err = c.List(context.Background(), client.AllNamespaces)
+	assert.NotNil(t, err)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type churnFactory struct {
+	relFactory
+
+	stats []watch.ChurnStat
+}
+
+func (f churnFactory) Churn() []watch.ChurnStat {
+	return f.stats
+}
@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	ciliumAgentLabel = "k8s-app"
+	ciliumAgentName  = "cilium"
+	ciliumHubblePort = "9965"
+	ciliumDropMetric = "hubble_drop_total"
+	ciliumDropsTTL   = 30 * time.Second
+)
+
+var _ Accessor = (*CiliumEndpoint)(nil)
+
+var ciliumDrops = newScrapeCache(ciliumDropsTTL)
+
+// CiliumEndpoint represents a CiliumEndpoint.
+type CiliumEndpoint struct {
+	Resource
+}
+
+// Get returns a CiliumEndpoint along with its dropped packets count.
+func (c *CiliumEndpoint) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := c.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return c.withDrops(ctx, o, make(map[string]string))
+}
+
+// List returns a collection of CiliumEndpoints along with their dropped packets count.
+func (c *CiliumEndpoint) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	agents, res := make(map[string]string), make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		ep, err := c.withDrops(ctx, o, agents)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, ep)
+	}
+
+	return res, nil
+}
+
+// withDrops decorates an endpoint with the drops reported by the hubble metrics
+// of the agent running on its node. Drops are only available when hubble drop
+// metrics are configured with a pod source or destination context.
+func (c *CiliumEndpoint) withDrops(ctx context.Context, o runtime.Object, agents map[string]string) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	ep := render.CiliumEndpointWithDrops{Raw: u}
+	node, _, _ := unstructured.NestedString(u.Object, "status", "networking", "node")
+	if node == "" {
+		return &ep, nil
+	}
+	agent, ok := agents[node]
+	if !ok {
+		agent, _ = runningPod(c.GetFactory(), client.AllNamespaces, labels.SelectorFromSet(labels.Set{ciliumAgentLabel: ciliumAgentName}), node)
+		agents[node] = agent
+	}
+	if agent == "" {
+		return &ep, nil
+	}
+	drops, ok := ciliumDrops.get(agent, func() (map[string]int64, error) {
+		raw, err := scrapePod(ctx, c.Client(), agent, ciliumHubblePort)
+		if err != nil {
+			return nil, err
+		}
+		return ciliumPodDrops(raw), nil
+	})
+	if !ok {
+		return &ep, nil
+	}
+	n := drops[client.FQN(u.GetNamespace(), u.GetName())]
+	ep.Drops = &n
+
+	return &ep, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ciliumPodDrops sums up dropped packets per pod from hubble metrics.
+// It returns nil if the metrics do not carry any pod context.
+func ciliumPodDrops(metrics string) map[string]int64 {
+	var (
+		drops      = make(map[string]int64)
+		hasContext bool
+	)
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		labels, val, ok := promSample(scanner.Text(), ciliumDropMetric)
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil {
+			continue
+		}
+		seen := make(map[string]struct{}, 2)
+		for _, l := range []string{"source", "destination"} {
+			pod, ok := promLabel(labels, l)
+			if !ok {
+				continue
+			}
+			hasContext = true
+			if _, ok := seen[pod]; ok || !strings.Contains(pod, "/") {
+				continue
+			}
+			seen[pod] = struct{}{}
+			drops[pod] += int64(v)
+		}
+	}
+	if !hasContext {
+		return nil
+	}
+
+	return drops
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCiliumPodDrops(t *testing.T) {
+	uu := map[string]struct {
+		metrics string
+		e       map[string]int64
+	}{
+		"no-context": {
+			metrics: `hubble_drop_total{protocol="TCP",reason="POLICY_DENIED"} 12
+`,
+		},
+		"context": {
+			metrics: `# TYPE hubble_drop_total counter
+hubble_drop_total{destination="default/be",protocol="TCP",reason="POLICY_DENIED",source="default/fe"} 12
+hubble_drop_total{destination="",protocol="UDP",reason="STALE_OR_UNROUTABLE_IP",source="default/fe"} 3
+hubble_drop_total{destination="default/fe",protocol="TCP",reason="POLICY_DENIED",source="default/fe"} 1
+hubble_flows_processed_total{source="default/fe"} 100
+`,
+			e: map[string]int64{"default/fe": 16, "default/be": 12},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ciliumPodDrops(u.metrics))
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	cnpgAPIVersion      = "postgresql.cnpg.io/v1"
+	cnpgBackupGVR       = "postgresql.cnpg.io/v1/backups"
+	cnpgMetricsPort     = "9187"
+	cnpgLagMetric       = "cnpg_pg_stat_replication_replay_lag_seconds"
+	cnpgLagTTL          = 15 * time.Second
+	cnpgStampFmt        = "20060102150405"
+	cnpgSwitchoverPhase = "Switchover in progress"
+)
+
+var _ Accessor = (*PGCluster)(nil)
+
+var cnpgLags = newScrapeCache(cnpgLagTTL)
+
+// PGCluster represents a CloudNativePG Cluster.
+type PGCluster struct {
+	Resource
+}
+
+// Get returns a Cluster along with its replicas lag.
+func (p *PGCluster) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := p.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return p.withLag(ctx, o)
+}
+
+// List returns a collection of Clusters along with their replicas lag.
+func (p *PGCluster) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		c, err := p.withLag(ctx, o)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, c)
+	}
+
+	return res, nil
+}
+
+// GetInstance returns a Cluster instance.
+func (p *PGCluster) GetInstance(path string) (*render.CNPGCluster, error) {
+	o, err := p.Resource.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var c render.CNPGCluster
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &c); err != nil {
+		return nil, err
+	}
+
+	return &c, nil
+}
+
+// SwitchoverTarget picks the healthy replica with the least replay lag.
+func (p *PGCluster) SwitchoverTarget(ctx context.Context, path string) (string, error) {
+	c, err := p.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+	var lags map[string]int64
+	if c.Status.CurrentPrimary != "" {
+		lags, _ = p.lags(ctx, client.FQN(c.Namespace, c.Status.CurrentPrimary))
+	}
+
+	return cnpgSwitchoverTarget(*c, lags)
+}
+
+// Switchover promotes a given replica as the new cluster primary.
+func (p *PGCluster) Switchover(ctx context.Context, path, target string) error {
+	c, err := p.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if c.Status.TargetPrimary != "" && c.Status.CurrentPrimary != c.Status.TargetPrimary {
+		return fmt.Errorf("cluster %s is already switching over to %s", path, c.Status.TargetPrimary)
+	}
+	if target == c.Status.CurrentPrimary {
+		return fmt.Errorf("%s is already the primary", target)
+	}
+	if !c.IsHealthy(target) {
+		return fmt.Errorf("%s is not a healthy instance of cluster %s", target, path)
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"status": map[string]interface{}{
+			"targetPrimary": target,
+			"phase":         cnpgSwitchoverPhase,
+			"phaseReason":   "Switching over to " + target,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.Patch(ctx, path, types.MergePatchType, patch, "status")
+}
+
+// Backup triggers an on-demand backup of a cluster.
+func (p *PGCluster) Backup(ctx context.Context, path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	b := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": cnpgAPIVersion,
+		"kind":       "Backup",
+		"metadata": map[string]interface{}{
+			"name":      n + "-" + time.Now().UTC().Format(cnpgStampFmt),
+			"namespace": ns,
+		},
+		"spec": map[string]interface{}{
+			"cluster": map[string]interface{}{
+				"name": n,
+			},
+		},
+	}}
+
+	var g Generic
+	g.Init(p.GetFactory(), client.NewGVR(cnpgBackupGVR))
+	o, err := g.Create(ctx, &b)
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(o.GetNamespace(), o.GetName()), nil
+}
+
+func (p *PGCluster) withLag(ctx context.Context, o runtime.Object) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	c := render.CNPGClusterWithLag{Raw: u}
+	primary, _, _ := unstructured.NestedString(u.Object, "status", "currentPrimary")
+	if primary == "" {
+		return &c, nil
+	}
+	if lags, ok := p.lags(ctx, client.FQN(u.GetNamespace(), primary)); ok {
+		c.Lags = lags
+	}
+
+	return &c, nil
+}
+
+// lags returns the replicas replay lag as reported by the primary instance.
+func (p *PGCluster) lags(ctx context.Context, primary string) (map[string]int64, bool) {
+	return cnpgLags.get(primary, func() (map[string]int64, error) {
+		raw, err := scrapePod(ctx, p.Client(), primary, cnpgMetricsPort)
+		if err != nil {
+			return nil, err
+		}
+		return cnpgReplicaLags(raw), nil
+	})
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cnpgReplicaLags extracts replay lag in seconds per replica from the primary metrics.
+func cnpgReplicaLags(metrics string) map[string]int64 {
+	lags := make(map[string]int64)
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		labels, val, ok := promSample(scanner.Text(), cnpgLagMetric)
+		if !ok {
+			continue
+		}
+		replica, ok := promLabel(labels, "application_name")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil || v < 0 {
+			continue
+		}
+		lags[replica] = int64(v)
+	}
+
+	return lags
+}
+
+func cnpgSwitchoverTarget(c render.CNPGCluster, lags map[string]int64) (string, error) {
+	var rr []string
+	for _, r := range c.Replicas() {
+		if c.IsHealthy(r) {
+			rr = append(rr, r)
+		}
+	}
+	if len(rr) == 0 {
+		return "", errors.New("no healthy replica available for switchover")
+	}
+	sort.SliceStable(rr, func(i, j int) bool {
+		return lags[rr[i]] < lags[rr[j]]
+	})
+
+	return rr[0], nil
+}
@@ -0,0 +1,61 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCNPGReplicaLags(t *testing.T) {
+	metrics := `# HELP cnpg_pg_stat_replication_replay_lag_seconds Time elapsed between flushing recent WAL locally and receiving notification that this standby server has written, flushed and applied it
+# TYPE cnpg_pg_stat_replication_replay_lag_seconds gauge
+cnpg_pg_stat_replication_replay_lag_seconds{application_name="pg-2",client_addr="10.0.0.2",state="streaming"} 0.5
+cnpg_pg_stat_replication_replay_lag_seconds{application_name="pg-3",client_addr="10.0.0.3",state="streaming"} 12.3
+cnpg_pg_replication_lag 0
+`
+
+	assert.Equal(t, map[string]int64{"pg-2": 0, "pg-3": 12}, cnpgReplicaLags(metrics))
+}
+
+func TestCNPGSwitchoverTarget(t *testing.T) {
+	uu := map[string]struct {
+		healthy []string
+		lags    map[string]int64
+		e       string
+		err     bool
+	}{
+		"by-name": {
+			healthy: []string{"pg-1", "pg-2", "pg-3"},
+			e:       "pg-2",
+		},
+		"by-lag": {
+			healthy: []string{"pg-1", "pg-2", "pg-3"},
+			lags:    map[string]int64{"pg-2": 10, "pg-3": 1},
+			e:       "pg-3",
+		},
+		"unhealthy": {
+			healthy: []string{"pg-1", "pg-3"},
+			lags:    map[string]int64{"pg-3": 10},
+			e:       "pg-3",
+		},
+		"none": {
+			healthy: []string{"pg-1"},
+			err:     true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var c render.CNPGCluster
+			c.Status.CurrentPrimary = "pg-1"
+			c.Status.InstanceNames = []string{"pg-3", "pg-1", "pg-2"}
+			c.Status.InstancesStatus = map[string][]string{render.CNPGHealthyState: u.healthy}
+
+			target, err := cnpgSwitchoverTarget(c, u.lags)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, target)
+		})
+	}
+}
@@ -0,0 +1,220 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	argoTrackingAnnotation = "argocd.argoproj.io/tracking-id"
+	fluxKsNameLabel        = "kustomize.toolkit.fluxcd.io/name"
+	fluxKsNamespaceLabel   = "kustomize.toolkit.fluxcd.io/namespace"
+	fluxHelmNameLabel      = "helm.toolkit.fluxcd.io/name"
+	fluxHelmNamespaceLabel = "helm.toolkit.fluxcd.io/namespace"
+)
+
+// gitOpsManagers tracks the field managers used by GitOps controllers server side applies.
+var gitOpsManagers = []string{"kustomize-controller", "helm-controller", "argocd-controller"}
+
+// DriftReport represents the drift between a live object and its applied configuration.
+type DriftReport struct {
+	Path string
+	// Source tracks the GitOps tool managing the object if any.
+	Source string
+	// Basis tracks what the live object was compared against.
+	Basis string
+	// Changes tracks the applied fields whose live values differ.
+	Changes []FieldChange
+	// Overrides tracks the GitOps applied fields claimed by other managers.
+	Overrides []render.ManagedFieldRes
+}
+
+// Drifted checks if the live object drifted from its applied configuration.
+func (d DriftReport) Drifted() bool {
+	return len(d.Changes) > 0 || len(d.Overrides) > 0
+}
+
+// ConfigDrift compares a live object with its last applied configuration or,
+// lacking one, with the fields server side applied by its GitOps controller.
+func ConfigDrift(f Factory, gvr, path string) (DriftReport, error) {
+	r := DriftReport{Path: path}
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return r, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	r.Source = gitOpsSource(u)
+
+	if raw, ok := u.GetAnnotations()[lastAppliedAnnotation]; ok {
+		var want map[string]interface{}
+		if err := json.Unmarshal([]byte(raw), &want); err != nil {
+			return r, fmt.Errorf("invalid last applied configuration: %w", err)
+		}
+		delete(want, "status")
+		r.Basis = "last-applied-configuration"
+		appliedDrift("", want, u.Object, &r.Changes)
+		return r, nil
+	}
+
+	manager, ok := gitOpsManager(u)
+	if !ok {
+		return r, nil
+	}
+	r.Basis = "fields applied by " + manager
+	ff, err := FieldOwners(u.GetManagedFields())
+	if err != nil {
+		return r, err
+	}
+	for _, f := range ff {
+		if f.Manager == manager && len(f.Conflicts) > 0 {
+			r.Overrides = append(r.Overrides, f)
+		}
+	}
+
+	return r, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// gitOpsSource returns the GitOps tool managing a given object if annotated.
+func gitOpsSource(u *unstructured.Unstructured) string {
+	if id, ok := u.GetAnnotations()[argoTrackingAnnotation]; ok {
+		if i := strings.Index(id, ":"); i > 0 {
+			id = id[:i]
+		}
+		return "argocd application " + id
+	}
+	ll := u.GetLabels()
+	if n, ok := ll[fluxKsNameLabel]; ok {
+		return "flux kustomization " + client.FQN(ll[fluxKsNamespaceLabel], n)
+	}
+	if n, ok := ll[fluxHelmNameLabel]; ok {
+		return "flux helmrelease " + client.FQN(ll[fluxHelmNamespaceLabel], n)
+	}
+
+	return ""
+}
+
+func gitOpsManager(u *unstructured.Unstructured) (string, bool) {
+	for _, m := range u.GetManagedFields() {
+		if m.Operation != "Apply" {
+			continue
+		}
+		for _, g := range gitOpsManagers {
+			if m.Manager == g {
+				return g, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// appliedDrift lists the applied fields whose live values differ. Fields
+// defaulted or added server side are not considered drift.
+func appliedDrift(path string, want, live interface{}, cc *[]FieldChange) {
+	switch w := want.(type) {
+	case nil:
+		return
+	case map[string]interface{}:
+		l, ok := live.(map[string]interface{})
+		if !ok {
+			if len(w) > 0 {
+				*cc = append(*cc, FieldChange{Path: path, Old: fieldValue(want), New: fieldValue(live)})
+			}
+			return
+		}
+		kk := make([]string, 0, len(w))
+		for k := range w {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		for _, k := range kk {
+			appliedDrift(fieldPath(path, k), w[k], l[k], cc)
+		}
+	case []interface{}:
+		l, ok := live.([]interface{})
+		if !ok {
+			if len(w) == 0 {
+				return
+			}
+			*cc = append(*cc, FieldChange{Path: path, Old: fieldValue(want), New: fieldValue(live)})
+			return
+		}
+		if namedItems(w) && namedItems(l) {
+			namedDrift(path, w, l, cc)
+			return
+		}
+		if len(w) != len(l) {
+			*cc = append(*cc, FieldChange{Path: path, Old: fieldValue(want), New: fieldValue(live)})
+			return
+		}
+		for i := range w {
+			appliedDrift(fmt.Sprintf("%s[%d]", path, i), w[i], l[i], cc)
+		}
+	default:
+		if o, n := fieldValue(want), fieldValue(live); o != n {
+			*cc = append(*cc, FieldChange{Path: path, Old: o, New: n})
+		}
+	}
+}
+
+// namedDrift matches list items by name ie containers, ports or env vars.
+func namedDrift(path string, want, live []interface{}, cc *[]FieldChange) {
+	seen := make(map[string]struct{}, len(want))
+	for _, w := range want {
+		n := itemName(w)
+		seen[n] = struct{}{}
+		var match interface{}
+		for _, l := range live {
+			if itemName(l) == n {
+				match = l
+				break
+			}
+		}
+		appliedDrift(fmt.Sprintf("%s[name=%s]", path, n), w, match, cc)
+	}
+	for _, l := range live {
+		if _, ok := seen[itemName(l)]; !ok {
+			*cc = append(*cc, FieldChange{
+				Path: fmt.Sprintf("%s[name=%s]", path, itemName(l)),
+				Old:  noFieldValue,
+				New:  fieldValue(l),
+			})
+		}
+	}
+}
+
+func namedItems(ii []interface{}) bool {
+	if len(ii) == 0 {
+		return false
+	}
+	for _, i := range ii {
+		if itemName(i) == "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+func itemName(i interface{}) string {
+	m, ok := i.(map[string]interface{})
+	if !ok {
+		return ""
+	}
+	n, _ := m["name"].(string)
+
+	return n
+}
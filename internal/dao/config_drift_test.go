@@ -0,0 +1,82 @@
+package dao
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestAppliedDrift(t *testing.T) {
+	var want map[string]interface{}
+	assert.Nil(t, json.Unmarshal([]byte(`{
+		"metadata": {"annotations": {}, "creationTimestamp": null, "name": "fred"},
+		"spec": {
+			"replicas": 2,
+			"volumes": [],
+			"containers": [{"name": "nginx", "image": "nginx:1.23", "ports": [{"containerPort": 80}]}]
+		}
+	}`), &want))
+	live := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "fred",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+			"annotations":       map[string]interface{}{lastAppliedAnnotation: "{}"},
+		},
+		"spec": map[string]interface{}{
+			"replicas":      int64(5),
+			"schedulerName": "default-scheduler",
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":            "nginx",
+					"image":           "nginx:1.23",
+					"imagePullPolicy": "IfNotPresent",
+					"ports":           []interface{}{map[string]interface{}{"containerPort": int64(80), "protocol": "TCP"}},
+				},
+				map[string]interface{}{"name": "debugger", "image": "busybox"},
+			},
+		},
+	}
+
+	var cc []FieldChange
+	appliedDrift("", want, live, &cc)
+	assert.Equal(t, []FieldChange{
+		{Path: "spec.containers[name=debugger]", Old: noFieldValue, New: `{"image":"busybox","name":"debugger"}`},
+		{Path: "spec.replicas", Old: "2", New: "5"},
+	}, cc)
+}
+
+func TestGitOpsSource(t *testing.T) {
+	uu := map[string]struct {
+		u *unstructured.Unstructured
+		e string
+	}{
+		"none": {
+			u: &unstructured.Unstructured{Object: map[string]interface{}{}},
+		},
+		"argo": {
+			u: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{argoTrackingAnnotation: "guestbook:apps/Deployment:default/fred"},
+				},
+			}},
+			e: "argocd application guestbook",
+		},
+		"flux": {
+			u: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"labels": map[string]interface{}{fluxKsNameLabel: "apps", fluxKsNamespaceLabel: "flux-system"},
+				},
+			}},
+			e: "flux kustomization flux-system/apps",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, gitOpsSource(u.u))
+		})
+	}
+}
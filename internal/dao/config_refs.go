@@ -0,0 +1,150 @@
+package dao
+
+import (
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// configIndex tracks the configmaps and secrets known to the cache.
+type configIndex struct {
+	cms, secs map[string]struct{}
+}
+
+// newConfigIndex indexes configmaps and secrets in a given namespace. A nil set denotes
+// resources that can not be checked ie not authorized or not synced yet.
+func newConfigIndex(f Factory, ns string) configIndex {
+	return configIndex{
+		cms:  cachedNames(f, "v1/configmaps", ns),
+		secs: cachedNames(f, "v1/secrets", ns),
+	}
+}
+
+// missing returns the configmaps and secrets referenced by a pod that do not exist.
+func (c configIndex) missing(ns string, spec *v1.PodSpec) []string {
+	cms, secs := configRefs(spec)
+
+	var mm []string
+	if c.cms != nil {
+		for _, n := range cms {
+			if _, ok := c.cms[client.FQN(ns, n)]; !ok {
+				mm = append(mm, "configmap/"+n)
+			}
+		}
+	}
+	if c.secs != nil {
+		for _, n := range secs {
+			if _, ok := c.secs[client.FQN(ns, n)]; !ok {
+				mm = append(mm, "secret/"+n)
+			}
+		}
+	}
+
+	return mm
+}
+
+// configRefs returns the required configmaps and secrets referenced by a pod spec.
+func configRefs(spec *v1.PodSpec) ([]string, []string) {
+	return podConfigRefs(spec, false)
+}
+
+// podConfigRefs returns the configmaps and secrets referenced by a pod spec, optional ones included if asked.
+func podConfigRefs(spec *v1.PodSpec, withOptional bool) ([]string, []string) {
+	skip := func(b *bool) bool {
+		return !withOptional && isOptional(b)
+	}
+	cms, secs := make(map[string]struct{}), make(map[string]struct{})
+	for _, v := range spec.Volumes {
+		if cm := v.ConfigMap; cm != nil && !skip(cm.Optional) {
+			cms[cm.Name] = struct{}{}
+		}
+		if sec := v.Secret; sec != nil && !skip(sec.Optional) {
+			secs[sec.SecretName] = struct{}{}
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if cm := s.ConfigMap; cm != nil && !skip(cm.Optional) {
+				cms[cm.Name] = struct{}{}
+			}
+			if sec := s.Secret; sec != nil && !skip(sec.Optional) {
+				secs[sec.Name] = struct{}{}
+			}
+		}
+	}
+	cc := make([]v1.Container, 0, len(spec.InitContainers)+len(spec.Containers))
+	cc = append(cc, spec.InitContainers...)
+	cc = append(cc, spec.Containers...)
+	for _, c := range cc {
+		for _, e := range c.EnvFrom {
+			if e.ConfigMapRef != nil && !skip(e.ConfigMapRef.Optional) {
+				cms[e.ConfigMapRef.Name] = struct{}{}
+			}
+			if e.SecretRef != nil && !skip(e.SecretRef.Optional) {
+				secs[e.SecretRef.Name] = struct{}{}
+			}
+		}
+		for _, e := range c.Env {
+			if e.ValueFrom == nil {
+				continue
+			}
+			if r := e.ValueFrom.ConfigMapKeyRef; r != nil && !skip(r.Optional) {
+				cms[r.Name] = struct{}{}
+			}
+			if r := e.ValueFrom.SecretKeyRef; r != nil && !skip(r.Optional) {
+				secs[r.Name] = struct{}{}
+			}
+		}
+	}
+
+	return sortedKeys(cms), sortedKeys(secs)
+}
+
+func cachedNames(f Factory, gvr, ns string) map[string]struct{} {
+	if f == nil {
+		return nil
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	inf, err := f.CanForResource(ns, gvr, client.MonitorAccess)
+	if err != nil || inf == nil || !inf.Informer().HasSynced() {
+		return nil
+	}
+	oo, err := f.List(gvr, ns, false, labels.Everything())
+	if err != nil {
+		return nil
+	}
+
+	return namesOf(oo)
+}
+
+func namesOf(oo []runtime.Object) map[string]struct{} {
+	nn := make(map[string]struct{}, len(oo))
+	for _, o := range oo {
+		if u, ok := o.(*unstructured.Unstructured); ok {
+			nn[client.FQN(u.GetNamespace(), u.GetName())] = struct{}{}
+		}
+	}
+
+	return nn
+}
+
+func isOptional(b *bool) bool {
+	return b != nil && *b
+}
+
+func sortedKeys(m map[string]struct{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		kk = append(kk, k)
+	}
+	sort.Strings(kk)
+
+	return kk
+}
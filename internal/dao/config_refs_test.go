@@ -0,0 +1,66 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestConfigIndexMissing(t *testing.T) {
+	optional := true
+	spec := v1.PodSpec{
+		Volumes: []v1.Volume{
+			{Name: "v1", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+			{Name: "v2", VolumeSource: v1.VolumeSource{Secret: &v1.SecretVolumeSource{SecretName: "sec1", Optional: &optional}}},
+			{Name: "v3", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{Secret: &v1.SecretProjection{LocalObjectReference: v1.LocalObjectReference{Name: "sec2"}}},
+				},
+			}}},
+		},
+		Containers: []v1.Container{
+			{
+				Name: "c1",
+				EnvFrom: []v1.EnvFromSource{
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm2"}}},
+				},
+				Env: []v1.EnvVar{
+					{Name: "e1", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "sec3"}, Key: "k"}}},
+				},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		idx configIndex
+		e   []string
+	}{
+		"all-missing": {
+			idx: configIndex{cms: map[string]struct{}{}, secs: map[string]struct{}{}},
+			e:   []string{"configmap/cm1", "configmap/cm2", "secret/sec2", "secret/sec3"},
+		},
+		"some": {
+			idx: configIndex{
+				cms:  map[string]struct{}{"default/cm1": {}, "default/cm2": {}},
+				secs: map[string]struct{}{"default/sec2": {}},
+			},
+			e: []string{"secret/sec3"},
+		},
+		"unchecked-secrets": {
+			idx: configIndex{cms: map[string]struct{}{"default/cm1": {}}},
+			e:   []string{"configmap/cm2"},
+		},
+		"other-ns": {
+			idx: configIndex{cms: map[string]struct{}{"fred/cm1": {}, "fred/cm2": {}}},
+			e:   []string{"configmap/cm1", "configmap/cm2"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, u.idx.missing("default", &spec))
+		})
+	}
+}
@@ -0,0 +1,219 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+// ContainerResources represents a workload container requests, limits and peak usage.
+type ContainerResources struct {
+	Name             string
+	Init             bool
+	Requests, Limits v1.ResourceList
+	Usage            v1.ResourceList
+}
+
+// ResourceSettings tracks the requests and limits to set on a container. Blank values
+// clear the setting.
+type ResourceSettings struct {
+	Requests, Limits map[v1.ResourceName]string
+}
+
+// WorkloadResources returns a workload containers resources along with their peak
+// usage across the workload pods. Usage is empty when metrics are not available.
+func WorkloadResources(ctx context.Context, f Factory, gvr, path string) ([]ContainerResources, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "template", "spec")
+	if err != nil || !ok {
+		return nil, fmt.Errorf("no pod template found on %s", path)
+	}
+	var spec v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+		return nil, err
+	}
+
+	rr := make([]ContainerResources, 0, len(spec.InitContainers)+len(spec.Containers))
+	for i, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			rr = append(rr, ContainerResources{
+				Name:     co.Name,
+				Init:     i == 0,
+				Requests: co.Resources.Requests,
+				Limits:   co.Resources.Limits,
+			})
+		}
+	}
+
+	mm, err := workloadPodsMetrics(ctx, f, u)
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to fetch %s pods metrics", path)
+		return rr, nil
+	}
+	peakUsage(rr, mm)
+
+	return rr, nil
+}
+
+// SetContainerResources patches a workload container requests and limits.
+func SetContainerResources(ctx context.Context, f Factory, gvr, path string, co ContainerResources, rs ResourceSettings) error {
+	patch, err := resourcesPatch(co, rs)
+	if err != nil {
+		return err
+	}
+	var g Generic
+	g.Init(f, client.NewGVR(gvr))
+
+	return g.Patch(ctx, path, types.StrategicMergePatchType, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func workloadPodsMetrics(ctx context.Context, f Factory, u *unstructured.Unstructured) ([]*mv1beta1.PodMetrics, error) {
+	if !f.Client().HasMetrics() {
+		return nil, nil
+	}
+	m, ok, err := unstructured.NestedMap(u.Object, "spec", "selector")
+	if err != nil || !ok {
+		return nil, err
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return nil, err
+	}
+	pp, err := f.List("v1/pods", u.GetNamespace(), true, sel)
+	if err != nil {
+		return nil, err
+	}
+	pmx, err := client.DialMetrics(f.Client()).FetchPodsMetricsMap(ctx, u.GetNamespace())
+	if err != nil {
+		return nil, err
+	}
+	mm := make([]*mv1beta1.PodMetrics, 0, len(pp))
+	for _, p := range pp {
+		po, ok := p.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if mx, ok := pmx[client.FQN(po.GetNamespace(), po.GetName())]; ok {
+			mm = append(mm, mx)
+		}
+	}
+
+	return mm, nil
+}
+
+// peakUsage records the highest usage of each container across the given pods metrics.
+func peakUsage(rr []ContainerResources, mm []*mv1beta1.PodMetrics) {
+	for i := range rr {
+		for _, mx := range mm {
+			for _, co := range mx.Containers {
+				if co.Name != rr[i].Name {
+					continue
+				}
+				if rr[i].Usage == nil {
+					rr[i].Usage = make(v1.ResourceList, 2)
+				}
+				for _, n := range []v1.ResourceName{v1.ResourceCPU, v1.ResourceMemory} {
+					q, ok := co.Usage[n]
+					if !ok {
+						continue
+					}
+					if peak, ok := rr[i].Usage[n]; !ok || q.Cmp(peak) > 0 {
+						rr[i].Usage[n] = q.DeepCopy()
+					}
+				}
+			}
+		}
+	}
+}
+
+// resourcesPatch builds a strategic merge patch setting a container requests and limits.
+func resourcesPatch(co ContainerResources, rs ResourceSettings) ([]byte, error) {
+	requests, err := quantities(rs.Requests)
+	if err != nil {
+		return nil, err
+	}
+	limits, err := quantities(rs.Limits)
+	if err != nil {
+		return nil, err
+	}
+	for n, r := range requests {
+		l, ok := limits[n]
+		if r == nil || !ok || l == nil {
+			continue
+		}
+		rq, lq := resource.MustParse(*r), resource.MustParse(*l)
+		if rq.Cmp(lq) > 0 {
+			return nil, fmt.Errorf("%s request %s exceeds limit %s", n, *r, *l)
+		}
+	}
+	res := make(map[string]interface{}, 2)
+	if len(requests) > 0 {
+		res["requests"] = requests
+	}
+	if len(limits) > 0 {
+		res["limits"] = limits
+	}
+	field := "containers"
+	if co.Init {
+		field = "initContainers"
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					field: []interface{}{
+						map[string]interface{}{
+							"name":      co.Name,
+							"resources": res,
+						},
+					},
+				},
+			},
+		},
+	})
+}
+
+// quantities validates resource quantities. Blank quantities map to nil to clear them.
+func quantities(m map[v1.ResourceName]string) (map[v1.ResourceName]*string, error) {
+	qq := make(map[v1.ResourceName]*string, len(m))
+	for n, v := range m {
+		if v == "" {
+			qq[n] = nil
+			continue
+		}
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s quantity %q", n, v)
+		}
+		s := q.String()
+		qq[n] = &s
+	}
+
+	return qq, nil
+}
@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestResourcesPatch(t *testing.T) {
+	uu := map[string]struct {
+		co  ContainerResources
+		rs  ResourceSettings
+		e   string
+		err string
+	}{
+		"set": {
+			co: ContainerResources{Name: "app"},
+			rs: ResourceSettings{
+				Requests: map[v1.ResourceName]string{v1.ResourceCPU: "0.1"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceMemory: "256Mi"},
+			},
+			e: `{"spec":{"template":{"spec":{"containers":[{"name":"app","resources":{"limits":{"memory":"256Mi"},"requests":{"cpu":"100m"}}}]}}}}`,
+		},
+		"clear-init": {
+			co: ContainerResources{Name: "init", Init: true},
+			rs: ResourceSettings{
+				Limits: map[v1.ResourceName]string{v1.ResourceCPU: ""},
+			},
+			e: `{"spec":{"template":{"spec":{"initContainers":[{"name":"init","resources":{"limits":{"cpu":null}}}]}}}}`,
+		},
+		"invalid": {
+			co: ContainerResources{Name: "app"},
+			rs: ResourceSettings{
+				Requests: map[v1.ResourceName]string{v1.ResourceCPU: "lots"},
+			},
+			err: `invalid cpu quantity "lots"`,
+		},
+		"request-over-limit": {
+			co: ContainerResources{Name: "app"},
+			rs: ResourceSettings{
+				Requests: map[v1.ResourceName]string{v1.ResourceMemory: "1Gi"},
+				Limits:   map[v1.ResourceName]string{v1.ResourceMemory: "512Mi"},
+			},
+			err: "memory request 1Gi exceeds limit 512Mi",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := resourcesPatch(u.co, u.rs)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
+
+func TestPeakUsage(t *testing.T) {
+	rr := []ContainerResources{{Name: "app"}, {Name: "sidecar"}}
+	peakUsage(rr, []*mv1beta1.PodMetrics{
+		makePodMetrics("app", "100m", "64Mi"),
+		makePodMetrics("app", "250m", "32Mi"),
+	})
+
+	assert.Equal(t, "250m", rr[0].Usage.Cpu().String())
+	assert.Equal(t, "64Mi", rr[0].Usage.Memory().String())
+	assert.Nil(t, rr[1].Usage)
+}
+
+// Helpers...
+
+func makePodMetrics(co, cpu, mem string) *mv1beta1.PodMetrics {
+	return &mv1beta1.PodMetrics{
+		Containers: []mv1beta1.ContainerMetrics{
+			{
+				Name: co,
+				Usage: v1.ResourceList{
+					v1.ResourceCPU:    resource.MustParse(cpu),
+					v1.ResourceMemory: resource.MustParse(mem),
+				},
+			},
+		},
+	}
+}
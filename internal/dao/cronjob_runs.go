@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// List returns a collection of cronjobs along with their last run outcome.
+func (c *CronJob) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := c.Generic.List(ctx, ns)
+	if err != nil || len(oo) == 0 {
+		return oo, err
+	}
+
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	jj, jerr := c.GetFactory().List(jobGVR, ns, false, labels.Everything())
+	if jerr != nil {
+		log.Warn().Err(jerr).Msgf("cronjob jobs list failed")
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		cj := render.CronJobWithLastRun{Raw: u}
+		if jerr == nil {
+			if cj.Result, err = lastRunResult(string(u.GetUID()), jj); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, &cj)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// lastRunResult returns the outcome of the most recent job spawned by a cronjob.
+func lastRunResult(uid string, jj []runtime.Object) (string, error) {
+	var last *batchv1.Job
+	for _, o := range jj {
+		if !IsOwnedBy(o, uid) {
+			continue
+		}
+		var job batchv1.Job
+		if err := fromUnstructured(o, &job); err != nil {
+			return "", err
+		}
+		if last == nil || last.CreationTimestamp.Before(&job.CreationTimestamp) {
+			last = &job
+		}
+	}
+	if last == nil {
+		return "", nil
+	}
+	for _, c := range last.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		if c.Type == batchv1.JobComplete || c.Type == batchv1.JobFailed {
+			return string(c.Type), nil
+		}
+	}
+
+	return "Running", nil
+}
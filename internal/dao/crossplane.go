@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const crossplaneMaxDepth = 10
+
+// crossplaneCategories tracks the CRD categories flagging Crossplane resources.
+var crossplaneCategories = []string{"crossplane", "claim", "composite"}
+
+var _ Accessor = (*Crossplane)(nil)
+
+// IsCrossplane checks if a resource is a Crossplane claim, composite or managed resource.
+func IsCrossplane(gvr client.GVR) bool {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return false
+	}
+	for _, c := range meta.Categories {
+		for _, cc := range crossplaneCategories {
+			if c == cc {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Crossplane represents a Crossplane claim, composite or managed resource.
+type Crossplane struct {
+	Resource
+}
+
+// ResourceRef returns the composite resource bound to a claim.
+func (c *Crossplane) ResourceRef(path string) (client.GVR, string, error) {
+	o, err := c.GetInstance(context.Background(), c.gvr, path)
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	ref := o.Spec.ResourceRef
+	if ref == nil {
+		return client.GVR{}, "", fmt.Errorf("%s is not bound to a composite resource", path)
+	}
+	gvr, namespaced, ok := crossplaneGVR(*ref)
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("no resource found for %s %s", ref.APIVersion, ref.Kind)
+	}
+
+	return gvr, crossplaneFQN(*ref, o.Namespace, namespaced), nil
+}
+
+// Tree returns the composition tree rooted at a given resource.
+func (c *Crossplane) Tree(ctx context.Context, path string) (*render.CrossplaneNode, error) {
+	return c.tree(ctx, c.gvr, path, 0)
+}
+
+// GetInstance returns a Crossplane resource instance.
+func (c *Crossplane) GetInstance(ctx context.Context, gvr client.GVR, path string) (*render.CrossplaneObject, error) {
+	var g Generic
+	g.Init(c.GetFactory(), gvr)
+	o, err := g.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var xo render.CrossplaneObject
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &xo)
+	if err != nil {
+		return nil, err
+	}
+
+	return &xo, nil
+}
+
+func (c *Crossplane) tree(ctx context.Context, gvr client.GVR, path string, depth int) (*render.CrossplaneNode, error) {
+	if depth > crossplaneMaxDepth {
+		return nil, errors.New("composition tree is too deep")
+	}
+	o, err := c.GetInstance(ctx, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	kind := gvr.R()
+	if meta, err := MetaAccess.MetaFor(gvr); err == nil {
+		kind = meta.Kind
+	}
+	n := render.NewCrossplaneNode(kind, *o)
+
+	refs := o.Refs()
+	if o.Spec.ResourceRef != nil {
+		refs = []render.CrossplaneRef{*o.Spec.ResourceRef}
+	}
+	for _, ref := range refs {
+		cgvr, namespaced, ok := crossplaneGVR(ref)
+		if !ok {
+			n.Children = append(n.Children, &render.CrossplaneNode{Kind: ref.Kind, Name: ref.Name, Message: "unknown resource"})
+			continue
+		}
+		child, err := c.tree(ctx, cgvr, crossplaneFQN(ref, o.Namespace, namespaced), depth+1)
+		if err != nil {
+			n.Children = append(n.Children, &render.CrossplaneNode{Kind: ref.Kind, Name: ref.Name, Message: err.Error()})
+			continue
+		}
+		n.Children = append(n.Children, child)
+	}
+
+	return n, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func crossplaneGVR(ref render.CrossplaneRef) (client.GVR, bool, bool) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return client.GVR{}, false, false
+	}
+	for _, gvr := range MetaAccess.AllGVRs() {
+		meta, err := MetaAccess.MetaFor(gvr)
+		if err != nil || meta.Kind != ref.Kind || gvr.G() != gv.Group {
+			continue
+		}
+		return client.NewGVR(gv.String() + "/" + meta.Name), meta.Namespaced, true
+	}
+
+	return client.GVR{}, false, false
+}
+
+func crossplaneFQN(ref render.CrossplaneRef, ns string, namespaced bool) string {
+	if !namespaced {
+		return client.FQN(client.ClusterScope, ref.Name)
+	}
+	if ref.Namespace != "" {
+		ns = ref.Namespace
+	}
+
+	return client.FQN(ns, ref.Name)
+}
@@ -0,0 +1,71 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestIsCrossplane(t *testing.T) {
+	MetaAccess.RegisterMeta("database.example.org/v1alpha1/postgresqlinstances", metav1.APIResource{
+		Name:       "postgresqlinstances",
+		Kind:       "PostgreSQLInstance",
+		Group:      "database.example.org",
+		Namespaced: true,
+		Categories: []string{"claim", CRD},
+	})
+	MetaAccess.RegisterMeta("database.example.org/v1alpha1/xpostgresqlinstances", metav1.APIResource{
+		Name:       "xpostgresqlinstances",
+		Kind:       "XPostgreSQLInstance",
+		Group:      "database.example.org",
+		Categories: []string{"composite", CRD},
+	})
+
+	assert.True(t, IsCrossplane(client.NewGVR("database.example.org/v1alpha1/postgresqlinstances")))
+	assert.False(t, IsCrossplane(client.NewGVR("v1/pods")))
+
+	gvr, namespaced, ok := crossplaneGVR(render.CrossplaneRef{APIVersion: "database.example.org/v1alpha1", Kind: "XPostgreSQLInstance", Name: "db-x7k"})
+	assert.True(t, ok)
+	assert.False(t, namespaced)
+	assert.Equal(t, "database.example.org/v1alpha1/xpostgresqlinstances", gvr.String())
+
+	_, _, ok = crossplaneGVR(render.CrossplaneRef{APIVersion: "database.example.org/v1alpha1", Kind: "Blee"})
+	assert.False(t, ok)
+}
+
+func TestCrossplaneFQN(t *testing.T) {
+	uu := map[string]struct {
+		ref        render.CrossplaneRef
+		ns         string
+		namespaced bool
+		e          string
+	}{
+		"cluster": {
+			ref: render.CrossplaneRef{Name: "db-x7k"},
+			ns:  "default",
+			e:   "-/db-x7k",
+		},
+		"parent-ns": {
+			ref:        render.CrossplaneRef{Name: "db"},
+			ns:         "default",
+			namespaced: true,
+			e:          "default/db",
+		},
+		"ref-ns": {
+			ref:        render.CrossplaneRef{Name: "db", Namespace: "fred"},
+			ns:         "default",
+			namespaced: true,
+			e:          "fred/db",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, crossplaneFQN(u.ref, u.ns, u.namespaced))
+		})
+	}
+}
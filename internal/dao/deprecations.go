@@ -0,0 +1,328 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	apiextv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	lastAppliedAnnotation = "kubectl.kubernetes.io/last-applied-configuration"
+	helmReleaseAnnotation = "meta.helm.sh/release-name"
+	managedByLabel        = "app.kubernetes.io/managed-by"
+	crdGVR                = "apiextensions.k8s.io/v1/customresourcedefinitions"
+)
+
+var minorRX = regexp.MustCompile(`^\d+`)
+
+// APIDeprecation represents a deprecated api version of a given resource.
+type APIDeprecation struct {
+	GroupVersion string
+	Resource     string
+	Kind         string
+	// Replacement tracks the replacing group version if any.
+	Replacement string
+	// Removed tracks the Kubernetes 1.x minor release the version is removed in.
+	Removed int
+}
+
+// apiDeprecations tracks the upstream Kubernetes api removals.
+var apiDeprecations = []APIDeprecation{
+	{"extensions/v1beta1", "ingresses", "Ingress", "networking.k8s.io/v1", 22},
+	{"networking.k8s.io/v1beta1", "ingresses", "Ingress", "networking.k8s.io/v1", 22},
+	{"networking.k8s.io/v1beta1", "ingressclasses", "IngressClass", "networking.k8s.io/v1", 22},
+	{"apiextensions.k8s.io/v1beta1", "customresourcedefinitions", "CustomResourceDefinition", "apiextensions.k8s.io/v1", 22},
+	{"admissionregistration.k8s.io/v1beta1", "mutatingwebhookconfigurations", "MutatingWebhookConfiguration", "admissionregistration.k8s.io/v1", 22},
+	{"admissionregistration.k8s.io/v1beta1", "validatingwebhookconfigurations", "ValidatingWebhookConfiguration", "admissionregistration.k8s.io/v1", 22},
+	{"rbac.authorization.k8s.io/v1beta1", "clusterroles", "ClusterRole", "rbac.authorization.k8s.io/v1", 22},
+	{"rbac.authorization.k8s.io/v1beta1", "clusterrolebindings", "ClusterRoleBinding", "rbac.authorization.k8s.io/v1", 22},
+	{"rbac.authorization.k8s.io/v1beta1", "roles", "Role", "rbac.authorization.k8s.io/v1", 22},
+	{"rbac.authorization.k8s.io/v1beta1", "rolebindings", "RoleBinding", "rbac.authorization.k8s.io/v1", 22},
+	{"scheduling.k8s.io/v1beta1", "priorityclasses", "PriorityClass", "scheduling.k8s.io/v1", 22},
+	{"storage.k8s.io/v1beta1", "csidrivers", "CSIDriver", "storage.k8s.io/v1", 22},
+	{"storage.k8s.io/v1beta1", "csinodes", "CSINode", "storage.k8s.io/v1", 22},
+	{"storage.k8s.io/v1beta1", "storageclasses", "StorageClass", "storage.k8s.io/v1", 22},
+	{"storage.k8s.io/v1beta1", "volumeattachments", "VolumeAttachment", "storage.k8s.io/v1", 22},
+	{"batch/v1beta1", "cronjobs", "CronJob", "batch/v1", 25},
+	{"discovery.k8s.io/v1beta1", "endpointslices", "EndpointSlice", "discovery.k8s.io/v1", 25},
+	{"autoscaling/v2beta1", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "autoscaling/v2", 25},
+	{"policy/v1beta1", "poddisruptionbudgets", "PodDisruptionBudget", "policy/v1", 25},
+	{"policy/v1beta1", "podsecuritypolicies", "PodSecurityPolicy", "", 25},
+	{"node.k8s.io/v1beta1", "runtimeclasses", "RuntimeClass", "node.k8s.io/v1", 25},
+	{"autoscaling/v2beta2", "horizontalpodautoscalers", "HorizontalPodAutoscaler", "autoscaling/v2", 26},
+	{"flowcontrol.apiserver.k8s.io/v1beta1", "flowschemas", "FlowSchema", "flowcontrol.apiserver.k8s.io/v1", 26},
+	{"flowcontrol.apiserver.k8s.io/v1beta1", "prioritylevelconfigurations", "PriorityLevelConfiguration", "flowcontrol.apiserver.k8s.io/v1", 26},
+	{"storage.k8s.io/v1beta1", "csistoragecapacities", "CSIStorageCapacity", "storage.k8s.io/v1", 27},
+	{"flowcontrol.apiserver.k8s.io/v1beta2", "flowschemas", "FlowSchema", "flowcontrol.apiserver.k8s.io/v1", 29},
+	{"flowcontrol.apiserver.k8s.io/v1beta2", "prioritylevelconfigurations", "PriorityLevelConfiguration", "flowcontrol.apiserver.k8s.io/v1", 29},
+	{"flowcontrol.apiserver.k8s.io/v1beta3", "flowschemas", "FlowSchema", "flowcontrol.apiserver.k8s.io/v1", 32},
+	{"flowcontrol.apiserver.k8s.io/v1beta3", "prioritylevelconfigurations", "PriorityLevelConfiguration", "flowcontrol.apiserver.k8s.io/v1", 32},
+}
+
+// DeprecatedUsage represents a live object relying on a deprecated api version.
+type DeprecatedUsage struct {
+	GVR, FQN, Kind string
+	APIVersion     string
+	Replacement    string
+	// Removed tracks the 1.x minor release removing the version. Zero if unknown.
+	Removed int
+	Source  string
+	Owner   string
+}
+
+// DeprecatedAPIs represents resources using deprecated api versions.
+type DeprecatedAPIs struct {
+	NonResource
+}
+
+// List returns all live objects and CRDs relying on deprecated api versions.
+func (d *DeprecatedAPIs) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	uu, err := ScanDeprecatedAPIs(d.Factory)
+	if err != nil {
+		return nil, err
+	}
+	minor := ServerMinor(d.Client())
+
+	oo := make([]runtime.Object, 0, len(uu))
+	for _, u := range uu {
+		fns, n := client.Namespaced(u.FQN)
+		if !client.IsClusterWide(ns) && fns != ns {
+			continue
+		}
+		oo = append(oo, render.DeprecatedRes{
+			Namespace:   fns,
+			Name:        n,
+			GVR:         u.GVR,
+			Kind:        u.Kind,
+			APIVersion:  u.APIVersion,
+			Replacement: u.Replacement,
+			Removed:     removedIn(u.Removed),
+			Source:      u.Source,
+			Owner:       u.Owner,
+			Issue:       u.Issue(minor),
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given deprecated usage.
+func (d *DeprecatedAPIs) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
+
+// Issue returns the usage severity given the cluster minor version.
+func (u DeprecatedUsage) Issue(minor int) string {
+	switch {
+	case u.Removed == 0:
+		return ""
+	case minor > 0 && u.Removed <= minor:
+		return fmt.Sprintf("removed in %s", removedIn(u.Removed))
+	case minor > 0 && u.Removed == minor+1:
+		return fmt.Sprintf("removed in next release %s", removedIn(u.Removed))
+	default:
+		return ""
+	}
+}
+
+// ScanDeprecatedAPIs collects live objects written via deprecated api versions
+// along with served CRD versions flagged as deprecated.
+func ScanDeprecatedAPIs(f Factory) ([]DeprecatedUsage, error) {
+	var uu []DeprecatedUsage
+	for _, d := range apiDeprecations {
+		gvr := d.Replacement + "/" + d.Resource
+		if d.Replacement == "" {
+			gvr = d.GroupVersion + "/" + d.Resource
+		}
+		if _, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err != nil {
+			continue
+		}
+		oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("deprecation scan failed for %s", gvr)
+			continue
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			src, ok := usesVersion(u, d.GroupVersion)
+			if !ok && d.Replacement != "" {
+				continue
+			}
+			if !ok {
+				src = "served"
+			}
+			uu = append(uu, DeprecatedUsage{
+				GVR:         gvr,
+				FQN:         client.FQN(u.GetNamespace(), u.GetName()),
+				Kind:        d.Kind,
+				APIVersion:  d.GroupVersion,
+				Replacement: d.Replacement,
+				Removed:     d.Removed,
+				Source:      src,
+				Owner:       objectOwner(u),
+			})
+		}
+	}
+
+	crds, err := deprecatedCRDVersions(f)
+	if err != nil {
+		return nil, err
+	}
+	uu = append(uu, crds...)
+	sort.SliceStable(uu, func(i, j int) bool {
+		if uu[i].Kind != uu[j].Kind {
+			return uu[i].Kind < uu[j].Kind
+		}
+		return uu[i].FQN < uu[j].FQN
+	})
+
+	return uu, nil
+}
+
+// ServerMinor returns the cluster minor version or 0 if unknown.
+func ServerMinor(c client.Connection) int {
+	if c == nil {
+		return 0
+	}
+	info, err := c.ServerVersion()
+	if err != nil || info == nil {
+		return 0
+	}
+	m, _ := strconv.Atoi(minorRX.FindString(info.Minor))
+
+	return m
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func removedIn(minor int) string {
+	if minor == 0 {
+		return ""
+	}
+
+	return "1." + strconv.Itoa(minor)
+}
+
+// usesVersion checks if an object was written via a given api version based
+// on its managed fields and last applied configuration.
+func usesVersion(u *unstructured.Unstructured, gv string) (string, bool) {
+	for _, m := range u.GetManagedFields() {
+		if m.APIVersion == gv {
+			return "managed by " + m.Manager, true
+		}
+	}
+	raw, ok := u.GetAnnotations()[lastAppliedAnnotation]
+	if !ok {
+		return "", false
+	}
+	var last struct {
+		APIVersion string `json:"apiVersion"`
+	}
+	if err := json.Unmarshal([]byte(raw), &last); err == nil && last.APIVersion == gv {
+		return "last-applied", true
+	}
+
+	return "", false
+}
+
+// objectOwner returns an object controller or managing release.
+func objectOwner(u *unstructured.Unstructured) string {
+	if ref, ok := controllerRef(u.GetOwnerReferences()); ok {
+		return ref.Kind + "/" + ref.Name
+	}
+	if r, ok := u.GetAnnotations()[helmReleaseAnnotation]; ok {
+		return "helm/" + r
+	}
+
+	return u.GetLabels()[managedByLabel]
+}
+
+// deprecatedCRDVersions collects served CRD versions flagged as deprecated and the objects written with them.
+func deprecatedCRDVersions(f Factory) ([]DeprecatedUsage, error) {
+	oo, err := f.List(crdGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var uu []DeprecatedUsage
+	for _, o := range oo {
+		var crd apiextv1.CustomResourceDefinition
+		if err := fromUnstructured(o, &crd); err != nil {
+			return nil, err
+		}
+		var storage string
+		for _, v := range crd.Spec.Versions {
+			if v.Storage {
+				storage = crd.Spec.Group + "/" + v.Name
+			}
+		}
+		for _, v := range crd.Spec.Versions {
+			if !v.Served || !v.Deprecated {
+				continue
+			}
+			gv, src := crd.Spec.Group+"/"+v.Name, "crd version deprecated"
+			if v.DeprecationWarning != nil {
+				src = *v.DeprecationWarning
+			}
+			uu = append(uu, DeprecatedUsage{
+				GVR:         crdGVR,
+				FQN:         crd.Name,
+				Kind:        "CustomResourceDefinition",
+				APIVersion:  gv,
+				Replacement: storage,
+				Source:      src,
+			})
+			uu = append(uu, crdUsages(f, &crd, storage, gv)...)
+		}
+	}
+
+	return uu, nil
+}
+
+func crdUsages(f Factory, crd *apiextv1.CustomResourceDefinition, storage, gv string) []DeprecatedUsage {
+	gvr := storage + "/" + crd.Spec.Names.Plural
+	oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("deprecation scan failed for %s", gvr)
+		return nil
+	}
+
+	var uu []DeprecatedUsage
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		src, ok := usesVersion(u, gv)
+		if !ok {
+			continue
+		}
+		uu = append(uu, DeprecatedUsage{
+			GVR:         gvr,
+			FQN:         client.FQN(u.GetNamespace(), u.GetName()),
+			Kind:        crd.Spec.Names.Kind,
+			APIVersion:  gv,
+			Replacement: storage,
+			Source:      src,
+			Owner:       objectOwner(u),
+		})
+	}
+
+	return uu
+}
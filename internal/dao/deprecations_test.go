@@ -0,0 +1,109 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestUsesVersion(t *testing.T) {
+	managed := relObj("batch/v1", "CronJob", "default", "cj", nil, map[string]interface{}{})
+	managed.SetManagedFields([]metav1.ManagedFieldsEntry{
+		{Manager: "kubectl", APIVersion: "batch/v1"},
+		{Manager: "helm", APIVersion: "batch/v1beta1"},
+	})
+	applied := relObj("batch/v1", "CronJob", "default", "cj", nil, map[string]interface{}{})
+	applied.SetAnnotations(map[string]string{lastAppliedAnnotation: `{"apiVersion":"batch/v1beta1","kind":"CronJob"}`})
+
+	src, ok := usesVersion(managed, "batch/v1beta1")
+	assert.True(t, ok)
+	assert.Equal(t, "managed by helm", src)
+	src, ok = usesVersion(applied, "batch/v1beta1")
+	assert.True(t, ok)
+	assert.Equal(t, "last-applied", src)
+	_, ok = usesVersion(applied, "extensions/v1beta1")
+	assert.False(t, ok)
+}
+
+func TestObjectOwner(t *testing.T) {
+	yes := true
+	owned := relObj("batch/v1", "Job", "default", "j", nil, map[string]interface{}{})
+	owned.SetOwnerReferences([]metav1.OwnerReference{{Kind: "CronJob", Name: "cj", Controller: &yes}})
+	released := relObj("batch/v1", "CronJob", "default", "cj", nil, map[string]interface{}{})
+	released.SetAnnotations(map[string]string{helmReleaseAnnotation: "backup"})
+	labeled := relObj("batch/v1", "CronJob", "default", "cj", map[string]string{managedByLabel: "argocd"}, map[string]interface{}{})
+
+	assert.Equal(t, "CronJob/cj", objectOwner(owned))
+	assert.Equal(t, "helm/backup", objectOwner(released))
+	assert.Equal(t, "argocd", objectOwner(labeled))
+}
+
+func TestDeprecatedUsageIssue(t *testing.T) {
+	uu := map[string]struct {
+		removed, minor int
+		e              string
+	}{
+		"removed": {removed: 25, minor: 26, e: "removed in 1.25"},
+		"next":    {removed: 25, minor: 24, e: "removed in next release 1.25"},
+		"later":   {removed: 29, minor: 24},
+		"unknown": {removed: 25},
+		"crd":     {minor: 24},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, DeprecatedUsage{Removed: u.removed}.Issue(u.minor))
+		})
+	}
+}
+
+func TestDeprecatedCRDVersions(t *testing.T) {
+	crd := relObj("apiextensions.k8s.io/v1", "CustomResourceDefinition", "", "widgets.example.com", nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"group": "example.com",
+			"names": map[string]interface{}{"kind": "Widget", "plural": "widgets"},
+			"scope": "Namespaced",
+			"versions": []interface{}{
+				map[string]interface{}{"name": "v1alpha1", "served": true, "deprecated": true, "deprecationWarning": "use v1"},
+				map[string]interface{}{"name": "v1beta1", "served": false, "deprecated": true},
+				map[string]interface{}{"name": "v1", "served": true, "storage": true},
+			},
+		},
+	})
+	yes := true
+	old := relObj("example.com/v1", "Widget", "default", "w1", nil, map[string]interface{}{})
+	old.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "operator", APIVersion: "example.com/v1alpha1"}})
+	old.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Gadget", Name: "g1", Controller: &yes}})
+	f := relFactory{rows: map[string][]runtime.Object{
+		crdGVR: {crd},
+		"example.com/v1/widgets": {
+			old,
+			relObj("example.com/v1", "Widget", "default", "w2", nil, map[string]interface{}{}),
+		},
+	}}
+
+	uu, err := deprecatedCRDVersions(f)
+	assert.Nil(t, err)
+	assert.Equal(t, []DeprecatedUsage{
+		{
+			GVR:         crdGVR,
+			FQN:         "widgets.example.com",
+			Kind:        "CustomResourceDefinition",
+			APIVersion:  "example.com/v1alpha1",
+			Replacement: "example.com/v1",
+			Source:      "use v1",
+		},
+		{
+			GVR:         "example.com/v1/widgets",
+			FQN:         "default/w1",
+			Kind:        "Widget",
+			APIVersion:  "example.com/v1alpha1",
+			Replacement: "example.com/v1",
+			Source:      "managed by operator",
+			Owner:       "Gadget/g1",
+		},
+	}, uu)
+}
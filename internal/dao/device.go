@@ -0,0 +1,249 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*Devices)(nil)
+
+	// devicePluginRX tracks daemonsets names running device plugins.
+	devicePluginRX = regexp.MustCompile(`device-plugin|gpu-plugin|sriov`)
+
+	deviceTokenRX = regexp.MustCompile(`[._\-/]`)
+
+	// genericDeviceTokens tracks names tokens that do not tell device vendors apart.
+	genericDeviceTokens = map[string]struct{}{
+		"com": {}, "io": {}, "k8s": {}, "kube": {}, "gpu": {}, "device": {}, "devices": {},
+		"plugin": {}, "daemonset": {}, "amd64": {}, "arm64": {},
+	}
+)
+
+// Devices represents nodes extended resources advertised by device plugins.
+type Devices struct {
+	NonResource
+}
+
+// List returns the nodes extended resources.
+func (d *Devices) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	oo, err := d.Factory.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	nodes := make([]v1.Node, 0, len(oo))
+	for _, o := range oo {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, no)
+	}
+
+	oo, err = d.Factory.List("v1/pods", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		pods = append(pods, po)
+	}
+
+	var dss []appsv1.DaemonSet
+	if oo, err := d.Factory.List("apps/v1/daemonsets", client.AllNamespaces, true, labels.Everything()); err == nil {
+		for _, o := range oo {
+			var ds appsv1.DaemonSet
+			if err := fromUnstructured(o, &ds); err != nil {
+				return nil, err
+			}
+			dss = append(dss, ds)
+		}
+	}
+
+	dd := DeviceUsage(nodes, pods, dss)
+	res := make([]runtime.Object, 0, len(dd))
+	for _, d := range dd {
+		res = append(res, d)
+	}
+
+	return res, nil
+}
+
+// Get fetch a given device.
+func (d *Devices) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// DeviceUsage lists nodes extended resources along with their requests and the
+// device plugin pods serving them. Device plugins are told apart by name.
+func DeviceUsage(nodes []v1.Node, pods []v1.Pod, dss []appsv1.DaemonSet) []render.DeviceRes {
+	plugins := make(map[string]struct{})
+	for _, ds := range dss {
+		if devicePluginRX.MatchString(ds.Name) {
+			plugins[client.FQN(ds.Namespace, ds.Name)] = struct{}{}
+		}
+	}
+
+	var (
+		nodePlugins = make(map[string][]*v1.Pod)
+		nodePods    = make(map[string][]*v1.Pod)
+	)
+	for i := range pods {
+		po := &pods[i]
+		if po.Spec.NodeName == "" || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		nodePods[po.Spec.NodeName] = append(nodePods[po.Spec.NodeName], po)
+		if ref := metav1.GetControllerOf(po); ref != nil && ref.Kind == "DaemonSet" {
+			if _, ok := plugins[client.FQN(po.Namespace, ref.Name)]; ok {
+				nodePlugins[po.Spec.NodeName] = append(nodePlugins[po.Spec.NodeName], po)
+			}
+		}
+	}
+
+	var dd []render.DeviceRes
+	for _, no := range nodes {
+		served := make(map[string]struct{})
+		for _, r := range extendedResources(no) {
+			d := render.DeviceRes{
+				Node:        no.Name,
+				Resource:    r.String(),
+				Capacity:    no.Status.Capacity[r],
+				Allocatable: no.Status.Allocatable[r],
+				Requested:   podsRequests(nodePods[no.Name], r),
+			}
+			if !strings.HasPrefix(d.Resource, v1.ResourceHugePagesPrefix) {
+				d.PluginExpected = len(plugins) > 0 && pluginServes(pluginNames(plugins), d.Resource)
+				if po := servingPlugin(nodePlugins[no.Name], d.Resource); po != nil {
+					d.Plugin, d.PluginReady = client.FQN(po.Namespace, po.Name), isPodReady(*po)
+					served[po.Name] = struct{}{}
+				}
+			}
+			dd = append(dd, d)
+		}
+		for _, po := range nodePlugins[no.Name] {
+			if _, ok := served[po.Name]; !ok {
+				dd = append(dd, render.DeviceRes{
+					Node:        no.Name,
+					Plugin:      client.FQN(po.Namespace, po.Name),
+					PluginReady: isPodReady(*po),
+				})
+			}
+		}
+	}
+
+	return dd
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// extendedResources returns a node device plugins resources and hugepages.
+func extendedResources(no v1.Node) []v1.ResourceName {
+	var rr []v1.ResourceName
+	for r := range no.Status.Capacity {
+		n := r.String()
+		if strings.HasPrefix(n, v1.ResourceHugePagesPrefix) || (strings.Contains(n, "/") && !strings.HasPrefix(n, v1.ResourceDefaultNamespacePrefix)) {
+			rr = append(rr, r)
+		}
+	}
+	sort.Slice(rr, func(i, j int) bool { return rr[i] < rr[j] })
+
+	return rr
+}
+
+// podsRequests sums up the pods requests for a given resource. Limits stand in for
+// missing requests as extended resources can not be overcommitted.
+func podsRequests(pp []*v1.Pod, r v1.ResourceName) resource.Quantity {
+	var total resource.Quantity
+	for _, po := range pp {
+		var sum, init resource.Quantity
+		for _, co := range po.Spec.Containers {
+			sum.Add(containerRequest(co, r))
+		}
+		for _, co := range po.Spec.InitContainers {
+			if q := containerRequest(co, r); q.Cmp(init) > 0 {
+				init = q
+			}
+		}
+		if init.Cmp(sum) > 0 {
+			sum = init
+		}
+		total.Add(sum)
+	}
+
+	return total
+}
+
+func containerRequest(co v1.Container, r v1.ResourceName) resource.Quantity {
+	if q, ok := co.Resources.Requests[r]; ok {
+		return q
+	}
+
+	return co.Resources.Limits[r]
+}
+
+func pluginNames(plugins map[string]struct{}) []string {
+	nn := make([]string, 0, len(plugins))
+	for fqn := range plugins {
+		_, n := client.Namespaced(fqn)
+		nn = append(nn, n)
+	}
+
+	return nn
+}
+
+// pluginServes checks if a device plugin name hints at a resource ie nvidia-device-plugin
+// serves nvidia.com/gpu and sriov-device-plugin serves intel.com/sriov_netdevice.
+func pluginServes(names []string, res string) bool {
+	rr := deviceTokens(res)
+	for _, n := range names {
+		for _, t := range deviceTokens(n) {
+			for _, r := range rr {
+				if strings.Contains(t, r) || strings.Contains(r, t) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func deviceTokens(s string) []string {
+	var tt []string
+	for _, t := range deviceTokenRX.Split(s, -1) {
+		if _, ok := genericDeviceTokens[t]; ok || len(t) < 3 {
+			continue
+		}
+		tt = append(tt, t)
+	}
+
+	return tt
+}
+
+// servingPlugin returns the device plugin pod serving a resource given its daemonset name.
+func servingPlugin(pp []*v1.Pod, res string) *v1.Pod {
+	for _, po := range pp {
+		if ref := metav1.GetControllerOf(po); ref != nil && pluginServes([]string{ref.Name}, res) {
+			return po
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDeviceUsage(t *testing.T) {
+	gpu := v1.ResourceName("nvidia.com/gpu")
+	node := func(n, alloc string) v1.Node {
+		return v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Status: v1.NodeStatus{
+				Capacity: v1.ResourceList{
+					v1.ResourceCPU:           resource.MustParse("8"),
+					gpu:                      resource.MustParse("2"),
+					"hugepages-2Mi":          resource.MustParse("1Gi"),
+					"kubernetes.io/batch":    resource.MustParse("1"),
+					"attachable-volumes-ebs": resource.MustParse("25"),
+				},
+				Allocatable: v1.ResourceList{
+					gpu:             resource.MustParse(alloc),
+					"hugepages-2Mi": resource.MustParse("1Gi"),
+				},
+			},
+		}
+	}
+	yes := true
+	plugin := func(n, node string, ready bool) v1.Pod {
+		st := v1.ConditionFalse
+		if ready {
+			st = v1.ConditionTrue
+		}
+		return v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "gpu-operator",
+				Name:            n,
+				OwnerReferences: []metav1.OwnerReference{{Kind: "DaemonSet", Name: "nvidia-device-plugin-daemonset", Controller: &yes}},
+			},
+			Spec:   v1.PodSpec{NodeName: node},
+			Status: v1.PodStatus{Conditions: []v1.PodCondition{{Type: v1.PodReady, Status: st}}},
+		}
+	}
+	workload := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "train"},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Containers: []v1.Container{
+				{Name: "c1", Resources: v1.ResourceRequirements{Limits: v1.ResourceList{gpu: resource.MustParse("1")}}},
+			},
+		},
+	}
+	dss := []appsv1.DaemonSet{
+		{ObjectMeta: metav1.ObjectMeta{Namespace: "gpu-operator", Name: "nvidia-device-plugin-daemonset"}},
+	}
+	nodes := []v1.Node{node("n1", "2"), node("n2", "0"), node("n3", "2")}
+	pods := []v1.Pod{plugin("p1", "n1", true), plugin("p2", "n2", false), workload}
+
+	dd := dao.DeviceUsage(nodes, pods, dss)
+	assert.Equal(t, 6, len(dd))
+
+	assert.Equal(t, "n1", dd[0].Node)
+	assert.Equal(t, "hugepages-2Mi", dd[0].Resource)
+	assert.Equal(t, "", dd[0].Plugin)
+	assert.False(t, dd[0].PluginExpected)
+
+	assert.Equal(t, "nvidia.com/gpu", dd[1].Resource)
+	assert.Equal(t, "gpu-operator/p1", dd[1].Plugin)
+	assert.True(t, dd[1].PluginReady)
+	assert.Equal(t, "1", dd[1].Requested.String())
+
+	assert.Equal(t, "n2", dd[3].Node)
+	assert.Equal(t, "gpu-operator/p2", dd[3].Plugin)
+	assert.False(t, dd[3].PluginReady)
+	assert.True(t, dd[3].Allocatable.IsZero())
+
+	assert.Equal(t, "n3", dd[5].Node)
+	assert.Equal(t, "", dd[5].Plugin)
+	assert.True(t, dd[5].PluginExpected)
+}
@@ -0,0 +1,246 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const mirrorPodAnnotation = "kubernetes.io/config.mirror"
+
+// DrainImpact represents the disruption a node drain inflicts on a resource.
+type DrainImpact struct {
+	GVR, FQN       string
+	Evicted        int
+	Ready, Desired int
+	Issue          string
+}
+
+// IsRisky checks if the drain degrades the resource.
+func (d DrainImpact) IsRisky() bool {
+	return d.Issue != ""
+}
+
+// DrainSimulation represents the outcome of a simulated node drain.
+type DrainSimulation struct {
+	Node       string
+	Evicted    int
+	DaemonSets int
+	StaticPods int
+	Impacts    []DrainImpact
+}
+
+// Risks returns the number of degraded resources.
+func (d DrainSimulation) Risks() int {
+	var count int
+	for _, i := range d.Impacts {
+		if i.IsRisky() {
+			count++
+		}
+	}
+
+	return count
+}
+
+type evictedSet struct {
+	gvr, fqn     string
+	count, ready int
+}
+
+// SimulateDrain reports the workloads and PDBs a node drain would degrade without evicting anything.
+func SimulateDrain(f Factory, node string) (DrainSimulation, error) {
+	sim := DrainSimulation{Node: node}
+	oo, err := f.List("v1/pods", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return sim, err
+	}
+
+	var (
+		pods      []v1.Pod
+		workloads = make(map[string]*evictedSet)
+		keys      []string
+	)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return sim, err
+		}
+		if po.Spec.NodeName != node || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		if _, ok := po.Annotations[mirrorPodAnnotation]; ok {
+			sim.StaticPods++
+			continue
+		}
+		ref, ok := controllerRef(po.OwnerReferences)
+		if ok && ref.Kind == "DaemonSet" {
+			sim.DaemonSets++
+			continue
+		}
+		sim.Evicted++
+		pods = append(pods, po)
+
+		gvr, fqn := "v1/pods", client.FQN(po.Namespace, po.Name)
+		if ok {
+			gvr, fqn = workloadFor(f, po.Namespace, ref)
+		}
+		key := gvr + ":" + fqn
+		w, ok := workloads[key]
+		if !ok {
+			w = &evictedSet{gvr: gvr, fqn: fqn}
+			workloads[key], keys = w, append(keys, key)
+		}
+		w.count++
+		if isPodReady(po) {
+			w.ready++
+		}
+	}
+
+	for _, k := range keys {
+		sim.Impacts = append(sim.Impacts, workloadImpact(f, workloads[k]))
+	}
+	pdbs, err := pdbImpacts(f, pods)
+	if err != nil {
+		return sim, err
+	}
+	sim.Impacts = append(sim.Impacts, pdbs...)
+	sort.SliceStable(sim.Impacts, func(i, j int) bool {
+		a, b := sim.Impacts[i], sim.Impacts[j]
+		if a.IsRisky() != b.IsRisky() {
+			return a.IsRisky()
+		}
+		if a.GVR != b.GVR {
+			return a.GVR < b.GVR
+		}
+		return a.FQN < b.FQN
+	})
+
+	return sim, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// workloadFor resolves a pod controller to its top level workload.
+func workloadFor(f Factory, ns string, ref metav1.OwnerReference) (string, string) {
+	gvr, fqn := ref.APIVersion+"/"+ownerResource(ref.Kind), client.FQN(ns, ref.Name)
+	if ref.Kind != "ReplicaSet" {
+		return gvr, fqn
+	}
+	o, err := f.Get(gvr, fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return gvr, fqn
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return gvr, fqn
+	}
+	if dref, ok := controllerRef(u.GetOwnerReferences()); ok && dref.Kind == "Deployment" {
+		return dref.APIVersion + "/" + ownerResource(dref.Kind), client.FQN(ns, dref.Name)
+	}
+
+	return gvr, fqn
+}
+
+func workloadImpact(f Factory, w *evictedSet) DrainImpact {
+	impact := DrainImpact{GVR: w.gvr, FQN: w.fqn, Evicted: w.count}
+	switch w.gvr {
+	case "v1/pods":
+		impact.Issue = "unmanaged pod will not be recreated"
+		return impact
+	case "batch/v1/jobs":
+		return impact
+	}
+
+	o, err := f.Get(w.gvr, w.fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		impact.Issue = fmt.Sprintf("unable to resolve %s", w.fqn)
+		return impact
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return impact
+	}
+	desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+	if !ok {
+		desired = 1
+	}
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+	impact.Desired, impact.Ready = int(desired), int(ready)
+	if left := impact.Ready - w.ready; left < impact.Desired {
+		impact.Issue = fmt.Sprintf("drops to %d/%d ready replicas", left, impact.Desired)
+	}
+
+	return impact
+}
+
+func pdbImpacts(f Factory, pods []v1.Pod) ([]DrainImpact, error) {
+	if len(pods) == 0 {
+		return nil, nil
+	}
+	gvr := pdbGVR()
+	oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ii []DrainImpact
+	for _, o := range oo {
+		var pdb policyv1.PodDisruptionBudget
+		if err := fromUnstructured(o, &pdb); err != nil {
+			return nil, err
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err != nil || pdb.Spec.Selector == nil {
+			continue
+		}
+		var count int
+		for _, po := range pods {
+			if po.Namespace == pdb.Namespace && sel.Matches(labels.Set(po.Labels)) {
+				count++
+			}
+		}
+		if count == 0 {
+			continue
+		}
+		impact := DrainImpact{
+			GVR:     gvr,
+			FQN:     client.FQN(pdb.Namespace, pdb.Name),
+			Evicted: count,
+			Ready:   int(pdb.Status.CurrentHealthy),
+			Desired: int(pdb.Status.DesiredHealthy),
+		}
+		if allowed := int(pdb.Status.DisruptionsAllowed); count > allowed {
+			impact.Issue = fmt.Sprintf("evicts %d pods but only allows %d disruptions", count, allowed)
+		}
+		ii = append(ii, impact)
+	}
+
+	return ii, nil
+}
+
+func pdbGVR() string {
+	for _, gvr := range []string{"policy/v1/poddisruptionbudgets", "policy/v1beta1/poddisruptionbudgets"} {
+		if _, err := MetaAccess.MetaFor(client.NewGVR(gvr)); err == nil {
+			return gvr
+		}
+	}
+
+	return "policy/v1/poddisruptionbudgets"
+}
+
+func isPodReady(po v1.Pod) bool {
+	for _, c := range po.Status.Conditions {
+		if c.Type == v1.PodReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSimulateDrain(t *testing.T) {
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			drainPod("p1", "n1", "ReplicaSet", "rs1", map[string]string{"app": "web"}),
+			drainPod("p2", "n1", "StatefulSet", "db", map[string]string{"app": "db"}),
+			drainPod("p3", "n1", "DaemonSet", "ds1", nil),
+			drainPod("p4", "n1", "", "", nil),
+			drainPod("p5", "n2", "ReplicaSet", "rs1", map[string]string{"app": "web"}),
+		},
+		"apps/v1/replicasets": {
+			withOwner(relObj("apps/v1", "ReplicaSet", "default", "rs1", nil, map[string]interface{}{}), "Deployment", "web"),
+		},
+		"apps/v1/deployments": {
+			relObj("apps/v1", "Deployment", "default", "web", nil, map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(2)},
+				"status": map[string]interface{}{"readyReplicas": int64(2)},
+			}),
+		},
+		"apps/v1/statefulsets": {
+			relObj("apps/v1", "StatefulSet", "default", "db", nil, map[string]interface{}{
+				"spec":   map[string]interface{}{"replicas": int64(3)},
+				"status": map[string]interface{}{"readyReplicas": int64(3)},
+			}),
+		},
+		"policy/v1/poddisruptionbudgets": {
+			relObj("policy/v1", "PodDisruptionBudget", "default", "db", nil, map[string]interface{}{
+				"spec":   map[string]interface{}{"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": "db"}}},
+				"status": map[string]interface{}{"disruptionsAllowed": int64(0), "currentHealthy": int64(3), "desiredHealthy": int64(3)},
+			}),
+		},
+	}}
+
+	sim, err := SimulateDrain(f, "n1")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, sim.Evicted)
+	assert.Equal(t, 1, sim.DaemonSets)
+	assert.Equal(t, 4, sim.Risks())
+	assert.Equal(t, []DrainImpact{
+		{GVR: "apps/v1/deployments", FQN: "default/web", Evicted: 1, Ready: 2, Desired: 2, Issue: "drops to 1/2 ready replicas"},
+		{GVR: "apps/v1/statefulsets", FQN: "default/db", Evicted: 1, Ready: 3, Desired: 3, Issue: "drops to 2/3 ready replicas"},
+		{GVR: "policy/v1/poddisruptionbudgets", FQN: "default/db", Evicted: 1, Ready: 3, Desired: 3, Issue: "evicts 1 pods but only allows 0 disruptions"},
+		{GVR: "v1/pods", FQN: "default/p4", Evicted: 1, Issue: "unmanaged pod will not be recreated"},
+	}, sim.Impacts)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func drainPod(n, node, kind, owner string, ll map[string]string) *unstructured.Unstructured {
+	po := relObj("v1", "Pod", "default", n, ll, map[string]interface{}{
+		"spec": map[string]interface{}{"nodeName": node},
+		"status": map[string]interface{}{
+			"phase":      "Running",
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+		},
+	})
+	if kind == "" {
+		return po
+	}
+	return withOwner(po, kind, owner)
+}
+
+func withOwner(o *unstructured.Unstructured, kind, n string) *unstructured.Unstructured {
+	o.Object["metadata"].(map[string]interface{})["ownerReferences"] = []interface{}{
+		map[string]interface{}{"apiVersion": "apps/v1", "kind": kind, "name": n, "uid": n, "controller": true},
+	}
+
+	return o
+}
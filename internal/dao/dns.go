@@ -0,0 +1,172 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	coreDNSNamespace   = "kube-system"
+	coreDNSConfigMap   = "coredns"
+	coreDNSCorefile    = "Corefile"
+	coreDNSMetricsPort = "9153"
+	clusterDomain      = "cluster.local"
+)
+
+// coreDNSCacheMetrics tracks the CoreDNS cache metrics to report.
+var coreDNSCacheMetrics = map[string]string{
+	"coredns_cache_entries":      "entries",
+	"coredns_cache_hits_total":   "hits",
+	"coredns_cache_misses_total": "misses",
+}
+
+// DNSRecord represents the addresses an in-cluster name should resolve to.
+type DNSRecord struct {
+	Service   string
+	Kind      string
+	Addresses []string
+}
+
+// CoreDNSInfo represents CoreDNS configuration and cache stats.
+type CoreDNSInfo struct {
+	Corefile string
+	Pod      string
+	Cache    map[string]int64
+}
+
+// ExpectedDNSRecord returns the record a service name should resolve to or nil if
+// the name does not refer to a known service. Relative names resolve in the given namespace.
+func ExpectedDNSRecord(f Factory, name, ns string) (*DNSRecord, error) {
+	host, svc, sns, ok := splitServiceName(name, ns)
+	if !ok {
+		return nil, nil
+	}
+	fqn := client.FQN(sns, svc)
+	o, err := f.Get("v1/services", fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil, nil
+	}
+	var s v1.Service
+	if err := fromUnstructured(o, &s); err != nil {
+		return nil, err
+	}
+
+	r := DNSRecord{Service: fqn}
+	switch {
+	case s.Spec.Type == v1.ServiceTypeExternalName:
+		r.Kind, r.Addresses = "CNAME", []string{s.Spec.ExternalName}
+	case s.Spec.ClusterIP == v1.ClusterIPNone || host != "":
+		r.Kind = "headless"
+		if r.Addresses, err = endpointAddresses(f, fqn, host); err != nil {
+			return nil, err
+		}
+	default:
+		r.Kind = "ClusterIP"
+		r.Addresses = s.Spec.ClusterIPs
+		if len(r.Addresses) == 0 {
+			r.Addresses = []string{s.Spec.ClusterIP}
+		}
+	}
+	if host != "" {
+		r.Kind = "hostname " + host
+	}
+
+	return &r, nil
+}
+
+// CoreDNS returns CoreDNS configuration and cache stats when available.
+func CoreDNS(ctx context.Context, f Factory) CoreDNSInfo {
+	var info CoreDNSInfo
+	o, err := f.Get("v1/configmaps", client.FQN(coreDNSNamespace, coreDNSConfigMap), true, labels.Everything())
+	if err == nil && o != nil {
+		var cm v1.ConfigMap
+		if err := fromUnstructured(o, &cm); err == nil {
+			info.Corefile = cm.Data[coreDNSCorefile]
+		}
+	}
+
+	pod, ok := runningPod(f, coreDNSNamespace, labels.SelectorFromSet(labels.Set{"k8s-app": "kube-dns"}), "")
+	if !ok {
+		return info
+	}
+	info.Pod = pod
+	if f.Client() == nil {
+		return info
+	}
+	if raw, err := scrapePod(ctx, f.Client(), pod, coreDNSMetricsPort); err == nil {
+		info.Cache = coreDNSCacheStats(raw)
+	}
+
+	return info
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitServiceName splits a service DNS name into its optional hostname, service and namespace.
+func splitServiceName(name, ns string) (string, string, string, bool) {
+	name = strings.TrimSuffix(strings.TrimSuffix(name, "."), "."+clusterDomain)
+	pp := strings.Split(name, ".")
+	switch {
+	case len(pp) == 1:
+		return "", pp[0], ns, true
+	case len(pp) == 2:
+		return "", pp[0], pp[1], true
+	case len(pp) == 3 && pp[2] == "svc":
+		return "", pp[0], pp[1], true
+	case len(pp) == 4 && pp[3] == "svc":
+		return pp[0], pp[1], pp[2], true
+	default:
+		return "", "", "", false
+	}
+}
+
+func endpointAddresses(f Factory, fqn, host string) ([]string, error) {
+	o, err := f.Get("v1/endpoints", fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return nil, nil
+	}
+	var ep v1.Endpoints
+	if err := fromUnstructured(o, &ep); err != nil {
+		return nil, err
+	}
+
+	var aa []string
+	for _, s := range ep.Subsets {
+		for _, a := range s.Addresses {
+			if host == "" || a.Hostname == host {
+				aa = append(aa, a.IP)
+			}
+		}
+	}
+	sort.Strings(aa)
+
+	return aa, nil
+}
+
+// coreDNSCacheStats sums up CoreDNS cache metrics across servers and zones.
+func coreDNSCacheStats(metrics string) map[string]int64 {
+	stats := make(map[string]int64)
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		l := scanner.Text()
+		for m, k := range coreDNSCacheMetrics {
+			_, val, ok := promSample(l, m)
+			if !ok {
+				continue
+			}
+			if v, err := strconv.ParseFloat(val, 64); err == nil {
+				stats[k] += int64(v)
+			}
+		}
+	}
+
+	return stats
+}
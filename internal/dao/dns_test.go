@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSplitServiceName(t *testing.T) {
+	uu := map[string]struct {
+		name          string
+		host, svc, ns string
+		ok            bool
+	}{
+		"short":    {name: "web", svc: "web", ns: "fred", ok: true},
+		"ns":       {name: "web.prod", svc: "web", ns: "prod", ok: true},
+		"svc":      {name: "web.prod.svc", svc: "web", ns: "prod", ok: true},
+		"fqdn":     {name: "web.prod.svc.cluster.local.", svc: "web", ns: "prod", ok: true},
+		"hostname": {name: "db-0.db.prod.svc.cluster.local", host: "db-0", svc: "db", ns: "prod", ok: true},
+		"external": {name: "www.example.com"},
+		"too-long": {name: "a.b.c.d.e"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			host, svc, ns, ok := splitServiceName(u.name, "fred")
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.host, host)
+			assert.Equal(t, u.svc, svc)
+			assert.Equal(t, u.ns, ns)
+		})
+	}
+}
+
+func TestExpectedDNSRecord(t *testing.T) {
+	svc := func(n string, spec map[string]interface{}) runtime.Object {
+		return relObj("v1", "Service", "prod", n, nil, map[string]interface{}{"spec": spec})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/services": {
+			svc("web", map[string]interface{}{"clusterIP": "10.96.0.12", "clusterIPs": []interface{}{"10.96.0.12"}}),
+			svc("db", map[string]interface{}{"clusterIP": "None"}),
+			svc("ext", map[string]interface{}{"type": "ExternalName", "externalName": "db.example.com"}),
+		},
+		"v1/endpoints": {
+			relObj("v1", "Endpoints", "prod", "db", nil, map[string]interface{}{
+				"subsets": []interface{}{map[string]interface{}{
+					"addresses": []interface{}{
+						map[string]interface{}{"ip": "10.0.0.2", "hostname": "db-1"},
+						map[string]interface{}{"ip": "10.0.0.1", "hostname": "db-0"},
+					},
+					"notReadyAddresses": []interface{}{map[string]interface{}{"ip": "10.0.0.3", "hostname": "db-2"}},
+				}},
+			}),
+		},
+	}}
+
+	uu := map[string]struct {
+		name, kind string
+		aa         []string
+		none       bool
+	}{
+		"cluster-ip": {name: "web.prod.svc.cluster.local", kind: "ClusterIP", aa: []string{"10.96.0.12"}},
+		"relative":   {name: "web", kind: "ClusterIP", aa: []string{"10.96.0.12"}},
+		"headless":   {name: "db.prod", kind: "headless", aa: []string{"10.0.0.1", "10.0.0.2"}},
+		"hostname":   {name: "db-1.db.prod.svc", kind: "hostname db-1", aa: []string{"10.0.0.2"}},
+		"cname":      {name: "ext.prod", kind: "CNAME", aa: []string{"db.example.com"}},
+		"unknown":    {name: "nope.prod", none: true},
+		"external":   {name: "www.example.com", none: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := ExpectedDNSRecord(f, u.name, "prod")
+			assert.NoError(t, err)
+			if u.none {
+				assert.Nil(t, r)
+				return
+			}
+			assert.Equal(t, u.kind, r.Kind)
+			assert.Equal(t, u.aa, r.Addresses)
+		})
+	}
+}
+
+func TestCoreDNSCacheStats(t *testing.T) {
+	metrics := `# HELP coredns_cache_entries The number of elements in the cache.
+# TYPE coredns_cache_entries gauge
+coredns_cache_entries{server="dns://:53",type="denial",zones="."} 12
+coredns_cache_entries{server="dns://:53",type="success",zones="."} 30
+coredns_cache_hits_total{server="dns://:53",type="success",zones="."} 1.5e+03
+coredns_cache_misses_total{server="dns://:53",zones="."} 250
+coredns_dns_requests_total{server="dns://:53",zones="."} 9999
+`
+
+	assert.Equal(t, map[string]int64{"entries": 42, "hits": 1500, "misses": 250}, coreDNSCacheStats(metrics))
+}
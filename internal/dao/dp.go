@@ -2,6 +2,7 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -27,6 +28,7 @@ var (
 	_ Scalable        = (*Deployment)(nil)
 	_ Controller      = (*Deployment)(nil)
 	_ ContainsPodSpec = (*Deployment)(nil)
+	_ Pausable        = (*Deployment)(nil)
 )
 
 // Deployment represents a deployment K8s resource.
@@ -113,6 +115,22 @@ func (d *Deployment) Restart(ctx context.Context, path string) error {
 	return err
 }
 
+// Pause pauses or resumes a Deployment rollout.
+func (d *Deployment) Pause(ctx context.Context, path string, pause bool) error {
+	var paused interface{}
+	if pause {
+		paused = true
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"paused": paused},
+	})
+	if err != nil {
+		return err
+	}
+
+	return d.Patch(ctx, path, types.MergePatchType, patch)
+}
+
 // TailLogs tail logs for all pods represented by this Deployment.
 func (d *Deployment) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
 	dp, err := d.Load(d.Factory, opts.Path)
@@ -307,6 +325,13 @@ func hasConfigMap(spec *v1.PodSpec, name string) bool {
 				return true
 			}
 		}
+		if pj := v.VolumeSource.Projected; pj != nil {
+			for _, s := range pj.Sources {
+				if s.ConfigMap != nil && s.ConfigMap.Name == name {
+					return true
+				}
+			}
+		}
 	}
 	return false
 }
@@ -350,6 +375,18 @@ func hasSecret(f Factory, spec *v1.PodSpec, ns, name string, wait bool) (bool, e
 				return true, nil
 			}
 		}
+		if pj := v.VolumeSource.Projected; pj != nil {
+			for _, s := range pj.Sources {
+				if s.Secret != nil && s.Secret.Name == name {
+					return true, nil
+				}
+			}
+		}
+	}
+	for _, ref := range spec.ImagePullSecrets {
+		if ref.Name == name {
+			return true, nil
+		}
 	}
 	return false, nil
 }
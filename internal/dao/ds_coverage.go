@@ -0,0 +1,151 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// daemonTolerations tracks the tolerations the daemonset controller adds to its pods.
+var daemonTolerations = []v1.Toleration{
+	{Key: v1.TaintNodeNotReady, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+	{Key: v1.TaintNodeUnreachable, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoExecute},
+	{Key: v1.TaintNodeDiskPressure, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: v1.TaintNodeMemoryPressure, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: v1.TaintNodePIDPressure, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+	{Key: v1.TaintNodeUnschedulable, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule},
+}
+
+// List returns a collection of daemonsets along with their node coverage gaps.
+func (d *DaemonSet) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := d.Resource.List(ctx, ns)
+	if err != nil || len(oo) == 0 {
+		return oo, err
+	}
+
+	var nodes []v1.Node
+	if nn, err := d.GetFactory().List("v1/nodes", client.ClusterScope, false, labels.Everything()); err == nil {
+		nodes = make([]v1.Node, 0, len(nn))
+		for _, o := range nn {
+			var no v1.Node
+			if err := fromUnstructured(o, &no); err == nil {
+				nodes = append(nodes, no)
+			}
+		}
+	} else {
+		log.Warn().Err(err).Msgf("daemonset nodes list failed")
+	}
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	pp, perr := d.GetFactory().List("v1/pods", ns, false, labels.Everything())
+	if perr != nil {
+		log.Warn().Err(perr).Msgf("daemonset pods list failed")
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if nodes == nil || perr != nil {
+			res = append(res, &render.DaemonSetWithGaps{Raw: u})
+			continue
+		}
+		var ds appsv1.DaemonSet
+		if err := fromUnstructured(u, &ds); err != nil {
+			return nil, err
+		}
+		gaps, err := daemonSetGaps(&ds, nodes, pp)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &render.DaemonSetWithGaps{Raw: u, Gaps: gaps})
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// daemonSetGaps lists the nodes a daemonset pod is missing or failing on along with
+// the nodes it is silently kept off by untolerated taints.
+func daemonSetGaps(ds *appsv1.DaemonSet, nodes []v1.Node, pp []runtime.Object) ([]render.CoverageGap, error) {
+	pods := make(map[string]*v1.Pod)
+	for _, o := range pp {
+		if !IsOwnedBy(o, string(ds.UID)) {
+			continue
+		}
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName != "" {
+			pods[po.Spec.NodeName] = &po
+		}
+	}
+
+	tt := append(append([]v1.Toleration{}, ds.Spec.Template.Spec.Tolerations...), daemonTolerations...)
+	if ds.Spec.Template.Spec.HostNetwork {
+		tt = append(tt, v1.Toleration{Key: v1.TaintNodeNetworkUnavailable, Operator: v1.TolerationOpExists, Effect: v1.TaintEffectNoSchedule})
+	}
+	tpl := v1.Pod{Spec: ds.Spec.Template.Spec}
+	tpl.Spec.Tolerations = tt
+
+	var gg []render.CoverageGap
+	for i := range nodes {
+		no := &nodes[i]
+		if !daemonTargets(&tpl, no) {
+			continue
+		}
+		po, ok := pods[no.Name]
+		switch {
+		case !ok:
+			reason := "missing"
+			if taint, ok := untoleratedTaint(tt, no); ok {
+				reason = "untolerated taint " + taint.ToString()
+			}
+			gg = append(gg, render.CoverageGap{Node: no.Name, Reason: reason})
+		case !isPodReady(*po):
+			gg = append(gg, render.CoverageGap{Node: no.Name, Reason: notReadyReason(po)})
+		}
+	}
+	sort.Slice(gg, func(i, j int) bool {
+		return gg[i].Node < gg[j].Node
+	})
+
+	return gg, nil
+}
+
+// daemonTargets checks if a daemonset pod template selects a node.
+func daemonTargets(po *v1.Pod, no *v1.Node) bool {
+	for k, v := range po.Spec.NodeSelector {
+		if no.Labels[k] != v {
+			return false
+		}
+	}
+
+	return matchesNodeAffinity(po, no)
+}
+
+func untoleratedTaint(tt []v1.Toleration, no *v1.Node) (v1.Taint, bool) {
+	for _, t := range no.Spec.Taints {
+		if t.Effect == v1.TaintEffectPreferNoSchedule || tolerates(tt, t) {
+			continue
+		}
+		return t, true
+	}
+
+	return v1.Taint{}, false
+}
@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDaemonSetGaps(t *testing.T) {
+	ds := appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "agent", UID: "ds-1"},
+		Spec: appsv1.DaemonSetSpec{
+			Template: v1.PodTemplateSpec{
+				Spec: v1.PodSpec{
+					NodeSelector: map[string]string{"kubernetes.io/os": "linux"},
+					Tolerations:  []v1.Toleration{{Key: "dedicated", Operator: v1.TolerationOpEqual, Value: "infra", Effect: v1.TaintEffectNoSchedule}},
+				},
+			},
+		},
+	}
+	linux := map[string]string{"kubernetes.io/os": "linux"}
+	nodes := []v1.Node{
+		{ObjectMeta: metav1.ObjectMeta{Name: "n1", Labels: linux}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n2", Labels: linux}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n3", Labels: linux}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n4", Labels: linux}, Spec: v1.NodeSpec{Taints: []v1.Taint{
+			{Key: "gpu", Value: "true", Effect: v1.TaintEffectNoSchedule},
+		}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "n5", Labels: linux}, Spec: v1.NodeSpec{
+			Unschedulable: true,
+			Taints: []v1.Taint{
+				{Key: v1.TaintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule},
+				{Key: "dedicated", Value: "infra", Effect: v1.TaintEffectNoSchedule},
+			},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "win", Labels: map[string]string{"kubernetes.io/os": "windows"}}},
+	}
+	pod := func(n, node string, ready bool, waiting string) runtime.Object {
+		status := "False"
+		if ready {
+			status = "True"
+		}
+		st := map[string]interface{}{
+			"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": status}},
+		}
+		if waiting != "" {
+			st["containerStatuses"] = []interface{}{map[string]interface{}{
+				"name":  "agent",
+				"state": map[string]interface{}{"waiting": map[string]interface{}{"reason": waiting}},
+			}}
+		}
+		o := relObj("v1", "Pod", "kube-system", n, nil, map[string]interface{}{
+			"spec":   map[string]interface{}{"nodeName": node},
+			"status": st,
+		})
+		o.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "DaemonSet", Name: "agent", UID: "ds-1"}})
+		return o
+	}
+	pp := []runtime.Object{
+		pod("agent-1", "n1", true, ""),
+		pod("agent-2", "n2", false, "CrashLoopBackOff"),
+		pod("agent-5", "n5", true, ""),
+		relObj("v1", "Pod", "kube-system", "other", nil, map[string]interface{}{
+			"spec": map[string]interface{}{"nodeName": "n3"},
+		}),
+	}
+
+	gaps, err := daemonSetGaps(&ds, nodes, pp)
+	assert.NoError(t, err)
+	assert.Equal(t, []render.CoverageGap{
+		{Node: "n2", Reason: "agent: CrashLoopBackOff"},
+		{Node: "n3", Reason: "missing"},
+		{Node: "n4", Reason: "untolerated taint gpu=true:NoSchedule"},
+	}, gaps)
+}
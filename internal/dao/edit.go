@@ -0,0 +1,208 @@
+package dao
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"gopkg.in/yaml.v3"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	syaml "sigs.k8s.io/yaml"
+)
+
+const (
+	draftExt = ".yaml"
+
+	// EditErrorPrefix marks the error comments prepended to a rejected edit buffer.
+	EditErrorPrefix = "# "
+)
+
+var (
+	yamlLineRX = regexp.MustCompile(`line (\d+)`)
+	fieldIdxRX = regexp.MustCompile(`^([^\[]*)\[(\d+)\]$`)
+)
+
+// EditManifest returns the live manifest of a given resource for editing.
+func EditManifest(f Factory, gvr, path string) ([]byte, error) {
+	var g Generic
+	g.Init(f, client.NewGVR(gvr))
+	raw, err := g.ToYAML(path, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(raw), nil
+}
+
+// ApplyEdit replaces a resource with an edited manifest. The manifest must still
+// describe the same resource.
+func ApplyEdit(ctx context.Context, f Factory, gvr, path string, buff []byte) error {
+	var o map[string]interface{}
+	if err := syaml.Unmarshal(StripEditErrors(buff), &o); err != nil {
+		return err
+	}
+	if len(o) == 0 {
+		return errors.New("edited manifest is empty")
+	}
+	u := unstructured.Unstructured{Object: o}
+	ns, n := client.Namespaced(path)
+	if client.IsClusterScoped(ns) {
+		ns = ""
+	}
+	if u.GetName() != n || u.GetNamespace() != ns {
+		return fmt.Errorf("edited manifest describes %s but expected %s", client.FQN(u.GetNamespace(), u.GetName()), path)
+	}
+
+	var g Generic
+	g.Init(f, client.NewGVR(gvr))
+
+	return g.Update(ctx, &u)
+}
+
+// EditErrorLine returns the manifest line an edit was rejected for or 0 if unknown.
+// Yaml errors carry their line while api validation errors are located via their field path.
+func EditErrorLine(buff []byte, err error) int {
+	if err == nil {
+		return 0
+	}
+	var serr *kerrors.StatusError
+	if errors.As(err, &serr) && serr.ErrStatus.Details != nil {
+		for _, c := range serr.ErrStatus.Details.Causes {
+			if l := fieldLine(buff, c.Field); l > 0 {
+				return l
+			}
+		}
+		return 0
+	}
+	if mm := yamlLineRX.FindStringSubmatch(err.Error()); len(mm) == 2 {
+		l, _ := strconv.Atoi(mm[1])
+		return l
+	}
+
+	return 0
+}
+
+// WithEditErrors prepends a rejected edit buffer with the error as comments. The returned
+// offset tracks the number of lines added.
+func WithEditErrors(buff []byte, err error) ([]byte, int) {
+	var b bytes.Buffer
+	b.WriteString(EditErrorPrefix + "Please edit the object below. The edit was rejected with:\n")
+	for _, l := range strings.Split(strings.TrimSpace(err.Error()), "\n") {
+		b.WriteString(EditErrorPrefix + l + "\n")
+	}
+	b.WriteString(EditErrorPrefix + "\n")
+	offset := strings.Count(b.String(), "\n")
+	b.Write(StripEditErrors(buff))
+
+	return b.Bytes(), offset
+}
+
+// StripEditErrors removes the leading comments from an edit buffer.
+func StripEditErrors(buff []byte) []byte {
+	for len(buff) > 0 && bytes.HasPrefix(buff, []byte("#")) {
+		i := bytes.IndexByte(buff, '\n')
+		if i < 0 {
+			return nil
+		}
+		buff = buff[i+1:]
+	}
+
+	return buff
+}
+
+// DraftPath returns the location of a rejected edit draft for a given resource.
+func DraftPath(f Factory, gvr, path string) string {
+	ns, n := client.Namespaced(path)
+	name := strings.Join([]string{client.NewGVR(gvr).R(), ns, n}, "_")
+
+	return filepath.Join(config.DraftsDir(f.Client().ActiveCluster()), config.SanitizeFilename(name)+draftExt)
+}
+
+// SaveDraft keeps a rejected edit buffer.
+func SaveDraft(file string, buff []byte) error {
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, StripEditErrors(buff), 0600)
+}
+
+// LoadDraft returns a rejected edit draft if any.
+func LoadDraft(file string) ([]byte, bool) {
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		return nil, false
+	}
+
+	return raw, true
+}
+
+// DiscardDraft removes a rejected edit draft if any.
+func DiscardDraft(file string) error {
+	if err := os.Remove(file); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// fieldLine locates a field path ie spec.containers[0].image in a yaml manifest.
+func fieldLine(buff []byte, field string) int {
+	field = strings.TrimPrefix(field, ".")
+	if field == "" {
+		return 0
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(buff, &doc); err != nil || len(doc.Content) == 0 {
+		return 0
+	}
+	n, line := doc.Content[0], 0
+	for _, seg := range strings.Split(field, ".") {
+		key, idx := seg, -1
+		if mm := fieldIdxRX.FindStringSubmatch(seg); len(mm) == 3 {
+			key = mm[1]
+			idx, _ = strconv.Atoi(mm[2])
+		}
+		if key != "" {
+			v, l := mappingValue(n, key)
+			if v == nil {
+				return line
+			}
+			n, line = v, l
+		}
+		if idx >= 0 {
+			if n.Kind != yaml.SequenceNode || idx >= len(n.Content) {
+				return line
+			}
+			n, line = n.Content[idx], n.Content[idx].Line
+		}
+	}
+
+	return line
+}
+
+func mappingValue(n *yaml.Node, key string) (*yaml.Node, int) {
+	if n.Kind != yaml.MappingNode {
+		return nil, 0
+	}
+	for i := 0; i+1 < len(n.Content); i += 2 {
+		if n.Content[i].Value == key {
+			return n.Content[i+1], n.Content[i].Line
+		}
+	}
+
+	return nil, 0
+}
@@ -0,0 +1,94 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+const editManifest = `apiVersion: v1
+kind: Pod
+metadata:
+  name: fred
+  namespace: blee
+spec:
+  containers:
+  - name: c1
+    image: nginx
+  - name: c2
+    image: busybox
+`
+
+func TestEditErrorLine(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   int
+	}{
+		"none": {},
+		"yaml": {
+			err: errors.New("error converting YAML to JSON: yaml: line 7: mapping values are not allowed"),
+			e:   7,
+		},
+		"field": {
+			err: invalidErr("spec.containers[1].image"),
+			e:   11,
+		},
+		"partial": {
+			err: invalidErr("spec.containers[1].ports"),
+			e:   10,
+		},
+		"unknown": {
+			err: invalidErr("status.phase"),
+		},
+		"plain": {
+			err: errors.New("boom"),
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, EditErrorLine([]byte(editManifest), u.err))
+		})
+	}
+}
+
+func TestWithEditErrors(t *testing.T) {
+	buff, offset := WithEditErrors([]byte(editManifest), errors.New("boom\nbang"))
+
+	assert.Equal(t, 4, offset)
+	assert.Equal(t, "# Please edit the object below. The edit was rejected with:\n# boom\n# bang\n# \n"+editManifest, string(buff))
+	assert.Equal(t, editManifest, string(StripEditErrors(buff)))
+
+	again, _ := WithEditErrors(buff, errors.New("zorg"))
+	assert.Equal(t, "# Please edit the object below. The edit was rejected with:\n# zorg\n# \n"+editManifest, string(again))
+}
+
+func TestStripEditErrors(t *testing.T) {
+	uu := map[string]struct {
+		buff, e string
+	}{
+		"none":     {buff: editManifest, e: editManifest},
+		"comments": {buff: "# a\n# b\n" + editManifest, e: editManifest},
+		"only":     {buff: "# a"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, string(StripEditErrors([]byte(u.buff))))
+		})
+	}
+}
+
+// Helpers...
+
+func invalidErr(path string) error {
+	return kerrors.NewInvalid(schema.GroupKind{Kind: "Pod"}, "fred", field.ErrorList{
+		field.Invalid(field.NewPath(path), "", "bad value"),
+	})
+}
@@ -0,0 +1,35 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const esoForceSyncAnnotation = "force-sync"
+
+var _ Accessor = (*ExternalSecret)(nil)
+
+// ExternalSecret represents an External Secrets Operator ExternalSecret.
+type ExternalSecret struct {
+	Resource
+}
+
+// Refresh forces the secret to be synced from its store regardless of its refresh interval.
+func (e *ExternalSecret) Refresh(ctx context.Context, path string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				esoForceSyncAnnotation: strconv.FormatInt(time.Now().Unix(), 10),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return e.Patch(ctx, path, types.MergePatchType, patch)
+}
@@ -0,0 +1,277 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// explainMaxDepth caps the schema tree depth as some schemas are recursive.
+	explainMaxDepth = 15
+
+	gvkExtension = "x-kubernetes-group-version-kind"
+)
+
+// SchemaField represents a documented resource field.
+type SchemaField struct {
+	Name, Type, Description string
+	Required                bool
+	Fields                  []*SchemaField
+}
+
+// ExplainSchema returns the documented schema of a given resource. Custom resources are
+// explained from their crd, builtin resources from the cluster openapi documents.
+func ExplainSchema(ctx context.Context, f Factory, gvr client.GVR) (*SchemaField, error) {
+	meta, err := MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return nil, err
+	}
+	if s, ok := crdSchema(f, gvr); ok {
+		return explainField(meta.Kind, s, nil, false, 0, nil), nil
+	}
+
+	doc, err := openAPIV3Doc(f.Client(), gvr)
+	if err != nil {
+		log.Warn().Err(err).Msgf("OpenAPI v3 unavailable for %s. Falling back to v2", gvr)
+		if doc, err = openAPIV2Doc(ctx, f.Client()); err != nil {
+			return nil, err
+		}
+	}
+	n, ok := findDefinition(doc, gvr.G(), gvr.V(), meta.Kind)
+	if !ok {
+		return nil, fmt.Errorf("no schema found for %s", gvr)
+	}
+
+	return explainField(meta.Kind, doc[n], doc, false, 0, map[string]struct{}{n: {}}), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// crdSchema returns a custom resource schema for a given version if defined.
+func crdSchema(f Factory, gvr client.GVR) (map[string]interface{}, bool) {
+	oo, err := f.List(crdGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, false
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(u.Object, "spec", "group")
+		plural, _, _ := unstructured.NestedString(u.Object, "spec", "names", "plural")
+		if group != gvr.G() || plural != gvr.R() {
+			continue
+		}
+		vv, _, _ := unstructured.NestedSlice(u.Object, "spec", "versions")
+		for _, v := range vv {
+			m, ok := v.(map[string]interface{})
+			if !ok || m["name"] != gvr.V() {
+				continue
+			}
+			s, ok, _ := unstructured.NestedMap(m, "schema", "openAPIV3Schema")
+			return s, ok
+		}
+	}
+
+	return nil, false
+}
+
+// openAPIV3Doc fetches the openapi v3 schemas of a given group version.
+func openAPIV3Doc(c client.Connection, gvr client.GVR) (map[string]interface{}, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return nil, err
+	}
+	pp, err := dial.Discovery().OpenAPIV3().Paths()
+	if err != nil {
+		return nil, err
+	}
+	key := "apis/" + gvr.GV().String()
+	if gvr.G() == "" {
+		key = "api/" + gvr.V()
+	}
+	gv, ok := pp[key]
+	if !ok {
+		return nil, fmt.Errorf("no openapi v3 path for %s", key)
+	}
+	raw, err := gv.Schema("application/json")
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	schemas, _, _ := unstructured.NestedMap(doc, "components", "schemas")
+
+	return schemas, nil
+}
+
+// openAPIV2Doc fetches the cluster openapi v2 definitions.
+func openAPIV2Doc(ctx context.Context, c client.Connection) (map[string]interface{}, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return nil, err
+	}
+	raw, err := dial.Discovery().RESTClient().Get().AbsPath("/openapi/v2").SetHeader("Accept", "application/json").Do(ctx).Raw()
+	if err != nil {
+		return nil, err
+	}
+	var doc map[string]interface{}
+	if err := json.Unmarshal(raw, &doc); err != nil {
+		return nil, err
+	}
+	defs, _, _ := unstructured.NestedMap(doc, "definitions")
+
+	return defs, nil
+}
+
+// findDefinition returns the name of the definition matching a given group version kind.
+func findDefinition(doc map[string]interface{}, group, version, kind string) (string, bool) {
+	for n, d := range doc {
+		m, ok := d.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		gvks, _ := m[gvkExtension].([]interface{})
+		for _, g := range gvks {
+			gvk, ok := g.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if gvk["group"] == group && gvk["version"] == version && gvk["kind"] == kind {
+				return n, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// explainField converts an openapi schema into a documented field tree. Refs are resolved
+// against the given definitions and recursive definitions are only expanded once per branch.
+func explainField(name string, s interface{}, defs map[string]interface{}, required bool, depth int, seen map[string]struct{}) *SchemaField {
+	m, _ := s.(map[string]interface{})
+	field := SchemaField{Name: name, Required: required, Description: schemaString(m, "description")}
+
+	m, ref := resolveSchema(m, defs)
+	if field.Description == "" {
+		field.Description = schemaString(m, "description")
+	}
+	field.Type = schemaType(m, ref, defs)
+	if depth >= explainMaxDepth {
+		return &field
+	}
+	if ref != "" {
+		if _, ok := seen[ref]; ok {
+			return &field
+		}
+		seen = withSeen(seen, ref)
+	}
+
+	for {
+		items, ok := m["items"].(map[string]interface{})
+		if !ok {
+			break
+		}
+		if m, ref = resolveSchema(items, defs); ref != "" {
+			if _, ok := seen[ref]; ok {
+				return &field
+			}
+			seen = withSeen(seen, ref)
+		}
+	}
+
+	props, _ := m["properties"].(map[string]interface{})
+	req := make(map[string]struct{})
+	rr, _ := m["required"].([]interface{})
+	for _, r := range rr {
+		if s, ok := r.(string); ok {
+			req[s] = struct{}{}
+		}
+	}
+	nn := make([]string, 0, len(props))
+	for n := range props {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+	for _, n := range nn {
+		_, ok := req[n]
+		field.Fields = append(field.Fields, explainField(n, props[n], defs, ok, depth+1, seen))
+	}
+
+	return &field
+}
+
+// resolveSchema follows a schema ref either direct or wrapped in a single allOf.
+func resolveSchema(m map[string]interface{}, defs map[string]interface{}) (map[string]interface{}, string) {
+	ref := schemaString(m, "$ref")
+	if ref == "" {
+		if all, ok := m["allOf"].([]interface{}); ok && len(all) == 1 {
+			a, _ := all[0].(map[string]interface{})
+			ref = schemaString(a, "$ref")
+		}
+	}
+	if ref == "" {
+		return m, ""
+	}
+	n := ref[strings.LastIndex(ref, "/")+1:]
+	d, _ := defs[n].(map[string]interface{})
+
+	return d, n
+}
+
+// schemaType returns a kubectl explain like type name.
+func schemaType(m map[string]interface{}, ref string, defs map[string]interface{}) string {
+	if ref != "" {
+		if t := schemaString(m, "type"); t != "" && t != "object" {
+			return t
+		}
+		return "<" + ref[strings.LastIndex(ref, ".")+1:] + ">"
+	}
+	if v, ok := m["x-kubernetes-int-or-string"].(bool); ok && v {
+		return "IntOrString"
+	}
+	switch t := schemaString(m, "type"); t {
+	case "array":
+		items, _ := m["items"].(map[string]interface{})
+		i, iref := resolveSchema(items, defs)
+		return "[]" + schemaType(i, iref, defs)
+	case "object", "":
+		if add, ok := m["additionalProperties"].(map[string]interface{}); ok {
+			a, aref := resolveSchema(add, defs)
+			return "map[string]" + schemaType(a, aref, defs)
+		}
+		if _, ok := m["properties"]; ok || t == "object" {
+			return "Object"
+		}
+		return "<unknown>"
+	default:
+		return t
+	}
+}
+
+func schemaString(m map[string]interface{}, k string) string {
+	s, _ := m[k].(string)
+	return s
+}
+
+func withSeen(seen map[string]struct{}, ref string) map[string]struct{} {
+	s := make(map[string]struct{}, len(seen)+1)
+	for k := range seen {
+		s[k] = struct{}{}
+	}
+	s[ref] = struct{}{}
+
+	return s
+}
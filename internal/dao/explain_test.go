@@ -0,0 +1,112 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainField(t *testing.T) {
+	defs := map[string]interface{}{
+		"io.k8s.api.apps.v1.Deployment": map[string]interface{}{
+			"description": "Deployment enables declarative updates.",
+			"type":        "object",
+			"properties": map[string]interface{}{
+				"kind": map[string]interface{}{"type": "string", "description": "Kind is a string value."},
+				"spec": map[string]interface{}{
+					"allOf":       []interface{}{map[string]interface{}{"$ref": "#/components/schemas/io.k8s.api.apps.v1.DeploymentSpec"}},
+					"description": "Specification of the desired behavior.",
+				},
+			},
+			gvkExtension: []interface{}{
+				map[string]interface{}{"group": "apps", "version": "v1", "kind": "Deployment"},
+			},
+		},
+		"io.k8s.api.apps.v1.DeploymentSpec": map[string]interface{}{
+			"type":     "object",
+			"required": []interface{}{"selector"},
+			"properties": map[string]interface{}{
+				"replicas": map[string]interface{}{"type": "integer", "format": "int32"},
+				"selector": map[string]interface{}{"$ref": "#/components/schemas/io.k8s.LabelSelector"},
+				"strategy": map[string]interface{}{"x-kubernetes-int-or-string": true},
+			},
+		},
+		"io.k8s.LabelSelector": map[string]interface{}{
+			"description": "A label selector.",
+			"type":        "object",
+			"properties": map[string]interface{}{
+				"matchLabels": map[string]interface{}{
+					"type":                 "object",
+					"additionalProperties": map[string]interface{}{"type": "string"},
+				},
+				"matchExpressions": map[string]interface{}{
+					"type":  "array",
+					"items": map[string]interface{}{"$ref": "#/components/schemas/io.k8s.LabelSelector"},
+				},
+			},
+		},
+	}
+
+	n, ok := findDefinition(defs, "apps", "v1", "Deployment")
+	assert.True(t, ok)
+	assert.Equal(t, "io.k8s.api.apps.v1.Deployment", n)
+	_, ok = findDefinition(defs, "apps", "v1", "StatefulSet")
+	assert.False(t, ok)
+
+	f := explainField("Deployment", defs[n], defs, false, 0, map[string]struct{}{n: {}})
+	assert.Equal(t, "Object", f.Type)
+	assert.Equal(t, "Deployment enables declarative updates.", f.Description)
+	assert.Equal(t, 2, len(f.Fields))
+
+	kind, spec := f.Fields[0], f.Fields[1]
+	assert.Equal(t, "kind", kind.Name)
+	assert.Equal(t, "string", kind.Type)
+	assert.Equal(t, "<DeploymentSpec>", spec.Type)
+	assert.Equal(t, "Specification of the desired behavior.", spec.Description)
+	assert.Equal(t, 3, len(spec.Fields))
+
+	replicas, sel, strategy := spec.Fields[0], spec.Fields[1], spec.Fields[2]
+	assert.Equal(t, "integer", replicas.Type)
+	assert.False(t, replicas.Required)
+	assert.Equal(t, "IntOrString", strategy.Type)
+	assert.True(t, sel.Required)
+	assert.Equal(t, "<LabelSelector>", sel.Type)
+	assert.Equal(t, "A label selector.", sel.Description)
+
+	exprs, labels := sel.Fields[0], sel.Fields[1]
+	assert.Equal(t, "[]<LabelSelector>", exprs.Type)
+	assert.Empty(t, exprs.Fields)
+	assert.Equal(t, "map[string]string", labels.Type)
+}
+
+func TestExplainFieldCRD(t *testing.T) {
+	s := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"spec": map[string]interface{}{
+				"type":     "object",
+				"required": []interface{}{"size"},
+				"properties": map[string]interface{}{
+					"size": map[string]interface{}{"type": "integer", "description": "Widget size."},
+					"tags": map[string]interface{}{
+						"type": "array",
+						"items": map[string]interface{}{
+							"type":       "object",
+							"properties": map[string]interface{}{"name": map[string]interface{}{"type": "string"}},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	f := explainField("Widget", s, nil, false, 0, nil)
+	assert.Equal(t, "Widget", f.Name)
+	spec := f.Fields[0]
+	assert.Equal(t, "Object", spec.Type)
+	size, tags := spec.Fields[0], spec.Fields[1]
+	assert.True(t, size.Required)
+	assert.Equal(t, "Widget size.", size.Description)
+	assert.Equal(t, "[]Object", tags.Type)
+	assert.Equal(t, "name", tags.Fields[0].Name)
+}
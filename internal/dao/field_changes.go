@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+const noFieldValue = "<none>"
+
+var (
+	plainFieldRX = regexp.MustCompile(`\A[A-Za-z0-9_\-]+\z`)
+
+	// noisyFields tracks fields updated on every write.
+	noisyFields = map[string]struct{}{
+		"metadata.resourceVersion": {},
+		"metadata.managedFields":   {},
+	}
+)
+
+// FieldChange represents a single field update between two object revisions.
+type FieldChange struct {
+	Path     string
+	Old, New string
+}
+
+// String returns a human readable change.
+func (f FieldChange) String() string {
+	return fmt.Sprintf("%s: %s → %s", f.Path, f.Old, f.New)
+}
+
+// FieldChanges lists the leaf fields that differ between two object revisions.
+func FieldChanges(prev, cur map[string]interface{}) []FieldChange {
+	var cc []FieldChange
+	diffFields("", prev, cur, &cc)
+
+	return cc
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func diffFields(path string, prev, cur interface{}, cc *[]FieldChange) {
+	if _, ok := noisyFields[path]; ok {
+		return
+	}
+	pm, pok := prev.(map[string]interface{})
+	cm, cok := cur.(map[string]interface{})
+	if pok && cok {
+		kk := make(map[string]struct{}, len(pm)+len(cm))
+		for k := range pm {
+			kk[k] = struct{}{}
+		}
+		for k := range cm {
+			kk[k] = struct{}{}
+		}
+		keys := make([]string, 0, len(kk))
+		for k := range kk {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			pv, pin := pm[k]
+			cv, cin := cm[k]
+			if !pin {
+				pv = nil
+			}
+			if !cin {
+				cv = nil
+			}
+			diffFields(fieldPath(path, k), pv, cv, cc)
+		}
+		return
+	}
+	ps, pok := prev.([]interface{})
+	cs, cok := cur.([]interface{})
+	if pok && cok && len(ps) == len(cs) {
+		for i := range ps {
+			diffFields(fmt.Sprintf("%s[%d]", path, i), ps[i], cs[i], cc)
+		}
+		return
+	}
+
+	o, n := fieldValue(prev), fieldValue(cur)
+	if o != n {
+		*cc = append(*cc, FieldChange{Path: path, Old: o, New: n})
+	}
+}
+
+func fieldPath(path, k string) string {
+	if !plainFieldRX.MatchString(k) {
+		return fmt.Sprintf("%s[%q]", path, k)
+	}
+	if path == "" {
+		return k
+	}
+
+	return path + "." + k
+}
+
+func fieldValue(v interface{}) string {
+	if v == nil {
+		return noFieldValue
+	}
+	if s, ok := v.(string); ok {
+		return s
+	}
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+
+	return string(raw)
+}
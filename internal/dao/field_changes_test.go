@@ -0,0 +1,50 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFieldChanges(t *testing.T) {
+	prev := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": "1",
+			"labels":          map[string]interface{}{"app.kubernetes.io/name": "fred"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"template": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "c1", "image": "nginx:1.0"},
+				},
+			},
+			"paused": true,
+		},
+	}
+	cur := map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"resourceVersion": "2",
+			"labels":          map[string]interface{}{"app.kubernetes.io/name": "blee"},
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(5),
+			"template": map[string]interface{}{
+				"containers": []interface{}{
+					map[string]interface{}{"name": "c1", "image": "nginx:1.1"},
+				},
+			},
+			"strategy": map[string]interface{}{"type": "Recreate"},
+		},
+	}
+
+	assert.Equal(t, []FieldChange{
+		{Path: `metadata.labels["app.kubernetes.io/name"]`, Old: "fred", New: "blee"},
+		{Path: "spec.paused", Old: "true", New: "<none>"},
+		{Path: "spec.replicas", Old: "3", New: "5"},
+		{Path: "spec.strategy", Old: "<none>", New: `{"type":"Recreate"}`},
+		{Path: "spec.template.containers[0].image", Old: "nginx:1.0", New: "nginx:1.1"},
+	}, FieldChanges(prev, cur))
+	assert.Empty(t, FieldChanges(prev, prev))
+	assert.Equal(t, "spec.replicas: 3 → 5", FieldChange{Path: "spec.replicas", Old: "3", New: "5"}.String())
+}
@@ -0,0 +1,223 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ControllerBuiltin tracks finalizers handled by the control plane.
+	ControllerBuiltin = "built-in"
+	// ControllerRunning tracks finalizers whose controller is up.
+	ControllerRunning = "running"
+	// ControllerDown tracks finalizers whose controller has no ready replicas.
+	ControllerDown = "not ready"
+	// ControllerMissing tracks finalizers whose controller could not be found.
+	ControllerMissing = "not found"
+)
+
+var (
+	// builtinFinalizers tracks finalizers handled by Kubernetes controllers.
+	builtinFinalizers = map[string]struct{}{
+		"kubernetes":         {},
+		"orphan":             {},
+		"foregroundDeletion": {},
+		"customresourcecleanup.apiextensions.k8s.io":  {},
+		"service.kubernetes.io/load-balancer-cleanup": {},
+		"batch.kubernetes.io/job-tracking":            {},
+	}
+
+	// genericFinalizerTokens tracks finalizer domain labels too generic to identify a controller.
+	genericFinalizerTokens = map[string]struct{}{
+		"finalizer": {}, "finalizers": {}, "io": {}, "com": {}, "org": {}, "dev": {}, "net": {},
+		"k8s": {}, "x-k8s": {}, "kubernetes": {}, "cleanup": {}, "protection": {}, "resources-finalizer": {},
+	}
+
+	controllerGVRs = []string{"apps/v1/deployments", "apps/v1/statefulsets", "apps/v1/daemonsets"}
+)
+
+// FinalizerController represents the controller expected to clear a finalizer.
+type FinalizerController struct {
+	Name       string
+	Controller string
+	Status     string
+}
+
+// IsBlocked checks if no controller is around to clear the finalizer.
+func (f FinalizerController) IsBlocked() bool {
+	return f.Status == ControllerDown || f.Status == ControllerMissing
+}
+
+// FinalizerReport represents a resource finalizers.
+type FinalizerReport struct {
+	GVR, Path  string
+	DeletedAt  time.Time
+	Finalizers []FinalizerController
+}
+
+// IsTerminating checks if the resource is pending deletion.
+func (f FinalizerReport) IsTerminating() bool {
+	return !f.DeletedAt.IsZero()
+}
+
+// InspectFinalizers lists a resource finalizers and whether their controllers are still around.
+func InspectFinalizers(f Factory, gvr, path string) (FinalizerReport, error) {
+	r := FinalizerReport{GVR: gvr, Path: path}
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return r, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	if ts := u.GetDeletionTimestamp(); ts != nil {
+		r.DeletedAt = ts.Time
+	}
+
+	var workloads []*unstructured.Unstructured
+	for _, fin := range u.GetFinalizers() {
+		c := FinalizerController{Name: fin, Status: ControllerBuiltin}
+		if !isBuiltinFinalizer(fin) {
+			if workloads == nil {
+				if workloads, err = controllerWorkloads(f); err != nil {
+					return r, err
+				}
+			}
+			c.Controller, c.Status = finalizerController(fin, workloads)
+		}
+		r.Finalizers = append(r.Finalizers, c)
+	}
+
+	return r, nil
+}
+
+// RemoveFinalizer removes a given finalizer from a resource.
+func RemoveFinalizer(ctx context.Context, f Factory, gvr, path, finalizer string) error {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	patch, err := finalizerPatch(u.GetFinalizers(), finalizer)
+	if err != nil {
+		return err
+	}
+
+	var g Generic
+	g.Init(f, client.NewGVR(gvr))
+
+	return g.Patch(ctx, path, types.JSONPatchType, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// finalizerPatch builds a json patch removing a finalizer. The patch fails
+// should the finalizers have changed in the meantime.
+func finalizerPatch(ff []string, finalizer string) ([]byte, error) {
+	for i, f := range ff {
+		if f != finalizer {
+			continue
+		}
+		path := fmt.Sprintf("/metadata/finalizers/%d", i)
+		return json.Marshal([]map[string]interface{}{
+			{"op": "test", "path": path, "value": finalizer},
+			{"op": "remove", "path": path},
+		})
+	}
+
+	return nil, fmt.Errorf("finalizer %q not found", finalizer)
+}
+
+func isBuiltinFinalizer(f string) bool {
+	if _, ok := builtinFinalizers[f]; ok {
+		return true
+	}
+	domain, _, _ := strings.Cut(f, "/")
+
+	return domain == "kubernetes.io" || strings.HasSuffix(domain, ".kubernetes.io") || strings.HasSuffix(domain, ".k8s.io")
+}
+
+// finalizerTokens returns the finalizer domain labels likely to name its controller.
+func finalizerTokens(f string) []string {
+	domain, _, _ := strings.Cut(f, "/")
+
+	var tt []string
+	for _, l := range strings.Split(domain, ".") {
+		if _, ok := genericFinalizerTokens[l]; ok || len(l) < 3 {
+			continue
+		}
+		tt = append(tt, l)
+	}
+
+	return tt
+}
+
+func controllerWorkloads(f Factory) ([]*unstructured.Unstructured, error) {
+	uu := make([]*unstructured.Unstructured, 0)
+	for _, gvr := range controllerGVRs {
+		oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok {
+				uu = append(uu, u)
+			}
+		}
+	}
+
+	return uu, nil
+}
+
+// finalizerController guesses the workload in charge of a finalizer based on its domain.
+func finalizerController(f string, uu []*unstructured.Unstructured) (string, string) {
+	tt := finalizerTokens(f)
+	var down string
+	for _, u := range uu {
+		if !matchesAny(u.GetName(), tt) {
+			continue
+		}
+		fqn := client.FQN(u.GetNamespace(), u.GetName())
+		if isAvailable(u) {
+			return fqn, ControllerRunning
+		}
+		down = fqn
+	}
+	if down != "" {
+		return down, ControllerDown
+	}
+
+	return "", ControllerMissing
+}
+
+func matchesAny(n string, tt []string) bool {
+	for _, t := range tt {
+		if strings.Contains(n, t) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isAvailable(u *unstructured.Unstructured) bool {
+	if ready, ok, _ := unstructured.NestedInt64(u.Object, "status", "numberReady"); ok {
+		return ready > 0
+	}
+	ready, _, _ := unstructured.NestedInt64(u.Object, "status", "readyReplicas")
+
+	return ready > 0
+}
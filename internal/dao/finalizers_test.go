@@ -0,0 +1,62 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestInspectFinalizers(t *testing.T) {
+	dp := func(ns, n string, ready int64) runtime.Object {
+		return relObj("apps/v1", "Deployment", ns, n, nil, map[string]interface{}{
+			"status": map[string]interface{}{"readyReplicas": ready},
+		})
+	}
+	cert := relObj("cert-manager.io/v1", "Certificate", "default", "c1", nil, map[string]interface{}{})
+	cert.SetFinalizers([]string{
+		"kubernetes.io/pvc-protection",
+		"finalizer.cert-manager.io",
+		"resources-finalizer.argocd.argoproj.io",
+		"acme.example.com/cleanup",
+	})
+	f := relFactory{rows: map[string][]runtime.Object{
+		"cert-manager.io/v1/certificates": {cert},
+		"apps/v1/deployments": {
+			dp("cert-manager", "cert-manager", 1),
+			dp("argocd", "argocd-server", 0),
+		},
+	}}
+
+	r, err := InspectFinalizers(f, "cert-manager.io/v1/certificates", "default/c1")
+	assert.Nil(t, err)
+	assert.False(t, r.IsTerminating())
+	assert.Equal(t, []FinalizerController{
+		{Name: "kubernetes.io/pvc-protection", Status: ControllerBuiltin},
+		{Name: "finalizer.cert-manager.io", Controller: "cert-manager/cert-manager", Status: ControllerRunning},
+		{Name: "resources-finalizer.argocd.argoproj.io", Controller: "argocd/argocd-server", Status: ControllerDown},
+		{Name: "acme.example.com/cleanup", Status: ControllerMissing},
+	}, r.Finalizers)
+}
+
+func TestFinalizerPatch(t *testing.T) {
+	p, err := finalizerPatch([]string{"a", "b"}, "b")
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[{"op":"test","path":"/metadata/finalizers/1","value":"b"},{"op":"remove","path":"/metadata/finalizers/1"}]`, string(p))
+
+	_, err = finalizerPatch([]string{"a"}, "b")
+	assert.NotNil(t, err)
+}
+
+func TestFinalizerTokens(t *testing.T) {
+	uu := map[string][]string{
+		"finalizer.cert-manager.io":              {"cert-manager"},
+		"resources-finalizer.argocd.argoproj.io": {"argocd", "argoproj"},
+		"cluster.cluster.x-k8s.io":               {"cluster", "cluster"},
+		"wrangler":                               {"wrangler"},
+	}
+
+	for k, e := range uu {
+		assert.Equal(t, e, finalizerTokens(k), k)
+	}
+}
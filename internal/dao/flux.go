@@ -0,0 +1,46 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const fluxReconcileAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+var _ Accessor = (*Flux)(nil)
+
+// Flux represents a Flux toolkit reconciled resource.
+type Flux struct {
+	Resource
+}
+
+// Suspend suspends or resumes the reconciliation of a resource.
+func (f *Flux) Suspend(ctx context.Context, path string, suspend bool) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"suspend": suspend},
+	})
+	if err != nil {
+		return err
+	}
+
+	return f.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// Reconcile requests an out of band reconciliation of a resource.
+func (f *Flux) Reconcile(ctx context.Context, path string) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{
+				fluxReconcileAnnotation: time.Now().Format(time.RFC3339Nano),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return f.Patch(ctx, path, types.MergePatchType, patch)
+}
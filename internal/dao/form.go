@@ -0,0 +1,195 @@
+package dao
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// formMaxDepth caps the nesting of the fields offered in a form.
+	formMaxDepth = 4
+
+	stringMapType = "map[string]string"
+	secretGVR     = "v1/secrets"
+)
+
+// FormField represents a scalar field of a structured edit form.
+type FormField struct {
+	Path     []string
+	Type     string
+	Required bool
+	Value    string
+
+	// Entry tracks string map entries ie configmap data keys.
+	Entry bool
+
+	// Encoded tracks base64 encoded values ie secret data.
+	Encoded bool
+}
+
+// Label returns the field label.
+func (f FormField) Label() string {
+	return strings.Join(f.Path, ".")
+}
+
+// Validate checks a field value against the field type.
+func (f FormField) Validate(v string) error {
+	if v == "" {
+		if f.Required {
+			return fmt.Errorf("%s is required", f.Label())
+		}
+		return nil
+	}
+	if _, err := f.convert(v); err != nil {
+		return fmt.Errorf("%s: invalid %s %q", f.Label(), f.Type, v)
+	}
+
+	return nil
+}
+
+func (f FormField) convert(v string) (interface{}, error) {
+	switch f.Type {
+	case "integer":
+		return strconv.ParseInt(v, 10, 64)
+	case "number":
+		return strconv.ParseFloat(v, 64)
+	case "boolean":
+		return strconv.ParseBool(v)
+	case "IntOrString":
+		if i, err := strconv.ParseInt(v, 10, 64); err == nil {
+			return i, nil
+		}
+		return v, nil
+	default:
+		if f.Encoded {
+			return base64.StdEncoding.EncodeToString([]byte(v)), nil
+		}
+		return v, nil
+	}
+}
+
+// FormFields returns the editable scalar fields of a resource as described by its schema.
+// String maps such as configmap data contribute a field per key.
+func FormFields(gvr client.GVR, s *SchemaField, o map[string]interface{}) []FormField {
+	var ff []FormField
+	for _, c := range s.Fields {
+		switch c.Name {
+		case "apiVersion", "kind", "metadata", "status":
+			continue
+		}
+		ff = append(ff, formFields(gvr, c, o, nil, true, 0)...)
+	}
+
+	return ff
+}
+
+// FormPatch returns a merge patch for the fields whose value changed or nil if none did.
+// Cleared fields are removed except for string map entries.
+func FormPatch(ff []FormField, vv map[string]string) (map[string]interface{}, error) {
+	var (
+		patch map[string]interface{}
+		errs  []string
+	)
+	for _, f := range ff {
+		v, ok := vv[f.Label()]
+		if !ok || v == f.Value {
+			continue
+		}
+		if err := f.Validate(v); err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		var val interface{}
+		if v != "" || f.Entry {
+			val, _ = f.convert(v)
+		}
+		if patch == nil {
+			patch = make(map[string]interface{})
+		}
+		if err := unstructured.SetNestedField(patch, val, f.Path...); err != nil {
+			return nil, err
+		}
+	}
+	if len(errs) > 0 {
+		return nil, errors.New(strings.Join(errs, "\n"))
+	}
+
+	return patch, nil
+}
+
+// ApplyForm merge patches a resource with the changes of a form.
+func ApplyForm(ctx context.Context, f Factory, gvr, path string, patch map[string]interface{}) error {
+	raw, err := json.Marshal(patch)
+	if err != nil {
+		return err
+	}
+	var g Generic
+	g.Init(f, client.NewGVR(gvr))
+
+	return g.Patch(ctx, path, types.MergePatchType, raw)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// formFields walks a schema field. Required nested fields are only enforced when their
+// parent is present.
+func formFields(gvr client.GVR, s *SchemaField, o map[string]interface{}, parent []string, present bool, depth int) []FormField {
+	path := append(append([]string{}, parent...), s.Name)
+	val, ok, _ := unstructured.NestedFieldNoCopy(o, path...)
+	switch {
+	case isFormScalar(s.Type):
+		f := FormField{Path: path, Type: s.Type, Required: s.Required && present}
+		if ok && val != nil {
+			f.Value = fmt.Sprintf("%v", val)
+		}
+		return []FormField{f}
+	case s.Type == stringMapType:
+		m, _ := val.(map[string]interface{})
+		kk := make([]string, 0, len(m))
+		for k := range m {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		encoded := gvr.String() == secretGVR && s.Name == "data" && len(parent) == 0
+		ff := make([]FormField, 0, len(kk))
+		for _, k := range kk {
+			f := FormField{Path: append(append([]string{}, path...), k), Type: "string", Entry: true, Encoded: encoded}
+			f.Value, _ = m[k].(string)
+			if encoded {
+				if b, err := base64.StdEncoding.DecodeString(f.Value); err == nil {
+					f.Value = string(b)
+				}
+			}
+			ff = append(ff, f)
+		}
+		return ff
+	case (s.Type == "Object" || strings.HasPrefix(s.Type, "<")) && depth < formMaxDepth:
+		var ff []FormField
+		for _, c := range s.Fields {
+			ff = append(ff, formFields(gvr, c, o, path, (present && s.Required) || ok, depth+1)...)
+		}
+		return ff
+	default:
+		return nil
+	}
+}
+
+func isFormScalar(t string) bool {
+	switch t {
+	case "string", "integer", "number", "boolean", "IntOrString":
+		return true
+	default:
+		return false
+	}
+}
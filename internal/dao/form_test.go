@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormFields(t *testing.T) {
+	hpa := SchemaField{
+		Name: "HorizontalPodAutoscaler",
+		Fields: []*SchemaField{
+			{Name: "apiVersion", Type: "string"},
+			{Name: "metadata", Type: "<ObjectMeta>", Fields: []*SchemaField{{Name: "name", Type: "string"}}},
+			{Name: "spec", Type: "<HorizontalPodAutoscalerSpec>", Fields: []*SchemaField{
+				{Name: "behavior", Type: "<Behavior>", Fields: []*SchemaField{{Name: "selectPolicy", Type: "string", Required: true}}},
+				{Name: "maxReplicas", Type: "integer", Required: true},
+				{Name: "metrics", Type: "[]<MetricSpec>"},
+				{Name: "minReplicas", Type: "integer"},
+			}},
+			{Name: "status", Type: "<Status>", Fields: []*SchemaField{{Name: "currentReplicas", Type: "integer"}}},
+		},
+	}
+	secret := SchemaField{
+		Name: "Secret",
+		Fields: []*SchemaField{
+			{Name: "data", Type: "map[string]string"},
+			{Name: "immutable", Type: "boolean"},
+			{Name: "type", Type: "string"},
+		},
+	}
+
+	uu := map[string]struct {
+		gvr    string
+		schema *SchemaField
+		o      map[string]interface{}
+		e      []FormField
+	}{
+		"hpa": {
+			gvr:    "autoscaling/v2/horizontalpodautoscalers",
+			schema: &hpa,
+			o: map[string]interface{}{
+				"spec": map[string]interface{}{"maxReplicas": int64(5), "minReplicas": int64(2)},
+			},
+			e: []FormField{
+				{Path: []string{"spec", "behavior", "selectPolicy"}, Type: "string"},
+				{Path: []string{"spec", "maxReplicas"}, Type: "integer", Required: true, Value: "5"},
+				{Path: []string{"spec", "minReplicas"}, Type: "integer", Value: "2"},
+			},
+		},
+		"secret": {
+			gvr:    "v1/secrets",
+			schema: &secret,
+			o: map[string]interface{}{
+				"data": map[string]interface{}{"b": "YmxlZQ==", "a": "ZnJlZA=="},
+				"type": "Opaque",
+			},
+			e: []FormField{
+				{Path: []string{"data", "a"}, Type: "string", Value: "fred", Entry: true, Encoded: true},
+				{Path: []string{"data", "b"}, Type: "string", Value: "blee", Entry: true, Encoded: true},
+				{Path: []string{"immutable"}, Type: "boolean"},
+				{Path: []string{"type"}, Type: "string", Value: "Opaque"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, FormFields(client.NewGVR(u.gvr), u.schema, u.o))
+		})
+	}
+}
+
+func TestFormPatch(t *testing.T) {
+	ff := []FormField{
+		{Path: []string{"data", "a"}, Type: "string", Value: "fred", Entry: true, Encoded: true},
+		{Path: []string{"data", "b"}, Type: "string", Value: "blee", Entry: true},
+		{Path: []string{"spec", "maxReplicas"}, Type: "integer", Required: true, Value: "5"},
+		{Path: []string{"spec", "minReplicas"}, Type: "integer", Value: "2"},
+		{Path: []string{"spec", "paused"}, Type: "boolean"},
+		{Path: []string{"spec", "port"}, Type: "IntOrString", Value: "http"},
+	}
+
+	uu := map[string]struct {
+		vv  map[string]string
+		e   map[string]interface{}
+		err string
+	}{
+		"unchanged": {
+			vv: map[string]string{"data.a": "fred", "spec.maxReplicas": "5"},
+		},
+		"changed": {
+			vv: map[string]string{
+				"data.a":           "zorg",
+				"data.b":           "",
+				"spec.minReplicas": "",
+				"spec.paused":      "true",
+				"spec.port":        "8080",
+			},
+			e: map[string]interface{}{
+				"data": map[string]interface{}{"a": "em9yZw==", "b": ""},
+				"spec": map[string]interface{}{"minReplicas": nil, "paused": true, "port": int64(8080)},
+			},
+		},
+		"invalid": {
+			vv:  map[string]string{"spec.maxReplicas": "", "spec.minReplicas": "x"},
+			err: "spec.maxReplicas is required\nspec.minReplicas: invalid integer \"x\"",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			p, err := FormPatch(ff, u.vv)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, p)
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 )
 
@@ -133,6 +134,82 @@ func (g *Generic) Delete(ctx context.Context, path string, propagation *metav1.D
 	return dial.Namespace(ns).Delete(ctx, n, opts)
 }
 
+// Patch applies a patch to a resource or one of its subresources.
+func (g *Generic) Patch(ctx context.Context, path string, pt types.PatchType, data []byte, subresources ...string) error {
+	ns, n := client.Namespaced(path)
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.PatchVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to patch %s", path)
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+	defer cancel()
+	if client.IsClusterScoped(ns) {
+		_, err = dial.Patch(ctx, n, pt, data, metav1.PatchOptions{}, subresources...)
+		return err
+	}
+	_, err = dial.Namespace(ns).Patch(ctx, n, pt, data, metav1.PatchOptions{}, subresources...)
+
+	return err
+}
+
+// Create creates a new resource.
+func (g *Generic) Create(ctx context.Context, o *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	ns := o.GetNamespace()
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.CreateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to create %s", g.gvr.R())
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+	defer cancel()
+	if ns == "" {
+		return dial.Create(ctx, o, metav1.CreateOptions{})
+	}
+
+	return dial.Namespace(ns).Create(ctx, o, metav1.CreateOptions{})
+}
+
+// Update replaces an existing resource.
+func (g *Generic) Update(ctx context.Context, o *unstructured.Unstructured) error {
+	ns := o.GetNamespace()
+	auth, err := g.Client().CanI(ns, g.gvr.String(), []string{client.UpdateVerb})
+	if err != nil {
+		return err
+	}
+	if !auth {
+		return fmt.Errorf("user is not authorized to update %s", client.FQN(ns, o.GetName()))
+	}
+
+	dial, err := g.dynClient()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, g.Client().Config().CallTimeout())
+	defer cancel()
+	if ns == "" {
+		_, err = dial.Update(ctx, o, metav1.UpdateOptions{})
+		return err
+	}
+	_, err = dial.Namespace(ns).Update(ctx, o, metav1.UpdateOptions{})
+
+	return err
+}
+
 func (g *Generic) dynClient() (dynamic.NamespaceableResourceInterface, error) {
 	dial, err := g.Client().DynDial()
 	if err != nil {
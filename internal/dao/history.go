@@ -0,0 +1,81 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/history"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const maxHistoryFields = 3
+
+var _ Accessor = (*History)(nil)
+
+// History represents the locally recorded revisions of a given object.
+type History struct {
+	NonResource
+}
+
+// List returns all the recorded revisions of the context object.
+func (h *History) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, errors.New("No context GVR found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+
+	rr, err := ObjectRevisions(h.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(rr))
+	for i, r := range rr {
+		res := render.HistoryRes{
+			Revision:        i + 1,
+			ResourceVersion: r.ResourceVersion,
+			Recorded:        r.At,
+		}
+		if i > 0 {
+			cc := FieldChanges(rr[i-1].Object, r.Object)
+			res.Changes = len(cc)
+			for _, c := range cc {
+				if len(res.Fields) == maxHistoryFields {
+					res.Fields = append(res.Fields, "...")
+					break
+				}
+				res.Fields = append(res.Fields, c.Path)
+			}
+		}
+		oo = append(oo, res)
+	}
+
+	return oo, nil
+}
+
+// ObjectRevisions returns the locally recorded revisions of a given object, oldest first.
+func ObjectRevisions(f Factory, gvr, path string) ([]history.Revision, error) {
+	return history.Revisions(config.HistoryDir(f.Client().ActiveCluster()), gvr, path)
+}
+
+// RevisionChanges returns a revision index and its changes from the previous
+// revision. The index is -1 if the revision is not found.
+func RevisionChanges(rr []history.Revision, rv string) (int, []FieldChange) {
+	for i, r := range rr {
+		if r.ResourceVersion != rv {
+			continue
+		}
+		if i == 0 {
+			return i, nil
+		}
+		return i, FieldChanges(rr[i-1].Object, r.Object)
+	}
+
+	return -1, nil
+}
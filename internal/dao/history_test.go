@@ -0,0 +1,26 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/history"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRevisionChanges(t *testing.T) {
+	rr := []history.Revision{
+		{ResourceVersion: "1", Object: map[string]interface{}{"spec": map[string]interface{}{"replicas": 1}}},
+		{ResourceVersion: "2", Object: map[string]interface{}{"spec": map[string]interface{}{"replicas": 3}}},
+	}
+
+	i, cc := RevisionChanges(rr, "1")
+	assert.Equal(t, 0, i)
+	assert.Empty(t, cc)
+
+	i, cc = RevisionChanges(rr, "2")
+	assert.Equal(t, 1, i)
+	assert.Equal(t, []FieldChange{{Path: "spec.replicas", Old: "1", New: "3"}}, cc)
+
+	i, _ = RevisionChanges(rr, "3")
+	assert.Equal(t, -1, i)
+}
@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ Accessor = (*HorizontalPodAutoscaler)(nil)
+
+// HPATuning represents the tunable settings of an HPA. Targets tracks the resource
+// metrics average utilization percentage keyed by resource name.
+type HPATuning struct {
+	Min, Max int64
+	Targets  map[string]int64
+}
+
+// TargetNames returns the tuned resource names.
+func (t HPATuning) TargetNames() []string {
+	nn := make([]string, 0, len(t.Targets))
+	for n := range t.Targets {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// Validate checks the tuning settings.
+func (t HPATuning) Validate() error {
+	if t.Min < 1 {
+		return fmt.Errorf("min replicas must be at least 1 but got %d", t.Min)
+	}
+	if t.Max < t.Min {
+		return fmt.Errorf("max replicas %d must be greater or equal to min replicas %d", t.Max, t.Min)
+	}
+	for n, v := range t.Targets {
+		if v < 1 {
+			return fmt.Errorf("%s target utilization must be a positive percentage but got %d", n, v)
+		}
+	}
+
+	return nil
+}
+
+// HorizontalPodAutoscaler represents an HPA.
+type HorizontalPodAutoscaler struct {
+	Resource
+}
+
+// Tuning returns the HPA current tuning settings.
+func (h *HorizontalPodAutoscaler) Tuning(ctx context.Context, path string) (HPATuning, error) {
+	u, err := h.load(ctx, path)
+	if err != nil {
+		return HPATuning{}, err
+	}
+
+	return hpaTuning(u), nil
+}
+
+// Tune updates the HPA replicas boundaries and target utilizations.
+func (h *HorizontalPodAutoscaler) Tune(ctx context.Context, path string, t HPATuning) error {
+	if err := t.Validate(); err != nil {
+		return err
+	}
+	u, err := h.load(ctx, path)
+	if err != nil {
+		return err
+	}
+	patch, err := hpaTunePatch(u, t)
+	if err != nil {
+		return err
+	}
+
+	return h.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+func (h *HorizontalPodAutoscaler) load(ctx context.Context, path string) (*unstructured.Unstructured, error) {
+	o, err := h.Resource.Get(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return u, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// hpaTuning extracts the tuning settings from either an autoscaling/v1 or v2 HPA.
+func hpaTuning(u *unstructured.Unstructured) HPATuning {
+	t := HPATuning{Min: 1, Targets: make(map[string]int64)}
+	if v, ok, _ := unstructured.NestedInt64(u.Object, "spec", "minReplicas"); ok {
+		t.Min = v
+	}
+	t.Max, _, _ = unstructured.NestedInt64(u.Object, "spec", "maxReplicas")
+	if v, ok, _ := unstructured.NestedInt64(u.Object, "spec", "targetCPUUtilizationPercentage"); ok {
+		t.Targets["cpu"] = v
+	}
+	mm, _, _ := unstructured.NestedSlice(u.Object, "spec", "metrics")
+	for _, m := range mm {
+		mx, ok := m.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		n, v, ok := resourceUtilization(mx)
+		if ok {
+			t.Targets[n] = v
+		}
+	}
+
+	return t
+}
+
+// resourceUtilization returns a resource metric average utilization target.
+func resourceUtilization(m map[string]interface{}) (string, int64, bool) {
+	if t, _, _ := unstructured.NestedString(m, "type"); t != "Resource" {
+		return "", 0, false
+	}
+	n, _, _ := unstructured.NestedString(m, "resource", "name")
+	if v, ok, _ := unstructured.NestedInt64(m, "resource", "target", "averageUtilization"); ok {
+		return n, v, true
+	}
+	if v, ok, _ := unstructured.NestedInt64(m, "resource", "targetAverageUtilization"); ok {
+		return n, v, true
+	}
+
+	return "", 0, false
+}
+
+// hpaTunePatch builds a merge patch. Metrics being a list, the whole list is patched.
+func hpaTunePatch(u *unstructured.Unstructured, t HPATuning) ([]byte, error) {
+	spec := map[string]interface{}{
+		"minReplicas": t.Min,
+		"maxReplicas": t.Max,
+	}
+	if _, ok, _ := unstructured.NestedFieldNoCopy(u.Object, "spec", "targetCPUUtilizationPercentage"); ok {
+		if v, ok := t.Targets["cpu"]; ok {
+			spec["targetCPUUtilizationPercentage"] = v
+		}
+	}
+	mm, ok, err := unstructured.NestedSlice(u.Object, "spec", "metrics")
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		for _, m := range mm {
+			mx, ok := m.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			n, _, ok := resourceUtilization(mx)
+			if !ok {
+				continue
+			}
+			v, ok := t.Targets[n]
+			if !ok {
+				continue
+			}
+			if _, ok, _ := unstructured.NestedFieldNoCopy(mx, "resource", "target"); ok {
+				err = unstructured.SetNestedField(mx, v, "resource", "target", "averageUtilization")
+			} else {
+				err = unstructured.SetNestedField(mx, v, "resource", "targetAverageUtilization")
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		spec["metrics"] = mm
+	}
+
+	return json.Marshal(map[string]interface{}{"spec": spec})
+}
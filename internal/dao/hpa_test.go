@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestHPATuning(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		e HPATuning
+	}{
+		"v1": {
+			o: makeHPA(map[string]interface{}{
+				"minReplicas":                    int64(2),
+				"maxReplicas":                    int64(10),
+				"targetCPUUtilizationPercentage": int64(80),
+			}),
+			e: HPATuning{Min: 2, Max: 10, Targets: map[string]int64{"cpu": 80}},
+		},
+		"v2": {
+			o: makeHPA(map[string]interface{}{
+				"maxReplicas": int64(5),
+				"metrics": []interface{}{
+					v2Metric("cpu", 70),
+					v2Metric("memory", 60),
+					map[string]interface{}{"type": "Pods"},
+				},
+			}),
+			e: HPATuning{Min: 1, Max: 5, Targets: map[string]int64{"cpu": 70, "memory": 60}},
+		},
+		"v2beta1": {
+			o: makeHPA(map[string]interface{}{
+				"minReplicas": int64(3),
+				"maxReplicas": int64(6),
+				"metrics": []interface{}{
+					map[string]interface{}{
+						"type":     "Resource",
+						"resource": map[string]interface{}{"name": "cpu", "targetAverageUtilization": int64(50)},
+					},
+				},
+			}),
+			e: HPATuning{Min: 3, Max: 6, Targets: map[string]int64{"cpu": 50}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, hpaTuning(u.o))
+		})
+	}
+}
+
+func TestHPATunePatch(t *testing.T) {
+	uu := map[string]struct {
+		o *unstructured.Unstructured
+		t HPATuning
+		e string
+	}{
+		"v1": {
+			o: makeHPA(map[string]interface{}{
+				"maxReplicas":                    int64(10),
+				"targetCPUUtilizationPercentage": int64(80),
+			}),
+			t: HPATuning{Min: 2, Max: 20, Targets: map[string]int64{"cpu": 60}},
+			e: `{"spec":{"maxReplicas":20,"minReplicas":2,"targetCPUUtilizationPercentage":60}}`,
+		},
+		"v2": {
+			o: makeHPA(map[string]interface{}{
+				"maxReplicas": int64(5),
+				"metrics": []interface{}{
+					v2Metric("cpu", 70),
+					map[string]interface{}{"type": "Pods"},
+				},
+			}),
+			t: HPATuning{Min: 1, Max: 8, Targets: map[string]int64{"cpu": 50}},
+			e: `{"spec":{"maxReplicas":8,"metrics":[{"resource":{"name":"cpu","target":{"averageUtilization":50,"type":"Utilization"}},"type":"Resource"},{"type":"Pods"}],"minReplicas":1}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := hpaTunePatch(u.o, u.t)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
+
+func TestHPATuningValidate(t *testing.T) {
+	uu := map[string]struct {
+		t   HPATuning
+		err string
+	}{
+		"ok": {
+			t: HPATuning{Min: 1, Max: 3, Targets: map[string]int64{"cpu": 80}},
+		},
+		"min": {
+			t:   HPATuning{Min: 0, Max: 3},
+			err: "min replicas must be at least 1 but got 0",
+		},
+		"max": {
+			t:   HPATuning{Min: 4, Max: 3},
+			err: "max replicas 3 must be greater or equal to min replicas 4",
+		},
+		"target": {
+			t:   HPATuning{Min: 1, Max: 3, Targets: map[string]int64{"cpu": 0}},
+			err: "cpu target utilization must be a positive percentage but got 0",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := u.t.Validate()
+			if u.err == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, u.err)
+		})
+	}
+}
+
+// Helpers...
+
+func makeHPA(spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "fred", "namespace": "default"},
+		"spec":     spec,
+	}}
+}
+
+func v2Metric(n string, v int64) map[string]interface{} {
+	return map[string]interface{}{
+		"type": "Resource",
+		"resource": map[string]interface{}{
+			"name":   n,
+			"target": map[string]interface{}{"type": "Utilization", "averageUtilization": v},
+		},
+	}
+}
@@ -0,0 +1,346 @@
+package dao
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptrace"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/port"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	httpProbeTimeout   = 10 * time.Second
+	httpProbeBodyLimit = 1 << 20
+)
+
+// ProbeCert represents a certificate presented by a probed server.
+type ProbeCert struct {
+	Subject, Issuer string
+	DNSNames        []string
+	NotAfter        time.Time
+}
+
+// HTTPProbeResult represents the outcome of a single HTTP request.
+type HTTPProbeResult struct {
+	URL        string
+	Via        string
+	Proto      string
+	Status     string
+	Code       int
+	Location   string
+	DNS        time.Duration
+	Connect    time.Duration
+	TLS        time.Duration
+	FirstByte  time.Duration
+	Total      time.Duration
+	TLSVersion string
+	Cipher     string
+	Certs      []ProbeCert
+	VerifyErr  string
+}
+
+// ProbeHTTP issues a single request to a URL and reports its latencies and TLS details.
+func ProbeHTTP(ctx context.Context, rawURL string) (HTTPProbeResult, error) {
+	u, err := parseProbeURL(rawURL)
+	if err != nil {
+		return HTTPProbeResult{URL: rawURL}, err
+	}
+
+	return probeHTTP(ctx, u, u.Hostname())
+}
+
+// ProbeServiceHTTP issues a single request to a service port via a temporary port-forward
+// to one of its ready pods. The URL port must match a service port.
+func ProbeServiceHTTP(ctx context.Context, f Factory, path, rawURL string) (HTTPProbeResult, error) {
+	res := HTTPProbeResult{URL: rawURL}
+	u, err := parseProbeURL(rawURL)
+	if err != nil {
+		return res, err
+	}
+	o, err := f.Get("v1/services", path, true, labels.Everything())
+	if err != nil {
+		return res, err
+	}
+	var svc v1.Service
+	if err := fromUnstructured(o, &svc); err != nil {
+		return res, err
+	}
+	sp, err := probeServicePort(&svc, u)
+	if err != nil {
+		return res, err
+	}
+	pod, target, err := probePodTarget(f, &svc, sp)
+	if err != nil {
+		return res, err
+	}
+
+	pf := NewPortForwarder(f)
+	fwd, err := pf.Start(pod, port.NewPortTunnel("localhost", "", "0", strconv.Itoa(target)))
+	if err != nil {
+		return res, err
+	}
+	defer pf.Stop()
+	errs := make(chan error, 1)
+	go func() {
+		errs <- fwd.ForwardPorts()
+	}()
+	select {
+	case <-pf.readyChan:
+	case err := <-errs:
+		return res, fmt.Errorf("port-forward to %s failed: %w", pod, err)
+	case <-ctx.Done():
+		return res, ctx.Err()
+	}
+	pp, err := fwd.GetPorts()
+	if err != nil || len(pp) == 0 {
+		return res, fmt.Errorf("unable to resolve port-forward local port for %s", pod)
+	}
+
+	host := u.Hostname()
+	u.Host = net.JoinHostPort("localhost", strconv.Itoa(int(pp[0].Local)))
+	res, err = probeHTTP(ctx, u, host)
+	res.URL, res.Via = rawURL, fmt.Sprintf("port-forward %s:%d", pod, target)
+
+	return res, err
+}
+
+// ServiceProbeURL returns a default probe URL for a service and whether it can be reached directly.
+func ServiceProbeURL(svc *v1.Service) (string, bool) {
+	if len(svc.Spec.Ports) == 0 {
+		return "", false
+	}
+	sp := svc.Spec.Ports[0]
+	scheme := probeScheme(sp.Port, sp.Name)
+	if svc.Spec.Type == v1.ServiceTypeLoadBalancer {
+		for _, ing := range svc.Status.LoadBalancer.Ingress {
+			host := ing.Hostname
+			if host == "" {
+				host = ing.IP
+			}
+			if host != "" {
+				return fmt.Sprintf("%s://%s/", scheme, net.JoinHostPort(host, strconv.Itoa(int(sp.Port)))), true
+			}
+		}
+	}
+	host := fmt.Sprintf("%s.%s.svc", svc.Name, svc.Namespace)
+
+	return fmt.Sprintf("%s://%s/", scheme, net.JoinHostPort(host, strconv.Itoa(int(sp.Port)))), false
+}
+
+// IngressProbeURL returns a default probe URL for an ingress first rule.
+func IngressProbeURL(ing *netv1.Ingress) string {
+	tlsHosts := make(map[string]struct{})
+	for _, t := range ing.Spec.TLS {
+		for _, h := range t.Hosts {
+			tlsHosts[h] = struct{}{}
+		}
+	}
+	host, path := "", "/"
+	for _, r := range ing.Spec.Rules {
+		host = r.Host
+		if r.HTTP != nil && len(r.HTTP.Paths) > 0 && r.HTTP.Paths[0].Path != "" {
+			path = r.HTTP.Paths[0].Path
+		}
+		break
+	}
+	scheme := "http"
+	if _, ok := tlsHosts[host]; ok {
+		scheme = "https"
+	}
+	if host == "" {
+		for _, lb := range ing.Status.LoadBalancer.Ingress {
+			if host = lb.Hostname; host == "" {
+				host = lb.IP
+			}
+			if host != "" {
+				break
+			}
+		}
+	}
+	if host == "" {
+		return ""
+	}
+
+	return fmt.Sprintf("%s://%s%s", scheme, host, path)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func parseProbeURL(raw string) (*url.URL, error) {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("expecting an http(s) URL but got %q", raw)
+	}
+	if u.Host == "" {
+		return nil, fmt.Errorf("missing host in %q", raw)
+	}
+
+	return u, nil
+}
+
+func probeScheme(p int32, name string) string {
+	if p == 443 || p == 8443 || strings.Contains(name, "https") {
+		return "https"
+	}
+
+	return "http"
+}
+
+func probeServicePort(svc *v1.Service, u *url.URL) (v1.ServicePort, error) {
+	p := u.Port()
+	if p == "" {
+		p = "80"
+		if u.Scheme == "https" {
+			p = "443"
+		}
+	}
+	for _, sp := range svc.Spec.Ports {
+		if strconv.Itoa(int(sp.Port)) == p {
+			return sp, nil
+		}
+	}
+
+	return v1.ServicePort{}, fmt.Errorf("service %s/%s exposes no port %s", svc.Namespace, svc.Name, p)
+}
+
+// probePodTarget picks a ready pod backing the service and resolves the target port on it.
+func probePodTarget(f Factory, svc *v1.Service, sp v1.ServicePort) (string, int, error) {
+	if len(svc.Spec.Selector) == 0 {
+		return "", 0, fmt.Errorf("service %s/%s has no selector", svc.Namespace, svc.Name)
+	}
+	oo, err := f.List("v1/pods", svc.Namespace, true, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return "", 0, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return "", 0, err
+		}
+		if po.Status.Phase != v1.PodRunning || !isPodReady(po) {
+			continue
+		}
+		if target, ok := resolveTargetPort(&po, sp); ok {
+			return client.FQN(po.Namespace, po.Name), target, nil
+		}
+	}
+
+	return "", 0, fmt.Errorf("no ready pod exposes port %s for service %s/%s", sp.TargetPort.String(), svc.Namespace, svc.Name)
+}
+
+func resolveTargetPort(po *v1.Pod, sp v1.ServicePort) (int, bool) {
+	if sp.TargetPort.Type == intstr.Int {
+		if sp.TargetPort.IntVal == 0 {
+			return int(sp.Port), true
+		}
+		return int(sp.TargetPort.IntVal), true
+	}
+	for _, co := range po.Spec.Containers {
+		for _, p := range co.Ports {
+			if p.Name == sp.TargetPort.StrVal {
+				return int(p.ContainerPort), true
+			}
+		}
+	}
+
+	return 0, false
+}
+
+func probeHTTP(ctx context.Context, u *url.URL, serverName string) (HTTPProbeResult, error) {
+	res := HTTPProbeResult{URL: u.String()}
+	ctx, cancel := context.WithTimeout(ctx, httpProbeTimeout)
+	defer cancel()
+
+	var start, dnsStart, connStart, tlsStart time.Time
+	trace := httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { dnsStart = time.Now() },
+		DNSDone:           func(httptrace.DNSDoneInfo) { res.DNS = time.Since(dnsStart) },
+		ConnectStart:      func(string, string) { connStart = time.Now() },
+		ConnectDone:       func(string, string, error) { res.Connect = time.Since(connStart) },
+		TLSHandshakeStart: func() { tlsStart = time.Now() },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { res.TLS = time.Since(tlsStart) },
+		GotFirstResponseByte: func() {
+			res.FirstByte = time.Since(start)
+		},
+	}
+	req, err := http.NewRequestWithContext(httptrace.WithClientTrace(ctx, &trace), http.MethodGet, u.String(), nil)
+	if err != nil {
+		return res, err
+	}
+	clt := http.Client{
+		Transport: &http.Transport{
+			Proxy: http.ProxyFromEnvironment,
+			// Certificates are verified below so their details are reported even when invalid.
+			// nolint:gosec
+			TLSClientConfig:   &tls.Config{ServerName: serverName, InsecureSkipVerify: true},
+			DisableKeepAlives: true,
+		},
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+
+	start = time.Now()
+	resp, err := clt.Do(req)
+	if err != nil {
+		return res, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, httpProbeBodyLimit))
+	res.Total = time.Since(start)
+	res.Proto, res.Status, res.Code = resp.Proto, resp.Status, resp.StatusCode
+	res.Location = resp.Header.Get("Location")
+	if resp.TLS != nil {
+		tlsDetails(&res, resp.TLS, serverName)
+	}
+
+	return res, nil
+}
+
+func tlsDetails(res *HTTPProbeResult, cs *tls.ConnectionState, serverName string) {
+	res.TLSVersion = tls.VersionName(cs.Version)
+	res.Cipher = tls.CipherSuiteName(cs.CipherSuite)
+	for _, c := range cs.PeerCertificates {
+		res.Certs = append(res.Certs, ProbeCert{
+			Subject:  c.Subject.String(),
+			Issuer:   c.Issuer.String(),
+			DNSNames: c.DNSNames,
+			NotAfter: c.NotAfter,
+		})
+	}
+	if len(cs.PeerCertificates) == 0 {
+		res.VerifyErr = "no peer certificates"
+		return
+	}
+	opts := x509.VerifyOptions{DNSName: serverName, Intermediates: x509.NewCertPool()}
+	for _, c := range cs.PeerCertificates[1:] {
+		opts.Intermediates.AddCert(c)
+	}
+	if _, err := cs.PeerCertificates[0].Verify(opts); err != nil {
+		var hErr x509.HostnameError
+		if errors.As(err, &hErr) {
+			res.VerifyErr = fmt.Sprintf("certificate is not valid for %s", serverName)
+			return
+		}
+		res.VerifyErr = err.Error()
+	}
+}
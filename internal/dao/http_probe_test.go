@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestProbeHTTP(t *testing.T) {
+	h := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/old" {
+			http.Redirect(w, r, "/new", http.StatusMovedPermanently)
+			return
+		}
+		w.WriteHeader(http.StatusTeapot)
+	})
+
+	srv := httptest.NewServer(h)
+	defer srv.Close()
+	res, err := ProbeHTTP(context.Background(), srv.URL+"/old")
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusMovedPermanently, res.Code)
+	assert.Equal(t, "/new", res.Location)
+	assert.Empty(t, res.TLSVersion)
+
+	tsrv := httptest.NewTLSServer(h)
+	defer tsrv.Close()
+	res, err = ProbeHTTP(context.Background(), tsrv.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, http.StatusTeapot, res.Code)
+	assert.NotEmpty(t, res.TLSVersion)
+	assert.NotEmpty(t, res.Certs)
+	assert.NotEmpty(t, res.VerifyErr)
+
+	_, err = ProbeHTTP(context.Background(), "ftp://fred")
+	assert.Error(t, err)
+}
+
+func TestServiceProbeURL(t *testing.T) {
+	uu := map[string]struct {
+		svc    v1.Service
+		url    string
+		direct bool
+	}{
+		"cluster-ip": {
+			svc: v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "api"},
+				Spec:       v1.ServiceSpec{Ports: []v1.ServicePort{{Name: "https", Port: 8443}}},
+			},
+			url: "https://api.default.svc:8443/",
+		},
+		"load-balancer": {
+			svc: v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "web"},
+				Spec:       v1.ServiceSpec{Type: v1.ServiceTypeLoadBalancer, Ports: []v1.ServicePort{{Port: 80}}},
+				Status: v1.ServiceStatus{LoadBalancer: v1.LoadBalancerStatus{
+					Ingress: []v1.LoadBalancerIngress{{IP: "1.2.3.4"}},
+				}},
+			},
+			url:    "http://1.2.3.4:80/",
+			direct: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			url, direct := ServiceProbeURL(&u.svc)
+			assert.Equal(t, u.url, url)
+			assert.Equal(t, u.direct, direct)
+		})
+	}
+}
+
+func TestIngressProbeURL(t *testing.T) {
+	ing := netv1.Ingress{
+		Spec: netv1.IngressSpec{
+			TLS: []netv1.IngressTLS{{Hosts: []string{"app.example.com"}}},
+			Rules: []netv1.IngressRule{{
+				Host: "app.example.com",
+				IngressRuleValue: netv1.IngressRuleValue{HTTP: &netv1.HTTPIngressRuleValue{
+					Paths: []netv1.HTTPIngressPath{{Path: "/api"}},
+				}},
+			}},
+		},
+	}
+	assert.Equal(t, "https://app.example.com/api", IngressProbeURL(&ing))
+
+	ing = netv1.Ingress{Status: netv1.IngressStatus{LoadBalancer: netv1.IngressLoadBalancerStatus{
+		Ingress: []netv1.IngressLoadBalancerIngress{{Hostname: "lb.example.com"}},
+	}}}
+	assert.Equal(t, "http://lb.example.com/", IngressProbeURL(&ing))
+}
+
+func TestResolveTargetPort(t *testing.T) {
+	po := v1.Pod{Spec: v1.PodSpec{Containers: []v1.Container{{
+		Ports: []v1.ContainerPort{{Name: "http", ContainerPort: 8080}},
+	}}}}
+
+	p, ok := resolveTargetPort(&po, v1.ServicePort{Port: 80, TargetPort: intstr.FromString("http")})
+	assert.True(t, ok)
+	assert.Equal(t, 8080, p)
+	p, ok = resolveTargetPort(&po, v1.ServicePort{Port: 80})
+	assert.True(t, ok)
+	assert.Equal(t, 80, p)
+	_, ok = resolveTargetPort(&po, v1.ServicePort{Port: 80, TargetPort: intstr.FromString("grpc")})
+	assert.False(t, ok)
+}
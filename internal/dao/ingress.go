@@ -0,0 +1,200 @@
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Ingress)(nil)
+
+// Ingress represents an ingress resource.
+type Ingress struct {
+	Resource
+}
+
+// Get returns an ingress along with its backends issues.
+func (i *Ingress) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := i.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	ns, _ := client.Namespaced(path)
+
+	return newIngBackends(i.GetFactory(), ns).withIssues(o, time.Now())
+}
+
+// List returns a collection of ingresses along with their backends issues.
+func (i *Ingress) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := i.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	bb, now := newIngBackends(i.GetFactory(), ns), time.Now()
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		ing, err := bb.withIssues(o, now)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, ing)
+	}
+
+	return res, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ingBackends tracks the services and tls secrets ingresses refer to.
+// A nil map denotes resources that can not be checked ie not authorized.
+type ingBackends struct {
+	svcs map[string]*v1.Service
+	secs map[string]*v1.Secret
+}
+
+func newIngBackends(f Factory, ns string) *ingBackends {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+
+	var bb ingBackends
+	if oo, err := f.List("v1/services", ns, true, labels.Everything()); err == nil {
+		bb.svcs = make(map[string]*v1.Service, len(oo))
+		for _, o := range oo {
+			var svc v1.Service
+			if err := fromUnstructured(o, &svc); err == nil {
+				bb.svcs[client.FQN(svc.Namespace, svc.Name)] = &svc
+			}
+		}
+	} else {
+		log.Warn().Err(err).Msgf("ingress services list failed")
+	}
+	if oo, err := f.List("v1/secrets", ns, true, labels.Everything()); err == nil {
+		bb.secs = make(map[string]*v1.Secret, len(oo))
+		for _, o := range oo {
+			var sec v1.Secret
+			if err := fromUnstructured(o, &sec); err == nil {
+				bb.secs[client.FQN(sec.Namespace, sec.Name)] = &sec
+			}
+		}
+	} else {
+		log.Warn().Err(err).Msgf("ingress secrets list failed")
+	}
+
+	return &bb
+}
+
+func (b *ingBackends) withIssues(o runtime.Object, now time.Time) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var ing netv1.Ingress
+	if err := fromUnstructured(u, &ing); err != nil {
+		return nil, err
+	}
+
+	return &render.IngressWithIssues{Raw: u, Issues: b.issues(&ing, now)}, nil
+}
+
+// issues returns the broken backends and tls secrets referenced by an ingress.
+func (b *ingBackends) issues(ing *netv1.Ingress, now time.Time) []string {
+	var (
+		ii   []string
+		seen = make(map[string]struct{})
+	)
+	check := func(be *netv1.IngressBackend) {
+		if be == nil || be.Service == nil || b.svcs == nil {
+			return
+		}
+		issue := b.backendIssue(ing.Namespace, be.Service)
+		if _, ok := seen[issue]; issue == "" || ok {
+			return
+		}
+		seen[issue] = struct{}{}
+		ii = append(ii, issue)
+	}
+	check(ing.Spec.DefaultBackend)
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for i := range r.HTTP.Paths {
+			check(&r.HTTP.Paths[i].Backend)
+		}
+	}
+	if b.secs == nil {
+		return ii
+	}
+	for _, t := range ing.Spec.TLS {
+		if t.SecretName == "" {
+			continue
+		}
+		if issue := b.tlsIssue(ing.Namespace, t.SecretName, now); issue != "" {
+			ii = append(ii, issue)
+		}
+	}
+
+	return ii
+}
+
+func (b *ingBackends) backendIssue(ns string, be *netv1.IngressServiceBackend) string {
+	svc, ok := b.svcs[client.FQN(ns, be.Name)]
+	if !ok {
+		return fmt.Sprintf("service %s not found", be.Name)
+	}
+	for _, p := range svc.Spec.Ports {
+		if be.Port.Name != "" && p.Name == be.Port.Name {
+			return ""
+		}
+		if be.Port.Name == "" && p.Port == be.Port.Number {
+			return ""
+		}
+	}
+	port := be.Port.Name
+	if port == "" {
+		port = strconv.Itoa(int(be.Port.Number))
+	}
+
+	return fmt.Sprintf("service %s has no port %s", be.Name, port)
+}
+
+func (b *ingBackends) tlsIssue(ns, n string, now time.Time) string {
+	sec, ok := b.secs[client.FQN(ns, n)]
+	if !ok {
+		return fmt.Sprintf("tls secret %s not found", n)
+	}
+	cert, err := leafCert(sec.Data[v1.TLSCertKey])
+	if err != nil {
+		return fmt.Sprintf("tls secret %s: %s", n, err)
+	}
+	if now.After(cert.NotAfter) {
+		return fmt.Sprintf("tls secret %s expired on %s", n, cert.NotAfter.Format("2006-01-02"))
+	}
+
+	return ""
+}
+
+// leafCert decodes the first certificate of a PEM bundle.
+func leafCert(raw []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(raw)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no certificate found")
+	}
+
+	return x509.ParseCertificate(block.Bytes)
+}
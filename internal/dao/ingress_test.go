@@ -0,0 +1,98 @@
+package dao
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIngressIssues(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	tlsSecret := func(n string, notAfter time.Time) runtime.Object {
+		return relObj("v1", "Secret", "default", n, nil, map[string]interface{}{
+			"type": "kubernetes.io/tls",
+			"data": map[string]interface{}{"tls.crt": base64.StdEncoding.EncodeToString(testCert(t, notAfter))},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/services": {
+			relObj("v1", "Service", "default", "web", nil, map[string]interface{}{
+				"spec": map[string]interface{}{
+					"ports": []interface{}{map[string]interface{}{"name": "http", "port": int64(80)}},
+				},
+			}),
+		},
+		"v1/secrets": {
+			tlsSecret("good", now.Add(24*time.Hour)),
+			tlsSecret("old", now.Add(-24*time.Hour)),
+			relObj("v1", "Secret", "default", "junk", nil, map[string]interface{}{
+				"data": map[string]interface{}{"tls.crt": base64.StdEncoding.EncodeToString([]byte("junk"))},
+			}),
+		},
+	}}
+	backend := func(svc string, port map[string]interface{}) interface{} {
+		return map[string]interface{}{
+			"path":     "/",
+			"pathType": "Prefix",
+			"backend":  map[string]interface{}{"service": map[string]interface{}{"name": svc, "port": port}},
+		}
+	}
+	ing := relObj("networking.k8s.io/v1", "Ingress", "default", "ing", nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"rules": []interface{}{
+				map[string]interface{}{
+					"host": "a.example.com",
+					"http": map[string]interface{}{"paths": []interface{}{
+						backend("web", map[string]interface{}{"name": "http"}),
+						backend("web", map[string]interface{}{"number": int64(80)}),
+						backend("web", map[string]interface{}{"number": int64(8080)}),
+						backend("api", map[string]interface{}{"number": int64(80)}),
+						backend("api", map[string]interface{}{"number": int64(80)}),
+					}},
+				},
+			},
+			"tls": []interface{}{
+				map[string]interface{}{"secretName": "good"},
+				map[string]interface{}{"secretName": "old"},
+				map[string]interface{}{"secretName": "junk"},
+				map[string]interface{}{"secretName": "gone"},
+			},
+		},
+	})
+
+	o, err := newIngBackends(f, "default").withIssues(ing, now)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{
+		"service web has no port 8080",
+		"service api not found",
+		"tls secret old expired on 2024-05-31",
+		"tls secret junk: no certificate found",
+		"tls secret gone not found",
+	}, o.(*render.IngressWithIssues).Issues)
+}
+
+func testCert(t *testing.T, notAfter time.Time) []byte {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+	tpl := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "a.example.com"},
+		NotBefore:    notAfter.Add(-48 * time.Hour),
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &tpl, &tpl, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+}
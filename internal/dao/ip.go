@@ -0,0 +1,206 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sort"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*IPSearch)(nil)
+
+// IPSearch represents resources matching a given IP address.
+type IPSearch struct {
+	NonResource
+}
+
+// List returns all resources using the context IP address.
+func (s *IPSearch) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ip, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context IP address")
+	}
+
+	refs, err := SearchIP(s.Factory, ip)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(refs))
+	for _, ref := range refs {
+		ns, n := client.Namespaced(ref.FQN)
+		oo = append(oo, render.RelatedRes{
+			Namespace: ns,
+			Name:      n,
+			GVR:       ref.GVR,
+			Relation:  ref.Relation,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given matching resource.
+func (s *IPSearch) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// SearchIP collects pods, services, endpoints and nodes using a given IP address.
+func SearchIP(f Factory, addr string) ([]RelatedRef, error) {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return nil, fmt.Errorf("invalid IP address %q", addr)
+	}
+
+	var refs []RelatedRef
+	for _, fn := range []func(Factory, net.IP) ([]RelatedRef, error){podIPs, serviceIPs, endpointIPs, nodeIPs} {
+		rr, err := fn(f, ip)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, rr...)
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].GVR != refs[j].GVR {
+			return refs[i].GVR < refs[j].GVR
+		}
+		return refs[i].FQN < refs[j].FQN
+	})
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podIPs(f Factory, ip net.IP) ([]RelatedRef, error) {
+	oo, err := f.List("v1/pods", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(po.Namespace, po.Name)
+		ips := []string{po.Status.PodIP}
+		for _, p := range po.Status.PodIPs {
+			ips = append(ips, p.IP)
+		}
+		switch {
+		case matchIP(ip, ips...) && po.Spec.HostNetwork:
+			refs = append(refs, RelatedRef{GVR: "v1/pods", FQN: fqn, Relation: "pod ip (host network)"})
+		case matchIP(ip, ips...):
+			refs = append(refs, RelatedRef{GVR: "v1/pods", FQN: fqn, Relation: "pod ip"})
+		}
+	}
+
+	return refs, nil
+}
+
+func serviceIPs(f Factory, ip net.IP) ([]RelatedRef, error) {
+	oo, err := f.List("v1/services", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, o := range oo {
+		var svc v1.Service
+		if err := fromUnstructured(o, &svc); err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(svc.Namespace, svc.Name)
+		if matchIP(ip, append(svc.Spec.ClusterIPs, svc.Spec.ClusterIP)...) {
+			refs = append(refs, RelatedRef{GVR: "v1/services", FQN: fqn, Relation: "cluster ip"})
+		}
+		if matchIP(ip, svc.Spec.ExternalIPs...) {
+			refs = append(refs, RelatedRef{GVR: "v1/services", FQN: fqn, Relation: "external ip"})
+		}
+		for _, lb := range svc.Status.LoadBalancer.Ingress {
+			if matchIP(ip, lb.IP) {
+				refs = append(refs, RelatedRef{GVR: "v1/services", FQN: fqn, Relation: "load balancer ip"})
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func endpointIPs(f Factory, ip net.IP) ([]RelatedRef, error) {
+	oo, err := f.List("v1/endpoints", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, o := range oo {
+		var ep v1.Endpoints
+		if err := fromUnstructured(o, &ep); err != nil {
+			return nil, err
+		}
+		fqn := client.FQN(ep.Namespace, ep.Name)
+		for _, ss := range ep.Subsets {
+			if endpointMatch(ip, ss.Addresses) {
+				refs = append(refs, RelatedRef{GVR: "v1/endpoints", FQN: fqn, Relation: "endpoint"})
+			}
+			if endpointMatch(ip, ss.NotReadyAddresses) {
+				refs = append(refs, RelatedRef{GVR: "v1/endpoints", FQN: fqn, Relation: "endpoint (not ready)"})
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func nodeIPs(f Factory, ip net.IP) ([]RelatedRef, error) {
+	oo, err := f.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, o := range oo {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			return nil, err
+		}
+		for _, a := range no.Status.Addresses {
+			if matchIP(ip, a.Address) {
+				refs = append(refs, RelatedRef{GVR: "v1/nodes", FQN: client.FQN(client.ClusterScope, no.Name), Relation: "node " + string(a.Type)})
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func endpointMatch(ip net.IP, aa []v1.EndpointAddress) bool {
+	for _, a := range aa {
+		if matchIP(ip, a.IP) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchIP(ip net.IP, ss ...string) bool {
+	for _, s := range ss {
+		if ip.Equal(net.ParseIP(s)) {
+			return true
+		}
+	}
+
+	return false
+}
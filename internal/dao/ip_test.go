@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestSearchIP(t *testing.T) {
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{
+				"status": map[string]interface{}{"podIP": "10.32.4.17", "hostIP": "192.168.0.10"},
+			}),
+			relObj("v1", "Pod", "default", "p2", nil, map[string]interface{}{
+				"status": map[string]interface{}{"podIP": "10.32.4.18"},
+			}),
+		},
+		"v1/services": {
+			relObj("v1", "Service", "default", "s1", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"clusterIP": "10.96.0.10", "clusterIPs": []interface{}{"10.96.0.10"}},
+			}),
+		},
+		"v1/endpoints": {
+			relObj("v1", "Endpoints", "default", "s1", nil, map[string]interface{}{
+				"subsets": []interface{}{
+					map[string]interface{}{
+						"addresses": []interface{}{map[string]interface{}{"ip": "10.32.4.17"}},
+					},
+				},
+			}),
+		},
+		"v1/nodes": {
+			relObj("v1", "Node", "", "n1", nil, map[string]interface{}{
+				"status": map[string]interface{}{
+					"addresses": []interface{}{
+						map[string]interface{}{"type": "InternalIP", "address": "192.168.0.10"},
+					},
+				},
+			}),
+		},
+	}}
+
+	uu := map[string]struct {
+		ip  string
+		e   []RelatedRef
+		err bool
+	}{
+		"pod": {
+			ip: "10.32.4.17",
+			e: []RelatedRef{
+				{GVR: "v1/endpoints", FQN: "default/s1", Relation: "endpoint"},
+				{GVR: "v1/pods", FQN: "default/p1", Relation: "pod ip"},
+			},
+		},
+		"service": {
+			ip: "10.96.0.10",
+			e: []RelatedRef{
+				{GVR: "v1/services", FQN: "default/s1", Relation: "cluster ip"},
+			},
+		},
+		"node": {
+			ip: "192.168.0.10",
+			e: []RelatedRef{
+				{GVR: "v1/nodes", FQN: "-/n1", Relation: "node InternalIP"},
+			},
+		},
+		"none": {
+			ip: "10.0.0.1",
+		},
+		"invalid": {
+			ip:  "fred",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			refs, err := SearchIP(f, u.ip)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.e, refs)
+		})
+	}
+}
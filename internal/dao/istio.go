@@ -0,0 +1,196 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*VirtualService)(nil)
+	_ Accessor = (*DestinationRule)(nil)
+)
+
+// VirtualService represents an Istio VirtualService.
+type VirtualService struct {
+	Resource
+}
+
+// BackingPods returns the service path and pod selector for the VirtualService primary route.
+func (v *VirtualService) BackingPods(path string) (string, map[string]string, error) {
+	o, err := v.Get(context.Background(), path)
+	if err != nil {
+		return "", nil, err
+	}
+	var vs render.IstioVirtualService
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &vs)
+	if err != nil {
+		return "", nil, err
+	}
+	dest, ok := vs.Spec.Primary()
+	if !ok {
+		return "", nil, fmt.Errorf("no route destinations found on VirtualService %s", path)
+	}
+	svcFQN, ok := render.IstioServiceFQN(dest.Host, vs.Namespace)
+	if !ok {
+		return "", nil, fmt.Errorf("destination %s is not an in-cluster service", dest.Host)
+	}
+	sel, err := istioServiceSelector(v.Factory, svcFQN)
+	if err != nil {
+		return "", nil, err
+	}
+	if dest.Subset == "" {
+		return svcFQN, sel, nil
+	}
+
+	drGVR := client.NewGVR(v.gvr.G() + "/" + v.gvr.V() + "/destinationrules")
+	ns, _ := client.Namespaced(svcFQN)
+	oo, err := v.GetFactory().List(drGVR.String(), client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return "", nil, err
+	}
+	for _, o := range oo {
+		var dr render.IstioDestinationRule
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &dr); err != nil {
+			return "", nil, err
+		}
+		if render.IstioFQDN(dr.Spec.Host, dr.Namespace) != render.IstioFQDN(dest.Host, ns) {
+			continue
+		}
+		for _, s := range dr.Spec.Subsets {
+			if s.Name == dest.Subset {
+				return svcFQN, mergeSelectors(sel, s.Labels), nil
+			}
+		}
+	}
+
+	return svcFQN, sel, nil
+}
+
+// DestinationRule represents an Istio DestinationRule.
+type DestinationRule struct {
+	Resource
+}
+
+// Get returns a DestinationRule along with its subsets health.
+func (d *DestinationRule) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := d.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return d.withHealth(o)
+}
+
+// List returns a collection of DestinationRules along with their subsets health.
+func (d *DestinationRule) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := d.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		dr, err := d.withHealth(o)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, dr)
+	}
+
+	return res, nil
+}
+
+func (d *DestinationRule) withHealth(o runtime.Object) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var dr render.IstioDestinationRule
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &dr)
+	if err != nil {
+		return nil, err
+	}
+
+	drh := render.DestinationRuleWithHealth{Raw: u}
+	svcFQN, ok := render.IstioServiceFQN(dr.Spec.Host, dr.Namespace)
+	if !ok || len(dr.Spec.Subsets) == 0 {
+		return &drh, nil
+	}
+	sel, err := istioServiceSelector(d.Factory, svcFQN)
+	if err != nil {
+		return &drh, nil
+	}
+	ns, _ := client.Namespaced(svcFQN)
+	drh.Subsets = make(map[string]render.SubsetHealth, len(dr.Spec.Subsets))
+	for _, s := range dr.Spec.Subsets {
+		h, err := podsHealth(d.Factory, ns, mergeSelectors(sel, s.Labels))
+		if err != nil {
+			return nil, err
+		}
+		drh.Subsets[s.Name] = h
+	}
+
+	return &drh, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func istioServiceSelector(f Factory, svcFQN string) (map[string]string, error) {
+	o, err := f.Get("v1/services", svcFQN, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var svc v1.Service
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc)
+	if err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("no valid selector found on Service %s", svcFQN)
+	}
+
+	return svc.Spec.Selector, nil
+}
+
+func podsHealth(f Factory, ns string, sel map[string]string) (render.SubsetHealth, error) {
+	var h render.SubsetHealth
+	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
+	if err != nil {
+		return h, err
+	}
+	for _, o := range oo {
+		var po v1.Pod
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &po)
+		if err != nil {
+			return h, err
+		}
+		h.Total++
+		for _, c := range po.Status.Conditions {
+			if c.Type == v1.PodReady && c.Status == v1.ConditionTrue {
+				h.Ready++
+				break
+			}
+		}
+	}
+
+	return h, nil
+}
+
+func mergeSelectors(ss ...map[string]string) map[string]string {
+	res := make(map[string]string)
+	for _, s := range ss {
+		for k, v := range s {
+			res[k] = v
+		}
+	}
+
+	return res
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/rs/zerolog/log"
 	batchv1 "k8s.io/api/batch/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -34,23 +35,31 @@ func (j *Job) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 	ctrl, _ := ctx.Value(internal.KeyPath).(string)
 	_, n := client.Namespaced(ctrl)
 
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	pp, perr := j.GetFactory().List("v1/pods", ns, false, labels.Everything())
+	if perr != nil {
+		log.Warn().Err(perr).Msgf("job pods list failed")
+	}
+
 	ll := make([]runtime.Object, 0, 10)
 	for _, o := range oo {
-		var j batchv1.Job
-		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &j)
+		var job batchv1.Job
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &job)
 		if err != nil {
 			return nil, errors.New("expecting Job resource")
 		}
-		if n == "" {
-			ll = append(ll, o)
+		if n != "" && !hasOwner(job.OwnerReferences, n) {
 			continue
 		}
-
-		for _, r := range j.ObjectMeta.OwnerReferences {
-			if r.Name == n {
-				ll = append(ll, o)
+		res := render.JobWithFailures{Raw: o.(*unstructured.Unstructured)}
+		if perr == nil {
+			if res.Reason, err = jobFailureReason(&job, pp); err != nil {
+				return nil, err
 			}
 		}
+		ll = append(ll, &res)
 	}
 
 	return ll, nil
@@ -0,0 +1,76 @@
+package dao
+
+import (
+	"sort"
+
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// jobFailureReason returns the most common failure reason amongst a job pods.
+func jobFailureReason(job *batchv1.Job, pp []runtime.Object) (string, error) {
+	counts := make(map[string]int)
+	for _, o := range pp {
+		if !IsOwnedBy(o, string(job.UID)) {
+			continue
+		}
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return "", err
+		}
+		if r := podFailureReason(&po); r != "" {
+			counts[r]++
+		}
+	}
+	if len(counts) == 0 {
+		return "", nil
+	}
+
+	rr := make([]string, 0, len(counts))
+	for r := range counts {
+		rr = append(rr, r)
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		if counts[rr[i]] != counts[rr[j]] {
+			return counts[rr[i]] > counts[rr[j]]
+		}
+		return rr[i] < rr[j]
+	})
+
+	return rr[0], nil
+}
+
+// podFailureReason returns why a batch pod failed or is failing if any.
+func podFailureReason(po *v1.Pod) string {
+	if po.Status.Phase == v1.PodFailed && po.Status.Reason != "" {
+		return po.Status.Reason
+	}
+	ss := make([]v1.ContainerStatus, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+	ss = append(ss, po.Status.InitContainerStatuses...)
+	ss = append(ss, po.Status.ContainerStatuses...)
+	for _, s := range ss {
+		switch {
+		case s.State.Terminated != nil && s.State.Terminated.ExitCode != 0:
+			return s.State.Terminated.Reason
+		case s.State.Waiting != nil && s.State.Waiting.Reason != "" && s.State.Waiting.Reason != "PodInitializing" && s.State.Waiting.Reason != "ContainerCreating":
+			return s.State.Waiting.Reason
+		}
+	}
+	if po.Status.Phase == v1.PodFailed {
+		return string(v1.PodFailed)
+	}
+
+	return ""
+}
+
+func hasOwner(rr []metav1.OwnerReference, n string) bool {
+	for _, r := range rr {
+		if r.Name == n {
+			return true
+		}
+	}
+
+	return false
+}
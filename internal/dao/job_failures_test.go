@@ -0,0 +1,72 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	batchv1 "k8s.io/api/batch/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestJobFailureReason(t *testing.T) {
+	job := batchv1.Job{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "batch", UID: "job-1"}}
+	pod := func(n string, status map[string]interface{}) runtime.Object {
+		o := relObj("v1", "Pod", "default", n, nil, map[string]interface{}{"status": status})
+		o.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: "batch", UID: "job-1"}})
+		return o
+	}
+	terminated := func(reason string, code int64) map[string]interface{} {
+		phase := "Failed"
+		if code == 0 {
+			phase = "Succeeded"
+		}
+		return map[string]interface{}{
+			"phase": phase,
+			"containerStatuses": []interface{}{map[string]interface{}{
+				"name":  "c1",
+				"state": map[string]interface{}{"terminated": map[string]interface{}{"reason": reason, "exitCode": code}},
+			}},
+		}
+	}
+
+	uu := map[string]struct {
+		pp []runtime.Object
+		e  string
+	}{
+		"none": {},
+		"succeeded": {
+			pp: []runtime.Object{pod("p1", terminated("Completed", 0))},
+		},
+		"dominant": {
+			pp: []runtime.Object{
+				pod("p1", terminated("OOMKilled", 137)),
+				pod("p2", terminated("Error", 1)),
+				pod("p3", terminated("OOMKilled", 137)),
+				pod("p4", map[string]interface{}{"phase": "Failed", "reason": "DeadlineExceeded"}),
+				relObj("v1", "Pod", "default", "other", nil, map[string]interface{}{"status": terminated("Error", 1)}),
+				relObj("v1", "Pod", "default", "other2", nil, map[string]interface{}{"status": terminated("Error", 1)}),
+			},
+			e: "OOMKilled",
+		},
+		"waiting": {
+			pp: []runtime.Object{pod("p1", map[string]interface{}{
+				"phase": "Pending",
+				"containerStatuses": []interface{}{map[string]interface{}{
+					"name":  "c1",
+					"state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "ImagePullBackOff"}},
+				}},
+			})},
+			e: "ImagePullBackOff",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r, err := jobFailureReason(&job, u.pp)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, r)
+		})
+	}
+}
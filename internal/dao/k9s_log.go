@@ -0,0 +1,45 @@
+package dao
+
+import (
+	"context"
+	"errors"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/applog"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// K9sLogsGVR tracks the k9s session logs resource.
+const K9sLogsGVR = "k9slogs"
+
+// K9sLogs represents the k9s session logs.
+type K9sLogs struct {
+	NonResource
+}
+
+// List returns the recent k9s log entries at or above the level set in the context.
+// The viewer own refresh traces are skipped.
+func (l *K9sLogs) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	level, ok := ctx.Value(internal.KeyLogLevel).(zerolog.Level)
+	if !ok {
+		level = zerolog.TraceLevel
+	}
+
+	ee := applog.Recent().Entries(level)
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		if gvr, ok := e.Field("gvr"); ok && gvr == K9sLogsGVR {
+			continue
+		}
+		oo = append(oo, render.K9sLogRes{Entry: e})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given log entry.
+func (l *K9sLogs) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
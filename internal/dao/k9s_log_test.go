@@ -0,0 +1,40 @@
+package dao
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/applog"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestK9sLogsList(t *testing.T) {
+	lvl := zerolog.GlobalLevel()
+	zerolog.SetGlobalLevel(zerolog.DebugLevel)
+	defer zerolog.SetGlobalLevel(lvl)
+	applog.Recent().Clear()
+	defer applog.Recent().Clear()
+	l := zerolog.New(applog.Recent())
+	l.Debug().Str("gvr", "v1/pods").Msg("Table refreshed")
+	l.Debug().Str("gvr", K9sLogsGVR).Msg("Table refreshed")
+	l.Warn().Msg("Watch failed")
+
+	var k K9sLogs
+	k.Init(relFactory{}, client.NewGVR(K9sLogsGVR))
+
+	oo, err := k.List(context.Background(), client.AllNamespaces)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(oo))
+	gvr, _ := oo[0].(render.K9sLogRes).Field("gvr")
+	assert.Equal(t, "v1/pods", gvr)
+
+	ctx := context.WithValue(context.Background(), internal.KeyLogLevel, zerolog.WarnLevel)
+	oo, err = k.List(ctx, client.AllNamespaces)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+	assert.Equal(t, "Watch failed", oo[0].(render.K9sLogRes).Message)
+}
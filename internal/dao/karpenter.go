@@ -0,0 +1,165 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*NodePool)(nil)
+	_ Accessor = (*NodeClaim)(nil)
+)
+
+// NodePool represents a Karpenter NodePool.
+type NodePool struct {
+	Resource
+}
+
+// Get returns a NodePool along with its NodeClaims count.
+func (n *NodePool) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := n.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	claims, err := n.claims()
+	if err != nil {
+		return nil, err
+	}
+
+	return n.withClaims(o, claims)
+}
+
+// List returns a collection of NodePools along with their NodeClaims count.
+func (n *NodePool) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := n.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	claims, err := n.claims()
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		np, err := n.withClaims(o, claims)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, np)
+	}
+
+	return res, nil
+}
+
+// NodeClaimsGVR returns the NodeClaims resource matching this NodePool version.
+func (n *NodePool) NodeClaimsGVR() client.GVR {
+	return client.NewGVR(n.gvr.G() + "/" + n.gvr.V() + "/nodeclaims")
+}
+
+func (n *NodePool) claims() (map[string]int, error) {
+	oo, err := n.GetFactory().List(n.NodeClaimsGVR().String(), client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	claims := make(map[string]int)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		claims[u.GetLabels()[render.KarpenterNodePoolLabel]]++
+	}
+
+	return claims, nil
+}
+
+func (n *NodePool) withClaims(o runtime.Object, claims map[string]int) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return &render.NodePoolWithClaims{Raw: u, Claims: claims[u.GetName()]}, nil
+}
+
+// NodeClaim represents a Karpenter NodeClaim.
+type NodeClaim struct {
+	Resource
+}
+
+// GetInstance returns a NodeClaim instance.
+func (n *NodeClaim) GetInstance(path string) (*render.KarpenterNodeClaim, error) {
+	o, err := n.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var nc render.KarpenterNodeClaim
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &nc)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nc, nil
+}
+
+// Events returns the events involving a NodeClaim or its Node, oldest first.
+// Karpenter surfaces consolidation and disruption decisions as such events.
+func (n *NodeClaim) Events(path string) ([]v1.Event, error) {
+	nc, err := n.GetInstance(path)
+	if err != nil {
+		return nil, err
+	}
+	oo, err := n.GetFactory().List("v1/events", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]v1.Event, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		var ev v1.Event
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &ev); err != nil {
+			return nil, err
+		}
+		if !karpenterInvolved(ev.InvolvedObject, nc) {
+			continue
+		}
+		ee = append(ee, ev)
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].LastTimestamp.Before(&ee[j].LastTimestamp)
+	})
+
+	return ee, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func karpenterInvolved(ref v1.ObjectReference, nc *render.KarpenterNodeClaim) bool {
+	switch ref.Kind {
+	case "NodeClaim":
+		return ref.Name == nc.Name
+	case "Node":
+		return nc.Status.NodeName != "" && ref.Name == nc.Status.NodeName
+	default:
+		return false
+	}
+}
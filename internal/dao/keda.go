@@ -0,0 +1,125 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const hpaGVR = "autoscaling/v2/horizontalpodautoscalers"
+
+var (
+	_ Accessor = (*ScaledObject)(nil)
+	_ Pausable = (*ScaledObject)(nil)
+	_ Accessor = (*ScaledJob)(nil)
+	_ Pausable = (*ScaledJob)(nil)
+)
+
+// ScaledObject represents a KEDA ScaledObject.
+type ScaledObject struct {
+	Resource
+}
+
+// Get returns a ScaledObject along with its HPA.
+func (s *ScaledObject) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := s.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return s.withHPA(o)
+}
+
+// List returns a collection of ScaledObjects along with their HPAs.
+func (s *ScaledObject) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := s.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		so, err := s.withHPA(o)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, so)
+	}
+
+	return res, nil
+}
+
+// Pause pauses or resumes autoscaling.
+func (s *ScaledObject) Pause(ctx context.Context, path string, pause bool) error {
+	return kedaPause(ctx, &s.Generic, path, pause)
+}
+
+func (s *ScaledObject) withHPA(o runtime.Object) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+	soh := render.ScaledObjectWithHPA{Raw: u}
+	name, _, _ := unstructured.NestedString(u.Object, "status", "hpaName")
+	if name == "" {
+		return &soh, nil
+	}
+	fqn := client.FQN(u.GetNamespace(), name)
+	h, err := s.GetFactory().Get(hpaGVR, fqn, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("Unable to locate HPA %s", fqn)
+		return &soh, nil
+	}
+	hu, ok := h.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got %T", h)
+	}
+	var hpa autoscalingv2.HorizontalPodAutoscaler
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(hu.Object, &hpa)
+	if err != nil {
+		return nil, err
+	}
+	soh.HPA = &hpa
+
+	return &soh, nil
+}
+
+// ScaledJob represents a KEDA ScaledJob.
+type ScaledJob struct {
+	Resource
+}
+
+// Pause pauses or resumes autoscaling.
+func (s *ScaledJob) Pause(ctx context.Context, path string, pause bool) error {
+	return kedaPause(ctx, &s.Generic, path, pause)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func kedaPause(ctx context.Context, g *Generic, path string, pause bool) error {
+	ann := map[string]interface{}{
+		render.KedaPausedAnnotation:         nil,
+		render.KedaPausedReplicasAnnotation: nil,
+	}
+	if pause {
+		ann[render.KedaPausedAnnotation] = "true"
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{"annotations": ann},
+	})
+	if err != nil {
+		return err
+	}
+
+	return g.Patch(ctx, path, types.MergePatchType, patch)
+}
@@ -0,0 +1,193 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor = (*KService)(nil)
+	_ Accessor = (*Revision)(nil)
+)
+
+// KService represents a Knative Service.
+type KService struct {
+	Resource
+}
+
+// ShiftTraffic routes a percentage of the Service traffic to a given revision.
+func (k *KService) ShiftTraffic(ctx context.Context, path, rev string, percent int64) error {
+	if percent < 0 || percent > 100 {
+		return fmt.Errorf("invalid traffic percentage %d", percent)
+	}
+	svc, err := k.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	tt := svc.Status.Traffic
+	if len(tt) == 0 {
+		tt = svc.Spec.Traffic
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"traffic": render.ShiftTraffic(tt, rev, percent)},
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// PruneRevisions deletes all the Service revisions no longer receiving traffic.
+func (k *KService) PruneRevisions(ctx context.Context, path string) (int, error) {
+	svc, err := k.GetInstance(path)
+	if err != nil {
+		return 0, err
+	}
+	keep := map[string]struct{}{
+		svc.Status.LatestCreatedRevisionName: {},
+		svc.Status.LatestReadyRevisionName:   {},
+	}
+	for _, t := range append(svc.Spec.Traffic, svc.Status.Traffic...) {
+		keep[t.RevisionName] = struct{}{}
+	}
+
+	var rev Revision
+	rev.Init(k.Factory, k.RevisionsGVR())
+	sel := labels.Set{render.KnativeServiceLabel: svc.Name}.AsSelector()
+	oo, err := k.GetFactory().List(rev.GVR(), svc.Namespace, true, sel)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return count, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if _, ok := keep[u.GetName()]; ok {
+			continue
+		}
+		if err := rev.Delete(ctx, client.FQN(u.GetNamespace(), u.GetName()), nil, DefaultGrace); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// RevisionsGVR returns the Revisions resource matching this Service version.
+func (k *KService) RevisionsGVR() client.GVR {
+	return client.NewGVR(k.gvr.G() + "/" + k.gvr.V() + "/revisions")
+}
+
+// GetInstance returns a Knative Service instance.
+func (k *KService) GetInstance(path string) (*render.KnativeService, error) {
+	o, err := k.GetFactory().Get(k.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var svc render.KnativeService
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc)
+	if err != nil {
+		return nil, fmt.Errorf("expecting Knative Service resource: %w", err)
+	}
+
+	return &svc, nil
+}
+
+// Revision represents a Knative Revision.
+type Revision struct {
+	Resource
+}
+
+// Get returns a Revision along with its traffic share.
+func (r *Revision) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := r.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	ns, _ := client.Namespaced(path)
+	traffic, err := r.traffic(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return r.withTraffic(o, traffic)
+}
+
+// List returns a collection of Revisions along with their traffic share.
+func (r *Revision) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := r.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	traffic, err := r.traffic(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		rev, err := r.withTraffic(o, traffic)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, rev)
+	}
+
+	return res, nil
+}
+
+// ServicesGVR returns the Services resource matching this Revision version.
+func (r *Revision) ServicesGVR() client.GVR {
+	return client.NewGVR(r.gvr.G() + "/" + r.gvr.V() + "/services")
+}
+
+func (r *Revision) withTraffic(o runtime.Object, traffic map[string]int64) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	rwt := render.RevisionWithTraffic{Raw: u}
+	if p, ok := traffic[client.FQN(u.GetNamespace(), u.GetName())]; ok {
+		rwt.Percent = &p
+	}
+
+	return &rwt, nil
+}
+
+// traffic tallies revisions traffic shares across services.
+func (r *Revision) traffic(ns string) (map[string]int64, error) {
+	oo, err := r.GetFactory().List(r.ServicesGVR().String(), ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	tt := make(map[string]int64)
+	for _, o := range oo {
+		var svc render.KnativeService
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &svc)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range svc.Status.Traffic {
+			if t.Percent == nil || t.RevisionName == "" {
+				continue
+			}
+			tt[client.FQN(svc.Namespace, t.RevisionName)] += *t.Percent
+		}
+	}
+
+	return tt, nil
+}
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	longhornDriver       = "driver.longhorn.io"
+	longhornVolumeGVR    = "longhorn.io/v1beta2/volumes"
+	longhornRunningState = "running"
+)
+
+var _ Accessor = (*Volume)(nil)
+
+// Volume represents a Longhorn Volume.
+type Volume struct {
+	Resource
+}
+
+// Get returns a Volume along with its running replicas count.
+func (v *Volume) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := v.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	ns, _ := client.Namespaced(path)
+	replicas, err := v.replicas(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.withReplicas(o, replicas)
+}
+
+// List returns a collection of Volumes along with their running replicas count.
+func (v *Volume) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := v.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	replicas, err := v.replicas(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		vol, err := v.withReplicas(o, replicas)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, vol)
+	}
+
+	return res, nil
+}
+
+// ReplicasGVR returns the Replicas resource matching this Volume version.
+func (v *Volume) ReplicasGVR() client.GVR {
+	return client.NewGVR(v.gvr.G() + "/" + v.gvr.V() + "/replicas")
+}
+
+func (v *Volume) replicas(ns string) (map[string]int, error) {
+	oo, err := v.GetFactory().List(v.ReplicasGVR().String(), ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	replicas := make(map[string]int)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if state, _, _ := unstructured.NestedString(u.Object, "status", "currentState"); state != longhornRunningState {
+			continue
+		}
+		replicas[client.FQN(u.GetNamespace(), u.GetLabels()[render.LonghornVolumeLabel])]++
+	}
+
+	return replicas, nil
+}
+
+func (v *Volume) withReplicas(o runtime.Object, replicas map[string]int) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return &render.LonghornVolumeWithReplicas{
+		Raw:      u,
+		Replicas: replicas[client.FQN(u.GetNamespace(), u.GetName())],
+	}, nil
+}
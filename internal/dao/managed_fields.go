@@ -0,0 +1,178 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ManagedFields)(nil)
+
+// ManagedFields represents the field managers of a given object.
+type ManagedFields struct {
+	NonResource
+}
+
+// List returns all the fields owned by the context object managers.
+func (m *ManagedFields) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, errors.New("No context GVR found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+
+	o, err := m.Factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	ff, err := FieldOwners(u.GetManagedFields())
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		oo = append(oo, f)
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given managed field.
+func (m *ManagedFields) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// FieldOwners maps each managed field to its manager and flags fields claimed by several managers.
+func FieldOwners(ee []metav1.ManagedFieldsEntry) ([]render.ManagedFieldRes, error) {
+	var (
+		ff     []render.ManagedFieldRes
+		owners = make(map[string][]string)
+	)
+	for _, e := range ee {
+		if e.FieldsV1 == nil || len(e.FieldsV1.Raw) == 0 {
+			continue
+		}
+		var m map[string]interface{}
+		if err := json.Unmarshal(e.FieldsV1.Raw, &m); err != nil {
+			return nil, fmt.Errorf("invalid fields for manager %s: %w", e.Manager, err)
+		}
+		var at metav1.Time
+		if e.Time != nil {
+			at = *e.Time
+		}
+		for _, p := range flattenFields("", m) {
+			ff = append(ff, render.ManagedFieldRes{
+				Field:       p,
+				Manager:     e.Manager,
+				Operation:   string(e.Operation),
+				Subresource: e.Subresource,
+				APIVersion:  e.APIVersion,
+				Updated:     at,
+			})
+			owners[p] = append(owners[p], e.Manager)
+		}
+	}
+
+	for i := range ff {
+		for _, o := range owners[ff[i].Field] {
+			if o != ff[i].Manager {
+				ff[i].Conflicts = append(ff[i].Conflicts, o)
+			}
+		}
+	}
+	sort.SliceStable(ff, func(i, j int) bool {
+		if ff[i].Field != ff[j].Field {
+			return ff[i].Field < ff[j].Field
+		}
+		return ff[i].Manager < ff[j].Manager
+	})
+
+	return ff, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// flattenFields walks a FieldsV1 set and returns the paths of all its leaves.
+func flattenFields(prefix string, m map[string]interface{}) []string {
+	kk := make([]string, 0, len(m))
+	for k := range m {
+		if k != "." {
+			kk = append(kk, k)
+		}
+	}
+	if len(kk) == 0 {
+		if prefix == "" {
+			return nil
+		}
+		return []string{prefix}
+	}
+	sort.Strings(kk)
+
+	var pp []string
+	for _, k := range kk {
+		p := prefix + fieldSegment(k)
+		if prefix == "" {
+			p = strings.TrimPrefix(p, ".")
+		}
+		sub, _ := m[k].(map[string]interface{})
+		pp = append(pp, flattenFields(p, sub)...)
+	}
+
+	return pp
+}
+
+// fieldSegment converts a FieldsV1 key to a readable path segment.
+func fieldSegment(k string) string {
+	if len(k) < 2 || k[1] != ':' {
+		return "." + k
+	}
+	v := k[2:]
+	switch k[0] {
+	case 'f':
+		return "." + v
+	case 'i':
+		return "[" + v + "]"
+	case 'v':
+		var val interface{}
+		if err := json.Unmarshal([]byte(v), &val); err == nil {
+			return fmt.Sprintf("[=%v]", val)
+		}
+		return "[=" + v + "]"
+	case 'k':
+		var kv map[string]interface{}
+		if err := json.Unmarshal([]byte(v), &kv); err != nil {
+			return "[" + v + "]"
+		}
+		kk := make([]string, 0, len(kv))
+		for key := range kv {
+			kk = append(kk, key)
+		}
+		sort.Strings(kk)
+		ss := make([]string, 0, len(kk))
+		for _, key := range kk {
+			ss = append(ss, fmt.Sprintf("%s=%v", key, kv[key]))
+		}
+		return "[" + strings.Join(ss, ",") + "]"
+	default:
+		return "." + k
+	}
+}
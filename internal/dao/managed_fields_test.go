@@ -0,0 +1,68 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestFieldOwners(t *testing.T) {
+	ee := []metav1.ManagedFieldsEntry{
+		{
+			Manager:   "kubectl",
+			Operation: metav1.ManagedFieldsOperationApply,
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{
+				"f:spec": {
+					"f:replicas": {},
+					"f:template": {"f:spec": {"f:containers": {
+						"k:{\"name\":\"nginx\"}": {".": {}, "f:image": {}, "f:name": {}}
+					}}}
+				}
+			}`)},
+		},
+		{
+			Manager:   "hpa-controller",
+			Operation: metav1.ManagedFieldsOperationUpdate,
+			FieldsV1:  &metav1.FieldsV1{Raw: []byte(`{"f:spec": {"f:replicas": {}}}`)},
+		},
+		{
+			Manager:     "kube-controller-manager",
+			Operation:   metav1.ManagedFieldsOperationUpdate,
+			Subresource: "status",
+			FieldsV1: &metav1.FieldsV1{Raw: []byte(`{
+				"f:metadata": {"f:finalizers": {".": {}, "v:\"foregroundDeletion\"": {}}},
+				"f:status": {"f:conditions": {"i:0": {}}}
+			}`)},
+		},
+	}
+
+	ff, err := FieldOwners(ee)
+	assert.Nil(t, err)
+
+	type owner struct {
+		field, manager string
+		conflicts      []string
+	}
+	var oo []owner
+	for _, f := range ff {
+		oo = append(oo, owner{field: f.Field, manager: f.Manager, conflicts: f.Conflicts})
+	}
+	assert.Equal(t, []owner{
+		{field: "metadata.finalizers[=foregroundDeletion]", manager: "kube-controller-manager"},
+		{field: "spec.replicas", manager: "hpa-controller", conflicts: []string{"kubectl"}},
+		{field: "spec.replicas", manager: "kubectl", conflicts: []string{"hpa-controller"}},
+		{field: "spec.template.spec.containers[name=nginx].image", manager: "kubectl"},
+		{field: "spec.template.spec.containers[name=nginx].name", manager: "kubectl"},
+		{field: "status.conditions[0]", manager: "kube-controller-manager"},
+	}, oo)
+	assert.Equal(t, "status", ff[0].Subresource)
+	assert.Equal(t, "Apply", ff[2].Operation)
+}
+
+func TestFieldOwnersInvalid(t *testing.T) {
+	_, err := FieldOwners([]metav1.ManagedFieldsEntry{
+		{Manager: "fred", FieldsV1: &metav1.FieldsV1{Raw: []byte(`{`)}},
+	})
+	assert.Error(t, err)
+}
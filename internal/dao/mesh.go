@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// meshControlPlanes tracks where meshes control planes live.
+var meshControlPlanes = map[string]struct {
+	ns, selector, container string
+}{
+	render.IstioMesh:   {ns: "istio-system", selector: "app=istiod", container: "discovery"},
+	render.LinkerdMesh: {ns: "linkerd", selector: "linkerd.io/control-plane-component=destination", container: "linkerd-proxy"},
+}
+
+// meshIndex tracks the namespaces sidecar injection settings and the meshes control
+// planes versions.
+type meshIndex struct {
+	namespaces map[string]string
+	versions   map[string][]string
+}
+
+// newMeshIndex indexes meshes settings. Control planes are only looked up for meshes
+// at least one namespace opts into.
+func newMeshIndex(f Factory) meshIndex {
+	idx := meshIndex{
+		namespaces: make(map[string]string),
+		versions:   make(map[string][]string),
+	}
+	oo, err := f.List("v1/namespaces", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("namespaces list failed")
+		return idx
+	}
+	for _, o := range oo {
+		var ns v1.Namespace
+		if err := fromUnstructured(o, &ns); err != nil {
+			continue
+		}
+		if m := render.NamespaceMesh(&ns); m != "" {
+			idx.namespaces[ns.Name] = m
+			if _, ok := idx.versions[m]; !ok {
+				idx.versions[m] = meshVersions(f, m)
+			}
+		}
+	}
+
+	return idx
+}
+
+func (m meshIndex) podMesh(u *unstructured.Unstructured) render.PodMesh {
+	return render.PodMesh{Namespace: m.namespaces[u.GetNamespace()], Versions: m.versions}
+}
+
+// meshVersions returns the versions a mesh control plane runs.
+func meshVersions(f Factory, mesh string) []string {
+	cp := meshControlPlanes[mesh]
+	sel, err := labels.Parse(cp.selector)
+	if err != nil {
+		return nil
+	}
+	oo, err := f.List("apps/v1/deployments", cp.ns, false, sel)
+	if err != nil {
+		log.Debug().Err(err).Msgf("%s control plane list failed", mesh)
+		return nil
+	}
+
+	vv := make(map[string]struct{})
+	for _, o := range oo {
+		var dp appsv1.Deployment
+		if err := fromUnstructured(o, &dp); err != nil {
+			continue
+		}
+		for _, co := range dp.Spec.Template.Spec.Containers {
+			if co.Name != cp.container {
+				continue
+			}
+			if v := render.ProxyVersion(mesh, dp.Spec.Template.Annotations, co.Image); v != "" {
+				vv[v] = struct{}{}
+			}
+		}
+	}
+	if len(vv) == 0 {
+		return nil
+	}
+
+	return sortedKeys(vv)
+}
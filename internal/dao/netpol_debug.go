@@ -0,0 +1,225 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+const (
+	netpolGVR = "networking.k8s.io/v1/networkpolicies"
+
+	// DebugNetPolLabel marks the network policies injected by k9s for troubleshooting.
+	DebugNetPolLabel = "k9s.derailed.io/debug-netpol"
+
+	// DebugNetPolExpiresAnn tracks when an injected debug network policy should be removed.
+	DebugNetPolExpiresAnn = "k9s.derailed.io/expires-at"
+
+	// DebugNetPolTargetLabel marks the pod selected by a debug network policy.
+	DebugNetPolTargetLabel = "k9s.derailed.io/debug-netpol-target"
+)
+
+// NetPolDirection represents the traffic opened by a debug network policy.
+type NetPolDirection string
+
+const (
+	// NetPolBoth allows both ingress and egress traffic.
+	NetPolBoth NetPolDirection = "Ingress+Egress"
+
+	// NetPolIngress only allows ingress traffic.
+	NetPolIngress NetPolDirection = "Ingress"
+
+	// NetPolEgress only allows egress traffic.
+	NetPolEgress NetPolDirection = "Egress"
+)
+
+// NetPolDirections lists the available debug network policy directions.
+var NetPolDirections = []NetPolDirection{NetPolBoth, NetPolIngress, NetPolEgress}
+
+// DebugNetPolSpec describes a temporary allow-all network policy.
+type DebugNetPolSpec struct {
+	Namespace string
+	// Target names the pod to open up. Blank targets the whole namespace.
+	Target    string
+	Direction NetPolDirection
+	TTL       time.Duration
+}
+
+// InjectDebugNetPol creates a temporary allow-all network policy and returns its path.
+// A targeted pod is labeled so the policy only selects that pod and not its siblings.
+// Expired debug policies in the same namespace are purged beforehand.
+func InjectDebugNetPol(ctx context.Context, f Factory, spec DebugNetPolSpec) (string, error) {
+	if spec.TTL <= 0 {
+		return "", errors.New("ttl must be positive")
+	}
+	if _, err := PurgeDebugNetPols(ctx, f, spec.Namespace, time.Now()); err != nil {
+		return "", err
+	}
+	var sel map[string]string
+	if spec.Target != "" {
+		sel = map[string]string{DebugNetPolTargetLabel: rand.String(8)}
+		if err := labelDebugTarget(ctx, f, client.FQN(spec.Namespace, spec.Target), sel[DebugNetPolTargetLabel]); err != nil {
+			return "", err
+		}
+	}
+	var g Generic
+	g.Init(f, client.NewGVR(netpolGVR))
+	u, err := g.Create(ctx, debugNetPol(spec, sel, time.Now().Add(spec.TTL)))
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(u.GetNamespace(), u.GetName()), nil
+}
+
+// RemoveDebugNetPol deletes an injected debug network policy and unlabels its target pod.
+func RemoveDebugNetPol(ctx context.Context, f Factory, path string) error {
+	var g Generic
+	g.Init(f, client.NewGVR(netpolGVR))
+	o, err := g.Get(ctx, path)
+	if kerrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if err := g.Delete(ctx, path, nil, DefaultGrace); err != nil && !kerrors.IsNotFound(err) {
+		return err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got %T", o)
+	}
+
+	return unlabelDebugTargets(ctx, f, u)
+}
+
+// PurgeDebugNetPols deletes the debug network policies that expired by now in a given namespace.
+// Policies are listed straight from the api server so no informer is started.
+func PurgeDebugNetPols(ctx context.Context, f Factory, ns string, now time.Time) (int, error) {
+	if client.IsAllNamespaces(ns) {
+		ns = client.AllNamespaces
+	}
+	var g Generic
+	g.Init(f, client.NewGVR(netpolGVR))
+	oo, err := g.List(context.WithValue(ctx, internal.KeyLabels, DebugNetPolLabel+"=true"), ns)
+	if err != nil {
+		return 0, err
+	}
+	var count int
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || !debugNetPolExpired(u, now) {
+			continue
+		}
+		if err := RemoveDebugNetPol(ctx, f, client.FQN(u.GetNamespace(), u.GetName())); err != nil {
+			return count, err
+		}
+		count++
+	}
+
+	return count, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func debugNetPolExpired(u *unstructured.Unstructured, now time.Time) bool {
+	exp, err := time.Parse(time.RFC3339, u.GetAnnotations()[DebugNetPolExpiresAnn])
+	if err != nil {
+		return false
+	}
+
+	return !now.Before(exp)
+}
+
+// labelDebugTarget sets or clears, given a blank value, a pod debug target label.
+func labelDebugTarget(ctx context.Context, f Factory, path, value string) error {
+	var v interface{}
+	if value != "" {
+		v = value
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{DebugNetPolTargetLabel: v},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	var g Generic
+	g.Init(f, client.NewGVR("v1/pods"))
+
+	return g.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// unlabelDebugTargets clears the target label of the pods selected by a debug network policy.
+func unlabelDebugTargets(ctx context.Context, f Factory, pol *unstructured.Unstructured) error {
+	token, _, _ := unstructured.NestedString(pol.Object, "spec", "podSelector", "matchLabels", DebugNetPolTargetLabel)
+	if token == "" {
+		return nil
+	}
+	var g Generic
+	g.Init(f, client.NewGVR("v1/pods"))
+	oo, err := g.List(context.WithValue(ctx, internal.KeyLabels, DebugNetPolTargetLabel+"="+token), pol.GetNamespace())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		err := labelDebugTarget(ctx, f, client.FQN(u.GetNamespace(), u.GetName()), "")
+		if err != nil && !kerrors.IsNotFound(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func debugNetPol(spec DebugNetPolSpec, selector map[string]string, expires time.Time) *unstructured.Unstructured {
+	sel := make(map[string]interface{}, len(selector))
+	for k, v := range selector {
+		sel[k] = v
+	}
+	target := spec.Target
+	if target == "" {
+		target = spec.Namespace
+	}
+	pol := map[string]interface{}{
+		"podSelector": map[string]interface{}{"matchLabels": sel},
+	}
+	var types []interface{}
+	if spec.Direction != NetPolEgress {
+		types = append(types, "Ingress")
+		pol["ingress"] = []interface{}{map[string]interface{}{}}
+	}
+	if spec.Direction != NetPolIngress {
+		types = append(types, "Egress")
+		pol["egress"] = []interface{}{map[string]interface{}{}}
+	}
+	pol["policyTypes"] = types
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "networking.k8s.io/v1",
+		"kind":       "NetworkPolicy",
+		"metadata": map[string]interface{}{
+			"generateName": "k9s-debug-" + target + "-",
+			"namespace":    spec.Namespace,
+			"labels":       map[string]interface{}{DebugNetPolLabel: "true"},
+			"annotations":  map[string]interface{}{DebugNetPolExpiresAnn: expires.UTC().Format(time.RFC3339)},
+		},
+		"spec": pol,
+	}}
+}
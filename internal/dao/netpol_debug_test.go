@@ -0,0 +1,91 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestDebugNetPol(t *testing.T) {
+	exp := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		spec   DebugNetPolSpec
+		target map[string]string
+		name   string
+		sel    map[string]interface{}
+		types  []interface{}
+		in, eg bool
+	}{
+		"pod": {
+			spec:   DebugNetPolSpec{Namespace: "ns1", Target: "p1", Direction: NetPolBoth},
+			target: map[string]string{DebugNetPolTargetLabel: "abc"},
+			name:   "k9s-debug-p1-",
+			sel:    map[string]interface{}{DebugNetPolTargetLabel: "abc"},
+			types:  []interface{}{"Ingress", "Egress"},
+			in:     true,
+			eg:     true,
+		},
+		"namespace-ingress": {
+			spec:  DebugNetPolSpec{Namespace: "ns1", Direction: NetPolIngress},
+			name:  "k9s-debug-ns1-",
+			sel:   map[string]interface{}{},
+			types: []interface{}{"Ingress"},
+			in:    true,
+		},
+		"egress": {
+			spec:  DebugNetPolSpec{Namespace: "ns1", Direction: NetPolEgress},
+			name:  "k9s-debug-ns1-",
+			sel:   map[string]interface{}{},
+			types: []interface{}{"Egress"},
+			eg:    true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := debugNetPol(u.spec, u.target, exp)
+			assert.Equal(t, u.name, o.GetGenerateName())
+			assert.Equal(t, "ns1", o.GetNamespace())
+			assert.Equal(t, "true", o.GetLabels()[DebugNetPolLabel])
+			assert.Equal(t, "2023-01-01T10:00:00Z", o.GetAnnotations()[DebugNetPolExpiresAnn])
+			sel, _, _ := unstructured.NestedMap(o.Object, "spec", "podSelector", "matchLabels")
+			assert.Equal(t, u.sel, sel)
+			types, _, _ := unstructured.NestedSlice(o.Object, "spec", "policyTypes")
+			assert.Equal(t, u.types, types)
+			_, in, _ := unstructured.NestedSlice(o.Object, "spec", "ingress")
+			assert.Equal(t, u.in, in)
+			_, eg, _ := unstructured.NestedSlice(o.Object, "spec", "egress")
+			assert.Equal(t, u.eg, eg)
+		})
+	}
+}
+
+func TestDebugNetPolExpired(t *testing.T) {
+	now := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		ann string
+		e   bool
+	}{
+		"expired": {ann: "2023-01-01T09:59:00Z", e: true},
+		"now":     {ann: "2023-01-01T10:00:00Z", e: true},
+		"pending": {ann: "2023-01-01T10:01:00Z"},
+		"missing": {},
+		"bad":     {ann: "soon"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o unstructured.Unstructured
+			if u.ann != "" {
+				o.SetAnnotations(map[string]string{DebugNetPolExpiresAnn: u.ann})
+			}
+			assert.Equal(t, u.e, debugNetPolExpired(&o, now))
+		})
+	}
+}
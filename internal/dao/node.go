@@ -2,8 +2,11 @@ package dao
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -13,6 +16,7 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/kubectl/pkg/drain"
 	"k8s.io/kubectl/pkg/scheme"
@@ -24,6 +28,8 @@ var (
 	_ NodeMaintainer = (*Node)(nil)
 )
 
+var errAlreadyCordoned = errors.New("node is already cordoned")
+
 // NodeMetricsFunc retrieves node metrics.
 type NodeMetricsFunc func() (*mv1beta1.NodeMetricsList, error)
 
@@ -48,7 +54,7 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 
 	if !h.UpdateIfRequired(cordon) {
 		if cordon {
-			return fmt.Errorf("node is already cordoned")
+			return errAlreadyCordoned
 		}
 		return fmt.Errorf("node is already uncordoned")
 	}
@@ -64,10 +70,40 @@ func (n *Node) ToggleCordon(path string, cordon bool) error {
 	if err != nil {
 		return err
 	}
+	if !cordon {
+		return n.setCordonReason(path, "")
+	}
 
 	return nil
 }
 
+// Cordon cordons a node if need be and records the reason as an annotation.
+func (n *Node) Cordon(path, reason string) error {
+	if err := n.ToggleCordon(path, true); err != nil && !errors.Is(err, errAlreadyCordoned) {
+		return err
+	}
+
+	return n.setCordonReason(path, reason)
+}
+
+// setCordonReason sets or clears a node cordon reason annotation.
+func (n *Node) setCordonReason(path, reason string) error {
+	var val interface{}
+	if reason = strings.TrimSpace(reason); reason != "" {
+		val = reason
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]interface{}{render.CordonReasonAnnotation: val},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return n.Patch(context.Background(), path, types.MergePatchType, patch)
+}
+
 func (o DrainOptions) toDrainHelper(k kubernetes.Interface, w io.Writer) drain.Helper {
 	return drain.Helper{
 		Client:              k,
@@ -130,7 +166,7 @@ func (n *Node) Get(ctx context.Context, path string) (runtime.Object, error) {
 		nmx, _ = client.DialMetrics(n.Client()).FetchNodeMetrics(ctx, path)
 	}
 
-	return &render.NodeWithMetrics{Raw: raw, MX: nmx}, nil
+	return &render.NodeWithMetrics{Raw: raw, MX: nmx, ServerMinor: ServerMinor(n.Client())}, nil
 }
 
 // List returns a collection of node resources.
@@ -145,6 +181,7 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		nmx, _ = client.DialMetrics(n.Client()).FetchNodesMetricsMap(ctx)
 	}
 
+	minor := ServerMinor(n.Client())
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
 		u, ok := o.(*unstructured.Unstructured)
@@ -159,9 +196,10 @@ func (n *Node) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			log.Error().Err(err).Msgf("unable to get pods count for %s", name)
 		}
 		res = append(res, &render.NodeWithMetrics{
-			Raw:      u,
-			MX:       nmx[name],
-			PodCount: podCount,
+			Raw:         u,
+			MX:          nmx[name],
+			PodCount:    podCount,
+			ServerMinor: minor,
 		})
 	}
 
@@ -0,0 +1,196 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+)
+
+// namespaceBlockingConditions tracks the namespace conditions explaining a stuck deletion.
+var namespaceBlockingConditions = map[v1.NamespaceConditionType]struct{}{
+	v1.NamespaceDeletionDiscoveryFailure: {},
+	v1.NamespaceDeletionGVParsingFailure: {},
+	v1.NamespaceDeletionContentFailure:   {},
+	v1.NamespaceContentRemaining:         {},
+	v1.NamespaceFinalizersRemaining:      {},
+}
+
+// NamespaceBlocker represents a resource holding up a namespace deletion.
+type NamespaceBlocker struct {
+	GVR, FQN string
+	Reason   string
+}
+
+// NamespaceBlockers represents the resources blocking a namespace termination.
+type NamespaceBlockers struct {
+	NonResource
+}
+
+// List returns all resources blocking the context namespace deletion.
+func (n *NamespaceBlockers) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ns, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+	bb, err := FindNamespaceBlockers(ctx, n.Client(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(bb))
+	for _, b := range bb {
+		fns, fn := client.Namespaced(b.FQN)
+		oo = append(oo, render.NamespaceBlockerRes{
+			Namespace: fns,
+			Name:      fn,
+			GVR:       b.GVR,
+			Reason:    b.Reason,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given blocker.
+func (n *NamespaceBlockers) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
+
+// FindNamespaceBlockers enumerates live the namespace conditions, unreachable api groups
+// and remaining resources across all served apis holding up a namespace deletion.
+func FindNamespaceBlockers(ctx context.Context, c client.Connection, ns string) ([]NamespaceBlocker, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return nil, err
+	}
+	nso, err := dial.CoreV1().Namespaces().Get(ctx, ns, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	bb := namespaceConditionBlockers(nso)
+
+	disc, err := c.CachedDiscovery()
+	if err != nil {
+		return nil, err
+	}
+	disc.Invalidate()
+	ll, err := disc.ServerPreferredNamespacedResources()
+	bb = append(bb, discoveryBlockers(err)...)
+	if err != nil && len(ll) == 0 {
+		return bb, nil
+	}
+
+	dyn, err := c.DynDial()
+	if err != nil {
+		return nil, err
+	}
+	for _, gvr := range deletableGVRs(ll) {
+		list, err := dyn.Resource(gvr.GVR()).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			log.Warn().Err(err).Msgf("Namespace blockers list failed for %s", gvr)
+			continue
+		}
+		for i := range list.Items {
+			bb = append(bb, remainingBlocker(gvr.String(), &list.Items[i]))
+		}
+	}
+
+	return bb, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func namespaceConditionBlockers(ns *v1.Namespace) []NamespaceBlocker {
+	var bb []NamespaceBlocker
+	for _, c := range ns.Status.Conditions {
+		if _, ok := namespaceBlockingConditions[c.Type]; !ok || c.Status != v1.ConditionTrue {
+			continue
+		}
+		bb = append(bb, NamespaceBlocker{
+			GVR:    "v1/namespaces",
+			FQN:    ns.Name,
+			Reason: fmt.Sprintf("%s: %s", c.Type, c.Message),
+		})
+	}
+	if len(ns.Spec.Finalizers) > 0 {
+		ff := make([]string, 0, len(ns.Spec.Finalizers))
+		for _, f := range ns.Spec.Finalizers {
+			ff = append(ff, string(f))
+		}
+		bb = append(bb, NamespaceBlocker{
+			GVR:    "v1/namespaces",
+			FQN:    ns.Name,
+			Reason: "spec finalizers: " + strings.Join(ff, ", "),
+		})
+	}
+
+	return bb
+}
+
+// discoveryBlockers reports api groups that could not be discovered ie unavailable aggregated apis.
+func discoveryBlockers(err error) []NamespaceBlocker {
+	if err == nil {
+		return nil
+	}
+	var gerr *discovery.ErrGroupDiscoveryFailed
+	if !errors.As(err, &gerr) {
+		return []NamespaceBlocker{{Reason: "api discovery failed: " + err.Error()}}
+	}
+
+	bb := make([]NamespaceBlocker, 0, len(gerr.Groups))
+	for gv, e := range gerr.Groups {
+		bb = append(bb, NamespaceBlocker{
+			GVR:    gv.String(),
+			Reason: "api group unavailable: " + e.Error(),
+		})
+	}
+	sort.Slice(bb, func(i, j int) bool {
+		return bb[i].GVR < bb[j].GVR
+	})
+
+	return bb
+}
+
+// deletableGVRs returns all the namespaced resources the namespace controller must purge.
+func deletableGVRs(ll []*metav1.APIResourceList) []client.GVR {
+	var gvrs []client.GVR
+	for _, l := range ll {
+		for _, r := range l.APIResources {
+			if strings.Contains(r.Name, "/") || !sets.NewString(r.Verbs...).HasAll("list", "delete") {
+				continue
+			}
+			gvrs = append(gvrs, client.FromGVAndR(l.GroupVersion, r.Name))
+		}
+	}
+	sort.Slice(gvrs, func(i, j int) bool {
+		return gvrs[i].String() < gvrs[j].String()
+	})
+
+	return gvrs
+}
+
+func remainingBlocker(gvr string, u *unstructured.Unstructured) NamespaceBlocker {
+	b := NamespaceBlocker{GVR: gvr, FQN: client.FQN(u.GetNamespace(), u.GetName()), Reason: "remaining"}
+	if u.GetDeletionTimestamp() != nil {
+		b.Reason = "terminating"
+	}
+	if ff := u.GetFinalizers(); len(ff) > 0 {
+		b.Reason += ", finalizers: " + strings.Join(ff, ", ")
+	}
+
+	return b
+}
@@ -0,0 +1,99 @@
+package dao
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+)
+
+func TestNamespaceConditionBlockers(t *testing.T) {
+	ns := v1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{Name: "fred"},
+		Spec:       v1.NamespaceSpec{Finalizers: []v1.FinalizerName{v1.FinalizerKubernetes}},
+		Status: v1.NamespaceStatus{
+			Phase: v1.NamespaceTerminating,
+			Conditions: []v1.NamespaceCondition{
+				{Type: v1.NamespaceDeletionDiscoveryFailure, Status: v1.ConditionTrue, Message: "metrics.k8s.io/v1beta1: stale"},
+				{Type: v1.NamespaceDeletionContentFailure, Status: v1.ConditionFalse, Message: "all good"},
+				{Type: v1.NamespaceFinalizersRemaining, Status: v1.ConditionTrue, Message: "acme.io/cleanup in 1 resource instances"},
+			},
+		},
+	}
+
+	assert.Equal(t, []NamespaceBlocker{
+		{GVR: "v1/namespaces", FQN: "fred", Reason: "NamespaceDeletionDiscoveryFailure: metrics.k8s.io/v1beta1: stale"},
+		{GVR: "v1/namespaces", FQN: "fred", Reason: "NamespaceFinalizersRemaining: acme.io/cleanup in 1 resource instances"},
+		{GVR: "v1/namespaces", FQN: "fred", Reason: "spec finalizers: kubernetes"},
+	}, namespaceConditionBlockers(&ns))
+}
+
+func TestDiscoveryBlockers(t *testing.T) {
+	uu := map[string]struct {
+		err error
+		e   []NamespaceBlocker
+	}{
+		"none": {},
+		"plain": {
+			err: errors.New("boom"),
+			e:   []NamespaceBlocker{{Reason: "api discovery failed: boom"}},
+		},
+		"groups": {
+			err: &discovery.ErrGroupDiscoveryFailed{Groups: map[schema.GroupVersion]error{
+				{Group: "metrics.k8s.io", Version: "v1beta1"}:   errors.New("service unavailable"),
+				{Group: "custom.metrics.k8s.io", Version: "v1"}: errors.New("timeout"),
+			}},
+			e: []NamespaceBlocker{
+				{GVR: "custom.metrics.k8s.io/v1", Reason: "api group unavailable: timeout"},
+				{GVR: "metrics.k8s.io/v1beta1", Reason: "api group unavailable: service unavailable"},
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, discoveryBlockers(u.err))
+		})
+	}
+}
+
+func TestDeletableGVRs(t *testing.T) {
+	ll := []*metav1.APIResourceList{
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Verbs: []string{"list", "delete"}},
+				{Name: "pods/log", Verbs: []string{"get"}},
+				{Name: "bindings", Verbs: []string{"create"}},
+			},
+		},
+		{
+			GroupVersion: "acme.io/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "widgets", Verbs: []string{"get", "list", "delete", "deletecollection"}},
+			},
+		},
+	}
+
+	gvrs := deletableGVRs(ll)
+	ss := make([]string, 0, len(gvrs))
+	for _, gvr := range gvrs {
+		ss = append(ss, gvr.String())
+	}
+	assert.Equal(t, []string{"acme.io/v1/widgets", "v1/pods"}, ss)
+}
+
+func TestRemainingBlocker(t *testing.T) {
+	o := relObj("acme.io/v1", "Widget", "fred", "w1", nil, map[string]interface{}{})
+	assert.Equal(t, NamespaceBlocker{GVR: "acme.io/v1/widgets", FQN: "fred/w1", Reason: "remaining"}, remainingBlocker("acme.io/v1/widgets", o))
+
+	now := metav1.Now()
+	o.SetDeletionTimestamp(&now)
+	o.SetFinalizers([]string{"acme.io/cleanup"})
+	assert.Equal(t, NamespaceBlocker{GVR: "acme.io/v1/widgets", FQN: "fred/w1", Reason: "terminating, finalizers: acme.io/cleanup"}, remainingBlocker("acme.io/v1/widgets", o))
+}
@@ -0,0 +1,214 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+const overviewTopConsumers = 5
+
+// overviewWorkloads tracks the workloads summarized in a namespace overview.
+var overviewWorkloads = []string{
+	"apps/v1/deployments",
+	"apps/v1/statefulsets",
+	"apps/v1/daemonsets",
+	"batch/v1/jobs",
+	"v1/pods",
+}
+
+// WorkloadHealth represents the health of a namespace workloads of a given kind.
+type WorkloadHealth struct {
+	GVR     string
+	Total   int
+	Healthy int
+}
+
+// QuotaUsage represents a resource quota consumption.
+type QuotaUsage struct {
+	Quota    string
+	Resource string
+	Used     string
+	Hard     string
+	Perc     int
+}
+
+// ResourceConsumer represents a pod resource consumption.
+type ResourceConsumer struct {
+	Pod string
+	CPU int64
+	Mem int64
+}
+
+// NamespaceWarning represents a recent warning event.
+type NamespaceWarning struct {
+	Object  string
+	Reason  string
+	Message string
+	Count   int32
+	Last    time.Time
+}
+
+// NamespaceSummary represents a namespace at a glance.
+type NamespaceSummary struct {
+	Namespace string
+	Workloads []WorkloadHealth
+	Quotas    []QuotaUsage
+	Warnings  []NamespaceWarning
+	TopCPU    []ResourceConsumer
+	TopMem    []ResourceConsumer
+}
+
+// SummarizeNamespace collects a namespace workloads health, quotas usage and warning events since a given time.
+func SummarizeNamespace(f Factory, ns string, since time.Time) (NamespaceSummary, error) {
+	s := NamespaceSummary{Namespace: ns}
+	for _, gvr := range overviewWorkloads {
+		oo, err := f.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			return s, err
+		}
+		h := WorkloadHealth{GVR: gvr, Total: len(oo)}
+		for _, o := range oo {
+			if u, ok := o.(*unstructured.Unstructured); ok && isWorkloadHealthy(gvr, u) {
+				h.Healthy++
+			}
+		}
+		s.Workloads = append(s.Workloads, h)
+	}
+
+	var err error
+	if s.Quotas, err = quotaUsages(f, ns); err != nil {
+		return s, err
+	}
+	s.Warnings, err = recentWarnings(f, ns, since)
+
+	return s, err
+}
+
+// TopConsumers returns the pods using the most cpu and memory.
+func TopConsumers(mx *mv1beta1.PodMetricsList) ([]ResourceConsumer, []ResourceConsumer) {
+	if mx == nil {
+		return nil, nil
+	}
+	cc := make([]ResourceConsumer, 0, len(mx.Items))
+	for _, m := range mx.Items {
+		c := ResourceConsumer{Pod: m.Name}
+		for _, co := range m.Containers {
+			c.CPU += co.Usage.Cpu().MilliValue()
+			c.Mem += co.Usage.Memory().Value()
+		}
+		cc = append(cc, c)
+	}
+
+	top := func(less func(a, b ResourceConsumer) bool) []ResourceConsumer {
+		rr := make([]ResourceConsumer, len(cc))
+		copy(rr, cc)
+		sort.SliceStable(rr, func(i, j int) bool { return less(rr[i], rr[j]) })
+		if len(rr) > overviewTopConsumers {
+			rr = rr[:overviewTopConsumers]
+		}
+		return rr
+	}
+
+	return top(func(a, b ResourceConsumer) bool { return a.CPU > b.CPU }),
+		top(func(a, b ResourceConsumer) bool { return a.Mem > b.Mem })
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isWorkloadHealthy(gvr string, u *unstructured.Unstructured) bool {
+	status := func(f string) int64 {
+		v, _, _ := unstructured.NestedInt64(u.Object, "status", f)
+		return v
+	}
+	switch gvr {
+	case "apps/v1/deployments", "apps/v1/statefulsets":
+		desired, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		if !ok {
+			desired = 1
+		}
+		return status("readyReplicas") >= desired
+	case "apps/v1/daemonsets":
+		return status("numberReady") >= status("desiredNumberScheduled")
+	case "batch/v1/jobs":
+		return status("failed") == 0
+	case "v1/pods":
+		var po v1.Pod
+		if err := fromUnstructured(u, &po); err != nil {
+			return false
+		}
+		return po.Status.Phase == v1.PodSucceeded || isPodReady(po)
+	default:
+		return true
+	}
+}
+
+func quotaUsages(f Factory, ns string) ([]QuotaUsage, error) {
+	oo, err := f.List("v1/resourcequotas", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var qq []QuotaUsage
+	for _, o := range oo {
+		var rq v1.ResourceQuota
+		if err := fromUnstructured(o, &rq); err != nil {
+			return nil, err
+		}
+		rr := make([]string, 0, len(rq.Status.Hard))
+		for r := range rq.Status.Hard {
+			rr = append(rr, string(r))
+		}
+		sort.Strings(rr)
+		for _, r := range rr {
+			hard, used := rq.Status.Hard[v1.ResourceName(r)], rq.Status.Used[v1.ResourceName(r)]
+			q := QuotaUsage{Quota: rq.Name, Resource: r, Used: used.String(), Hard: hard.String()}
+			if hard.MilliValue() > 0 {
+				q.Perc = int(used.MilliValue() * 100 / hard.MilliValue())
+			}
+			qq = append(qq, q)
+		}
+	}
+
+	return qq, nil
+}
+
+func recentWarnings(f Factory, ns string, since time.Time) ([]NamespaceWarning, error) {
+	oo, err := f.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ww []NamespaceWarning
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		if ev.Type != v1.EventTypeWarning || eventTime(&ev).Before(since) {
+			continue
+		}
+		count := ev.Count
+		if count == 0 {
+			count = 1
+		}
+		ww = append(ww, NamespaceWarning{
+			Object:  fmt.Sprintf("%s/%s", ev.InvolvedObject.Kind, ev.InvolvedObject.Name),
+			Reason:  ev.Reason,
+			Message: ev.Message,
+			Count:   count,
+			Last:    eventTime(&ev),
+		})
+	}
+	sort.SliceStable(ww, func(i, j int) bool {
+		return ww[i].Last.After(ww[j].Last)
+	})
+
+	return ww, nil
+}
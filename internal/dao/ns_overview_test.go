@@ -0,0 +1,114 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
+)
+
+func TestSummarizeNamespace(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	dp := func(n string, replicas, ready int64) runtime.Object {
+		return relObj("apps/v1", "Deployment", "default", n, nil, map[string]interface{}{
+			"spec":   map[string]interface{}{"replicas": replicas},
+			"status": map[string]interface{}{"readyReplicas": ready},
+		})
+	}
+	event := func(n, typ, reason, last string) runtime.Object {
+		return relObj("v1", "Event", "default", n, nil, map[string]interface{}{
+			"type":           typ,
+			"reason":         reason,
+			"message":        reason + " happened",
+			"count":          int64(2),
+			"lastTimestamp":  last,
+			"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1"},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"apps/v1/deployments": {dp("ok", 2, 2), dp("bad", 2, 1)},
+		"v1/pods": {
+			relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Succeeded"},
+			}),
+			relObj("v1", "Pod", "default", "p2", nil, map[string]interface{}{
+				"status": map[string]interface{}{"phase": "Running"},
+			}),
+		},
+		"v1/resourcequotas": {
+			relObj("v1", "ResourceQuota", "default", "compute", nil, map[string]interface{}{
+				"status": map[string]interface{}{
+					"hard": map[string]interface{}{"requests.cpu": "2", "pods": "10"},
+					"used": map[string]interface{}{"requests.cpu": "500m", "pods": "9"},
+				},
+			}),
+		},
+		"v1/events": {
+			event("e1", "Warning", "BackOff", "2024-06-01T11:50:00Z"),
+			event("e2", "Warning", "Failed", "2024-06-01T10:00:00Z"),
+			event("e3", "Normal", "Pulled", "2024-06-01T11:55:00Z"),
+			event("e4", "Warning", "Unhealthy", "2024-06-01T11:30:00Z"),
+		},
+	}}
+
+	s, err := SummarizeNamespace(f, "default", now.Add(-time.Hour))
+	assert.Nil(t, err)
+	assert.Equal(t, []WorkloadHealth{
+		{GVR: "apps/v1/deployments", Total: 2, Healthy: 1},
+		{GVR: "apps/v1/statefulsets"},
+		{GVR: "apps/v1/daemonsets"},
+		{GVR: "batch/v1/jobs"},
+		{GVR: "v1/pods", Total: 2, Healthy: 1},
+	}, s.Workloads)
+	assert.Equal(t, []QuotaUsage{
+		{Quota: "compute", Resource: "pods", Used: "9", Hard: "10", Perc: 90},
+		{Quota: "compute", Resource: "requests.cpu", Used: "500m", Hard: "2", Perc: 25},
+	}, s.Quotas)
+	assert.Equal(t, 2, len(s.Warnings))
+	assert.Equal(t, "BackOff", s.Warnings[0].Reason)
+	assert.Equal(t, "Pod/p1", s.Warnings[0].Object)
+	assert.Equal(t, int32(2), s.Warnings[0].Count)
+	assert.Equal(t, "Unhealthy", s.Warnings[1].Reason)
+}
+
+func TestTopConsumers(t *testing.T) {
+	pod := func(n, cpu, mem string) mv1beta1.PodMetrics {
+		return mv1beta1.PodMetrics{
+			ObjectMeta: metav1.ObjectMeta{Name: n},
+			Containers: []mv1beta1.ContainerMetrics{
+				{Usage: v1.ResourceList{v1.ResourceCPU: resource.MustParse(cpu), v1.ResourceMemory: resource.MustParse(mem)}},
+			},
+		}
+	}
+	mx := mv1beta1.PodMetricsList{Items: []mv1beta1.PodMetrics{
+		pod("a", "100m", "1Gi"),
+		pod("b", "300m", "10Mi"),
+		pod("c", "200m", "100Mi"),
+	}}
+
+	cpu, mem := TopConsumers(&mx)
+	assert.Equal(t, []string{"b", "c", "a"}, consumerNames(cpu))
+	assert.Equal(t, []string{"a", "c", "b"}, consumerNames(mem))
+	assert.Equal(t, int64(300), cpu[0].CPU)
+
+	cpu, mem = TopConsumers(nil)
+	assert.Nil(t, cpu)
+	assert.Nil(t, mem)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func consumerNames(cc []ResourceConsumer) []string {
+	nn := make([]string, 0, len(cc))
+	for _, c := range cc {
+		nn = append(nn, c.Pod)
+	}
+
+	return nn
+}
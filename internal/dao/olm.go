@@ -0,0 +1,105 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var (
+	_ Accessor = (*Subscription)(nil)
+	_ Accessor = (*InstallPlan)(nil)
+)
+
+// Subscription represents an OLM Subscription.
+type Subscription struct {
+	Resource
+}
+
+// GetInstance returns a Subscription instance.
+func (s *Subscription) GetInstance(path string) (*render.OLMSubscription, error) {
+	var sub render.OLMSubscription
+	if err := olmGet(context.Background(), &s.Generic, path, &sub); err != nil {
+		return nil, err
+	}
+
+	return &sub, nil
+}
+
+// InstallPlansGVR returns the InstallPlans resource matching this Subscription version.
+func (s *Subscription) InstallPlansGVR() client.GVR {
+	return client.NewGVR(s.gvr.G() + "/" + s.gvr.V() + "/installplans")
+}
+
+// CSVsGVR returns the ClusterServiceVersions resource matching this Subscription version.
+func (s *Subscription) CSVsGVR() client.GVR {
+	return client.NewGVR(s.gvr.G() + "/" + s.gvr.V() + "/clusterserviceversions")
+}
+
+// ApproveUpgrade approves the install plan pending for a Subscription.
+func (s *Subscription) ApproveUpgrade(ctx context.Context, path string) (string, error) {
+	sub, err := s.GetInstance(path)
+	if err != nil {
+		return "", err
+	}
+	ref := sub.Status.InstallPlanRef
+	if ref == nil {
+		return "", fmt.Errorf("no install plan found for subscription %s", path)
+	}
+	ns := ref.Namespace
+	if ns == "" {
+		ns = sub.Namespace
+	}
+
+	var ip InstallPlan
+	ip.Init(s.GetFactory(), s.InstallPlansGVR())
+	fqn := client.FQN(ns, ref.Name)
+
+	return fqn, ip.Approve(ctx, fqn)
+}
+
+// InstallPlan represents an OLM InstallPlan.
+type InstallPlan struct {
+	Resource
+}
+
+// Approve approves an InstallPlan pending a manual approval.
+func (i *InstallPlan) Approve(ctx context.Context, path string) error {
+	var p render.OLMInstallPlan
+	if err := olmGet(ctx, &i.Generic, path, &p); err != nil {
+		return err
+	}
+	if !p.PendingApproval() {
+		return fmt.Errorf("install plan %s is not pending approval (%s)", path, p.Status.Phase)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"approved": true},
+	})
+	if err != nil {
+		return err
+	}
+
+	return i.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func olmGet(ctx context.Context, g *Generic, path string, obj interface{}) error {
+	o, err := g.Get(ctx, path)
+	if err != nil {
+		return err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
@@ -0,0 +1,472 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	batchv1 "k8s.io/api/batch/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	rootCAConfigMap = "kube-root-ca.crt"
+	orphansGVR      = "orphans"
+
+	// orphanConfigReason flags configs no pod, service account or ingress refers to.
+	// They may still be consumed via label selectors or custom resources.
+	orphanConfigReason = "no direct reference found"
+)
+
+var (
+	_ Accessor = (*Orphans)(nil)
+	_ Nuker    = (*Orphans)(nil)
+
+	// orphanSkipNamespaces tracks system namespaces excluded from the orphans scan.
+	orphanSkipNamespaces = map[string]struct{}{
+		"kube-system":     {},
+		"kube-public":     {},
+		"kube-node-lease": {},
+	}
+
+	// orphanSkipSecretTypes tracks secrets consumed by the cluster rather than by workloads.
+	orphanSkipSecretTypes = map[v1.SecretType]struct{}{
+		v1.SecretTypeServiceAccountToken: {},
+		v1.SecretTypeBootstrapToken:      {},
+		"helm.sh/release.v1":             {},
+	}
+
+	// orphanConsumerLabels tracks labels marking configs picked up by label selectors ie dashboard sidecars.
+	orphanConsumerLabels = []string{
+		"grafana_dashboard",
+		"grafana_datasource",
+		"grafana_alert",
+		"grafana_notifier",
+		"argocd.argoproj.io/secret-type",
+	}
+
+	// orphanConsumerAnnotations tracks annotation prefixes marking configs managed by controllers.
+	orphanConsumerAnnotations = []string{
+		"cert-manager.io/",
+		"reflector.v1.k8s.emberstack.com/",
+		"replicator.v1.mittwald.de/",
+		"kubed.appscode.com/",
+	}
+
+	// podSpecPaths tracks where pod specs live in workload resources.
+	podSpecPaths = map[string][]string{
+		"v1/pods":              {"spec"},
+		"apps/v1/deployments":  {"spec", "template", "spec"},
+		"apps/v1/statefulsets": {"spec", "template", "spec"},
+		"apps/v1/daemonsets":   {"spec", "template", "spec"},
+		"apps/v1/replicasets":  {"spec", "template", "spec"},
+		"batch/v1/jobs":        {"spec", "template", "spec"},
+		"batch/v1/cronjobs":    {"spec", "jobTemplate", "spec", "template", "spec"},
+	}
+)
+
+// Orphan represents a resource no longer used by anything.
+type Orphan struct {
+	GVR, FQN string
+	Reason   string
+	Created  metav1.Time
+}
+
+// Orphans represents garbage resources that are candidates for deletion.
+type Orphans struct {
+	NonResource
+}
+
+// List returns all orphaned resources.
+func (o *Orphans) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+	oo, err := FindOrphans(o.Factory, ns, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	rr := make([]runtime.Object, 0, len(oo))
+	for _, orphan := range oo {
+		fns, n := client.Namespaced(orphan.FQN)
+		rr = append(rr, render.OrphanRes{
+			Namespace: fns,
+			Name:      n,
+			GVR:       orphan.GVR,
+			Reason:    orphan.Reason,
+			Created:   orphan.Created,
+		})
+	}
+
+	return rr, nil
+}
+
+// Get fetch a given orphan.
+func (o *Orphans) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
+
+// Delete deletes an orphan given its gvr:fqn path.
+func (o *Orphans) Delete(ctx context.Context, path string, _ *metav1.DeletionPropagation, grace Grace) error {
+	gvr, fqn, err := orphanPath(path)
+	if err != nil {
+		return err
+	}
+	acc, err := AccessorFor(o.Factory, client.NewGVR(gvr))
+	if err != nil {
+		return err
+	}
+	nuker, ok := acc.(Nuker)
+	if !ok {
+		return fmt.Errorf("no nuker for %q", gvr)
+	}
+	// Make sure job pods and the like get cleaned up too.
+	p := metav1.DeletePropagationBackground
+
+	return nuker.Delete(ctx, fqn, &p, grace)
+}
+
+// orphanPath splits an orphan gvr:fqn path.
+func orphanPath(path string) (string, string, error) {
+	tokens := strings.SplitN(path, ":", 2)
+	if len(tokens) != 2 {
+		return "", "", fmt.Errorf("invalid orphan path %q", path)
+	}
+
+	return tokens[0], tokens[1], nil
+}
+
+// FindOrphans looks for unowned scaled down replicasets, unmounted pvcs,
+// unreferenced configmaps and secrets and completed jobs past their TTL.
+func FindOrphans(f Factory, ns string, now time.Time) ([]Orphan, error) {
+	specs, err := podSpecs(f, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	var oo []Orphan
+	for _, find := range []func(Factory, string, []nsPodSpec) ([]Orphan, error){
+		orphanReplicaSets,
+		orphanClaims,
+		orphanConfigs,
+	} {
+		rr, err := find(f, ns, specs)
+		if err != nil {
+			return nil, err
+		}
+		oo = append(oo, rr...)
+	}
+	jj, err := expiredJobs(f, ns, now)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(oo, jj...), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func isSystemNamespace(ns string) bool {
+	_, ok := orphanSkipNamespaces[ns]
+	return ok
+}
+
+// nsPodSpec represents a pod spec in a given namespace.
+type nsPodSpec struct {
+	ns   string
+	spec v1.PodSpec
+}
+
+// podSpecs collects all pod specs from pods and workload templates.
+func podSpecs(f Factory, ns string) ([]nsPodSpec, error) {
+	var ss []nsPodSpec
+	for gvr, path := range podSpecPaths {
+		oo, err := f.List(gvr, ns, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			u, ok := o.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			m, ok, err := unstructured.NestedMap(u.Object, path...)
+			if err != nil || !ok {
+				continue
+			}
+			var spec v1.PodSpec
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &spec); err != nil {
+				return nil, err
+			}
+			ss = append(ss, nsPodSpec{ns: u.GetNamespace(), spec: spec})
+		}
+	}
+
+	return ss, nil
+}
+
+func unstructuredOrphans(f Factory, gvr, ns string, check func(u *unstructured.Unstructured) string) ([]Orphan, error) {
+	oo, err := f.List(gvr, ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var rr []Orphan
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok || isSystemNamespace(u.GetNamespace()) {
+			continue
+		}
+		if reason := check(u); reason != "" {
+			rr = append(rr, Orphan{
+				GVR:     gvr,
+				FQN:     extractFQN(u),
+				Reason:  reason,
+				Created: u.GetCreationTimestamp(),
+			})
+		}
+	}
+
+	return rr, nil
+}
+
+func orphanReplicaSets(f Factory, ns string, _ []nsPodSpec) ([]Orphan, error) {
+	return unstructuredOrphans(f, "apps/v1/replicasets", ns, func(u *unstructured.Unstructured) string {
+		replicas, ok, _ := unstructured.NestedInt64(u.Object, "spec", "replicas")
+		if !ok || replicas != 0 || len(u.GetOwnerReferences()) > 0 {
+			return ""
+		}
+		return "scaled to zero with no owner"
+	})
+}
+
+func orphanClaims(f Factory, ns string, specs []nsPodSpec) ([]Orphan, error) {
+	used := make(map[string]struct{})
+	for _, s := range specs {
+		for _, v := range s.spec.Volumes {
+			if v.PersistentVolumeClaim != nil {
+				used[client.FQN(s.ns, v.PersistentVolumeClaim.ClaimName)] = struct{}{}
+			}
+		}
+	}
+
+	tmpls, err := claimTemplates(f, ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return unstructuredOrphans(f, "v1/persistentvolumeclaims", ns, func(u *unstructured.Unstructured) string {
+		if _, ok := used[extractFQN(u)]; ok || len(u.GetOwnerReferences()) > 0 {
+			return ""
+		}
+		if isTemplateClaim(u.GetName(), tmpls[u.GetNamespace()]) {
+			return ""
+		}
+		return "not mounted by any pod"
+	})
+}
+
+// claimTemplates collects statefulsets claims name prefixes ie <tmpl>-<sts>- per namespace.
+// Their claims outlive scaled down replicas and get reattached on scale up.
+func claimTemplates(f Factory, ns string) (map[string][]string, error) {
+	oo, err := f.List("apps/v1/statefulsets", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	tt := make(map[string][]string)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		cc, _, _ := unstructured.NestedSlice(u.Object, "spec", "volumeClaimTemplates")
+		for _, c := range cc {
+			m, ok := c.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if n, _, _ := unstructured.NestedString(m, "metadata", "name"); n != "" {
+				tt[u.GetNamespace()] = append(tt[u.GetNamespace()], n+"-"+u.GetName()+"-")
+			}
+		}
+	}
+
+	return tt, nil
+}
+
+// isTemplateClaim checks if a claim name matches a statefulset claim template ordinal.
+func isTemplateClaim(n string, prefixes []string) bool {
+	for _, p := range prefixes {
+		if !strings.HasPrefix(n, p) {
+			continue
+		}
+		if _, err := strconv.Atoi(strings.TrimPrefix(n, p)); err == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+func orphanConfigs(f Factory, ns string, specs []nsPodSpec) ([]Orphan, error) {
+	cms, secs := make(map[string]struct{}), make(map[string]struct{})
+	for i := range specs {
+		s := &specs[i]
+		cc, ss := podConfigRefs(&s.spec, true)
+		for _, c := range cc {
+			cms[client.FQN(s.ns, c)] = struct{}{}
+		}
+		for _, n := range ss {
+			secs[client.FQN(s.ns, n)] = struct{}{}
+		}
+		for _, r := range s.spec.ImagePullSecrets {
+			secs[client.FQN(s.ns, r.Name)] = struct{}{}
+		}
+	}
+	if err := otherSecretRefs(f, ns, secs); err != nil {
+		return nil, err
+	}
+
+	oo, err := unstructuredOrphans(f, "v1/configmaps", ns, func(u *unstructured.Unstructured) string {
+		if _, ok := cms[extractFQN(u)]; ok || u.GetName() == rootCAConfigMap || len(u.GetOwnerReferences()) > 0 {
+			return ""
+		}
+		if hasConsumerMarks(u) {
+			return ""
+		}
+		return orphanConfigReason
+	})
+	if err != nil {
+		return nil, err
+	}
+	ss, err := unstructuredOrphans(f, "v1/secrets", ns, func(u *unstructured.Unstructured) string {
+		t, _, _ := unstructured.NestedString(u.Object, "type")
+		if _, ok := orphanSkipSecretTypes[v1.SecretType(t)]; ok {
+			return ""
+		}
+		if _, ok := secs[extractFQN(u)]; ok || len(u.GetOwnerReferences()) > 0 {
+			return ""
+		}
+		if hasConsumerMarks(u) {
+			return ""
+		}
+		return orphanConfigReason
+	})
+
+	return append(oo, ss...), err
+}
+
+// hasConsumerMarks checks if a config carries well known labels or annotations
+// denoting indirect consumers.
+func hasConsumerMarks(u *unstructured.Unstructured) bool {
+	ll := u.GetLabels()
+	for _, l := range orphanConsumerLabels {
+		if _, ok := ll[l]; ok {
+			return true
+		}
+	}
+	for k := range u.GetAnnotations() {
+		for _, p := range orphanConsumerAnnotations {
+			if strings.HasPrefix(k, p) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// otherSecretRefs collects secrets referenced by service accounts and ingresses, including
+// ingress annotations ie nginx.ingress.kubernetes.io/auth-secret.
+func otherSecretRefs(f Factory, ns string, secs map[string]struct{}) error {
+	oo, err := f.List("v1/serviceaccounts", ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		var sa v1.ServiceAccount
+		if err := fromUnstructured(o, &sa); err != nil {
+			return err
+		}
+		for _, s := range sa.Secrets {
+			secs[client.FQN(sa.Namespace, s.Name)] = struct{}{}
+		}
+		for _, s := range sa.ImagePullSecrets {
+			secs[client.FQN(sa.Namespace, s.Name)] = struct{}{}
+		}
+	}
+
+	oo, err = f.List("networking.k8s.io/v1/ingresses", ns, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		tt, _, _ := unstructured.NestedSlice(u.Object, "spec", "tls")
+		for _, t := range tt {
+			if m, ok := t.(map[string]interface{}); ok {
+				if n, ok := m["secretName"].(string); ok {
+					secs[client.FQN(u.GetNamespace(), n)] = struct{}{}
+				}
+			}
+		}
+		for k, v := range u.GetAnnotations() {
+			if !strings.HasSuffix(k, "-secret") || v == "" {
+				continue
+			}
+			if sns, n := client.Namespaced(v); sns != "" {
+				secs[client.FQN(sns, n)] = struct{}{}
+			} else {
+				secs[client.FQN(u.GetNamespace(), n)] = struct{}{}
+			}
+		}
+	}
+
+	return nil
+}
+
+func expiredJobs(f Factory, ns string, now time.Time) ([]Orphan, error) {
+	oo, err := f.List("batch/v1/jobs", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var rr []Orphan
+	for _, o := range oo {
+		var job batchv1.Job
+		if err := fromUnstructured(o, &job); err != nil {
+			return nil, err
+		}
+		ttl, done := job.Spec.TTLSecondsAfterFinished, job.Status.CompletionTime
+		if ttl == nil || done == nil || isSystemNamespace(job.Namespace) {
+			continue
+		}
+		expiry := time.Duration(*ttl) * time.Second
+		if now.Sub(done.Time) <= expiry {
+			continue
+		}
+		rr = append(rr, Orphan{
+			GVR:     "batch/v1/jobs",
+			FQN:     client.FQN(job.Namespace, job.Name),
+			Reason:  fmt.Sprintf("completed %s ago, past its %s TTL", now.Sub(done.Time).Truncate(time.Second), expiry),
+			Created: job.CreationTimestamp,
+		})
+	}
+
+	return rr, nil
+}
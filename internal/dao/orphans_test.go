@@ -0,0 +1,138 @@
+package dao
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestFindOrphans(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	owned := func(o runtime.Object) runtime.Object {
+		u := o.(interface {
+			SetOwnerReferences([]metav1.OwnerReference)
+		})
+		u.SetOwnerReferences([]metav1.OwnerReference{{Kind: "Deployment", Name: "dp"}})
+		return o
+	}
+	rs := func(ns, n string, replicas int64) runtime.Object {
+		return relObj("apps/v1", "ReplicaSet", ns, n, nil, map[string]interface{}{
+			"spec": map[string]interface{}{"replicas": replicas},
+		})
+	}
+	job := func(n string, ttl int64, done string) runtime.Object {
+		spec := map[string]interface{}{}
+		if ttl >= 0 {
+			spec["ttlSecondsAfterFinished"] = ttl
+		}
+		return relObj("batch/v1", "Job", "default", n, nil, map[string]interface{}{
+			"spec":   spec,
+			"status": map[string]interface{}{"completionTime": done},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{map[string]interface{}{
+						"name": "c1",
+						"envFrom": []interface{}{
+							map[string]interface{}{"configMapRef": map[string]interface{}{"name": "opt", "optional": true}},
+						},
+					}},
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "data", "persistentVolumeClaim": map[string]interface{}{"claimName": "used"}},
+					},
+					"imagePullSecrets": []interface{}{map[string]interface{}{"name": "regcred"}},
+				},
+			}),
+		},
+		"batch/v1/cronjobs": {
+			relObj("batch/v1", "CronJob", "default", "cj", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"jobTemplate": map[string]interface{}{"spec": map[string]interface{}{"template": map[string]interface{}{"spec": map[string]interface{}{
+					"volumes": []interface{}{
+						map[string]interface{}{"name": "cfg", "configMap": map[string]interface{}{"name": "cron"}},
+					},
+				}}}}},
+			}),
+		},
+		"apps/v1/replicasets": {
+			rs("default", "old", 0),
+			owned(rs("default", "managed", 0)),
+			rs("default", "live", 2),
+			rs("kube-system", "sys", 0),
+		},
+		"apps/v1/statefulsets": {
+			relObj("apps/v1", "StatefulSet", "default", "db", nil, map[string]interface{}{
+				"spec": map[string]interface{}{
+					"replicas": int64(0),
+					"volumeClaimTemplates": []interface{}{
+						map[string]interface{}{"metadata": map[string]interface{}{"name": "data"}},
+					},
+				},
+			}),
+		},
+		"v1/persistentvolumeclaims": {
+			relObj("v1", "PersistentVolumeClaim", "default", "used", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "default", "data-db-0", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "default", "data-db-12", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "default", "data-db-backup", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "other", "data-db-0", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "default", "stale", nil, nil),
+			relObj("v1", "PersistentVolumeClaim", "other", "used", nil, nil),
+		},
+		"v1/configmaps": {
+			relObj("v1", "ConfigMap", "default", "opt", nil, nil),
+			relObj("v1", "ConfigMap", "default", "cron", nil, nil),
+			relObj("v1", "ConfigMap", "default", rootCAConfigMap, nil, nil),
+			relObj("v1", "ConfigMap", "default", "unused", nil, nil),
+			relObj("v1", "ConfigMap", "default", "dashboard", map[string]string{"grafana_dashboard": "1"}, nil),
+		},
+		"v1/secrets": {
+			relObj("v1", "Secret", "default", "regcred", nil, nil),
+			relObj("v1", "Secret", "default", "tls", nil, nil),
+			relObj("v1", "Secret", "default", "sa-token", nil, map[string]interface{}{"type": "kubernetes.io/service-account-token"}),
+			relObj("v1", "Secret", "default", "leftover", nil, nil),
+			relObj("v1", "Secret", "default", "basic-auth", nil, nil),
+			relObj("v1", "Secret", "other", "client-ca", nil, nil),
+			relObj("v1", "Secret", "default", "cert", nil, map[string]interface{}{
+				"metadata": map[string]interface{}{"annotations": map[string]interface{}{"cert-manager.io/certificate-name": "cert"}},
+			}),
+		},
+		"networking.k8s.io/v1/ingresses": {
+			relObj("networking.k8s.io/v1", "Ingress", "default", "ing", nil, map[string]interface{}{
+				"metadata": map[string]interface{}{"annotations": map[string]interface{}{
+					"nginx.ingress.kubernetes.io/auth-secret":     "basic-auth",
+					"nginx.ingress.kubernetes.io/auth-tls-secret": "other/client-ca",
+				}},
+				"spec": map[string]interface{}{"tls": []interface{}{map[string]interface{}{"secretName": "tls"}}},
+			}),
+		},
+		"batch/v1/jobs": {
+			job("expired", 60, "2024-06-01T11:00:00Z"),
+			job("fresh", 3600, "2024-06-01T11:30:00Z"),
+			job("forever", -1, "2024-05-01T11:00:00Z"),
+		},
+	}}
+
+	oo, err := FindOrphans(f, "", now)
+	assert.Nil(t, err)
+
+	actual := make(map[string]string, len(oo))
+	for _, o := range oo {
+		actual[o.GVR+":"+o.FQN] = o.Reason
+	}
+	assert.Equal(t, map[string]string{
+		"apps/v1/replicasets:default/old":                  "scaled to zero with no owner",
+		"v1/persistentvolumeclaims:default/stale":          "not mounted by any pod",
+		"v1/persistentvolumeclaims:other/used":             "not mounted by any pod",
+		"v1/persistentvolumeclaims:default/data-db-backup": "not mounted by any pod",
+		"v1/persistentvolumeclaims:other/data-db-0":        "not mounted by any pod",
+		"v1/configmaps:default/unused":                     "no direct reference found",
+		"v1/secrets:default/leftover":                      "no direct reference found",
+		"batch/v1/jobs:default/expired":                    "completed 1h0m0s ago, past its 1m0s TTL",
+	}, actual)
+}
@@ -0,0 +1,157 @@
+package dao
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ownedGVRs tracks the resources managed by a given controller.
+var ownedGVRs = map[string]string{
+	"apps/v1/deployments":  "apps/v1/replicasets",
+	"apps/v1/replicasets":  "v1/pods",
+	"apps/v1/statefulsets": "v1/pods",
+	"apps/v1/daemonsets":   "v1/pods",
+	"batch/v1/jobs":        "v1/pods",
+	"batch/v1/cronjobs":    "batch/v1/jobs",
+}
+
+// ChildrenGVR returns the resource managed by a given controller if any.
+func ChildrenGVR(gvr string) (client.GVR, bool) {
+	c, ok := ownedGVRs[gvr]
+	if !ok {
+		return client.GVR{}, false
+	}
+
+	return client.NewGVR(c), true
+}
+
+// OwnerFor returns the controller managing a given resource or its first owner.
+func OwnerFor(f Factory, gvr, path string) (client.GVR, string, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	ref, ok := controllerRef(u.GetOwnerReferences())
+	if !ok {
+		return client.GVR{}, "", fmt.Errorf("no owner found for %s", path)
+	}
+
+	return ownerGVR(u.GetNamespace(), ref)
+}
+
+// IsOwnedBy checks if an object is owned by a given uid.
+func IsOwnedBy(o runtime.Object, uid string) bool {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return false
+	}
+	for _, ref := range u.GetOwnerReferences() {
+		if string(ref.UID) == uid {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ----------------------------------------------------------------------------
+// Relations...
+
+func ownerRelation(_ Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	ref, ok := controllerRef(o.GetOwnerReferences())
+	if !ok {
+		return nil, nil
+	}
+	gvr, fqn, err := ownerGVR(o.GetNamespace(), ref)
+	if err != nil {
+		return nil, nil
+	}
+
+	return []RelatedRef{{GVR: gvr.String(), FQN: fqn, Relation: "owned by"}}, nil
+}
+
+func childrenRelation(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	gvr, ok := ChildrenGVR(o.GetAPIVersion() + "/" + ownerResource(o.GetKind()))
+	if !ok {
+		return nil, nil
+	}
+	oo, err := f.List(gvr.String(), o.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, c := range oo {
+		if !IsOwnedBy(c, string(o.GetUID())) {
+			continue
+		}
+		u := c.(*unstructured.Unstructured)
+		refs = append(refs, RelatedRef{GVR: gvr.String(), FQN: client.FQN(u.GetNamespace(), u.GetName()), Relation: "owns"})
+	}
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func controllerRef(rr []metav1.OwnerReference) (metav1.OwnerReference, bool) {
+	for _, r := range rr {
+		if r.Controller != nil && *r.Controller {
+			return r, true
+		}
+	}
+	if len(rr) > 0 {
+		return rr[0], true
+	}
+
+	return metav1.OwnerReference{}, false
+}
+
+// ownerGVR resolves an owner reference to its resource and path.
+func ownerGVR(ns string, ref metav1.OwnerReference) (client.GVR, string, error) {
+	for _, gvr := range MetaAccess.AllGVRs() {
+		if gvr.GV().String() != ref.APIVersion {
+			continue
+		}
+		m, err := MetaAccess.MetaFor(gvr)
+		if err != nil || m.Kind != ref.Kind {
+			continue
+		}
+		if !m.Namespaced {
+			ns = client.ClusterScope
+		}
+		return gvr, client.FQN(ns, ref.Name), nil
+	}
+
+	return client.GVR{}, "", fmt.Errorf("unable to resolve owner %s/%s", ref.Kind, ref.Name)
+}
+
+// ownerResource returns the resource name for a known controller kind.
+func ownerResource(kind string) string {
+	switch kind {
+	case "Deployment":
+		return "deployments"
+	case "ReplicaSet":
+		return "replicasets"
+	case "StatefulSet":
+		return "statefulsets"
+	case "DaemonSet":
+		return "daemonsets"
+	case "Job":
+		return "jobs"
+	case "CronJob":
+		return "cronjobs"
+	default:
+		return ""
+	}
+}
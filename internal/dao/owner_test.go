@@ -0,0 +1,63 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestOwnerFor(t *testing.T) {
+	MetaAccess.RegisterMeta("apps/v1/replicasets", metav1.APIResource{Name: "replicasets", Kind: "ReplicaSet", Namespaced: true})
+	MetaAccess.RegisterMeta("v1/nodes", metav1.APIResource{Name: "nodes", Kind: "Node"})
+
+	yes := true
+	po1 := relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{})
+	po1.SetOwnerReferences([]metav1.OwnerReference{
+		{APIVersion: "v1", Kind: "Node", Name: "n1"},
+		{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs1", UID: types.UID("u1"), Controller: &yes},
+	})
+	po2 := relObj("v1", "Pod", "default", "p2", nil, map[string]interface{}{})
+	po2.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "v1", Kind: "Node", Name: "n1"}})
+	po3 := relObj("v1", "Pod", "default", "p3", nil, map[string]interface{}{})
+	f := relFactory{rows: map[string][]runtime.Object{"v1/pods": {po1, po2, po3}}}
+
+	uu := map[string]struct {
+		path, gvr, fqn string
+		err            bool
+	}{
+		"controller": {path: "default/p1", gvr: "apps/v1/replicasets", fqn: "default/rs1"},
+		"cluster":    {path: "default/p2", gvr: "v1/nodes", fqn: "-/n1"},
+		"orphan":     {path: "default/p3", err: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gvr, fqn, err := OwnerFor(f, "v1/pods", u.path)
+			assert.Equal(t, u.err, err != nil)
+			assert.Equal(t, u.gvr, gvr.String())
+			assert.Equal(t, u.fqn, fqn)
+		})
+	}
+}
+
+func TestChildrenRelation(t *testing.T) {
+	rs := relObj("apps/v1", "ReplicaSet", "default", "rs1", nil, map[string]interface{}{})
+	rs.SetUID(types.UID("u1"))
+	po1 := relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{})
+	po1.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "ReplicaSet", Name: "rs1", UID: types.UID("u1")}})
+	po2 := relObj("v1", "Pod", "default", "p2", nil, map[string]interface{}{})
+	f := relFactory{rows: map[string][]runtime.Object{"v1/pods": {po1, po2}}}
+
+	refs, err := childrenRelation(f, rs)
+	assert.NoError(t, err)
+	assert.Equal(t, []RelatedRef{{GVR: "v1/pods", FQN: "default/p1", Relation: "owns"}}, refs)
+
+	gvr, ok := ChildrenGVR("apps/v1/deployments")
+	assert.True(t, ok)
+	assert.Equal(t, client.NewGVR("apps/v1/replicasets"), gvr)
+}
@@ -2,8 +2,14 @@ package dao
 
 import (
 	"encoding/json"
+	"regexp"
+	"strings"
+
+	"github.com/derailed/k9s/internal/render"
 )
 
+var imageTagRX = regexp.MustCompile(`^[\w][\w.-]{0,127}$`)
+
 // ImageSpec represents a container image.
 type ImageSpec struct {
 	Index             int
@@ -84,3 +90,23 @@ func extractElements(imageSpecs ImageSpecs) (initElementsOrders []Element, initE
 	}
 	return initElementsOrders, initElements, elementsOrders, elements
 }
+
+// ValidImageTag checks if a string is a valid container image tag.
+func ValidImageTag(tag string) bool {
+	return imageTagRX.MatchString(tag)
+}
+
+// ImageTag returns a container image tag or an empty string if none.
+func ImageTag(image string) string {
+	return render.ImageTag(image)
+}
+
+// WithImageTag returns a container image using the given tag. Digests are dropped.
+func WithImageTag(image, tag string) string {
+	repo := strings.SplitN(image, "@", 2)[0]
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		repo = repo[:i]
+	}
+
+	return repo + ":" + tag
+}
@@ -3,6 +3,7 @@ package dao
 import (
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -91,3 +92,61 @@ func TestGetJsonPatch(t *testing.T) {
 		})
 	}
 }
+
+func TestWithImageTag(t *testing.T) {
+	uu := map[string]struct {
+		image, tag, e string
+	}{
+		"plain": {
+			image: "nginx:1.23",
+			tag:   "1.24",
+			e:     "nginx:1.24",
+		},
+		"untagged": {
+			image: "nginx",
+			tag:   "1.24",
+			e:     "nginx:1.24",
+		},
+		"registry-port": {
+			image: "registry:5000/team/app",
+			tag:   "v2",
+			e:     "registry:5000/team/app:v2",
+		},
+		"registry-port-tagged": {
+			image: "registry:5000/team/app:v1",
+			tag:   "v2",
+			e:     "registry:5000/team/app:v2",
+		},
+		"digest": {
+			image: "nginx:1.23@sha256:deadbeef",
+			tag:   "1.24",
+			e:     "nginx:1.24",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, WithImageTag(u.image, u.tag))
+		})
+	}
+}
+
+func TestImageTag(t *testing.T) {
+	uu := map[string]struct {
+		image, e string
+	}{
+		"plain":         {image: "nginx:1.23", e: "1.23"},
+		"untagged":      {image: "nginx", e: ""},
+		"registry-port": {image: "registry:5000/app", e: ""},
+		"digest":        {image: "app:v1@sha256:deadbeef", e: "v1"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ImageTag(u.image))
+			assert.True(t, u.e == "" || ValidImageTag(u.e))
+		})
+	}
+}
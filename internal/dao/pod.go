@@ -17,6 +17,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -36,6 +37,8 @@ const (
 	logRetryCount                 = 20
 	logRetryWait                  = 1 * time.Second
 	defaultLogContainerAnnotation = "kubectl.kubernetes.io/default-logs-container"
+	unhealthyReason               = "Unhealthy"
+	probeFailureTTL               = 5 * time.Minute
 )
 
 // Pod represents a pod resource.
@@ -70,7 +73,13 @@ func (p *Pod) Get(ctx context.Context, path string) (runtime.Object, error) {
 		pmx, _ = client.DialMetrics(p.Client()).FetchPodMetrics(ctx, path)
 	}
 
-	return &render.PodWithMetrics{Raw: u, MX: pmx}, nil
+	ns, _ := client.Namespaced(path)
+	pff := probeFailures(ctx, p.Client(), ns)
+	idx := newConfigIndex(p.GetFactory(), ns)
+	oss := nodeOSes(p.GetFactory())
+	mi := newMeshIndex(p.GetFactory())
+
+	return &render.PodWithMetrics{Raw: u, MX: pmx, ProbeFailure: pff[path], MissingRefs: missingRefs(idx, u), NodeOS: oss[podNodeName(u)], Mesh: mi.podMesh(u)}, nil
 }
 
 // List returns a collection of nodes.
@@ -90,6 +99,10 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		return nil, err
 	}
 	nodeName := fsel["spec.nodeName"]
+	pff := probeFailures(ctx, p.Client(), ns)
+	idx := newConfigIndex(p.GetFactory(), ns)
+	oss := nodeOSes(p.GetFactory())
+	mi := newMeshIndex(p.GetFactory())
 
 	res := make([]runtime.Object, 0, len(oo))
 	for _, o := range oo {
@@ -99,7 +112,7 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 		}
 		fqn := extractFQN(o)
 		if nodeName == "" {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn], ProbeFailure: pff[fqn], MissingRefs: missingRefs(idx, u), NodeOS: oss[podNodeName(u)], Mesh: mi.podMesh(u)})
 			continue
 		}
 
@@ -108,13 +121,117 @@ func (p *Pod) List(ctx context.Context, ns string) ([]runtime.Object, error) {
 			return res, fmt.Errorf("expecting interface map but got `%T", o)
 		}
 		if spec["nodeName"] == nodeName {
-			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn]})
+			res = append(res, &render.PodWithMetrics{Raw: u, MX: pmx[fqn], ProbeFailure: pff[fqn], MissingRefs: missingRefs(idx, u), NodeOS: oss[podNodeName(u)], Mesh: mi.podMesh(u)})
 		}
 	}
 
 	return res, nil
 }
 
+// probeFailures returns the most recent probe failure per pod in a given namespace.
+// Unhealthy events are queried directly rather than via an events informer. All
+// namespaces are skipped as too costly on large clusters.
+func probeFailures(ctx context.Context, c client.Connection, ns string) map[string]string {
+	if client.IsAllNamespaces(ns) || client.IsClusterScoped(ns) || c == nil {
+		return nil
+	}
+	dial, err := c.Dial()
+	if err != nil || dial == nil {
+		return nil
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Config().CallTimeout())
+	defer cancel()
+	ll, err := dial.CoreV1().Events(ns).List(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{"reason": unhealthyReason, "involvedObject.kind": "Pod"}.String(),
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("probe events list failed")
+		return nil
+	}
+
+	return latestProbeFailures(ll.Items, time.Now())
+}
+
+// latestProbeFailures returns the most recent probe failure per pod.
+func latestProbeFailures(ee []v1.Event, now time.Time) map[string]string {
+	type failure struct {
+		msg  string
+		last time.Time
+	}
+	ff := make(map[string]failure)
+	for i := range ee {
+		ev := &ee[i]
+		if ev.Reason != unhealthyReason || ev.InvolvedObject.Kind != "Pod" {
+			continue
+		}
+		last := eventTime(ev)
+		if now.Sub(last) > probeFailureTTL {
+			continue
+		}
+		fqn := client.FQN(ev.InvolvedObject.Namespace, ev.InvolvedObject.Name)
+		if f, ok := ff[fqn]; ok && f.last.After(last) {
+			continue
+		}
+		ff[fqn] = failure{msg: ev.Message, last: last}
+	}
+
+	mm := make(map[string]string, len(ff))
+	for fqn, f := range ff {
+		mm[fqn] = f.msg
+	}
+
+	return mm
+}
+
+// nodeOSes returns the nodes operating system keyed by node name.
+func nodeOSes(f Factory) map[string]string {
+	oo, err := f.List("v1/nodes", client.ClusterScope, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("nodes list failed")
+		return nil
+	}
+
+	mm := make(map[string]string, len(oo))
+	for _, o := range oo {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			continue
+		}
+		mm[no.Name] = render.NodeOS(&no)
+	}
+
+	return mm
+}
+
+func podNodeName(u *unstructured.Unstructured) string {
+	n, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName")
+	return n
+}
+
+func missingRefs(idx configIndex, u *unstructured.Unstructured) []string {
+	spec, ok := u.Object["spec"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	var ps v1.PodSpec
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(spec, &ps); err != nil {
+		return nil
+	}
+
+	return idx.missing(u.GetNamespace(), &ps)
+}
+
+func eventTime(ev *v1.Event) time.Time {
+	switch {
+	case !ev.LastTimestamp.IsZero():
+		return ev.LastTimestamp.Time
+	case ev.Series != nil && !ev.Series.LastObservedTime.IsZero():
+		return ev.Series.LastObservedTime.Time
+	default:
+		return ev.EventTime.Time
+	}
+}
+
 // Logs fetch container logs for a given pod and container.
 func (p *Pod) Logs(path string, opts *v1.PodLogOptions) (*restclient.Request, error) {
 	ns, _ := client.Namespaced(path)
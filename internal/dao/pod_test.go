@@ -2,6 +2,7 @@ package dao
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
@@ -54,3 +55,29 @@ func TestGetDefaultLogContainer(t *testing.T) {
 		})
 	}
 }
+
+func TestLatestProbeFailures(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	ev := func(n, msg string, ago time.Duration) v1.Event {
+		return v1.Event{
+			Reason:         unhealthyReason,
+			Message:        msg,
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: "default", Name: n},
+			LastTimestamp:  metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	stale := ev("p3", "old", 10*time.Minute)
+	other := ev("p4", "nope", time.Minute)
+	other.Reason = "BackOff"
+
+	assert.Equal(t, map[string]string{
+		"default/p1": "Readiness probe failed: 503",
+		"default/p2": "Liveness probe failed",
+	}, latestProbeFailures([]v1.Event{
+		ev("p1", "Readiness probe failed: 500", 3*time.Minute),
+		ev("p1", "Readiness probe failed: 503", time.Minute),
+		ev("p2", "Liveness probe failed", 2*time.Minute),
+		stale,
+		other,
+	}, now))
+}
@@ -0,0 +1,160 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*PodVolumes)(nil)
+
+// PodVolumes represents a pod volumes and their mounts.
+type PodVolumes struct {
+	NonResource
+}
+
+// List returns the volume mounts of the context pod.
+func (p *PodVolumes) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+	o, err := p.Factory.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return nil, err
+	}
+
+	vv := PodVolumeMounts(&po)
+	oo := make([]runtime.Object, 0, len(vv))
+	for _, v := range vv {
+		if v.GVR == "v1/persistentvolumeclaims" {
+			v.PV = claimVolume(p.Factory, client.FQN(po.Namespace, v.Source))
+		}
+		oo = append(oo, v)
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given volume mount.
+func (p *PodVolumes) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// PodVolumeMounts returns a pod volumes along with where they are mounted. Unmounted
+// volumes are listed once without a container.
+func PodVolumeMounts(po *v1.Pod) []render.PodVolumeRes {
+	cc := make([]v1.Container, 0, len(po.Spec.InitContainers)+len(po.Spec.Containers))
+	cc = append(cc, po.Spec.InitContainers...)
+	cc = append(cc, po.Spec.Containers...)
+
+	var vv []render.PodVolumeRes
+	for _, vol := range po.Spec.Volumes {
+		kind, gvr, src := volumeSource(po, vol)
+		v := render.PodVolumeRes{
+			Namespace: po.Namespace,
+			Pod:       po.Name,
+			Volume:    vol.Name,
+			Type:      kind,
+			GVR:       gvr,
+			Source:    src,
+		}
+		var mounted bool
+		for _, co := range cc {
+			for _, m := range co.VolumeMounts {
+				if m.Name != vol.Name {
+					continue
+				}
+				mounted = true
+				v.Container, v.MountPath, v.SubPath, v.ReadOnly = co.Name, m.MountPath, m.SubPath, m.ReadOnly
+				vv = append(vv, v)
+			}
+		}
+		if !mounted {
+			vv = append(vv, v)
+		}
+	}
+
+	return vv
+}
+
+// volumeSource returns a volume type along with its backing object if any.
+func volumeSource(po *v1.Pod, v v1.Volume) (kind, gvr, name string) {
+	s := v.VolumeSource
+	switch {
+	case s.PersistentVolumeClaim != nil:
+		return "persistentVolumeClaim", "v1/persistentvolumeclaims", s.PersistentVolumeClaim.ClaimName
+	case s.Ephemeral != nil:
+		// Generic ephemeral volumes are backed by a claim named after the pod and volume.
+		return "ephemeral", "v1/persistentvolumeclaims", po.Name + "-" + v.Name
+	case s.ConfigMap != nil:
+		return "configMap", "v1/configmaps", s.ConfigMap.Name
+	case s.Secret != nil:
+		return "secret", "v1/secrets", s.Secret.SecretName
+	case s.HostPath != nil:
+		return "hostPath", "", s.HostPath.Path
+	case s.CSI != nil:
+		return "csi", "", s.CSI.Driver
+	case s.Projected != nil:
+		return "projected", "", projectedSources(s.Projected)
+	}
+
+	// Fallback on the volume source field name for the less common types.
+	raw, err := json.Marshal(s)
+	if err != nil {
+		return "", "", ""
+	}
+	var mm map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &mm); err != nil {
+		return "", "", ""
+	}
+	for k := range mm {
+		return k, "", ""
+	}
+
+	return "", "", ""
+}
+
+func projectedSources(p *v1.ProjectedVolumeSource) string {
+	ss := make([]string, 0, len(p.Sources))
+	for _, s := range p.Sources {
+		switch {
+		case s.ConfigMap != nil:
+			ss = append(ss, "configmap:"+s.ConfigMap.Name)
+		case s.Secret != nil:
+			ss = append(ss, "secret:"+s.Secret.Name)
+		case s.ServiceAccountToken != nil:
+			ss = append(ss, "serviceAccountToken")
+		case s.DownwardAPI != nil:
+			ss = append(ss, "downwardAPI")
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
+
+// claimVolume returns the persistent volume bound to a claim if any.
+func claimVolume(f Factory, fqn string) string {
+	o, err := f.Get("v1/persistentvolumeclaims", fqn, false, labels.Everything())
+	if err != nil {
+		return ""
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := fromUnstructured(o, &pvc); err != nil {
+		return ""
+	}
+
+	return pvc.Spec.VolumeName
+}
@@ -0,0 +1,55 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPodVolumeMounts(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1"},
+		Spec: v1.PodSpec{
+			Volumes: []v1.Volume{
+				{Name: "data", VolumeSource: v1.VolumeSource{PersistentVolumeClaim: &v1.PersistentVolumeClaimVolumeSource{ClaimName: "pvc1"}}},
+				{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}}},
+				{Name: "scratch", VolumeSource: v1.VolumeSource{EmptyDir: &v1.EmptyDirVolumeSource{}}},
+				{Name: "tmp", VolumeSource: v1.VolumeSource{Ephemeral: &v1.EphemeralVolumeSource{}}},
+			},
+			InitContainers: []v1.Container{
+				{Name: "i1", VolumeMounts: []v1.VolumeMount{{Name: "data", MountPath: "/init"}}},
+			},
+			Containers: []v1.Container{
+				{Name: "c1", VolumeMounts: []v1.VolumeMount{
+					{Name: "data", MountPath: "/data"},
+					{Name: "cfg", MountPath: "/etc/app", SubPath: "app.yaml", ReadOnly: true},
+				}},
+			},
+		},
+	}
+
+	vv := dao.PodVolumeMounts(&po)
+	assert.Len(t, vv, 5)
+
+	assert.Equal(t, "persistentVolumeClaim", vv[0].Type)
+	assert.Equal(t, "v1/persistentvolumeclaims", vv[0].GVR)
+	assert.Equal(t, "pvc1", vv[0].Source)
+	assert.Equal(t, "i1", vv[0].Container)
+	assert.Equal(t, "c1", vv[1].Container)
+	assert.Equal(t, "/data", vv[1].MountPath)
+
+	assert.Equal(t, "v1/configmaps", vv[2].GVR)
+	assert.Equal(t, "cm1", vv[2].Source)
+	assert.Equal(t, "app.yaml", vv[2].SubPath)
+	assert.True(t, vv[2].ReadOnly)
+
+	assert.Equal(t, "emptyDir", vv[3].Type)
+	assert.Equal(t, "", vv[3].GVR)
+	assert.Equal(t, "", vv[3].Container)
+
+	assert.Equal(t, "v1/persistentvolumeclaims", vv[4].GVR)
+	assert.Equal(t, "p1-tmp", vv[4].Source)
+}
@@ -0,0 +1,163 @@
+package dao
+
+import (
+	"context"
+	"regexp"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const preemptedReason = "Preempted"
+
+var (
+	_ Accessor = (*Preemptions)(nil)
+
+	// preemptorRX matches older schedulers messages ie Preempted by ns1/p1 on node n1.
+	preemptorRX = regexp.MustCompile(`Preempted by (?:pod )?([\w.-]+)/([\w.-]+) on node (\S+)`)
+	// preemptorUIDRX matches newer schedulers messages ie Preempted by pod <uid> on node n1.
+	preemptorUIDRX = regexp.MustCompile(`Preempted by pod ([0-9a-f-]{36}) on node (\S+)`)
+	preemptNodeRX  = regexp.MustCompile(`on node (\S+)`)
+)
+
+// Preemptions represents pods recently preempted by higher priority pods.
+type Preemptions struct {
+	NonResource
+}
+
+// List returns the preempted pods in a given namespace.
+func (p *Preemptions) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	oo, err := p.Factory.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var ee []v1.Event
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		if ev.Reason == preemptedReason && ev.InvolvedObject.Kind == "Pod" {
+			ee = append(ee, ev)
+		}
+	}
+	if len(ee) == 0 {
+		return nil, nil
+	}
+
+	var pods []v1.Pod
+	if oo, err := p.Factory.List("v1/pods", client.AllNamespaces, false, labels.Everything()); err == nil {
+		pods = make([]v1.Pod, 0, len(oo))
+		for _, o := range oo {
+			var po v1.Pod
+			if err := fromUnstructured(o, &po); err != nil {
+				return nil, err
+			}
+			pods = append(pods, po)
+		}
+	}
+
+	pp := PreemptedPods(ee, pods)
+	res := make([]runtime.Object, 0, len(pp))
+	for _, p := range pp {
+		res = append(res, p)
+	}
+
+	return res, nil
+}
+
+// Get fetch a given preemption.
+func (p *Preemptions) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// PreemptedPods lists the pods preempted per preemptor out of preemption events sorted by
+// namespace. Preemptors are resolved from the events related object or message.
+func PreemptedPods(ee []v1.Event, pods []v1.Pod) []render.PreemptionRes {
+	var (
+		byUID = make(map[string]*v1.Pod, len(pods))
+		byFQN = make(map[string]*v1.Pod, len(pods))
+	)
+	for i := range pods {
+		byUID[string(pods[i].UID)] = &pods[i]
+		byFQN[client.FQN(pods[i].Namespace, pods[i].Name)] = &pods[i]
+	}
+
+	mm := make(map[string]*render.PreemptionRes)
+	for i := range ee {
+		ev := &ee[i]
+		preemptor, node := eventPreemptor(ev, byUID)
+		res := render.PreemptionRes{
+			Namespace: ev.InvolvedObject.Namespace,
+			Victim:    ev.InvolvedObject.Name,
+			Preemptor: preemptor,
+			Node:      node,
+			Count:     ev.Count,
+			Last:      eventTime(ev),
+		}
+		if res.Count == 0 {
+			res.Count = 1
+		}
+		if po, ok := byFQN[preemptor]; ok {
+			res.PriorityClass, res.Priority = po.Spec.PriorityClassName, po.Spec.Priority
+		}
+
+		key := client.FQN(res.Namespace, res.Victim) + ":" + res.Preemptor
+		if p, ok := mm[key]; ok {
+			p.Count += res.Count
+			if res.Last.After(p.Last) {
+				p.Last = res.Last
+			}
+			continue
+		}
+		mm[key] = &res
+	}
+
+	pp := make([]render.PreemptionRes, 0, len(mm))
+	for _, p := range mm {
+		pp = append(pp, *p)
+	}
+	sort.Slice(pp, func(i, j int) bool {
+		if pp[i].Namespace != pp[j].Namespace {
+			return pp[i].Namespace < pp[j].Namespace
+		}
+		if !pp[i].Last.Equal(pp[j].Last) {
+			return pp[i].Last.After(pp[j].Last)
+		}
+		return pp[i].Victim < pp[j].Victim
+	})
+
+	return pp
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// eventPreemptor returns the preempting pod and the node it preempted from.
+func eventPreemptor(ev *v1.Event, byUID map[string]*v1.Pod) (string, string) {
+	var node string
+	if mm := preemptNodeRX.FindStringSubmatch(ev.Message); len(mm) == 2 {
+		node = mm[1]
+	}
+	if ev.Related != nil && ev.Related.Name != "" {
+		return client.FQN(ev.Related.Namespace, ev.Related.Name), node
+	}
+	if mm := preemptorUIDRX.FindStringSubmatch(ev.Message); len(mm) == 3 {
+		if po, ok := byUID[mm[1]]; ok {
+			return client.FQN(po.Namespace, po.Name), node
+		}
+		return "", node
+	}
+	if mm := preemptorRX.FindStringSubmatch(ev.Message); len(mm) == 4 {
+		return client.FQN(mm[1], mm[2]), node
+	}
+
+	return "", node
+}
@@ -0,0 +1,59 @@
+package dao_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPreemptedPods(t *testing.T) {
+	now := time.Now()
+	ev := func(ns, victim, msg string, related *v1.ObjectReference, count int32, ago time.Duration) v1.Event {
+		return v1.Event{
+			Reason:         "Preempted",
+			Message:        msg,
+			InvolvedObject: v1.ObjectReference{Kind: "Pod", Namespace: ns, Name: victim},
+			Related:        related,
+			Count:          count,
+			LastTimestamp:  metav1.NewTime(now.Add(-ago)),
+		}
+	}
+	prio := int32(1000)
+	pods := []v1.Pod{
+		{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "ml", Name: "train", UID: "7c5f7a6e-5b7e-4b8e-9f43-0f4a8a1e2d3c"},
+			Spec:       v1.PodSpec{PriorityClassName: "high", Priority: &prio},
+		},
+	}
+	ee := []v1.Event{
+		ev("web", "fe-1", "Preempted by pod 7c5f7a6e-5b7e-4b8e-9f43-0f4a8a1e2d3c on node n1", nil, 1, time.Minute),
+		ev("web", "fe-1", "Preempted by pod 7c5f7a6e-5b7e-4b8e-9f43-0f4a8a1e2d3c on node n1", nil, 2, 2*time.Minute),
+		ev("batch", "job-1", "Preempted by ml/train on node n2", nil, 0, time.Minute),
+		ev("batch", "job-2", "Preempted by a pod on node n3", &v1.ObjectReference{Namespace: "ml", Name: "infer"}, 1, 3*time.Minute),
+	}
+
+	pp := dao.PreemptedPods(ee, pods)
+	assert.Equal(t, 3, len(pp))
+
+	assert.Equal(t, "batch", pp[0].Namespace)
+	assert.Equal(t, "job-1", pp[0].Victim)
+	assert.Equal(t, "ml/train", pp[0].Preemptor)
+	assert.Equal(t, "n2", pp[0].Node)
+	assert.Equal(t, int32(1), pp[0].Count)
+	assert.Equal(t, "high", pp[0].PriorityClass)
+
+	assert.Equal(t, "job-2", pp[1].Victim)
+	assert.Equal(t, "ml/infer", pp[1].Preemptor)
+	assert.Equal(t, "n3", pp[1].Node)
+	assert.Nil(t, pp[1].Priority)
+
+	assert.Equal(t, "web", pp[2].Namespace)
+	assert.Equal(t, "ml/train", pp[2].Preemptor)
+	assert.Equal(t, int32(3), pp[2].Count)
+	assert.Equal(t, int32(1000), *pp[2].Priority)
+	assert.WithinDuration(t, now.Add(-time.Minute), pp[2].Last, time.Second)
+}
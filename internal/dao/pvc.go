@@ -0,0 +1,251 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	volumeStatsTTL      = 30 * time.Second
+	volumeUsedKey       = "used:"
+	volumeCapacityKey   = "capacity:"
+	kubeletStatsSummary = "stats/summary"
+)
+
+var _ Accessor = (*PersistentVolumeClaim)(nil)
+
+var volumeStats = newScrapeCache(volumeStatsTTL)
+
+// PersistentVolumeClaim represents a PVC.
+type PersistentVolumeClaim struct {
+	Resource
+}
+
+// List returns a collection of PVCs along with their volumes usage.
+func (p *PersistentVolumeClaim) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	usage, ww := p.usage(ctx, ns), claimWarnings(p.GetFactory(), ns)
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return res, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		fqn := extractFQN(o)
+		res = append(res, &render.PVCWithUsage{Raw: u, Usage: usage[fqn], Warning: ww[fqn]})
+	}
+
+	return res, nil
+}
+
+// usage returns the claims volume usage as reported by the kubelets hosting them.
+func (p *PersistentVolumeClaim) usage(ctx context.Context, ns string) map[string]*render.VolumeUsage {
+	if p.Client() == nil {
+		return nil
+	}
+	nodes, err := claimNodes(p.GetFactory(), ns)
+	if err != nil || len(nodes) == 0 {
+		return nil
+	}
+
+	uu := make(map[string]*render.VolumeUsage)
+	for _, n := range nodes {
+		stats, ok := volumeStats.get(n, func() (map[string]int64, error) {
+			raw, err := scrapeNode(ctx, p.Client(), n, kubeletStatsSummary)
+			if err != nil {
+				return nil, err
+			}
+			return volumeUsage(raw)
+		})
+		if !ok {
+			continue
+		}
+		for k, used := range stats {
+			if !strings.HasPrefix(k, volumeUsedKey) {
+				continue
+			}
+			fqn := strings.TrimPrefix(k, volumeUsedKey)
+			uu[fqn] = &render.VolumeUsage{Used: used, Capacity: stats[volumeCapacityKey+fqn]}
+		}
+	}
+
+	return uu
+}
+
+// Volume returns the object backing a bound claim. Longhorn volumes are
+// resolved to their Longhorn Volume, others to their PersistentVolume.
+func (p *PersistentVolumeClaim) Volume(path string) (client.GVR, string, error) {
+	o, err := p.GetFactory().Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pvc); err != nil {
+		return client.GVR{}, "", err
+	}
+	if pvc.Spec.VolumeName == "" {
+		return client.GVR{}, "", fmt.Errorf("pvc %s is not bound", path)
+	}
+
+	pvGVR, pvFQN := client.NewGVR("v1/persistentvolumes"), client.FQN(client.ClusterScope, pvc.Spec.VolumeName)
+	o, err = p.GetFactory().Get(pvGVR.String(), pvFQN, true, labels.Everything())
+	if err != nil {
+		return client.GVR{}, "", err
+	}
+	var pv v1.PersistentVolume
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &pv); err != nil {
+		return client.GVR{}, "", err
+	}
+	if pv.Spec.CSI == nil || pv.Spec.CSI.Driver != longhornDriver {
+		return pvGVR, pvFQN, nil
+	}
+	if fqn, ok := p.longhornVolume(pv.Spec.CSI.VolumeHandle); ok {
+		return client.NewGVR(longhornVolumeGVR), fqn, nil
+	}
+
+	return pvGVR, pvFQN, nil
+}
+
+func (p *PersistentVolumeClaim) longhornVolume(name string) (string, bool) {
+	if _, err := MetaAccess.MetaFor(client.NewGVR(longhornVolumeGVR)); err != nil {
+		return "", false
+	}
+	oo, err := p.GetFactory().List(longhornVolumeGVR, client.AllNamespaces, false, labels.Everything())
+	if err != nil {
+		return "", false
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if ok && u.GetName() == name {
+			return client.FQN(u.GetNamespace(), u.GetName()), true
+		}
+	}
+
+	return "", false
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// volumeSummary represents the volume stats reported by the kubelet summary api.
+type volumeSummary struct {
+	Pods []struct {
+		Volumes []struct {
+			CapacityBytes *int64 `json:"capacityBytes"`
+			UsedBytes     *int64 `json:"usedBytes"`
+			PVCRef        *struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"pvcRef"`
+		} `json:"volume"`
+	} `json:"pods"`
+}
+
+// volumeUsage extracts used and capacity bytes per claim from a kubelet stats summary.
+func volumeUsage(raw string) (map[string]int64, error) {
+	var s volumeSummary
+	if err := json.Unmarshal([]byte(raw), &s); err != nil {
+		return nil, err
+	}
+
+	vv := make(map[string]int64)
+	for _, p := range s.Pods {
+		for _, v := range p.Volumes {
+			if v.PVCRef == nil || v.UsedBytes == nil || v.CapacityBytes == nil {
+				continue
+			}
+			fqn := client.FQN(v.PVCRef.Namespace, v.PVCRef.Name)
+			vv[volumeUsedKey+fqn], vv[volumeCapacityKey+fqn] = *v.UsedBytes, *v.CapacityBytes
+		}
+	}
+
+	return vv, nil
+}
+
+// claimWarnings returns the most recent warning event per claim, i.e. provisioning or binding failures.
+func claimWarnings(f Factory, ns string) map[string]string {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	oo, err := f.List("v1/events", ns, false, labels.Everything())
+	if err != nil {
+		log.Warn().Err(err).Msgf("claim events list failed")
+		return nil
+	}
+
+	latest := make(map[string]*v1.Event)
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			continue
+		}
+		if ev.Type != v1.EventTypeWarning || ev.InvolvedObject.Kind != "PersistentVolumeClaim" {
+			continue
+		}
+		fqn := client.FQN(ev.InvolvedObject.Namespace, ev.InvolvedObject.Name)
+		if l, ok := latest[fqn]; ok && eventTime(l).After(eventTime(&ev)) {
+			continue
+		}
+		e := ev
+		latest[fqn] = &e
+	}
+
+	mm := make(map[string]string, len(latest))
+	for fqn, ev := range latest {
+		mm[fqn] = ev.Reason + ": " + ev.Message
+	}
+
+	return mm
+}
+
+// claimNodes returns the nodes running pods that mount claims in a given namespace.
+func claimNodes(f Factory, ns string) ([]string, error) {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	oo, err := f.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		nn   []string
+		seen = make(map[string]struct{})
+	)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName == "" || po.Status.Phase != v1.PodRunning {
+			continue
+		}
+		if _, ok := seen[po.Spec.NodeName]; ok {
+			continue
+		}
+		for _, v := range po.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil {
+				seen[po.Spec.NodeName] = struct{}{}
+				nn = append(nn, po.Spec.NodeName)
+				break
+			}
+		}
+	}
+
+	return nn, nil
+}
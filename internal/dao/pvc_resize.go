@@ -0,0 +1,152 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const scGVR = "storage.k8s.io/v1/storageclasses"
+
+// ResizePhase represents a claim expansion progress.
+type ResizePhase string
+
+const (
+	// ResizePending indicates the expansion was not picked up yet.
+	ResizePending ResizePhase = "Pending"
+
+	// ResizeInProgress indicates the volume is being expanded.
+	ResizeInProgress ResizePhase = "Resizing"
+
+	// ResizeFileSystemPending indicates the volume was expanded but its file system
+	// will only be resized once mounted by a pod.
+	ResizeFileSystemPending ResizePhase = "FileSystemResizePending"
+
+	// ResizeDone indicates the claim capacity matches its request.
+	ResizeDone ResizePhase = "Done"
+)
+
+// ResizeStatus represents a claim expansion status.
+type ResizeStatus struct {
+	Requested, Capacity string
+	Phase               ResizePhase
+	Message             string
+}
+
+// Size returns the claim requested storage.
+func (p *PersistentVolumeClaim) Size(path string) (string, error) {
+	pvc, err := p.load(path)
+	if err != nil {
+		return "", err
+	}
+	q := pvc.Spec.Resources.Requests[v1.ResourceStorage]
+
+	return q.String(), nil
+}
+
+// Resize expands a claim. The claim storage class must allow volume expansion.
+func (p *PersistentVolumeClaim) Resize(ctx context.Context, path, size string) error {
+	pvc, err := p.load(path)
+	if err != nil {
+		return err
+	}
+	if err := p.checkExpandable(pvc); err != nil {
+		return err
+	}
+	q, err := resource.ParseQuantity(size)
+	if err != nil {
+		return fmt.Errorf("invalid size %q", size)
+	}
+	if cur := pvc.Spec.Resources.Requests[v1.ResourceStorage]; q.Cmp(cur) <= 0 {
+		return fmt.Errorf("new size %s must be greater than the current size %s", q.String(), cur.String())
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"requests": map[string]string{string(v1.ResourceStorage): q.String()},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return p.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// ResizeStatus returns a claim expansion progress.
+func (p *PersistentVolumeClaim) ResizeStatus(path string) (ResizeStatus, error) {
+	pvc, err := p.load(path)
+	if err != nil {
+		return ResizeStatus{}, err
+	}
+
+	return resizeStatus(pvc), nil
+}
+
+func (p *PersistentVolumeClaim) load(path string) (*v1.PersistentVolumeClaim, error) {
+	o, err := p.GetFactory().Get(p.gvr.String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var pvc v1.PersistentVolumeClaim
+	if err := fromUnstructured(o, &pvc); err != nil {
+		return nil, err
+	}
+
+	return &pvc, nil
+}
+
+func (p *PersistentVolumeClaim) checkExpandable(pvc *v1.PersistentVolumeClaim) error {
+	if pvc.Spec.VolumeName == "" {
+		return fmt.Errorf("pvc %s/%s is not bound", pvc.Namespace, pvc.Name)
+	}
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return fmt.Errorf("pvc %s/%s has no storage class", pvc.Namespace, pvc.Name)
+	}
+	o, err := p.GetFactory().Get(scGVR, client.FQN(client.ClusterScope, *pvc.Spec.StorageClassName), true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	var sc storagev1.StorageClass
+	if err := fromUnstructured(o, &sc); err != nil {
+		return err
+	}
+	if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
+		return fmt.Errorf("storage class %s does not allow volume expansion", sc.Name)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func resizeStatus(pvc *v1.PersistentVolumeClaim) ResizeStatus {
+	req, capacity := pvc.Spec.Resources.Requests[v1.ResourceStorage], pvc.Status.Capacity[v1.ResourceStorage]
+	st := ResizeStatus{Requested: req.String(), Capacity: capacity.String(), Phase: ResizePending}
+	for _, c := range pvc.Status.Conditions {
+		if c.Status != v1.ConditionTrue {
+			continue
+		}
+		switch c.Type {
+		case v1.PersistentVolumeClaimFileSystemResizePending:
+			st.Phase, st.Message = ResizeFileSystemPending, c.Message
+			return st
+		case v1.PersistentVolumeClaimResizing:
+			st.Phase, st.Message = ResizeInProgress, c.Message
+		}
+	}
+	if st.Phase == ResizePending && capacity.Cmp(req) >= 0 {
+		st.Phase = ResizeDone
+	}
+
+	return st
+}
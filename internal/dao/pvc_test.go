@@ -0,0 +1,146 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestVolumeUsage(t *testing.T) {
+	raw := `{
+  "node": {"nodeName": "n1"},
+  "pods": [
+    {
+      "podRef": {"name": "db-0", "namespace": "default"},
+      "volume": [
+        {"name": "data", "capacityBytes": 1000, "usedBytes": 950, "pvcRef": {"name": "data-db-0", "namespace": "default"}},
+        {"name": "kube-api-access", "capacityBytes": 10, "usedBytes": 1}
+      ]
+    },
+    {
+      "podRef": {"name": "fred", "namespace": "blee"},
+      "volume": [
+        {"name": "scratch", "pvcRef": {"name": "scratch", "namespace": "blee"}}
+      ]
+    }
+  ]
+}`
+
+	vv, err := volumeUsage(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int64{
+		"used:default/data-db-0":     950,
+		"capacity:default/data-db-0": 1000,
+	}, vv)
+
+	_, err = volumeUsage("not json")
+	assert.Error(t, err)
+}
+
+func TestClaimNodes(t *testing.T) {
+	claim := []interface{}{
+		map[string]interface{}{"name": "data", "persistentVolumeClaim": map[string]interface{}{"claimName": "data"}},
+	}
+	pod := func(n, node, phase string, vv []interface{}) runtime.Object {
+		return relObj("v1", "Pod", "default", n, nil, map[string]interface{}{
+			"spec":   map[string]interface{}{"nodeName": node, "volumes": vv},
+			"status": map[string]interface{}{"phase": phase},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			pod("p1", "n1", "Running", claim),
+			pod("p2", "n1", "Running", claim),
+			pod("p3", "n2", "Running", nil),
+			pod("p4", "n3", "Succeeded", claim),
+			pod("p5", "", "Pending", claim),
+			pod("p6", "n4", "Running", claim),
+		},
+	}}
+
+	nn, err := claimNodes(f, "default")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"n1", "n4"}, nn)
+}
+
+func TestClaimWarnings(t *testing.T) {
+	event := func(n, kind, obj, typ, reason, msg, last string) runtime.Object {
+		return relObj("v1", "Event", "default", n, nil, map[string]interface{}{
+			"involvedObject": map[string]interface{}{"kind": kind, "name": obj, "namespace": "default"},
+			"type":           typ,
+			"reason":         reason,
+			"message":        msg,
+			"lastTimestamp":  last,
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/events": {
+			event("e1", "PersistentVolumeClaim", "data", "Warning", "ProvisioningFailed", "old", "2024-01-01T10:00:00Z"),
+			event("e2", "PersistentVolumeClaim", "data", "Warning", "ProvisioningFailed", `storageclass.storage.k8s.io "fast" not found`, "2024-01-01T11:00:00Z"),
+			event("e3", "PersistentVolumeClaim", "logs", "Normal", "ExternalProvisioning", "waiting", "2024-01-01T11:00:00Z"),
+			event("e4", "Pod", "data", "Warning", "FailedMount", "boom", "2024-01-01T11:00:00Z"),
+		},
+	}}
+
+	assert.Equal(t, map[string]string{
+		"default/data": `ProvisioningFailed: storageclass.storage.k8s.io "fast" not found`,
+	}, claimWarnings(f, "default"))
+}
+
+func TestResizeStatus(t *testing.T) {
+	uu := map[string]struct {
+		req, capacity string
+		cc            []v1.PersistentVolumeClaimCondition
+		e             ResizePhase
+	}{
+		"pending": {
+			req: "2Gi", capacity: "1Gi",
+			e: ResizePending,
+		},
+		"resizing": {
+			req: "2Gi", capacity: "1Gi",
+			cc: []v1.PersistentVolumeClaimCondition{{Type: v1.PersistentVolumeClaimResizing, Status: v1.ConditionTrue}},
+			e:  ResizeInProgress,
+		},
+		"fs-pending": {
+			req: "2Gi", capacity: "1Gi",
+			cc: []v1.PersistentVolumeClaimCondition{
+				{Type: v1.PersistentVolumeClaimResizing, Status: v1.ConditionTrue},
+				{Type: v1.PersistentVolumeClaimFileSystemResizePending, Status: v1.ConditionTrue},
+			},
+			e: ResizeFileSystemPending,
+		},
+		"done": {
+			req: "2Gi", capacity: "2Gi",
+			e: ResizeDone,
+		},
+		"stale-condition": {
+			req: "2Gi", capacity: "2Gi",
+			cc: []v1.PersistentVolumeClaimCondition{{Type: v1.PersistentVolumeClaimResizing, Status: v1.ConditionFalse}},
+			e:  ResizeDone,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pvc := v1.PersistentVolumeClaim{
+				Spec: v1.PersistentVolumeClaimSpec{
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse(u.req)},
+					},
+				},
+				Status: v1.PersistentVolumeClaimStatus{
+					Capacity:   v1.ResourceList{v1.ResourceStorage: resource.MustParse(u.capacity)},
+					Conditions: u.cc,
+				},
+			}
+			st := resizeStatus(&pvc)
+			assert.Equal(t, u.e, st.Phase)
+			assert.Equal(t, u.req, st.Requested)
+		})
+	}
+}
@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const cmGVR = "v1/configmaps"
+
+// QuickEditKind represents a kind of quick edit.
+type QuickEditKind int
+
+const (
+	// EnvVarEdit edits a container environment variable.
+	EnvVarEdit QuickEditKind = iota
+
+	// ConfigMapKeyEdit edits a referenced ConfigMap key.
+	ConfigMapKeyEdit
+)
+
+// QuickEdit represents a single value that can be edited in place on a workload.
+type QuickEdit struct {
+	Kind      QuickEditKind
+	Container string
+	Init      bool
+	ConfigMap string
+	Key       string
+	Value     string
+}
+
+// String returns the edit label.
+func (q QuickEdit) String() string {
+	if q.Kind == ConfigMapKeyEdit {
+		return fmt.Sprintf("cm %s/%s", q.ConfigMap, q.Key)
+	}
+
+	return fmt.Sprintf("env %s/%s", q.Container, q.Key)
+}
+
+// QuickEdits returns the literal environment variables and referenced ConfigMap keys of a pod spec.
+func QuickEdits(f Factory, ns string, spec *v1.PodSpec) []QuickEdit {
+	qq := envEdits(spec)
+	for _, n := range configMapRefs(spec) {
+		o, err := f.Get(cmGVR, client.FQN(ns, n), true, labels.Everything())
+		if err != nil {
+			log.Warn().Err(err).Msgf("Unable to load configmap %s/%s", ns, n)
+			continue
+		}
+		var cm v1.ConfigMap
+		if err := fromUnstructured(o, &cm); err != nil {
+			log.Warn().Err(err).Msgf("Invalid configmap %s/%s", ns, n)
+			continue
+		}
+		kk := make([]string, 0, len(cm.Data))
+		for k := range cm.Data {
+			kk = append(kk, k)
+		}
+		sort.Strings(kk)
+		for _, k := range kk {
+			qq = append(qq, QuickEdit{Kind: ConfigMapKeyEdit, ConfigMap: n, Key: k, Value: cm.Data[k]})
+		}
+	}
+
+	return qq
+}
+
+// ApplyQuickEdit patches either a workload environment variable or a ConfigMap key.
+func ApplyQuickEdit(ctx context.Context, f Factory, gvr, path string, q QuickEdit, value string) error {
+	var (
+		g     Generic
+		patch []byte
+		err   error
+		pt    = types.StrategicMergePatchType
+	)
+	switch q.Kind {
+	case ConfigMapKeyEdit:
+		ns, _ := client.Namespaced(path)
+		path, pt = client.FQN(ns, q.ConfigMap), types.MergePatchType
+		g.Init(f, client.NewGVR(cmGVR))
+		patch, err = json.Marshal(map[string]interface{}{
+			"data": map[string]string{q.Key: value},
+		})
+	default:
+		g.Init(f, client.NewGVR(gvr))
+		patch, err = envPatch(q, value)
+	}
+	if err != nil {
+		return err
+	}
+
+	return g.Patch(ctx, path, pt, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func envEdits(spec *v1.PodSpec) []QuickEdit {
+	var qq []QuickEdit
+	for i, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			for _, e := range co.Env {
+				if e.ValueFrom != nil {
+					continue
+				}
+				qq = append(qq, QuickEdit{Kind: EnvVarEdit, Container: co.Name, Init: i == 0, Key: e.Name, Value: e.Value})
+			}
+		}
+	}
+
+	return qq
+}
+
+// configMapRefs returns the ConfigMaps referenced by a pod spec.
+func configMapRefs(spec *v1.PodSpec) []string {
+	set := make(map[string]struct{})
+	for _, cc := range [][]v1.Container{spec.InitContainers, spec.Containers} {
+		for _, co := range cc {
+			for _, e := range co.EnvFrom {
+				if e.ConfigMapRef != nil {
+					set[e.ConfigMapRef.Name] = struct{}{}
+				}
+			}
+			for _, e := range co.Env {
+				if e.ValueFrom != nil && e.ValueFrom.ConfigMapKeyRef != nil {
+					set[e.ValueFrom.ConfigMapKeyRef.Name] = struct{}{}
+				}
+			}
+		}
+	}
+	for _, v := range spec.Volumes {
+		if v.ConfigMap != nil {
+			set[v.ConfigMap.Name] = struct{}{}
+		}
+		if v.Projected == nil {
+			continue
+		}
+		for _, s := range v.Projected.Sources {
+			if s.ConfigMap != nil {
+				set[s.ConfigMap.Name] = struct{}{}
+			}
+		}
+	}
+	nn := make([]string, 0, len(set))
+	for n := range set {
+		nn = append(nn, n)
+	}
+	sort.Strings(nn)
+
+	return nn
+}
+
+// envPatch builds a strategic merge patch setting a container environment variable.
+func envPatch(q QuickEdit, value string) ([]byte, error) {
+	field := "containers"
+	if q.Init {
+		field = "initContainers"
+	}
+
+	return json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					field: []interface{}{
+						map[string]interface{}{
+							"name": q.Container,
+							"env":  []interface{}{map[string]string{"name": q.Key, "value": value}},
+						},
+					},
+				},
+			},
+		},
+	})
+}
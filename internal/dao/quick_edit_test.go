@@ -0,0 +1,79 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+)
+
+func TestQuickEditEnvs(t *testing.T) {
+	spec := v1.PodSpec{
+		InitContainers: []v1.Container{
+			{Name: "init", Env: []v1.EnvVar{{Name: "MODE", Value: "migrate"}}},
+		},
+		Containers: []v1.Container{
+			{
+				Name: "app",
+				Env: []v1.EnvVar{
+					{Name: "LEVEL", Value: "debug"},
+					{Name: "URL", ValueFrom: &v1.EnvVarSource{
+						ConfigMapKeyRef: &v1.ConfigMapKeySelector{
+							LocalObjectReference: v1.LocalObjectReference{Name: "urls"},
+							Key:                  "api",
+						},
+					}},
+				},
+				EnvFrom: []v1.EnvFromSource{
+					{ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "settings"}}},
+				},
+			},
+		},
+		Volumes: []v1.Volume{
+			{Name: "cfg", VolumeSource: v1.VolumeSource{ConfigMap: &v1.ConfigMapVolumeSource{
+				LocalObjectReference: v1.LocalObjectReference{Name: "settings"},
+			}}},
+			{Name: "all", VolumeSource: v1.VolumeSource{Projected: &v1.ProjectedVolumeSource{
+				Sources: []v1.VolumeProjection{
+					{ConfigMap: &v1.ConfigMapProjection{LocalObjectReference: v1.LocalObjectReference{Name: "extra"}}},
+				},
+			}}},
+		},
+	}
+
+	assert.Equal(t, []QuickEdit{
+		{Kind: EnvVarEdit, Container: "init", Init: true, Key: "MODE", Value: "migrate"},
+		{Kind: EnvVarEdit, Container: "app", Key: "LEVEL", Value: "debug"},
+	}, envEdits(&spec))
+	assert.Equal(t, []string{"extra", "settings", "urls"}, configMapRefs(&spec))
+}
+
+func TestQuickEditString(t *testing.T) {
+	assert.Equal(t, "env app/LEVEL", QuickEdit{Kind: EnvVarEdit, Container: "app", Key: "LEVEL"}.String())
+	assert.Equal(t, "cm settings/mode", QuickEdit{Kind: ConfigMapKeyEdit, ConfigMap: "settings", Key: "mode"}.String())
+}
+
+func TestEnvPatch(t *testing.T) {
+	uu := map[string]struct {
+		q QuickEdit
+		e string
+	}{
+		"container": {
+			q: QuickEdit{Container: "app", Key: "LEVEL"},
+			e: `{"spec":{"template":{"spec":{"containers":[{"env":[{"name":"LEVEL","value":"info"}],"name":"app"}]}}}}`,
+		},
+		"init": {
+			q: QuickEdit{Container: "init", Init: true, Key: "LEVEL"},
+			e: `{"spec":{"template":{"spec":{"initContainers":[{"env":[{"name":"LEVEL","value":"info"}],"name":"init"}]}}}}`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw, err := envPatch(u.q, "info")
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, string(raw))
+		})
+	}
+}
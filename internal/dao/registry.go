@@ -78,21 +78,34 @@ func NewMeta() *Meta {
 // Customize here for non resource types or types with metrics or logs.
 func AccessorFor(f Factory, gvr client.GVR) (Accessor, error) {
 	m := Accessors{
-		client.NewGVR("contexts"):               &Context{},
-		client.NewGVR("containers"):             &Container{},
-		client.NewGVR("screendumps"):            &ScreenDump{},
-		client.NewGVR("benchmarks"):             &Benchmark{},
-		client.NewGVR("portforwards"):           &PortForward{},
-		client.NewGVR("v1/services"):            &Service{},
-		client.NewGVR("v1/pods"):                &Pod{},
-		client.NewGVR("v1/nodes"):               &Node{},
-		client.NewGVR("apps/v1/deployments"):    &Deployment{},
-		client.NewGVR("apps/v1/daemonsets"):     &DaemonSet{},
-		client.NewGVR("apps/v1/statefulsets"):   &StatefulSet{},
-		client.NewGVR("batch/v1/cronjobs"):      &CronJob{},
-		client.NewGVR("batch/v1beta1/cronjobs"): &CronJob{},
-		client.NewGVR("batch/v1/jobs"):          &Job{},
-		client.NewGVR("v1/namespaces"):          &Namespace{},
+		client.NewGVR("contexts"):                        &Context{},
+		client.NewGVR("containers"):                      &Container{},
+		client.NewGVR("screendumps"):                     &ScreenDump{},
+		client.NewGVR("benchmarks"):                      &Benchmark{},
+		client.NewGVR("portforwards"):                    &PortForward{},
+		client.NewGVR("v1/services"):                     &Service{},
+		client.NewGVR("v1/pods"):                         &Pod{},
+		client.NewGVR("v1/nodes"):                        &Node{},
+		client.NewGVR("apps/v1/deployments"):             &Deployment{},
+		client.NewGVR("apps/v1/daemonsets"):              &DaemonSet{},
+		client.NewGVR("apps/v1/statefulsets"):            &StatefulSet{},
+		client.NewGVR("batch/v1/cronjobs"):               &CronJob{},
+		client.NewGVR("batch/v1beta1/cronjobs"):          &CronJob{},
+		client.NewGVR("batch/v1/jobs"):                   &Job{},
+		client.NewGVR("v1/namespaces"):                   &Namespace{},
+		client.NewGVR("tekton.dev/v1/pipelineruns"):      &PipelineRun{},
+		client.NewGVR("tekton.dev/v1beta1/pipelineruns"): &PipelineRun{},
+		client.NewGVR("tekton.dev/v1/taskruns"):          &TaskRun{},
+		client.NewGVR("tekton.dev/v1beta1/taskruns"):     &TaskRun{},
+		client.NewGVR("argoproj.io/v1alpha1/workflows"):  &Workflow{},
+		client.NewGVR("keda.sh/v1alpha1/scaledobjects"):  &ScaledObject{},
+		client.NewGVR("keda.sh/v1alpha1/scaledjobs"):     &ScaledJob{},
+		client.NewGVR("velero.io/v1/backups"):            &Backup{},
+		client.NewGVR("velero.io/v1/schedules"):          &Schedule{},
+
+		client.NewGVR("autoscaling/v1/horizontalpodautoscalers"):      &HorizontalPodAutoscaler{},
+		client.NewGVR("autoscaling/v2/horizontalpodautoscalers"):      &HorizontalPodAutoscaler{},
+		client.NewGVR("autoscaling/v2beta2/horizontalpodautoscalers"): &HorizontalPodAutoscaler{},
 		// BOZO!! Revamp with latest...
 		// client.NewGVR("openfaas"):               &OpenFaas{},
 		client.NewGVR("popeye"):    &Popeye{},
@@ -230,6 +243,134 @@ func loadK9s(m ResourceMetas) {
 		Verbs:        []string{},
 		Categories:   []string{"k9s"},
 	}
+	m[client.NewGVR("related")] = metav1.APIResource{
+		Name:         "related",
+		Kind:         "Related",
+		SingularName: "related",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("podvolumes")] = metav1.APIResource{
+		Name:         "podvolumes",
+		Kind:         "PodVolumes",
+		SingularName: "podvolume",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("svcsplits")] = metav1.APIResource{
+		Name:         "svcsplits",
+		Kind:         "ServiceSplits",
+		SingularName: "svcsplit",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("ips")] = metav1.APIResource{
+		Name:         "ips",
+		Kind:         "IPs",
+		SingularName: "ip",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("apihealth")] = metav1.APIResource{
+		Name:         "apihealth",
+		Kind:         "APIHealth",
+		SingularName: "apihealth",
+		ShortNames:   []string{"health"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("deprecations")] = metav1.APIResource{
+		Name:         "deprecations",
+		Kind:         "Deprecations",
+		SingularName: "deprecation",
+		ShortNames:   []string{"deprecated"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("upgrade")] = metav1.APIResource{
+		Name:         "upgrade",
+		Kind:         "Upgrade",
+		SingularName: "upgrade",
+		ShortNames:   []string{"readiness"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR(K9sLogsGVR)] = metav1.APIResource{
+		Name:         K9sLogsGVR,
+		Kind:         "K9sLogs",
+		SingularName: "k9slog",
+		ShortNames:   []string{"k9s-logs"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("churn")] = metav1.APIResource{
+		Name:         "churn",
+		Kind:         "Churn",
+		SingularName: "churn",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("preemptions")] = metav1.APIResource{
+		Name:         "preemptions",
+		Kind:         "Preemptions",
+		SingularName: "preemption",
+		ShortNames:   []string{"preempted"},
+		Namespaced:   true,
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("devices")] = metav1.APIResource{
+		Name:         "devices",
+		Kind:         "Devices",
+		SingularName: "device",
+		ShortNames:   []string{"gpu", "gpus"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("orphans")] = metav1.APIResource{
+		Name:         "orphans",
+		Kind:         "Orphans",
+		SingularName: "orphan",
+		Namespaced:   true,
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("nsblockers")] = metav1.APIResource{
+		Name:         "nsblockers",
+		Kind:         "NamespaceBlockers",
+		SingularName: "nsblocker",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("webhooks")] = metav1.APIResource{
+		Name:         "webhooks",
+		Kind:         "Webhooks",
+		SingularName: "webhook",
+		ShortNames:   []string{"wh"},
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("managedfields")] = metav1.APIResource{
+		Name:         "managedfields",
+		Kind:         "ManagedFields",
+		SingularName: "managedfield",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("history")] = metav1.APIResource{
+		Name:         "history",
+		Kind:         "History",
+		SingularName: "history",
+		Verbs:        []string{},
+		Categories:   []string{"k9s"},
+	}
+	m[client.NewGVR("trash")] = metav1.APIResource{
+		Name:         "trash",
+		Kind:         "Trash",
+		SingularName: "trash",
+		Verbs:        []string{"delete"},
+		Categories:   []string{"k9s"},
+	}
 	m[client.NewGVR("aliases")] = metav1.APIResource{
 		Name:         "aliases",
 		Kind:         "Aliases",
@@ -451,6 +592,7 @@ func extractMeta(o runtime.Object) (metav1.APIResource, []error) {
 	m.SingularName, errs = extractStr(names, "singular", errs)
 	m.Name, errs = extractStr(names, "plural", errs)
 	m.ShortNames, errs = extractSlice(names, "shortNames", errs)
+	m.Categories, errs = extractSlice(names, "categories", errs)
 
 	return m, errs
 }
@@ -23,6 +23,7 @@ func TestExtractMeta(t *testing.T) {
 	assert.Equal(t, "v1alpha3", m.Version)
 	assert.Equal(t, true, m.Namespaced)
 	assert.Equal(t, []string{"dr"}, m.ShortNames)
+	assert.Equal(t, []string{"istio-io", "networking-istio-io"}, m.Categories)
 	var vv metav1.Verbs
 	assert.Equal(t, vv, m.Verbs)
 }
@@ -0,0 +1,372 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	v1 "k8s.io/api/core/v1"
+	netv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*Related)(nil)
+
+// RelatedRef tracks a resource related to a given object.
+type RelatedRef struct {
+	GVR, FQN, Relation string
+}
+
+// RelationFn collects resources related to a given object.
+type RelationFn func(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error)
+
+var (
+	relations = map[string][]RelationFn{
+		"v1/services":                    {servicePods},
+		"v1/pods":                        {podClaims, podServices, podNetworkPolicies, podNode},
+		"v1/persistentvolumeclaims":      {claimPods},
+		"apps/v1/deployments":            {selectorPods, scaleTargetHPAs},
+		"apps/v1/statefulsets":           {selectorPods, scaleTargetHPAs},
+		"apps/v1/replicasets":            {selectorPods, scaleTargetHPAs},
+		"apps/v1/daemonsets":             {selectorPods},
+		"networking.k8s.io/v1/ingresses": {ingressServices},
+	}
+	relationsMX sync.RWMutex
+)
+
+// RegisterRelation adds a relation for a given resource.
+func RegisterRelation(gvr string, fn RelationFn) {
+	relationsMX.Lock()
+	defer relationsMX.Unlock()
+
+	relations[gvr] = append(relations[gvr], fn)
+}
+
+// Related represents resources related to a given object.
+type Related struct {
+	NonResource
+}
+
+// List returns all resources related to the context object.
+func (r *Related) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	gvr, ok := ctx.Value(internal.KeyGVR).(string)
+	if !ok {
+		return nil, errors.New("No context GVR found")
+	}
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+
+	refs, err := RelatedFor(r.Factory, gvr, path)
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(refs))
+	for _, ref := range refs {
+		ns, n := client.Namespaced(ref.FQN)
+		oo = append(oo, render.RelatedRes{
+			Namespace: ns,
+			Name:      n,
+			GVR:       ref.GVR,
+			Relation:  ref.Relation,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given related resource.
+func (r *Related) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// RelatedFor collects all the resources related to a given object.
+func RelatedFor(f Factory, gvr, path string) ([]RelatedRef, error) {
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	relationsMX.RLock()
+	ff := append([]RelationFn{involvedEvents, ownerRelation, childrenRelation}, relations[gvr]...)
+	relationsMX.RUnlock()
+
+	var refs []RelatedRef
+	for _, fn := range ff {
+		rr, err := fn(f, u)
+		if err != nil {
+			return nil, err
+		}
+		refs = append(refs, rr...)
+	}
+	sort.SliceStable(refs, func(i, j int) bool {
+		if refs[i].GVR != refs[j].GVR {
+			return refs[i].GVR < refs[j].GVR
+		}
+		return refs[i].FQN < refs[j].FQN
+	})
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Relations...
+
+func involvedEvents(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	ns := o.GetNamespace()
+	if ns == "" {
+		ns = client.AllNamespaces
+	}
+	oo, err := f.List("v1/events", ns, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, e := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(e, &ev); err != nil {
+			return nil, err
+		}
+		if !involves(ev.InvolvedObject, o) {
+			continue
+		}
+		refs = append(refs, RelatedRef{GVR: "v1/events", FQN: client.FQN(ev.Namespace, ev.Name), Relation: "event"})
+	}
+
+	return refs, nil
+}
+
+func servicePods(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	sel, _, err := unstructured.NestedStringMap(o.Object, "spec", "selector")
+	if err != nil || len(sel) == 0 {
+		return nil, err
+	}
+
+	return podsFor(f, o.GetNamespace(), labels.SelectorFromSet(sel), "selects")
+}
+
+func selectorPods(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	m, ok, err := unstructured.NestedMap(o.Object, "spec", "selector")
+	if err != nil || !ok {
+		return nil, err
+	}
+	var ls metav1.LabelSelector
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(m, &ls); err != nil {
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(&ls)
+	if err != nil {
+		return nil, err
+	}
+
+	return podsFor(f, o.GetNamespace(), sel, "selects")
+}
+
+func scaleTargetHPAs(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	oo, err := f.List(hpaGVR, o.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, h := range oo {
+		u, ok := h.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", h)
+		}
+		kind, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "kind")
+		name, _, _ := unstructured.NestedString(u.Object, "spec", "scaleTargetRef", "name")
+		if kind != o.GetKind() || name != o.GetName() {
+			continue
+		}
+		refs = append(refs, RelatedRef{GVR: hpaGVR, FQN: client.FQN(u.GetNamespace(), u.GetName()), Relation: "scaled by"})
+	}
+
+	return refs, nil
+}
+
+func podClaims(_ Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	var po v1.Pod
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &po); err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, v := range po.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		refs = append(refs, RelatedRef{
+			GVR:      "v1/persistentvolumeclaims",
+			FQN:      client.FQN(po.Namespace, v.PersistentVolumeClaim.ClaimName),
+			Relation: "mounts",
+		})
+	}
+
+	return refs, nil
+}
+
+func podServices(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	oo, err := f.List("v1/services", o.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, s := range oo {
+		var svc v1.Service
+		if err := fromUnstructured(s, &svc); err != nil {
+			return nil, err
+		}
+		if len(svc.Spec.Selector) == 0 || !labels.SelectorFromSet(svc.Spec.Selector).Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+		refs = append(refs, RelatedRef{GVR: "v1/services", FQN: client.FQN(svc.Namespace, svc.Name), Relation: "selected by"})
+	}
+
+	return refs, nil
+}
+
+func podNetworkPolicies(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	gvr := "networking.k8s.io/v1/networkpolicies"
+	oo, err := f.List(gvr, o.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, n := range oo {
+		var np netv1.NetworkPolicy
+		if err := fromUnstructured(n, &np); err != nil {
+			return nil, err
+		}
+		sel, err := metav1.LabelSelectorAsSelector(&np.Spec.PodSelector)
+		if err != nil || !sel.Matches(labels.Set(o.GetLabels())) {
+			continue
+		}
+		refs = append(refs, RelatedRef{GVR: gvr, FQN: client.FQN(np.Namespace, np.Name), Relation: "selected by"})
+	}
+
+	return refs, nil
+}
+
+func podNode(_ Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	node, _, _ := unstructured.NestedString(o.Object, "spec", "nodeName")
+	if node == "" {
+		return nil, nil
+	}
+
+	return []RelatedRef{{GVR: "v1/nodes", FQN: client.FQN(client.ClusterScope, node), Relation: "runs on"}}, nil
+}
+
+func claimPods(f Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	oo, err := f.List("v1/pods", o.GetNamespace(), false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var refs []RelatedRef
+	for _, p := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(p, &po); err != nil {
+			return nil, err
+		}
+		for _, v := range po.Spec.Volumes {
+			if v.PersistentVolumeClaim != nil && v.PersistentVolumeClaim.ClaimName == o.GetName() {
+				refs = append(refs, RelatedRef{GVR: "v1/pods", FQN: client.FQN(po.Namespace, po.Name), Relation: "mounted by"})
+				break
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func ingressServices(_ Factory, o *unstructured.Unstructured) ([]RelatedRef, error) {
+	var ing netv1.Ingress
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(o.Object, &ing); err != nil {
+		return nil, err
+	}
+
+	var (
+		refs []RelatedRef
+		seen = make(map[string]struct{})
+	)
+	add := func(b *netv1.IngressBackend) {
+		if b == nil || b.Service == nil {
+			return
+		}
+		if _, ok := seen[b.Service.Name]; ok {
+			return
+		}
+		seen[b.Service.Name] = struct{}{}
+		refs = append(refs, RelatedRef{GVR: "v1/services", FQN: client.FQN(ing.Namespace, b.Service.Name), Relation: "routes to"})
+	}
+	add(ing.Spec.DefaultBackend)
+	for _, r := range ing.Spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for i := range r.HTTP.Paths {
+			add(&r.HTTP.Paths[i].Backend)
+		}
+	}
+	for _, tls := range ing.Spec.TLS {
+		if tls.SecretName != "" {
+			refs = append(refs, RelatedRef{GVR: "v1/secrets", FQN: client.FQN(ing.Namespace, tls.SecretName), Relation: "terminates with"})
+		}
+	}
+
+	return refs, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func podsFor(f Factory, ns string, sel labels.Selector, relation string) ([]RelatedRef, error) {
+	oo, err := f.List("v1/pods", ns, false, sel)
+	if err != nil {
+		return nil, err
+	}
+	refs := make([]RelatedRef, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		refs = append(refs, RelatedRef{GVR: "v1/pods", FQN: client.FQN(u.GetNamespace(), u.GetName()), Relation: relation})
+	}
+
+	return refs, nil
+}
+
+func involves(ref v1.ObjectReference, o *unstructured.Unstructured) bool {
+	if ref.UID != "" && o.GetUID() != "" {
+		return ref.UID == o.GetUID()
+	}
+
+	return ref.Kind == o.GetKind() && ref.Name == o.GetName() && ref.Namespace == o.GetNamespace()
+}
+
+func fromUnstructured(o runtime.Object, obj interface{}) error {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, obj)
+}
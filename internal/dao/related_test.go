@@ -0,0 +1,115 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/watch"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+)
+
+func TestRelatedFor(t *testing.T) {
+	po := relObj("v1", "Pod", "default", "p1", map[string]string{"app": "nginx"}, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"nodeName": "n1",
+			"volumes": []interface{}{
+				map[string]interface{}{"name": "data", "persistentVolumeClaim": map[string]interface{}{"claimName": "pvc1"}},
+			},
+		},
+	})
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {po},
+		"v1/services": {
+			relObj("v1", "Service", "default", "s1", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"selector": map[string]interface{}{"app": "nginx"}},
+			}),
+			relObj("v1", "Service", "default", "s2", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"selector": map[string]interface{}{"app": "blee"}},
+			}),
+		},
+		"networking.k8s.io/v1/networkpolicies": {
+			relObj("networking.k8s.io/v1", "NetworkPolicy", "default", "deny-all", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"podSelector": map[string]interface{}{}},
+			}),
+		},
+		"v1/events": {
+			relObj("v1", "Event", "default", "p1.123", nil, map[string]interface{}{
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1", "namespace": "default"},
+			}),
+			relObj("v1", "Event", "default", "p2.123", nil, map[string]interface{}{
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p2", "namespace": "default"},
+			}),
+		},
+	}}
+
+	refs, err := RelatedFor(f, "v1/pods", "default/p1")
+	assert.NoError(t, err)
+	assert.Equal(t, []RelatedRef{
+		{GVR: "networking.k8s.io/v1/networkpolicies", FQN: "default/deny-all", Relation: "selected by"},
+		{GVR: "v1/events", FQN: "default/p1.123", Relation: "event"},
+		{GVR: "v1/nodes", FQN: "-/n1", Relation: "runs on"},
+		{GVR: "v1/persistentvolumeclaims", FQN: "default/pvc1", Relation: "mounts"},
+		{GVR: "v1/services", FQN: "default/s1", Relation: "selected by"},
+	}, refs)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func relObj(apiVersion, kind, ns, n string, ll map[string]string, fields map[string]interface{}) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: fields}
+	o.SetAPIVersion(apiVersion)
+	o.SetKind(kind)
+	o.SetNamespace(ns)
+	o.SetName(n)
+	o.SetLabels(ll)
+
+	return &o
+}
+
+type relFactory struct {
+	rows map[string][]runtime.Object
+}
+
+var _ Factory = relFactory{}
+
+func (f relFactory) Client() client.Connection {
+	return nil
+}
+
+func (f relFactory) Get(gvr, path string, wait bool, sel labels.Selector) (runtime.Object, error) {
+	for _, o := range f.rows[gvr] {
+		u := o.(*unstructured.Unstructured)
+		if client.FQN(u.GetNamespace(), u.GetName()) == path {
+			return o, nil
+		}
+	}
+	return nil, nil
+}
+
+func (f relFactory) List(gvr, ns string, wait bool, sel labels.Selector) ([]runtime.Object, error) {
+	var oo []runtime.Object
+	for _, o := range f.rows[gvr] {
+		if sel.Matches(labels.Set(o.(*unstructured.Unstructured).GetLabels())) {
+			oo = append(oo, o)
+		}
+	}
+	return oo, nil
+}
+
+func (f relFactory) ForResource(ns, gvr string) (informers.GenericInformer, error) {
+	return nil, nil
+}
+
+func (f relFactory) CanForResource(ns, gvr string, verbs []string) (informers.GenericInformer, error) {
+	return nil, nil
+}
+func (f relFactory) WaitForCacheSync() {}
+func (f relFactory) Forwarders() watch.Forwarders {
+	return nil
+}
+func (f relFactory) DeleteForwarder(string) {}
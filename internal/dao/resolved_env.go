@@ -0,0 +1,326 @@
+package dao
+
+import (
+	"encoding/base64"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// MaskedValue replaces secret values in resolved environments.
+const MaskedValue = "****"
+
+// EnvVar represents a container environment variable as seen by the process.
+type EnvVar struct {
+	Name, Value string
+	// Source tells where the value comes from.
+	Source string
+	// Missing flags values that could not be resolved.
+	Missing bool
+}
+
+// ConfigDataFn returns a configmap or secret data given its gvr and fully qualified name.
+type ConfigDataFn func(gvr, fqn string) (map[string]string, error)
+
+// ResolvedEnv returns a pod container environment with all references resolved.
+// Secret values are masked.
+func ResolvedEnv(f Factory, path, co string) ([]EnvVar, error) {
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return nil, err
+	}
+
+	return ResolveEnv(&po, co, func(gvr, fqn string) (map[string]string, error) {
+		return configData(f, gvr, fqn)
+	})
+}
+
+// ResolveEnv expands a pod container environment variables the way the kubelet does.
+// EnvFrom sources come first and get overridden by explicit env vars.
+func ResolveEnv(po *v1.Pod, name string, dataFn ConfigDataFn) ([]EnvVar, error) {
+	co := podContainer(po, name)
+	if co == nil {
+		return nil, fmt.Errorf("unable to locate container %q on pod %s", name, client.FQN(po.Namespace, po.Name))
+	}
+
+	var (
+		ee    []EnvVar
+		index = make(map[string]int)
+	)
+	set := func(e EnvVar) {
+		if i, ok := index[e.Name]; ok {
+			ee[i] = e
+			return
+		}
+		index[e.Name] = len(ee)
+		ee = append(ee, e)
+	}
+
+	for _, from := range co.EnvFrom {
+		for _, e := range resolveEnvFrom(po.Namespace, from, dataFn) {
+			set(e)
+		}
+	}
+	for _, env := range co.Env {
+		e := EnvVar{Name: env.Name, Source: "value"}
+		if env.ValueFrom == nil {
+			e.Value = expandEnv(env.Value, ee, index)
+		} else {
+			e = resolveEnvVar(po, co, env, dataFn)
+		}
+		set(e)
+	}
+
+	return ee, nil
+}
+
+func resolveEnvFrom(ns string, from v1.EnvFromSource, dataFn ConfigDataFn) []EnvVar {
+	var (
+		gvr, n, kind string
+		optional     *bool
+	)
+	switch {
+	case from.ConfigMapRef != nil:
+		gvr, n, kind, optional = "v1/configmaps", from.ConfigMapRef.Name, "configmap", from.ConfigMapRef.Optional
+	case from.SecretRef != nil:
+		gvr, n, kind, optional = "v1/secrets", from.SecretRef.Name, "secret", from.SecretRef.Optional
+	default:
+		return nil
+	}
+
+	src := kind + "/" + n
+	data, err := dataFn(gvr, client.FQN(ns, n))
+	if err != nil {
+		if isOptional(optional) {
+			return nil
+		}
+		return []EnvVar{{Name: from.Prefix + "*", Source: src, Missing: true}}
+	}
+
+	ee := make([]EnvVar, 0, len(data))
+	for _, k := range sortedKeys(asKeySet(data)) {
+		v := data[k]
+		if kind == "secret" {
+			v = MaskedValue
+		}
+		ee = append(ee, EnvVar{Name: from.Prefix + k, Value: v, Source: src})
+	}
+
+	return ee
+}
+
+func resolveEnvVar(po *v1.Pod, co *v1.Container, env v1.EnvVar, dataFn ConfigDataFn) EnvVar {
+	e, ref := EnvVar{Name: env.Name}, env.ValueFrom
+	switch {
+	case ref.ConfigMapKeyRef != nil:
+		e.Source = "configmap/" + ref.ConfigMapKeyRef.Name + ":" + ref.ConfigMapKeyRef.Key
+		e.Value, e.Missing = keyRef(dataFn, "v1/configmaps", po.Namespace, ref.ConfigMapKeyRef.LocalObjectReference, ref.ConfigMapKeyRef.Key, ref.ConfigMapKeyRef.Optional)
+	case ref.SecretKeyRef != nil:
+		e.Source = "secret/" + ref.SecretKeyRef.Name + ":" + ref.SecretKeyRef.Key
+		e.Value, e.Missing = keyRef(dataFn, "v1/secrets", po.Namespace, ref.SecretKeyRef.LocalObjectReference, ref.SecretKeyRef.Key, ref.SecretKeyRef.Optional)
+		if !e.Missing && e.Value != "" {
+			e.Value = MaskedValue
+		}
+	case ref.FieldRef != nil:
+		e.Source = "field/" + ref.FieldRef.FieldPath
+		e.Value, e.Missing = podField(po, ref.FieldRef.FieldPath)
+	case ref.ResourceFieldRef != nil:
+		e.Source = "resource/" + ref.ResourceFieldRef.Resource
+		e.Value, e.Missing = containerResource(po, co, ref.ResourceFieldRef)
+	}
+
+	return e
+}
+
+func keyRef(dataFn ConfigDataFn, gvr, ns string, ref v1.LocalObjectReference, key string, optional *bool) (string, bool) {
+	data, err := dataFn(gvr, client.FQN(ns, ref.Name))
+	if err != nil {
+		return "", !isOptional(optional)
+	}
+	v, ok := data[key]
+	if !ok {
+		return "", !isOptional(optional)
+	}
+
+	return v, false
+}
+
+// podField resolves a downward API field path.
+func podField(po *v1.Pod, path string) (string, bool) {
+	if k, ok := fieldKey(path, "metadata.labels"); ok {
+		v, ok := po.Labels[k]
+		return v, !ok
+	}
+	if k, ok := fieldKey(path, "metadata.annotations"); ok {
+		v, ok := po.Annotations[k]
+		return v, !ok
+	}
+
+	var v string
+	switch path {
+	case "metadata.name":
+		v = po.Name
+	case "metadata.namespace":
+		v = po.Namespace
+	case "metadata.uid":
+		v = string(po.UID)
+	case "spec.nodeName":
+		v = po.Spec.NodeName
+	case "spec.serviceAccountName":
+		v = po.Spec.ServiceAccountName
+	case "status.hostIP":
+		v = po.Status.HostIP
+	case "status.podIP":
+		v = po.Status.PodIP
+	case "status.podIPs":
+		ips := make([]string, 0, len(po.Status.PodIPs))
+		for _, ip := range po.Status.PodIPs {
+			ips = append(ips, ip.IP)
+		}
+		v = strings.Join(ips, ",")
+	default:
+		return "", true
+	}
+
+	return v, v == ""
+}
+
+// fieldKey extracts a map key from a field path ie metadata.labels['app'].
+func fieldKey(path, field string) (string, bool) {
+	if !strings.HasPrefix(path, field+"['") || !strings.HasSuffix(path, "']") {
+		return "", false
+	}
+
+	return strings.TrimSuffix(strings.TrimPrefix(path, field+"['"), "']"), true
+}
+
+// containerResource resolves a resource field ref. Unset limits default to the node
+// allocatable which is not known here.
+func containerResource(po *v1.Pod, co *v1.Container, ref *v1.ResourceFieldSelector) (string, bool) {
+	if ref.ContainerName != "" {
+		if co = podContainer(po, ref.ContainerName); co == nil {
+			return "", true
+		}
+	}
+	tokens := strings.SplitN(ref.Resource, ".", 2)
+	if len(tokens) != 2 {
+		return "", true
+	}
+
+	rl := co.Resources.Limits
+	if tokens[0] == "requests" {
+		rl = co.Resources.Requests
+	}
+	q, ok := rl[v1.ResourceName(tokens[1])]
+	if !ok {
+		if tokens[0] == "limits" {
+			return "<node allocatable>", false
+		}
+		return "0", false
+	}
+
+	divisor := resource.MustParse("1")
+	if !ref.Divisor.IsZero() {
+		divisor = ref.Divisor
+	}
+	if tokens[1] == string(v1.ResourceCPU) {
+		return fmt.Sprintf("%d", int64(math.Ceil(float64(q.MilliValue())/float64(divisor.MilliValue())))), false
+	}
+
+	return fmt.Sprintf("%d", int64(math.Ceil(float64(q.Value())/float64(divisor.Value())))), false
+}
+
+// expandEnv expands $(VAR) references to previously defined variables. Unknown
+// references are left as is and $$ escapes a $.
+func expandEnv(s string, ee []EnvVar, index map[string]int) string {
+	var buff strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '$' || i+1 >= len(s) {
+			buff.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '$':
+			buff.WriteByte('$')
+			i++
+		case '(':
+			end := strings.IndexByte(s[i+2:], ')')
+			if end < 0 {
+				buff.WriteByte(s[i])
+				continue
+			}
+			n := s[i+2 : i+2+end]
+			if j, ok := index[n]; ok {
+				buff.WriteString(ee[j].Value)
+			} else {
+				buff.WriteString(s[i : i+3+end])
+			}
+			i += 2 + end
+		default:
+			buff.WriteByte(s[i])
+		}
+	}
+
+	return buff.String()
+}
+
+func podContainer(po *v1.Pod, name string) *v1.Container {
+	for _, cc := range [][]v1.Container{po.Spec.InitContainers, po.Spec.Containers} {
+		for i := range cc {
+			if cc[i].Name == name {
+				return &cc[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// configData returns a configmap or secret data. Secret values are decoded.
+func configData(f Factory, gvr, fqn string) (map[string]string, error) {
+	o, err := f.Get(gvr, fqn, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	data := make(map[string]string)
+	for _, field := range []string{"data", "binaryData"} {
+		m, _, err := unstructured.NestedStringMap(u.Object, field)
+		if err != nil {
+			return nil, err
+		}
+		for k, v := range m {
+			if gvr == "v1/secrets" || field == "binaryData" {
+				if b, err := base64.StdEncoding.DecodeString(v); err == nil {
+					v = string(b)
+				}
+			}
+			data[k] = v
+		}
+	}
+
+	return data, nil
+}
+
+func asKeySet(m map[string]string) map[string]struct{} {
+	s := make(map[string]struct{}, len(m))
+	for k := range m {
+		s[k] = struct{}{}
+	}
+
+	return s
+}
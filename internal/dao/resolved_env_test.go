@@ -0,0 +1,72 @@
+package dao_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestResolveEnv(t *testing.T) {
+	optional := true
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "p1", Labels: map[string]string{"app": "fred"}},
+		Spec: v1.PodSpec{
+			NodeName: "n1",
+			Containers: []v1.Container{
+				{
+					Name: "c1",
+					EnvFrom: []v1.EnvFromSource{
+						{Prefix: "CM_", ConfigMapRef: &v1.ConfigMapEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "cm1"}}},
+						{SecretRef: &v1.SecretEnvSource{LocalObjectReference: v1.LocalObjectReference{Name: "missing"}, Optional: &optional}},
+					},
+					Env: []v1.EnvVar{
+						{Name: "CM_B", Value: "override"},
+						{Name: "POD", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.name"}}},
+						{Name: "APP", ValueFrom: &v1.EnvVarSource{FieldRef: &v1.ObjectFieldSelector{FieldPath: "metadata.labels['app']"}}},
+						{Name: "PASS", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "sec1"}, Key: "pwd"}}},
+						{Name: "TOKEN", ValueFrom: &v1.EnvVarSource{SecretKeyRef: &v1.SecretKeySelector{LocalObjectReference: v1.LocalObjectReference{Name: "sec1"}, Key: "nope"}}},
+						{Name: "MEM", ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "limits.memory", Divisor: resource.MustParse("1Mi")}}},
+						{Name: "CPU", ValueFrom: &v1.EnvVarSource{ResourceFieldRef: &v1.ResourceFieldSelector{Resource: "requests.cpu"}}},
+						{Name: "URL", Value: "http://$(POD).$(NODE):$$80"},
+					},
+					Resources: v1.ResourceRequirements{
+						Requests: v1.ResourceList{v1.ResourceCPU: resource.MustParse("250m")},
+						Limits:   v1.ResourceList{v1.ResourceMemory: resource.MustParse("128Mi")},
+					},
+				},
+			},
+		},
+	}
+	data := map[string]map[string]string{
+		"v1/configmaps:ns1/cm1": {"A": "a", "B": "b"},
+		"v1/secrets:ns1/sec1":   {"pwd": "s3cr3t"},
+	}
+	dataFn := func(gvr, fqn string) (map[string]string, error) {
+		if d, ok := data[gvr+":"+fqn]; ok {
+			return d, nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	ee, err := dao.ResolveEnv(&po, "c1", dataFn)
+	assert.Nil(t, err)
+	assert.Equal(t, []dao.EnvVar{
+		{Name: "CM_A", Value: "a", Source: "configmap/cm1"},
+		{Name: "CM_B", Value: "override", Source: "value"},
+		{Name: "POD", Value: "p1", Source: "field/metadata.name"},
+		{Name: "APP", Value: "fred", Source: "field/metadata.labels['app']"},
+		{Name: "PASS", Value: dao.MaskedValue, Source: "secret/sec1:pwd"},
+		{Name: "TOKEN", Source: "secret/sec1:nope", Missing: true},
+		{Name: "MEM", Value: "128", Source: "resource/limits.memory"},
+		{Name: "CPU", Value: "1", Source: "resource/requests.cpu"},
+		{Name: "URL", Value: "http://p1.$(NODE):$80", Source: "value"},
+	}, ee)
+
+	_, err = dao.ResolveEnv(&po, "c2", dataFn)
+	assert.NotNil(t, err)
+}
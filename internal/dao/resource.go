@@ -30,7 +30,23 @@ func (r *Resource) List(ctx context.Context, ns string) ([]runtime.Object, error
 		}
 	}
 
-	return r.GetFactory().List(r.gvr.String(), ns, false, lsel)
+	oo, err := r.GetFactory().List(r.gvr.String(), ns, false, lsel)
+	if err != nil {
+		return nil, err
+	}
+	uid, _ := ctx.Value(internal.KeyUID).(string)
+	if uid == "" {
+		return oo, nil
+	}
+
+	ll := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		if IsOwnedBy(o, uid) {
+			ll = append(ll, o)
+		}
+	}
+
+	return ll, nil
 }
 
 // Get returns a resource instance if found, else an error.
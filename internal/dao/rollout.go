@@ -0,0 +1,86 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const statusSubresource = "status"
+
+var _ Accessor = (*Rollout)(nil)
+
+// Rollout represents an Argo Rollout.
+type Rollout struct {
+	Resource
+}
+
+// Promote resumes a paused Rollout or skips its current canary step.
+func (r *Rollout) Promote(ctx context.Context, path string) error {
+	ro, err := r.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if ro.Status.Abort {
+		return fmt.Errorf("rollout %s is aborted. Retry it first", path)
+	}
+
+	if ro.Spec.Paused {
+		if err := r.patch(ctx, path, map[string]interface{}{"spec": map[string]interface{}{"paused": false}}); err != nil {
+			return err
+		}
+	}
+	if len(ro.Status.PauseConditions) > 0 {
+		return r.patch(ctx, path, map[string]interface{}{"status": map[string]interface{}{"pauseConditions": nil}}, statusSubresource)
+	}
+	steps := ro.Steps()
+	if ro.Spec.Paused || len(steps) == 0 || ro.Status.CurrentStepIndex == nil {
+		return nil
+	}
+	if idx := int(*ro.Status.CurrentStepIndex); idx < len(steps) {
+		return r.patch(ctx, path, map[string]interface{}{"status": map[string]interface{}{"currentStepIndex": idx + 1}}, statusSubresource)
+	}
+
+	return errors.New("rollout is already fully promoted")
+}
+
+// Abort aborts a Rollout update and scales the stable version back up.
+func (r *Rollout) Abort(ctx context.Context, path string) error {
+	return r.patch(ctx, path, map[string]interface{}{"status": map[string]interface{}{"abort": true}}, statusSubresource)
+}
+
+// Retry restarts an aborted Rollout update.
+func (r *Rollout) Retry(ctx context.Context, path string) error {
+	return r.patch(ctx, path, map[string]interface{}{"status": map[string]interface{}{"abort": false}}, statusSubresource)
+}
+
+// GetInstance returns a Rollout instance.
+func (r *Rollout) GetInstance(path string) (*render.ArgoRollout, error) {
+	o, err := r.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ro render.ArgoRollout
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ro)
+	if err != nil {
+		return nil, fmt.Errorf("expecting Rollout resource: %w", err)
+	}
+
+	return &ro, nil
+}
+
+func (r *Rollout) patch(ctx context.Context, path string, p map[string]interface{}, subresources ...string) error {
+	data, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+
+	return r.Patch(ctx, path, types.MergePatchType, data, subresources...)
+}
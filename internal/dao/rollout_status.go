@@ -0,0 +1,181 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	revisionAnnotation       = "deployment.kubernetes.io/revision"
+	progressDeadlineExceeded = "ProgressDeadlineExceeded"
+)
+
+// FailingPod represents a rollout pod that is not becoming ready.
+type FailingPod struct {
+	FQN    string
+	Reason string
+	Event  string
+}
+
+// RolloutProgress represents a deployment rollout progress.
+type RolloutProgress struct {
+	Path      string
+	Revision  string
+	NewRS     string
+	Desired   int32
+	Updated   int32
+	Ready     int32
+	Available int32
+	Total     int32
+	Done      bool
+	Failed    string
+	Failing   []FailingPod
+}
+
+// DeploymentRollout tracks the progress of a deployment latest rollout.
+func DeploymentRollout(f Factory, path string) (RolloutProgress, error) {
+	p := RolloutProgress{Path: path}
+	o, err := f.Get("apps/v1/deployments", path, true, labels.Everything())
+	if err != nil {
+		return p, err
+	}
+	var dp appsv1.Deployment
+	if err := fromUnstructured(o, &dp); err != nil {
+		return p, err
+	}
+
+	p.Revision = dp.Annotations[revisionAnnotation]
+	p.Desired = 1
+	if dp.Spec.Replicas != nil {
+		p.Desired = *dp.Spec.Replicas
+	}
+	st := dp.Status
+	p.Updated, p.Ready, p.Available, p.Total = st.UpdatedReplicas, st.ReadyReplicas, st.AvailableReplicas, st.Replicas
+	for _, c := range st.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Reason == progressDeadlineExceeded {
+			p.Failed = c.Message
+		}
+	}
+	p.Done = dp.Generation <= st.ObservedGeneration &&
+		p.Updated == p.Desired && p.Total == p.Updated && p.Available == p.Updated
+
+	rs, err := newReplicaSet(f, &dp)
+	if err != nil || rs == nil {
+		return p, err
+	}
+	p.NewRS = client.FQN(rs.Namespace, rs.Name)
+	p.Failing, err = failingPods(f, rs)
+
+	return p, err
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newReplicaSet(f Factory, dp *appsv1.Deployment) (*appsv1.ReplicaSet, error) {
+	oo, err := f.List("apps/v1/replicasets", dp.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		if !IsOwnedBy(o, string(dp.UID)) {
+			continue
+		}
+		var rs appsv1.ReplicaSet
+		if err := fromUnstructured(o, &rs); err != nil {
+			return nil, err
+		}
+		if rs.Annotations[revisionAnnotation] == dp.Annotations[revisionAnnotation] {
+			return &rs, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func failingPods(f Factory, rs *appsv1.ReplicaSet) ([]FailingPod, error) {
+	oo, err := f.List("v1/pods", rs.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ff []FailingPod
+	for _, o := range oo {
+		if !IsOwnedBy(o, string(rs.UID)) {
+			continue
+		}
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if isPodReady(po) {
+			continue
+		}
+		ev, err := firstWarning(f, &po)
+		if err != nil {
+			return nil, err
+		}
+		ff = append(ff, FailingPod{
+			FQN:    client.FQN(po.Namespace, po.Name),
+			Reason: notReadyReason(&po),
+			Event:  ev,
+		})
+	}
+	sort.Slice(ff, func(i, j int) bool {
+		return ff[i].FQN < ff[j].FQN
+	})
+
+	return ff, nil
+}
+
+func notReadyReason(po *v1.Pod) string {
+	ss := make([]v1.ContainerStatus, 0, len(po.Status.InitContainerStatuses)+len(po.Status.ContainerStatuses))
+	ss = append(ss, po.Status.InitContainerStatuses...)
+	ss = append(ss, po.Status.ContainerStatuses...)
+	for _, s := range ss {
+		switch {
+		case s.State.Waiting != nil && s.State.Waiting.Reason != "" && s.State.Waiting.Reason != "PodInitializing":
+			return fmt.Sprintf("%s: %s", s.Name, s.State.Waiting.Reason)
+		case s.State.Terminated != nil && s.State.Terminated.ExitCode != 0:
+			return fmt.Sprintf("%s: %s (%d)", s.Name, s.State.Terminated.Reason, s.State.Terminated.ExitCode)
+		}
+	}
+	if po.Status.Phase == v1.PodPending {
+		return string(v1.PodPending)
+	}
+
+	return "NotReady"
+}
+
+// firstWarning returns the oldest warning event for a given pod.
+func firstWarning(f Factory, po *v1.Pod) (string, error) {
+	oo, err := f.List("v1/events", po.Namespace, false, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+
+	var first *v1.Event
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return "", err
+		}
+		if ev.Type != v1.EventTypeWarning || ev.InvolvedObject.Kind != "Pod" || ev.InvolvedObject.Name != po.Name {
+			continue
+		}
+		if first == nil || eventTime(&ev).Before(eventTime(first)) {
+			e := ev
+			first = &e
+		}
+	}
+	if first == nil {
+		return "", nil
+	}
+
+	return fmt.Sprintf("%s: %s", first.Reason, first.Message), nil
+}
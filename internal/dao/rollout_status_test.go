@@ -0,0 +1,83 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestDeploymentRollout(t *testing.T) {
+	dp := relObj("apps/v1", "Deployment", "default", "web", nil, map[string]interface{}{
+		"spec": map[string]interface{}{"replicas": int64(2)},
+		"status": map[string]interface{}{
+			"observedGeneration": int64(2),
+			"replicas":           int64(3),
+			"updatedReplicas":    int64(1),
+			"readyReplicas":      int64(2),
+			"availableReplicas":  int64(2),
+		},
+	})
+	dp.SetGeneration(2)
+	dp.SetUID("dp1")
+	dp.SetAnnotations(map[string]string{revisionAnnotation: "2"})
+
+	rs1 := ownedBy(relObj("apps/v1", "ReplicaSet", "default", "web-1", nil, map[string]interface{}{}), "dp1", "rs1")
+	rs1.SetAnnotations(map[string]string{revisionAnnotation: "1"})
+	rs2 := ownedBy(relObj("apps/v1", "ReplicaSet", "default", "web-2", nil, map[string]interface{}{}), "dp1", "rs2")
+	rs2.SetAnnotations(map[string]string{revisionAnnotation: "2"})
+
+	f := relFactory{rows: map[string][]runtime.Object{
+		"apps/v1/deployments": {dp},
+		"apps/v1/replicasets": {rs1, rs2},
+		"v1/pods": {
+			ownedBy(relObj("v1", "Pod", "default", "web-1-a", nil, map[string]interface{}{
+				"status": map[string]interface{}{
+					"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+				},
+			}), "rs1", "p1"),
+			ownedBy(relObj("v1", "Pod", "default", "web-2-a", nil, map[string]interface{}{
+				"status": map[string]interface{}{
+					"phase": "Pending",
+					"containerStatuses": []interface{}{
+						map[string]interface{}{
+							"name":  "c1",
+							"state": map[string]interface{}{"waiting": map[string]interface{}{"reason": "ImagePullBackOff"}},
+						},
+					},
+				},
+			}), "rs2", "p2"),
+		},
+		"v1/events": {
+			relObj("v1", "Event", "default", "web-2-a.1", nil, map[string]interface{}{
+				"type":           "Warning",
+				"reason":         "Failed",
+				"message":        "Failed to pull image fred:blee",
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "web-2-a", "namespace": "default"},
+			}),
+		},
+	}}
+
+	p, err := DeploymentRollout(f, "default/web")
+	assert.NoError(t, err)
+	assert.False(t, p.Done)
+	assert.Equal(t, "2", p.Revision)
+	assert.Equal(t, "default/web-2", p.NewRS)
+	assert.Equal(t, []FailingPod{
+		{FQN: "default/web-2-a", Reason: "c1: ImagePullBackOff", Event: "Failed: Failed to pull image fred:blee"},
+	}, p.Failing)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ownedBy(o *unstructured.Unstructured, owner, uid string) *unstructured.Unstructured {
+	o.SetUID(types.UID(uid))
+	o.Object["metadata"].(map[string]interface{})["ownerReferences"] = []interface{}{
+		map[string]interface{}{"apiVersion": "apps/v1", "kind": "ReplicaSet", "name": owner, "uid": owner, "controller": true},
+	}
+
+	return o
+}
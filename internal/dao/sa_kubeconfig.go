@@ -0,0 +1,80 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	authv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+// ServiceAccountKubeconfig generates a kubeconfig authenticating as a given service account
+// using a bound token valid for the given duration.
+func ServiceAccountKubeconfig(ctx context.Context, c client.Connection, path string, expiry time.Duration) ([]byte, error) {
+	ns, n := client.Namespaced(path)
+	auth, err := c.CanI(ns, "v1/serviceaccounts:token", []string{client.CreateVerb})
+	if err != nil {
+		return nil, err
+	}
+	if !auth {
+		return nil, fmt.Errorf("user is not authorized to request tokens for service account %s", path)
+	}
+	cfg, err := c.RestConfig()
+	if err != nil {
+		return nil, err
+	}
+	ca, err := clusterCA(cfg)
+	if err != nil {
+		return nil, err
+	}
+	dial, err := c.Dial()
+	if err != nil {
+		return nil, err
+	}
+	secs := int64(expiry.Seconds())
+	tr, err := dial.CoreV1().ServiceAccounts(ns).CreateToken(ctx, n, &authv1.TokenRequest{
+		Spec: authv1.TokenRequestSpec{ExpirationSeconds: &secs},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return clientcmd.Write(saKubeconfig(c.ActiveCluster(), cfg.Host, ca, cfg.Insecure, ns, n, tr.Status.Token))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func clusterCA(cfg *restclient.Config) ([]byte, error) {
+	if len(cfg.CAData) > 0 || cfg.CAFile == "" {
+		return cfg.CAData, nil
+	}
+
+	return os.ReadFile(cfg.CAFile)
+}
+
+func saKubeconfig(cluster, server string, ca []byte, insecure bool, ns, sa, token string) clientcmdapi.Config {
+	user := fmt.Sprintf("system:serviceaccount:%s:%s", ns, sa)
+	ctx := fmt.Sprintf("%s-%s-%s", cluster, ns, sa)
+	cfg := clientcmdapi.NewConfig()
+	cfg.Clusters[cluster] = &clientcmdapi.Cluster{
+		Server:                   server,
+		CertificateAuthorityData: ca,
+		InsecureSkipTLSVerify:    insecure && len(ca) == 0,
+	}
+	cfg.AuthInfos[user] = &clientcmdapi.AuthInfo{Token: token}
+	cfg.Contexts[ctx] = &clientcmdapi.Context{
+		Cluster:   cluster,
+		AuthInfo:  user,
+		Namespace: ns,
+	}
+	cfg.CurrentContext = ctx
+
+	return *cfg
+}
@@ -0,0 +1,57 @@
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	restclient "k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+)
+
+func TestSAKubeconfig(t *testing.T) {
+	raw, err := clientcmd.Write(saKubeconfig("prod", "https://10.0.0.1:6443", []byte("ca"), false, "ci", "deployer", "tok"))
+	assert.NoError(t, err)
+
+	cfg, err := clientcmd.Load(raw)
+	assert.NoError(t, err)
+	assert.Equal(t, "prod-ci-deployer", cfg.CurrentContext)
+	ctx := cfg.Contexts[cfg.CurrentContext]
+	assert.Equal(t, "ci", ctx.Namespace)
+	assert.Equal(t, "prod", ctx.Cluster)
+	assert.Equal(t, "tok", cfg.AuthInfos[ctx.AuthInfo].Token)
+	assert.Equal(t, "system:serviceaccount:ci:deployer", ctx.AuthInfo)
+	assert.Equal(t, "https://10.0.0.1:6443", cfg.Clusters["prod"].Server)
+	assert.Equal(t, []byte("ca"), cfg.Clusters["prod"].CertificateAuthorityData)
+	assert.False(t, cfg.Clusters["prod"].InsecureSkipTLSVerify)
+}
+
+func TestClusterCA(t *testing.T) {
+	f := filepath.Join(t.TempDir(), "ca.crt")
+	assert.NoError(t, os.WriteFile(f, []byte("file-ca"), 0600))
+
+	uu := map[string]struct {
+		cfg restclient.Config
+		e   []byte
+	}{
+		"data": {
+			cfg: restclient.Config{TLSClientConfig: restclient.TLSClientConfig{CAData: []byte("data-ca"), CAFile: f}},
+			e:   []byte("data-ca"),
+		},
+		"file": {
+			cfg: restclient.Config{TLSClientConfig: restclient.TLSClientConfig{CAFile: f}},
+			e:   []byte("file-ca"),
+		},
+		"none": {},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ca, err := clusterCA(&u.cfg)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, ca)
+		})
+	}
+}
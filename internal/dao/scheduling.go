@@ -0,0 +1,352 @@
+package dao
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	resourcehelper "k8s.io/kubectl/pkg/util/resource"
+)
+
+const failedSchedulingReason = "FailedScheduling"
+
+// SchedulingVerdict represents whether a pod fits on a given node.
+type SchedulingVerdict struct {
+	Node    string
+	Reasons []string
+}
+
+// Fits checks if the pod could land on the node.
+func (s SchedulingVerdict) Fits() bool {
+	return len(s.Reasons) == 0
+}
+
+// SchedulingReport explains why a pod can or can not be scheduled.
+type SchedulingReport struct {
+	Pod      string
+	Phase    string
+	NodeName string
+	Requests v1.ResourceList
+	Events   []string
+	Blockers []string
+	Nodes    []SchedulingVerdict
+}
+
+// Fits returns the number of nodes the pod could land on.
+func (s SchedulingReport) Fits() int {
+	var count int
+	for _, n := range s.Nodes {
+		if n.Fits() {
+			count++
+		}
+	}
+
+	return count
+}
+
+// ExplainScheduling evaluates client side why a pod can not be scheduled on each node.
+func ExplainScheduling(f Factory, path string) (SchedulingReport, error) {
+	r := SchedulingReport{Pod: path}
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return r, err
+	}
+	r.Phase, r.NodeName = string(po.Status.Phase), po.Spec.NodeName
+	r.Requests, _ = resourcehelper.PodRequestsAndLimits(&po)
+
+	if r.Events, err = schedulingEvents(f, &po); err != nil {
+		return r, err
+	}
+	if r.Blockers, err = claimBlockers(f, &po); err != nil {
+		return r, err
+	}
+
+	nn, err := f.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	pp, err := f.List("v1/pods", client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	used, err := nodeUsage(pp, client.FQN(po.Namespace, po.Name))
+	if err != nil {
+		return r, err
+	}
+	for _, o := range nn {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			return r, err
+		}
+		r.Nodes = append(r.Nodes, SchedulingVerdict{
+			Node:    no.Name,
+			Reasons: unfitReasons(&po, &no, r.Requests, used[no.Name]),
+		})
+	}
+	sort.SliceStable(r.Nodes, func(i, j int) bool {
+		if r.Nodes[i].Fits() != r.Nodes[j].Fits() {
+			return r.Nodes[i].Fits()
+		}
+		return r.Nodes[i].Node < r.Nodes[j].Node
+	})
+
+	return r, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type nodeLoad struct {
+	pods     int
+	requests v1.ResourceList
+}
+
+func schedulingEvents(f Factory, po *v1.Pod) ([]string, error) {
+	oo, err := f.List("v1/events", po.Namespace, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ee []v1.Event
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return nil, err
+		}
+		if ev.Reason != failedSchedulingReason || ev.InvolvedObject.Kind != "Pod" || ev.InvolvedObject.Name != po.Name {
+			continue
+		}
+		if ev.InvolvedObject.UID != "" && po.UID != "" && ev.InvolvedObject.UID != po.UID {
+			continue
+		}
+		ee = append(ee, ev)
+	}
+	sort.SliceStable(ee, func(i, j int) bool {
+		return ee[i].LastTimestamp.Before(&ee[j].LastTimestamp)
+	})
+
+	mm := make([]string, 0, len(ee))
+	for _, ev := range ee {
+		if ev.Count > 1 {
+			mm = append(mm, fmt.Sprintf("(x%d) %s", ev.Count, ev.Message))
+			continue
+		}
+		mm = append(mm, ev.Message)
+	}
+
+	return mm, nil
+}
+
+func claimBlockers(f Factory, po *v1.Pod) ([]string, error) {
+	var (
+		bb []string
+		ww map[string]string
+	)
+	for _, v := range po.Spec.Volumes {
+		if v.PersistentVolumeClaim == nil {
+			continue
+		}
+		fqn := client.FQN(po.Namespace, v.PersistentVolumeClaim.ClaimName)
+		o, err := f.Get("v1/persistentvolumeclaims", fqn, true, labels.Everything())
+		if err != nil || o == nil {
+			bb = append(bb, fmt.Sprintf("pvc %s not found", fqn))
+			continue
+		}
+		var pvc v1.PersistentVolumeClaim
+		if err := fromUnstructured(o, &pvc); err != nil {
+			return nil, err
+		}
+		if pvc.Status.Phase == v1.ClaimBound {
+			continue
+		}
+		if ww == nil {
+			ww = claimWarnings(f, po.Namespace)
+		}
+		if w, ok := ww[fqn]; ok {
+			bb = append(bb, fmt.Sprintf("pvc %s is %s (%s)", fqn, pvc.Status.Phase, w))
+			continue
+		}
+		bb = append(bb, fmt.Sprintf("pvc %s is %s", fqn, pvc.Status.Phase))
+	}
+
+	return bb, nil
+}
+
+// nodeUsage sums up the requests of all active pods per node but the skipped one.
+func nodeUsage(oo []runtime.Object, skip string) (map[string]nodeLoad, error) {
+	uu := make(map[string]nodeLoad)
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Spec.NodeName == "" || client.FQN(po.Namespace, po.Name) == skip || po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		l := uu[po.Spec.NodeName]
+		if l.requests == nil {
+			l.requests = make(v1.ResourceList)
+		}
+		l.pods++
+		reqs, _ := resourcehelper.PodRequestsAndLimits(&po)
+		for k, q := range reqs {
+			sum := l.requests[k]
+			sum.Add(q)
+			l.requests[k] = sum
+		}
+		uu[po.Spec.NodeName] = l
+	}
+
+	return uu, nil
+}
+
+func unfitReasons(po *v1.Pod, no *v1.Node, reqs v1.ResourceList, load nodeLoad) []string {
+	var rr []string
+	if !isNodeReady(no) {
+		rr = append(rr, "node is not ready")
+	}
+	if no.Spec.Unschedulable && !tolerates(po.Spec.Tolerations, v1.Taint{Key: v1.TaintNodeUnschedulable, Effect: v1.TaintEffectNoSchedule}) {
+		rr = append(rr, "node is cordoned")
+	}
+	for _, t := range no.Spec.Taints {
+		if t.Effect == v1.TaintEffectPreferNoSchedule || tolerates(po.Spec.Tolerations, t) {
+			continue
+		}
+		rr = append(rr, fmt.Sprintf("untolerated taint %s", t.ToString()))
+	}
+	sels := make([]string, 0, len(po.Spec.NodeSelector))
+	for k := range po.Spec.NodeSelector {
+		sels = append(sels, k)
+	}
+	sort.Strings(sels)
+	for _, k := range sels {
+		if v := po.Spec.NodeSelector[k]; no.Labels[k] != v {
+			rr = append(rr, fmt.Sprintf("node selector %s=%s does not match", k, v))
+		}
+	}
+	if !matchesNodeAffinity(po, no) {
+		rr = append(rr, "required node affinity does not match")
+	}
+	if alloc, ok := no.Status.Allocatable[v1.ResourcePods]; ok && int64(load.pods+1) > alloc.Value() {
+		rr = append(rr, fmt.Sprintf("too many pods (%d/%d)", load.pods, alloc.Value()))
+	}
+
+	kk := make([]string, 0, len(reqs))
+	for k := range reqs {
+		kk = append(kk, string(k))
+	}
+	sort.Strings(kk)
+	for _, k := range kk {
+		req := reqs[v1.ResourceName(k)]
+		if req.IsZero() {
+			continue
+		}
+		alloc, ok := no.Status.Allocatable[v1.ResourceName(k)]
+		if !ok {
+			rr = append(rr, fmt.Sprintf("no %s available", k))
+			continue
+		}
+		free := alloc.DeepCopy()
+		if used, ok := load.requests[v1.ResourceName(k)]; ok {
+			free.Sub(used)
+		}
+		if free.Cmp(req) < 0 {
+			rr = append(rr, fmt.Sprintf("insufficient %s (requested %s, free %s)", k, req.String(), freeString(free)))
+		}
+	}
+
+	return rr
+}
+
+func freeString(q resource.Quantity) string {
+	if q.Sign() < 0 {
+		return "0"
+	}
+
+	return q.String()
+}
+
+func tolerates(tt []v1.Toleration, taint v1.Taint) bool {
+	for i := range tt {
+		if tt[i].ToleratesTaint(&taint) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNodeReady(no *v1.Node) bool {
+	for _, c := range no.Status.Conditions {
+		if c.Type == v1.NodeReady {
+			return c.Status == v1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// matchesNodeAffinity checks the pod required node affinity terms. Terms are ORed.
+func matchesNodeAffinity(po *v1.Pod, no *v1.Node) bool {
+	aff := po.Spec.Affinity
+	if aff == nil || aff.NodeAffinity == nil || aff.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return true
+	}
+	tt := aff.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms
+	for _, t := range tt {
+		if matchesNodeTerm(t, no) {
+			return true
+		}
+	}
+
+	return len(tt) == 0
+}
+
+func matchesNodeTerm(t v1.NodeSelectorTerm, no *v1.Node) bool {
+	if len(t.MatchExpressions) == 0 && len(t.MatchFields) == 0 {
+		return false
+	}
+	for _, e := range t.MatchExpressions {
+		if !matchesNodeRequirement(e, labels.Set(no.Labels)) {
+			return false
+		}
+	}
+	for _, e := range t.MatchFields {
+		if e.Key != "metadata.name" || !matchesNodeRequirement(e, labels.Set{e.Key: no.Name}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+var nodeSelectorOps = map[v1.NodeSelectorOperator]selection.Operator{
+	v1.NodeSelectorOpIn:           selection.In,
+	v1.NodeSelectorOpNotIn:        selection.NotIn,
+	v1.NodeSelectorOpExists:       selection.Exists,
+	v1.NodeSelectorOpDoesNotExist: selection.DoesNotExist,
+	v1.NodeSelectorOpGt:           selection.GreaterThan,
+	v1.NodeSelectorOpLt:           selection.LessThan,
+}
+
+func matchesNodeRequirement(e v1.NodeSelectorRequirement, ll labels.Set) bool {
+	op, ok := nodeSelectorOps[e.Operator]
+	if !ok {
+		return false
+	}
+	req, err := labels.NewRequirement(e.Key, op, e.Values)
+	if err != nil {
+		return false
+	}
+
+	return req.Matches(ll)
+}
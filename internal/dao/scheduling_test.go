@@ -0,0 +1,84 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestExplainScheduling(t *testing.T) {
+	pending := relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"nodeSelector": map[string]interface{}{"disk": "ssd"},
+			"tolerations": []interface{}{
+				map[string]interface{}{"key": "gpu", "operator": "Exists", "effect": "NoSchedule"},
+			},
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":      "c1",
+					"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "1", "memory": "1Gi"}},
+				},
+			},
+		},
+		"status": map[string]interface{}{"phase": "Pending"},
+	})
+	busy := relObj("v1", "Pod", "default", "p2", nil, map[string]interface{}{
+		"spec": map[string]interface{}{
+			"nodeName": "n2",
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":      "c1",
+					"resources": map[string]interface{}{"requests": map[string]interface{}{"cpu": "1500m"}},
+				},
+			},
+		},
+		"status": map[string]interface{}{"phase": "Running"},
+	})
+
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {pending, busy},
+		"v1/nodes": {
+			schedNode("n1", map[string]string{"disk": "hdd"}, nil),
+			schedNode("n2", map[string]string{"disk": "ssd"}, nil),
+			schedNode("n3", map[string]string{"disk": "ssd"}, []interface{}{
+				map[string]interface{}{"key": "gpu", "value": "true", "effect": "NoSchedule"},
+			}),
+			schedNode("n4", map[string]string{"disk": "ssd"}, []interface{}{
+				map[string]interface{}{"key": "dedicated", "value": "infra", "effect": "NoSchedule"},
+			}),
+		},
+		"v1/events": {
+			relObj("v1", "Event", "default", "p1.1", nil, map[string]interface{}{
+				"reason":         "FailedScheduling",
+				"message":        "0/4 nodes are available",
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1", "namespace": "default"},
+			}),
+		},
+	}}
+
+	r, err := ExplainScheduling(f, "default/p1")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"0/4 nodes are available"}, r.Events)
+	assert.Equal(t, 1, r.Fits())
+	assert.Equal(t, []SchedulingVerdict{
+		{Node: "n3"},
+		{Node: "n1", Reasons: []string{"node selector disk=ssd does not match"}},
+		{Node: "n2", Reasons: []string{"insufficient cpu (requested 1, free 500m)"}},
+		{Node: "n4", Reasons: []string{"untolerated taint dedicated=infra:NoSchedule"}},
+	}, r.Nodes)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func schedNode(n string, ll map[string]string, taints []interface{}) *unstructured.Unstructured {
+	return relObj("v1", "Node", "", n, ll, map[string]interface{}{
+		"spec": map[string]interface{}{"taints": taints},
+		"status": map[string]interface{}{
+			"allocatable": map[string]interface{}{"cpu": "2", "memory": "4Gi", "pods": "110"},
+			"conditions":  []interface{}{map[string]interface{}{"type": "Ready", "status": "True"}},
+		},
+	})
+}
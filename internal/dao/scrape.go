@@ -0,0 +1,139 @@
+package dao
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// scrapeCache caches values scraped off pods metrics endpoints to spare scrapes on each refresh.
+type scrapeCache struct {
+	ttl     time.Duration
+	entries map[string]scrapeEntry
+	mx      sync.Mutex
+}
+
+type scrapeEntry struct {
+	values  map[string]int64
+	expires time.Time
+}
+
+type scrapeFn func() (map[string]int64, error)
+
+func newScrapeCache(ttl time.Duration) *scrapeCache {
+	return &scrapeCache{ttl: ttl, entries: make(map[string]scrapeEntry)}
+}
+
+// get returns the cached values for a given pod or scrapes them when expired.
+func (s *scrapeCache) get(path string, scrape scrapeFn) (map[string]int64, bool) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if e, ok := s.entries[path]; ok && time.Now().Before(e.expires) {
+		return e.values, e.values != nil
+	}
+	vv, err := scrape()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Unable to scrape metrics from %s", path)
+	}
+	s.entries[path] = scrapeEntry{values: vv, expires: time.Now().Add(s.ttl)}
+
+	return vv, vv != nil
+}
+
+// scrapePod fetches a pod metrics via the api server proxy.
+func scrapePod(ctx context.Context, c client.Connection, path, port string) (string, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Config().CallTimeout())
+	defer cancel()
+
+	ns, n := client.Namespaced(path)
+	raw, err := dial.CoreV1().Pods(ns).ProxyGet("http", n, port, "metrics", nil).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// scrapeNode fetches a kubelet endpoint via the api server node proxy.
+func scrapeNode(ctx context.Context, c client.Connection, node, path string) (string, error) {
+	dial, err := c.Dial()
+	if err != nil {
+		return "", err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.Config().CallTimeout())
+	defer cancel()
+
+	raw, err := dial.CoreV1().RESTClient().Get().AbsPath("/api/v1/nodes", node, "proxy", path).DoRaw(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return string(raw), nil
+}
+
+// runningPod returns the first running pod matching a selector and optionally
+// a node given either by name or IP.
+func runningPod(f Factory, ns string, sel labels.Selector, node string) (string, bool) {
+	oo, err := f.List("v1/pods", ns, false, sel)
+	if err != nil {
+		return "", false
+	}
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		n, _, _ := unstructured.NestedString(u.Object, "spec", "nodeName")
+		ip, _, _ := unstructured.NestedString(u.Object, "status", "hostIP")
+		if node != "" && node != n && node != ip {
+			continue
+		}
+		if phase, _, _ := unstructured.NestedString(u.Object, "status", "phase"); phase == string(v1.PodRunning) {
+			return client.FQN(u.GetNamespace(), u.GetName()), true
+		}
+	}
+
+	return "", false
+}
+
+// promSample splits a prometheus text sample into its labels and value
+// if it matches the given metric name.
+func promSample(line, metric string) (string, string, bool) {
+	if !strings.HasPrefix(line, metric+"{") {
+		return "", "", false
+	}
+	end := strings.LastIndex(line, "}")
+	if end < 0 {
+		return "", "", false
+	}
+	ff := strings.Fields(line[end+1:])
+	if len(ff) == 0 {
+		return "", "", false
+	}
+
+	return line[len(metric)+1 : end], ff[0], true
+}
+
+func promLabel(labels, name string) (string, bool) {
+	for _, kv := range strings.Split(labels, ",") {
+		tokens := strings.SplitN(kv, "=", 2)
+		if len(tokens) != 2 || strings.TrimSpace(tokens[0]) != name {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(tokens[1]), `"`), true
+	}
+
+	return "", false
+}
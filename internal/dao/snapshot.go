@@ -0,0 +1,177 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/rs/zerolog/log"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+const metricsGroup = "metrics.k8s.io"
+
+// SnapshotOpts tracks a cluster snapshot export options.
+type SnapshotOpts struct {
+	// Namespace tracks the namespace to export or all namespaces when blank.
+	Namespace string
+	// Events includes the cluster events.
+	Events bool
+	// Metrics includes the current pods and nodes metrics samples.
+	Metrics bool
+}
+
+// SnapshotStats tracks a snapshot export outcome.
+type SnapshotStats struct {
+	Resources, Objects int
+	Skipped            []string
+}
+
+// ExportSnapshot exports the cluster resources into a snapshot archive
+// that can be browsed offline via k9s --snapshot.
+func ExportSnapshot(ctx context.Context, f Factory, w io.Writer, opts SnapshotOpts) (SnapshotStats, error) {
+	var stats SnapshotStats
+	conn := f.Client()
+	if conn == nil || !conn.ConnectionOK() {
+		return stats, errors.New("no api server connection")
+	}
+	info, err := conn.ServerVersion()
+	if err != nil {
+		return stats, err
+	}
+	dial, err := conn.CachedDiscovery()
+	if err != nil {
+		return stats, err
+	}
+	rr, err := dial.ServerPreferredResources()
+	if err != nil {
+		log.Warn().Err(err).Msgf("Snapshot partial discovery")
+	}
+	dyn, err := conn.DynDial()
+	if err != nil {
+		return stats, err
+	}
+
+	sw := snapshot.NewWriter(w)
+	if err := sw.WriteVersion(info); err != nil {
+		return stats, err
+	}
+	ns := opts.Namespace
+	if client.IsAllNamespaces(ns) {
+		ns = client.AllNamespaces
+	}
+	exported := make([]*metav1.APIResourceList, 0, len(rr))
+	for _, l := range rr {
+		gv, err := schema.ParseGroupVersion(l.GroupVersion)
+		if err != nil {
+			continue
+		}
+		el := metav1.APIResourceList{GroupVersion: l.GroupVersion}
+		for _, r := range l.APIResources {
+			if !snapshotable(gv, r, ns, opts) {
+				continue
+			}
+			oo, err := listForSnapshot(ctx, dyn.Resource(gv.WithResource(r.Name)), r, ns)
+			if err != nil {
+				if errors.Is(err, context.Canceled) {
+					return stats, err
+				}
+				log.Warn().Err(err).Msgf("Snapshot skipping %s", r.Name)
+				stats.Skipped = append(stats.Skipped, client.FromGVAndR(l.GroupVersion, r.Name).String())
+				continue
+			}
+			if err := sw.WriteObjects(gv.WithResource(r.Name), r.Kind, ns, oo); err != nil {
+				return stats, err
+			}
+			el.APIResources = append(el.APIResources, r)
+			stats.Resources++
+			stats.Objects += len(oo)
+		}
+		if len(el.APIResources) > 0 {
+			exported = append(exported, &el)
+		}
+	}
+	if err := sw.WriteDiscovery(exported); err != nil {
+		return stats, err
+	}
+
+	return stats, sw.Close()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func snapshotable(gv schema.GroupVersion, r metav1.APIResource, ns string, opts SnapshotOpts) bool {
+	if strings.Contains(r.Name, "/") || !inList(r.Verbs, "list") {
+		return false
+	}
+	switch {
+	case gv.Group == metricsGroup:
+		if !opts.Metrics {
+			return false
+		}
+	case r.Name == "events":
+		if !opts.Events {
+			return false
+		}
+	}
+	if client.IsNamespaced(ns) && !r.Namespaced {
+		return gv.Group == "" && r.Name == "namespaces"
+	}
+
+	return true
+}
+
+func listForSnapshot(ctx context.Context, ri dynamic.NamespaceableResourceInterface, r metav1.APIResource, ns string) ([]unstructured.Unstructured, error) {
+	var (
+		l   *unstructured.UnstructuredList
+		err error
+	)
+	switch {
+	case r.Namespaced:
+		l, err = ri.Namespace(ns).List(ctx, metav1.ListOptions{})
+	case r.Name == "namespaces" && client.IsNamespaced(ns):
+		var o *unstructured.Unstructured
+		if o, err = ri.Get(ctx, ns, metav1.GetOptions{}); err == nil {
+			l = &unstructured.UnstructuredList{Items: []unstructured.Unstructured{*o}}
+		}
+	default:
+		l, err = ri.List(ctx, metav1.ListOptions{})
+	}
+	if err != nil {
+		if kerrors.IsNotFound(err) && r.Name == "namespaces" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range l.Items {
+		scrubForSnapshot(&l.Items[i])
+	}
+
+	return l.Items, nil
+}
+
+// scrubForSnapshot drops managed fields and redacts secrets values.
+func scrubForSnapshot(o *unstructured.Unstructured) {
+	o.SetManagedFields(nil)
+	if o.GetKind() != "Secret" {
+		return
+	}
+	for _, f := range []string{"data", "stringData"} {
+		m, ok, _ := unstructured.NestedMap(o.Object, f)
+		if !ok {
+			continue
+		}
+		for k := range m {
+			m[k] = ""
+		}
+		_ = unstructured.SetNestedMap(o.Object, m, f)
+	}
+}
@@ -0,0 +1,87 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestSnapshotable(t *testing.T) {
+	list := metav1.Verbs{"get", "list"}
+	uu := map[string]struct {
+		gv   schema.GroupVersion
+		r    metav1.APIResource
+		ns   string
+		opts SnapshotOpts
+		e    bool
+	}{
+		"pods": {
+			r:  metav1.APIResource{Name: "pods", Namespaced: true, Verbs: list},
+			ns: "fred", e: true,
+		},
+		"subresource": {
+			r: metav1.APIResource{Name: "pods/log", Namespaced: true, Verbs: list},
+		},
+		"no-list": {
+			r: metav1.APIResource{Name: "bindings", Namespaced: true, Verbs: metav1.Verbs{"create"}},
+		},
+		"events": {
+			r: metav1.APIResource{Name: "events", Namespaced: true, Verbs: list},
+		},
+		"with-events": {
+			r:    metav1.APIResource{Name: "events", Namespaced: true, Verbs: list},
+			opts: SnapshotOpts{Events: true}, e: true,
+		},
+		"metrics": {
+			gv: schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"},
+			r:  metav1.APIResource{Name: "pods", Namespaced: true, Verbs: list},
+		},
+		"with-metrics": {
+			gv:   schema.GroupVersion{Group: "metrics.k8s.io", Version: "v1beta1"},
+			r:    metav1.APIResource{Name: "pods", Namespaced: true, Verbs: list},
+			opts: SnapshotOpts{Metrics: true}, e: true,
+		},
+		"cluster-scoped": {
+			r: metav1.APIResource{Name: "nodes", Verbs: list}, e: true,
+		},
+		"cluster-scoped-ns": {
+			r:  metav1.APIResource{Name: "nodes", Verbs: list},
+			ns: "fred",
+		},
+		"namespace-ns": {
+			r:  metav1.APIResource{Name: "namespaces", Verbs: list},
+			ns: "fred", e: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			gv := u.gv
+			if gv.Version == "" {
+				gv.Version = "v1"
+			}
+			assert.Equal(t, u.e, snapshotable(gv, u.r, u.ns, u.opts))
+		})
+	}
+}
+
+func TestScrubForSnapshot(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata": map[string]interface{}{
+			"name":          "s1",
+			"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+		},
+		"data": map[string]interface{}{"pwd": "c2VjcmV0"},
+	}}
+	scrubForSnapshot(&o)
+
+	assert.Empty(t, o.GetManagedFields())
+	v, _, _ := unstructured.NestedString(o.Object, "data", "pwd")
+	assert.Equal(t, "", v)
+}
@@ -0,0 +1,141 @@
+package dao
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	strimziNameLabel    = "strimzi.io/name"
+	strimziExporterPort = "9404"
+	strimziLagMetric    = "kafka_consumergroup_lag"
+	strimziLagTTL       = 30 * time.Second
+)
+
+var _ Accessor = (*KafkaTopic)(nil)
+
+var strimziLags = newScrapeCache(strimziLagTTL)
+
+// KafkaTopic represents a Strimzi KafkaTopic.
+type KafkaTopic struct {
+	Resource
+}
+
+// Get returns a KafkaTopic along with its consumer lag.
+func (k *KafkaTopic) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := k.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+
+	return k.withLag(ctx, o)
+}
+
+// List returns a collection of KafkaTopics along with their consumer lag.
+func (k *KafkaTopic) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := k.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		t, err := k.withLag(ctx, o)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, t)
+	}
+
+	return res, nil
+}
+
+func (k *KafkaTopic) withLag(ctx context.Context, o runtime.Object) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var t render.StrimziTopic
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &t); err != nil {
+		return nil, err
+	}
+
+	tl := render.KafkaTopicWithLag{Raw: u}
+	cluster := t.Labels[render.StrimziClusterLabel]
+	if cluster == "" {
+		return &tl, nil
+	}
+	lags, ok := k.lags(ctx, t.Namespace, cluster)
+	if !ok {
+		return &tl, nil
+	}
+	lag := lags[t.Name()]
+	tl.Lag = &lag
+
+	return &tl, nil
+}
+
+// lags returns consumer lags per topic for a given cluster if a Kafka exporter is deployed.
+func (k *KafkaTopic) lags(ctx context.Context, ns, cluster string) (map[string]int64, bool) {
+	pod, ok := k.exporter(ns, cluster)
+	if !ok {
+		return nil, false
+	}
+
+	return strimziLags.get(pod, func() (map[string]int64, error) {
+		return k.scrape(ctx, pod)
+	})
+}
+
+func (k *KafkaTopic) exporter(ns, cluster string) (string, bool) {
+	sel := labels.SelectorFromSet(labels.Set{
+		render.StrimziClusterLabel: cluster,
+		strimziNameLabel:           cluster + "-kafka-exporter",
+	})
+
+	return runningPod(k.GetFactory(), ns, sel, "")
+}
+
+func (k *KafkaTopic) scrape(ctx context.Context, path string) (map[string]int64, error) {
+	raw, err := scrapePod(ctx, k.Client(), path, strimziExporterPort)
+	if err != nil {
+		return nil, err
+	}
+
+	return strimziTopicLags(raw), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// strimziTopicLags sums up consumer groups lag per topic from exporter metrics.
+func strimziTopicLags(metrics string) map[string]int64 {
+	lags := make(map[string]int64)
+	scanner := bufio.NewScanner(strings.NewReader(metrics))
+	for scanner.Scan() {
+		labels, val, ok := promSample(scanner.Text(), strimziLagMetric)
+		if !ok {
+			continue
+		}
+		topic, ok := promLabel(labels, "topic")
+		if !ok {
+			continue
+		}
+		v, err := strconv.ParseFloat(val, 64)
+		if err != nil || v < 0 {
+			continue
+		}
+		lags[topic] += int64(v)
+	}
+
+	return lags
+}
@@ -0,0 +1,22 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStrimziTopicLags(t *testing.T) {
+	metrics := `# HELP kafka_consumergroup_lag Current Approximate Lag of a ConsumerGroup at Topic/Partition
+# TYPE kafka_consumergroup_lag gauge
+kafka_consumergroup_lag{consumergroup="g1",partition="0",topic="orders"} 10
+kafka_consumergroup_lag{consumergroup="g1",partition="1",topic="orders"} 5
+kafka_consumergroup_lag{consumergroup="g2",partition="0",topic="orders"} 1
+kafka_consumergroup_lag{consumergroup="g2",partition="0",topic="payments"} 0
+kafka_consumergroup_lag{consumergroup="g3",partition="0",topic="payments"} -1
+kafka_consumergroup_lag_sum{consumergroup="g1",topic="orders"} 15
+kafka_topic_partitions{topic="orders"} 2
+`
+
+	assert.Equal(t, map[string]int64{"orders": 16, "payments": 0}, strimziTopicLags(metrics))
+}
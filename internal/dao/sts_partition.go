@@ -0,0 +1,126 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+// List returns a collection of statefulsets along with their pods revisions.
+func (s *StatefulSet) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := s.Resource.List(ctx, ns)
+	if err != nil || len(oo) == 0 {
+		return oo, err
+	}
+
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	pp, perr := s.GetFactory().List("v1/pods", ns, false, labels.Everything())
+	if perr != nil {
+		log.Warn().Err(perr).Msgf("statefulset pods list failed")
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		if perr != nil {
+			res = append(res, &render.StatefulSetWithRevisions{Raw: u})
+			continue
+		}
+		var sts appsv1.StatefulSet
+		if err := fromUnstructured(u, &sts); err != nil {
+			return nil, err
+		}
+		rr, err := ordinalRevisions(&sts, pp)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &render.StatefulSetWithRevisions{Raw: u, Ordinals: rr})
+	}
+
+	return res, nil
+}
+
+// SetPartition updates a statefulset rolling update partition.
+func (s *StatefulSet) SetPartition(ctx context.Context, path string, partition int32) error {
+	sts, err := s.Load(s.GetFactory(), path)
+	if err != nil {
+		return err
+	}
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return fmt.Errorf("statefulset %s uses the %s update strategy", path, appsv1.OnDeleteStatefulSetStrategyType)
+	}
+	if partition < 0 {
+		return fmt.Errorf("invalid partition %d", partition)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			"updateStrategy": map[string]interface{}{
+				"type":          appsv1.RollingUpdateStatefulSetStrategyType,
+				"rollingUpdate": map[string]interface{}{"partition": partition},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ordinalRevisions checks whether each statefulset pod runs the update revision.
+func ordinalRevisions(sts *appsv1.StatefulSet, pp []runtime.Object) ([]render.OrdinalRevision, error) {
+	var rr []render.OrdinalRevision
+	for _, o := range pp {
+		if !IsOwnedBy(o, string(sts.UID)) {
+			continue
+		}
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		ordinal, ok := podOrdinal(sts.Name, po.Name)
+		if !ok {
+			continue
+		}
+		rr = append(rr, render.OrdinalRevision{
+			Ordinal: ordinal,
+			Updated: sts.Status.UpdateRevision != "" && po.Labels[appsv1.StatefulSetRevisionLabel] == sts.Status.UpdateRevision,
+			Ready:   isPodReady(po),
+		})
+	}
+	sort.Slice(rr, func(i, j int) bool {
+		return rr[i].Ordinal < rr[j].Ordinal
+	})
+
+	return rr, nil
+}
+
+func podOrdinal(sts, pod string) (int, bool) {
+	if !strings.HasPrefix(pod, sts+"-") {
+		return 0, false
+	}
+	o, err := strconv.Atoi(strings.TrimPrefix(pod, sts+"-"))
+
+	return o, err == nil
+}
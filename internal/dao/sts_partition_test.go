@@ -0,0 +1,47 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestOrdinalRevisions(t *testing.T) {
+	sts := appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "db", UID: "sts-1"},
+		Status:     appsv1.StatefulSetStatus{CurrentRevision: "db-1", UpdateRevision: "db-2"},
+	}
+	pod := func(n, rev string, ready bool) runtime.Object {
+		status := "False"
+		if ready {
+			status = "True"
+		}
+		o := relObj("v1", "Pod", "default", n, map[string]string{appsv1.StatefulSetRevisionLabel: rev}, map[string]interface{}{
+			"status": map[string]interface{}{
+				"conditions": []interface{}{map[string]interface{}{"type": "Ready", "status": status}},
+			},
+		})
+		o.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "StatefulSet", Name: "db", UID: "sts-1"}})
+		return o
+	}
+	pp := []runtime.Object{
+		pod("db-10", "db-2", true),
+		pod("db-2", "db-2", false),
+		pod("db-0", "db-1", true),
+		pod("db-1", "db-1", true),
+		relObj("v1", "Pod", "default", "db-3", nil, nil),
+	}
+
+	rr, err := ordinalRevisions(&sts, pp)
+	assert.NoError(t, err)
+	assert.Equal(t, []render.OrdinalRevision{
+		{Ordinal: 0, Ready: true},
+		{Ordinal: 1, Ready: true},
+		{Ordinal: 2, Updated: true},
+		{Ordinal: 10, Updated: true, Ready: true},
+	}, rr)
+}
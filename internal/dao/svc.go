@@ -4,12 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 var (
@@ -23,6 +26,44 @@ type Service struct {
 	Resource
 }
 
+// Get returns a service along with its backends.
+func (s *Service) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := s.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	ns, _ := client.Namespaced(path)
+	bb, err := newSvcBackends(s.GetFactory(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return bb.withEndpoints(o)
+}
+
+// List returns a collection of services along with their backends.
+func (s *Service) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := s.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	bb, err := newSvcBackends(s.GetFactory(), ns)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		svc, err := bb.withEndpoints(o)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, svc)
+	}
+
+	return res, nil
+}
+
 // TailLogs tail logs for all pods represented by this Service.
 func (s *Service) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
 	svc, err := s.GetInstance(opts.Path)
@@ -65,6 +106,129 @@ func (s *Service) GetInstance(fqn string) (*v1.Service, error) {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// svcBackends tracks the pods and endpoints backing services in a namespace.
+type svcBackends struct {
+	pods      []v1.Pod
+	endpoints map[string]*v1.Endpoints
+}
+
+func newSvcBackends(f Factory, ns string) (*svcBackends, error) {
+	if client.IsAllNamespace(ns) {
+		ns = client.AllNamespaces
+	}
+	pp, err := f.List("v1/pods", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	ee, err := f.List("v1/endpoints", ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	bb := svcBackends{
+		pods:      make([]v1.Pod, 0, len(pp)),
+		endpoints: make(map[string]*v1.Endpoints, len(ee)),
+	}
+	for _, o := range pp {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		bb.pods = append(bb.pods, po)
+	}
+	for _, o := range ee {
+		var ep v1.Endpoints
+		if err := fromUnstructured(o, &ep); err != nil {
+			return nil, err
+		}
+		bb.endpoints[client.FQN(ep.Namespace, ep.Name)] = &ep
+	}
+
+	return &bb, nil
+}
+
+func (b *svcBackends) withEndpoints(o runtime.Object) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var svc v1.Service
+	if err := fromUnstructured(u, &svc); err != nil {
+		return nil, err
+	}
+
+	res := render.ServiceWithEndpoints{Raw: u}
+	if ep, ok := b.endpoints[client.FQN(svc.Namespace, svc.Name)]; ok {
+		for _, s := range ep.Subsets {
+			res.Ready += len(s.Addresses)
+			res.Total += len(s.Addresses) + len(s.NotReadyAddresses)
+		}
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return &res, nil
+	}
+
+	sel := labels.SelectorFromSet(svc.Spec.Selector)
+	var pods []v1.Pod
+	for _, po := range b.pods {
+		if po.Namespace == svc.Namespace && sel.Matches(labels.Set(po.Labels)) {
+			pods = append(pods, po)
+		}
+	}
+	res.Pods = len(pods)
+	res.UnmatchedPorts = unmatchedTargetPorts(svc.Spec.Ports, pods)
+
+	return &res, nil
+}
+
+// unmatchedTargetPorts returns the service target ports none of the pods expose.
+// Numeric target ports are only checked when pods declare container ports.
+func unmatchedTargetPorts(pp []v1.ServicePort, pods []v1.Pod) []string {
+	if len(pods) == 0 {
+		return nil
+	}
+	var (
+		names   = make(map[string]struct{})
+		numbers = make(map[int32]struct{})
+	)
+	for _, po := range pods {
+		for _, co := range po.Spec.Containers {
+			for _, p := range co.Ports {
+				numbers[p.ContainerPort] = struct{}{}
+				if p.Name != "" {
+					names[p.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var uu []string
+	for _, p := range pp {
+		target := p.TargetPort
+		if target.Type == intstr.String {
+			if _, ok := names[target.StrVal]; !ok {
+				uu = append(uu, target.StrVal)
+			}
+			continue
+		}
+		port := target.IntVal
+		if port == 0 {
+			port = p.Port
+		}
+		if len(numbers) == 0 {
+			continue
+		}
+		if _, ok := numbers[port]; !ok {
+			uu = append(uu, strconv.Itoa(int(port)))
+		}
+	}
+
+	return uu
+}
+
 func podFromSelector(f Factory, ns string, sel map[string]string) (string, error) {
 	oo, err := f.List("v1/pods", ns, true, labels.Set(sel).AsSelector())
 	if err != nil {
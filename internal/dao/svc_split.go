@@ -0,0 +1,252 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var _ Accessor = (*ServiceSplits)(nil)
+
+// trackNoiseLabels tracks pod labels set by controllers that never tell tracks apart.
+var trackNoiseLabels = map[string]struct{}{
+	appsv1.DefaultDeploymentUniqueLabelKey: {},
+	appsv1.ControllerRevisionHashLabelKey:  {},
+	appsv1.StatefulSetPodNameLabel:         {},
+}
+
+// ServiceSplits represents a service traffic split across its backing workloads.
+type ServiceSplits struct {
+	NonResource
+}
+
+// List returns the workloads backing the context service.
+func (s *ServiceSplits) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	path, ok := ctx.Value(internal.KeyPath).(string)
+	if !ok {
+		return nil, errors.New("expecting context Path")
+	}
+	o, err := s.Factory.Get("v1/services", path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	var svc v1.Service
+	if err := fromUnstructured(o, &svc); err != nil {
+		return nil, err
+	}
+	if len(svc.Spec.Selector) == 0 {
+		return nil, fmt.Errorf("no selector found on service %s", path)
+	}
+
+	oo, err := s.Factory.List("v1/pods", svc.Namespace, true, labels.SelectorFromSet(svc.Spec.Selector))
+	if err != nil {
+		return nil, err
+	}
+	pods := make([]v1.Pod, 0, len(oo))
+	for _, o := range oo {
+		var po v1.Pod
+		if err := fromUnstructured(o, &po); err != nil {
+			return nil, err
+		}
+		if po.Status.Phase == v1.PodSucceeded || po.Status.Phase == v1.PodFailed {
+			continue
+		}
+		pods = append(pods, po)
+	}
+
+	var ep *v1.Endpoints
+	if o, err := s.Factory.Get("v1/endpoints", path, true, labels.Everything()); err == nil {
+		var e v1.Endpoints
+		if err := fromUnstructured(o, &e); err == nil {
+			ep = &e
+		}
+	}
+
+	ss := SplitBackends(&svc, pods, ep, replicaSetOwners(s.Factory, svc.Namespace))
+	res := make([]runtime.Object, 0, len(ss))
+	for _, s := range ss {
+		res = append(res, s)
+	}
+
+	return res, nil
+}
+
+// Get fetch a given split.
+func (s *ServiceSplits) Get(ctx context.Context, path string) (runtime.Object, error) {
+	panic("NYI")
+}
+
+// SplitBackends groups a service pods by owning workload along with their ready
+// endpoints. Pods readiness comes from the service endpoints when known.
+// RsOwners maps replicasets to their deployment.
+func SplitBackends(svc *v1.Service, pods []v1.Pod, ep *v1.Endpoints, rsOwners map[string]string) []render.ServiceSplitRes {
+	var ready map[string]struct{}
+	if ep != nil {
+		ready = make(map[string]struct{})
+		for _, s := range ep.Subsets {
+			for _, a := range s.Addresses {
+				if a.TargetRef != nil && a.TargetRef.Kind == "Pod" {
+					ready[a.TargetRef.Name] = struct{}{}
+				}
+			}
+		}
+	}
+
+	var (
+		gg    = make(map[string]*splitGroup)
+		total int
+	)
+	for i := range pods {
+		po := &pods[i]
+		gvr, name := podWorkload(po, rsOwners)
+		key := gvr + ":" + name
+		g, ok := gg[key]
+		if !ok {
+			g = &splitGroup{
+				res:    render.ServiceSplitRes{Namespace: svc.Namespace, Service: svc.Name, GVR: gvr, Name: name},
+				labels: trackLabels(po.Labels, svc.Spec.Selector),
+			}
+			gg[key] = g
+		} else {
+			for k, v := range g.labels {
+				if po.Labels[k] != v {
+					delete(g.labels, k)
+				}
+			}
+		}
+		g.res.Pods++
+		if isEndpointReady(po, ready) {
+			g.res.Ready++
+			total++
+		}
+	}
+
+	keys := make([]string, 0, len(gg))
+	for k := range gg {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	ss := make([]render.ServiceSplitRes, 0, len(gg))
+	for _, k := range keys {
+		g := gg[k]
+		g.res.TotalReady = total
+		g.res.Track = g.track(k, gg)
+		ss = append(ss, g.res)
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// splitGroup tracks the pods of a workload backing a service.
+type splitGroup struct {
+	res render.ServiceSplitRes
+	// labels tracks the labels shared by all the group pods.
+	labels map[string]string
+}
+
+// track returns the labels telling a group apart from the other groups.
+func (g *splitGroup) track(key string, gg map[string]*splitGroup) string {
+	if len(gg) < 2 {
+		return ""
+	}
+	var tt []string
+	for _, k := range sortedKeys(asKeySet(g.labels)) {
+		v := g.labels[k]
+		for other, og := range gg {
+			if other == key {
+				continue
+			}
+			if ov, ok := og.labels[k]; !ok || ov != v {
+				tt = append(tt, k+"="+v)
+				break
+			}
+		}
+	}
+
+	return strings.Join(tt, ",")
+}
+
+func trackLabels(ll, sel map[string]string) map[string]string {
+	mm := make(map[string]string, len(ll))
+	for k, v := range ll {
+		if _, ok := sel[k]; ok {
+			continue
+		}
+		if _, ok := trackNoiseLabels[k]; ok {
+			continue
+		}
+		mm[k] = v
+	}
+
+	return mm
+}
+
+// isEndpointReady checks if a pod serves traffic falling back to its ready condition.
+func isEndpointReady(po *v1.Pod, ready map[string]struct{}) bool {
+	if ready != nil {
+		_, ok := ready[po.Name]
+		return ok
+	}
+
+	return isPodReady(*po)
+}
+
+// podWorkload returns the workload controlling a pod. Bare pods are their own workload.
+func podWorkload(po *v1.Pod, rsOwners map[string]string) (string, string) {
+	ref := metav1.GetControllerOf(po)
+	if ref == nil {
+		return "v1/pods", po.Name
+	}
+	switch ref.Kind {
+	case "ReplicaSet":
+		if dp, ok := rsOwners[ref.Name]; ok {
+			return "apps/v1/deployments", dp
+		}
+		return "apps/v1/replicasets", ref.Name
+	case "StatefulSet":
+		return "apps/v1/statefulsets", ref.Name
+	case "DaemonSet":
+		return "apps/v1/daemonsets", ref.Name
+	case "Job":
+		return "batch/v1/jobs", ref.Name
+	}
+
+	return ref.APIVersion + "/" + strings.ToLower(ref.Kind) + "s", ref.Name
+}
+
+// replicaSetOwners returns the deployments owning replicasets in a namespace.
+func replicaSetOwners(f Factory, ns string) map[string]string {
+	oo, err := f.List("apps/v1/replicasets", ns, false, labels.Everything())
+	if err != nil {
+		log.Debug().Err(err).Msgf("replicasets list failed")
+		return nil
+	}
+
+	mm := make(map[string]string, len(oo))
+	for _, o := range oo {
+		var rs appsv1.ReplicaSet
+		if err := fromUnstructured(o, &rs); err != nil {
+			continue
+		}
+		if ref := metav1.GetControllerOf(&rs); ref != nil && ref.Kind == "Deployment" {
+			mm[rs.Name] = ref.Name
+		}
+	}
+
+	return mm
+}
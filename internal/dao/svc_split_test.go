@@ -0,0 +1,62 @@
+package dao_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSplitBackends(t *testing.T) {
+	svc := v1.Service{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns1", Name: "fred"},
+		Spec:       v1.ServiceSpec{Selector: map[string]string{"app": "fred"}},
+	}
+	pod := func(n, rs, track string) v1.Pod {
+		yes := true
+		return v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ns1",
+			Name:            n,
+			Labels:          map[string]string{"app": "fred", "track": track, "team": "blee", "pod-template-hash": n},
+			OwnerReferences: []metav1.OwnerReference{{Kind: "ReplicaSet", Name: rs, Controller: &yes}},
+		}}
+	}
+	pods := []v1.Pod{
+		pod("p1", "fred-stable-1", "stable"),
+		pod("p2", "fred-stable-1", "stable"),
+		pod("p3", "fred-stable-1", "stable"),
+		pod("p4", "fred-canary-1", "canary"),
+		pod("p5", "fred-canary-1", "canary"),
+	}
+	ep := v1.Endpoints{Subsets: []v1.EndpointSubset{
+		{
+			Addresses: []v1.EndpointAddress{
+				{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p1"}},
+				{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p2"}},
+				{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p3"}},
+				{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p4"}},
+			},
+			NotReadyAddresses: []v1.EndpointAddress{
+				{TargetRef: &v1.ObjectReference{Kind: "Pod", Name: "p5"}},
+			},
+		},
+	}}
+	owners := map[string]string{"fred-stable-1": "fred-stable", "fred-canary-1": "fred-canary"}
+
+	ss := dao.SplitBackends(&svc, pods, &ep, owners)
+	assert.Equal(t, []render.ServiceSplitRes{
+		{Namespace: "ns1", Service: "fred", GVR: "apps/v1/deployments", Name: "fred-canary", Track: "track=canary", Pods: 2, Ready: 1, TotalReady: 4},
+		{Namespace: "ns1", Service: "fred", GVR: "apps/v1/deployments", Name: "fred-stable", Track: "track=stable", Pods: 3, Ready: 3, TotalReady: 4},
+	}, ss)
+	assert.Equal(t, "25%", ss[0].Share())
+	assert.Equal(t, "75%", ss[1].Share())
+
+	ss = dao.SplitBackends(&svc, pods[:3], nil, nil)
+	assert.Equal(t, 1, len(ss))
+	assert.Equal(t, "apps/v1/replicasets", ss[0].GVR)
+	assert.Equal(t, "", ss[0].Track)
+	assert.Equal(t, 0, ss[0].Ready)
+}
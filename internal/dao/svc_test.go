@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestServiceWithEndpoints(t *testing.T) {
+	svc := func(n string, sel map[string]interface{}, target interface{}) runtime.Object {
+		return relObj("v1", "Service", "default", n, nil, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"selector": sel,
+				"ports":    []interface{}{map[string]interface{}{"port": int64(80), "targetPort": target}},
+			},
+		})
+	}
+	pod := func(n, app string, ports ...interface{}) runtime.Object {
+		return relObj("v1", "Pod", "default", n, map[string]string{"app": app}, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"containers": []interface{}{map[string]interface{}{"name": "c1", "ports": ports}},
+			},
+			"status": map[string]interface{}{"phase": "Running"},
+		})
+	}
+	port := func(name string, p int64) interface{} {
+		return map[string]interface{}{"name": name, "containerPort": p}
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			pod("web-1", "web", port("http", 8080)),
+			pod("web-2", "web", port("http", 8080)),
+			pod("raw-1", "raw"),
+		},
+		"v1/endpoints": {
+			relObj("v1", "Endpoints", "default", "web", nil, map[string]interface{}{
+				"subsets": []interface{}{
+					map[string]interface{}{
+						"addresses":         []interface{}{map[string]interface{}{"ip": "10.0.0.1"}},
+						"notReadyAddresses": []interface{}{map[string]interface{}{"ip": "10.0.0.2"}},
+					},
+				},
+			}),
+		},
+	}}
+
+	uu := map[string]struct {
+		svc runtime.Object
+		e   render.ServiceWithEndpoints
+	}{
+		"named": {
+			svc: svc("web", map[string]interface{}{"app": "web"}, "http"),
+			e:   render.ServiceWithEndpoints{Ready: 1, Total: 2, Pods: 2},
+		},
+		"bad-name": {
+			svc: svc("web-bad", map[string]interface{}{"app": "web"}, "https"),
+			e:   render.ServiceWithEndpoints{Pods: 2, UnmatchedPorts: []string{"https"}},
+		},
+		"bad-number": {
+			svc: svc("web-num", map[string]interface{}{"app": "web"}, int64(9090)),
+			e:   render.ServiceWithEndpoints{Pods: 2, UnmatchedPorts: []string{"9090"}},
+		},
+		"undeclared": {
+			svc: svc("raw", map[string]interface{}{"app": "raw"}, int64(9090)),
+			e:   render.ServiceWithEndpoints{Pods: 1},
+		},
+		"no-pods": {
+			svc: svc("nope", map[string]interface{}{"app": "nope"}, "http"),
+			e:   render.ServiceWithEndpoints{},
+		},
+		"no-selector": {
+			svc: svc("ext", nil, int64(80)),
+			e:   render.ServiceWithEndpoints{},
+		},
+	}
+
+	bb, err := newSvcBackends(f, "default")
+	assert.NoError(t, err)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := bb.withEndpoints(u.svc)
+			assert.NoError(t, err)
+			s := o.(*render.ServiceWithEndpoints)
+			assert.Equal(t, u.e.Ready, s.Ready)
+			assert.Equal(t, u.e.Total, s.Total)
+			assert.Equal(t, u.e.Pods, s.Pods)
+			assert.Equal(t, u.e.UnmatchedPorts, s.UnmatchedPorts)
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+var (
+	_ Accessor = (*PipelineRun)(nil)
+	_ Loggable = (*PipelineRun)(nil)
+	_ Accessor = (*TaskRun)(nil)
+	_ Loggable = (*TaskRun)(nil)
+)
+
+// PipelineRun represents a Tekton PipelineRun.
+type PipelineRun struct {
+	Resource
+}
+
+// Get returns a PipelineRun along with its tasks progress.
+func (p *PipelineRun) Get(ctx context.Context, path string) (runtime.Object, error) {
+	o, err := p.Resource.Get(ctx, path)
+	if err != nil {
+		return o, err
+	}
+	ns, _ := client.Namespaced(path)
+	tasks, err := p.tasksProgress(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	return p.withTasks(o, tasks)
+}
+
+// List returns a collection of PipelineRuns along with their tasks progress.
+func (p *PipelineRun) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	oo, err := p.Resource.List(ctx, ns)
+	if err != nil {
+		return oo, err
+	}
+	tasks, err := p.tasksProgress(ns)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]runtime.Object, 0, len(oo))
+	for _, o := range oo {
+		pr, err := p.withTasks(o, tasks)
+		if err != nil {
+			return res, err
+		}
+		res = append(res, pr)
+	}
+
+	return res, nil
+}
+
+// TailLogs tail logs for all the TaskRuns pods of a PipelineRun.
+func (p *PipelineRun) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	_, n := client.Namespaced(opts.Path)
+
+	return podLogs(ctx, map[string]string{render.TektonPipelineRunLabel: n}, opts)
+}
+
+// TaskRunsGVR returns the TaskRuns resource matching this PipelineRun version.
+func (p *PipelineRun) TaskRunsGVR() client.GVR {
+	return client.NewGVR(p.gvr.G() + "/" + p.gvr.V() + "/taskruns")
+}
+
+func (p *PipelineRun) withTasks(o runtime.Object, tasks map[string]render.TektonTasks) (runtime.Object, error) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return &render.PipelineRunWithTasks{
+		Raw:   u,
+		Tasks: tasks[client.FQN(u.GetNamespace(), u.GetName())],
+	}, nil
+}
+
+// tasksProgress tallies TaskRuns states by owning PipelineRun.
+func (p *PipelineRun) tasksProgress(ns string) (map[string]render.TektonTasks, error) {
+	oo, err := p.GetFactory().List(p.TaskRunsGVR().String(), ns, false, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	tt := make(map[string]render.TektonTasks)
+	for _, o := range oo {
+		var tr render.TektonRun
+		err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &tr)
+		if err != nil {
+			return nil, err
+		}
+		pr, ok := tr.Labels[render.TektonPipelineRunLabel]
+		if !ok {
+			continue
+		}
+		fqn := client.FQN(tr.Namespace, pr)
+		t := tt[fqn]
+		t.Total++
+		switch c := tr.Status.Succeeded(); {
+		case c == nil || c.Status == "Unknown":
+			t.Running++
+		case c.Status == "True":
+			t.Succeeded++
+		default:
+			t.Failed++
+		}
+		tt[fqn] = t
+	}
+
+	return tt, nil
+}
+
+// TaskRun represents a Tekton TaskRun.
+type TaskRun struct {
+	Resource
+}
+
+// TailLogs tail logs for all the steps of a TaskRun.
+func (t *TaskRun) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	_, n := client.Namespaced(opts.Path)
+
+	return podLogs(ctx, map[string]string{render.TektonTaskRunLabel: n}, opts)
+}
+
+// Pod returns the TaskRun pod.
+func (t *TaskRun) Pod(fqn string) (string, error) {
+	ns, n := client.Namespaced(fqn)
+
+	return podFromSelector(t.Factory, ns, map[string]string{render.TektonTaskRunLabel: n})
+}
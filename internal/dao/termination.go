@@ -0,0 +1,222 @@
+package dao
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	crashLoopReason = "CrashLoopBackOff"
+	oomKilledReason = "OOMKilled"
+)
+
+var (
+	backOffRX = regexp.MustCompile(`back-off (\S+)`)
+
+	// terminationEvents tracks the events relevant to a container termination.
+	terminationEvents = map[string]struct{}{
+		"BackOff":    {},
+		"Killing":    {},
+		"Failed":     {},
+		"Unhealthy":  {},
+		"OOMKilling": {},
+		"Evicted":    {},
+		"Preempting": {},
+	}
+)
+
+// Termination represents a container last termination.
+type Termination struct {
+	ExitCode   int32
+	Signal     int32
+	Reason     string
+	Message    string
+	StartedAt  time.Time
+	FinishedAt time.Time
+}
+
+// Ran returns how long the container ran before terminating.
+func (t Termination) Ran() time.Duration {
+	if t.StartedAt.IsZero() || t.FinishedAt.IsZero() {
+		return 0
+	}
+
+	return t.FinishedAt.Sub(t.StartedAt)
+}
+
+// IsOOMKilled checks if the container ran out of memory.
+func (t Termination) IsOOMKilled() bool {
+	return t.Reason == oomKilledReason
+}
+
+// ContainerHistory represents a container termination history.
+type ContainerHistory struct {
+	Name     string
+	Init     bool
+	Restarts int32
+	State    string
+	BackOff  string
+	Current  *Termination
+	Last     *Termination
+}
+
+// IsCrashLooping checks if the container is backing off restarts.
+func (c ContainerHistory) IsCrashLooping() bool {
+	return c.State == crashLoopReason
+}
+
+// TerminationEvent represents a pod event related to terminations.
+type TerminationEvent struct {
+	Reason  string
+	Message string
+	Count   int32
+	Last    time.Time
+}
+
+// TerminationReport summarizes a pod containers termination history.
+type TerminationReport struct {
+	Pod        string
+	Containers []ContainerHistory
+	Events     []TerminationEvent
+}
+
+// Patterns summarizes the crash patterns found in the history.
+func (r TerminationReport) Patterns() []string {
+	var pp []string
+	for _, c := range r.Containers {
+		if c.IsCrashLooping() {
+			pp = append(pp, fmt.Sprintf("%s is crash looping (%d restarts)", c.Name, c.Restarts))
+		}
+		for _, t := range []*Termination{c.Current, c.Last} {
+			if t != nil && t.IsOOMKilled() {
+				pp = append(pp, fmt.Sprintf("%s was OOMKilled after running %s", c.Name, t.Ran()))
+				break
+			}
+		}
+		if c.Last != nil && !c.Last.IsOOMKilled() && c.Last.ExitCode != 0 {
+			pp = append(pp, fmt.Sprintf("%s last exited with %d (%s)", c.Name, c.Last.ExitCode, ExitCodeHint(c.Last.ExitCode)))
+		}
+	}
+
+	return pp
+}
+
+// TerminationHistory collects a pod containers termination history from its status and events.
+func TerminationHistory(f Factory, path string) (TerminationReport, error) {
+	r := TerminationReport{Pod: path}
+	o, err := f.Get("v1/pods", path, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	var po v1.Pod
+	if err := fromUnstructured(o, &po); err != nil {
+		return r, err
+	}
+
+	for _, s := range po.Status.InitContainerStatuses {
+		r.Containers = append(r.Containers, containerHistory(s, true))
+	}
+	for _, s := range po.Status.ContainerStatuses {
+		r.Containers = append(r.Containers, containerHistory(s, false))
+	}
+
+	oo, err := f.List("v1/events", po.Namespace, true, labels.Everything())
+	if err != nil {
+		return r, err
+	}
+	for _, o := range oo {
+		var ev v1.Event
+		if err := fromUnstructured(o, &ev); err != nil {
+			return r, err
+		}
+		if _, ok := terminationEvents[ev.Reason]; !ok {
+			continue
+		}
+		if ev.InvolvedObject.Kind != "Pod" || ev.InvolvedObject.Name != po.Name {
+			continue
+		}
+		if ev.InvolvedObject.UID != "" && po.UID != "" && ev.InvolvedObject.UID != po.UID {
+			continue
+		}
+		r.Events = append(r.Events, TerminationEvent{
+			Reason:  ev.Reason,
+			Message: ev.Message,
+			Count:   ev.Count,
+			Last:    ev.LastTimestamp.Time,
+		})
+	}
+	sort.SliceStable(r.Events, func(i, j int) bool {
+		return r.Events[i].Last.After(r.Events[j].Last)
+	})
+
+	return r, nil
+}
+
+// ExitCodeHint returns a human readable hint for a container exit code.
+func ExitCodeHint(code int32) string {
+	switch code {
+	case 0:
+		return "completed"
+	case 1:
+		return "application error"
+	case 2:
+		return "shell misuse"
+	case 126:
+		return "command not executable"
+	case 127:
+		return "command not found"
+	case 134:
+		return "SIGABRT"
+	case 137:
+		return "SIGKILL"
+	case 139:
+		return "SIGSEGV"
+	case 143:
+		return "SIGTERM"
+	default:
+		if code > 128 {
+			return fmt.Sprintf("signal %d", code-128)
+		}
+		return "error"
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func containerHistory(s v1.ContainerStatus, init bool) ContainerHistory {
+	h := ContainerHistory{Name: s.Name, Init: init, Restarts: s.RestartCount}
+	switch {
+	case s.State.Running != nil:
+		h.State = "Running"
+	case s.State.Waiting != nil:
+		h.State = s.State.Waiting.Reason
+		if m := backOffRX.FindStringSubmatch(s.State.Waiting.Message); len(m) == 2 {
+			h.BackOff = m[1]
+		}
+	case s.State.Terminated != nil:
+		h.State = "Terminated"
+		h.Current = toTermination(s.State.Terminated)
+	}
+	if s.LastTerminationState.Terminated != nil {
+		h.Last = toTermination(s.LastTerminationState.Terminated)
+	}
+
+	return h
+}
+
+func toTermination(t *v1.ContainerStateTerminated) *Termination {
+	return &Termination{
+		ExitCode:   t.ExitCode,
+		Signal:     t.Signal,
+		Reason:     t.Reason,
+		Message:    t.Message,
+		StartedAt:  t.StartedAt.Time,
+		FinishedAt: t.FinishedAt.Time,
+	}
+}
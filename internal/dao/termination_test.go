@@ -0,0 +1,90 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTerminationHistory(t *testing.T) {
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/pods": {
+			relObj("v1", "Pod", "default", "p1", nil, map[string]interface{}{
+				"status": map[string]interface{}{
+					"containerStatuses": []interface{}{
+						map[string]interface{}{
+							"name":         "c1",
+							"restartCount": int64(5),
+							"state": map[string]interface{}{
+								"waiting": map[string]interface{}{
+									"reason":  "CrashLoopBackOff",
+									"message": "back-off 2m40s restarting failed container=c1 pod=p1_default",
+								},
+							},
+							"lastState": map[string]interface{}{
+								"terminated": map[string]interface{}{
+									"exitCode":   int64(137),
+									"reason":     "OOMKilled",
+									"startedAt":  "2023-01-01T10:00:00Z",
+									"finishedAt": "2023-01-01T10:00:30Z",
+								},
+							},
+						},
+						map[string]interface{}{
+							"name":  "c2",
+							"state": map[string]interface{}{"running": map[string]interface{}{}},
+						},
+					},
+				},
+			}),
+		},
+		"v1/events": {
+			relObj("v1", "Event", "default", "p1.1", nil, map[string]interface{}{
+				"reason":         "BackOff",
+				"message":        "Back-off restarting failed container",
+				"count":          int64(12),
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1", "namespace": "default"},
+			}),
+			relObj("v1", "Event", "default", "p1.2", nil, map[string]interface{}{
+				"reason":         "Pulled",
+				"involvedObject": map[string]interface{}{"kind": "Pod", "name": "p1", "namespace": "default"},
+			}),
+		},
+	}}
+
+	r, err := TerminationHistory(f, "default/p1")
+	assert.NoError(t, err)
+	assert.Equal(t, 2, len(r.Containers))
+	c := r.Containers[0]
+	assert.True(t, c.IsCrashLooping())
+	assert.Equal(t, "2m40s", c.BackOff)
+	assert.Equal(t, int32(137), c.Last.ExitCode)
+	assert.Equal(t, "Running", r.Containers[1].State)
+	assert.Equal(t, 1, len(r.Events))
+	assert.Equal(t, int32(12), r.Events[0].Count)
+	assert.Equal(t, []string{
+		"c1 is crash looping (5 restarts)",
+		"c1 was OOMKilled after running 30s",
+	}, r.Patterns())
+}
+
+func TestExitCodeHint(t *testing.T) {
+	uu := map[string]struct {
+		code int32
+		e    string
+	}{
+		"ok":      {code: 0, e: "completed"},
+		"kill":    {code: 137, e: "SIGKILL"},
+		"term":    {code: 143, e: "SIGTERM"},
+		"signal":  {code: 130, e: "signal 2"},
+		"generic": {code: 42, e: "error"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, ExitCodeHint(u.code))
+		})
+	}
+}
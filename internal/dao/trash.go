@@ -0,0 +1,264 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const trashExt = ".json"
+
+var (
+	_ Accessor = (*Trash)(nil)
+	_ Nuker    = (*Trash)(nil)
+
+	// serverFields tracks the metadata fields populated by the api server.
+	serverFields = []string{
+		"uid",
+		"resourceVersion",
+		"generation",
+		"creationTimestamp",
+		"deletionTimestamp",
+		"deletionGracePeriodSeconds",
+		"managedFields",
+		"selfLink",
+		"ownerReferences",
+	}
+
+	// jobControllerLabels tracks the labels generated by the job controller.
+	jobControllerLabels = []string{"controller-uid", "batch.kubernetes.io/controller-uid"}
+)
+
+// TrashEntry represents a deleted object stashed locally.
+type TrashEntry struct {
+	ID        string                 `json:"-"`
+	GVR       string                 `json:"gvr"`
+	DeletedAt time.Time              `json:"deletedAt"`
+	ExpiresAt time.Time              `json:"expiresAt"`
+	Object    map[string]interface{} `json:"object"`
+}
+
+// Path returns the deleted object path.
+func (e TrashEntry) Path() string {
+	o := unstructured.Unstructured{Object: e.Object}
+
+	return client.FQN(o.GetNamespace(), o.GetName())
+}
+
+// Trash represents the deleted objects that can still be restored.
+type Trash struct {
+	NonResource
+}
+
+// List returns all the restorable deleted objects.
+func (t *Trash) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	ee, err := TrashEntries(TrashDir(t.Factory), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	oo := make([]runtime.Object, 0, len(ee))
+	for _, e := range ee {
+		o := unstructured.Unstructured{Object: e.Object}
+		oo = append(oo, render.TrashRes{
+			ID:        e.ID,
+			GVR:       e.GVR,
+			Namespace: o.GetNamespace(),
+			Name:      o.GetName(),
+			DeletedAt: e.DeletedAt,
+			ExpiresAt: e.ExpiresAt,
+		})
+	}
+
+	return oo, nil
+}
+
+// Delete discards a stashed object.
+func (t *Trash) Delete(_ context.Context, id string, _ *metav1.DeletionPropagation, _ Grace) error {
+	return os.Remove(filepath.Join(TrashDir(t.Factory), id+trashExt))
+}
+
+// Stash tracks a resource manifest captured prior to its deletion.
+type Stash struct {
+	GVR    string
+	Object *unstructured.Unstructured
+}
+
+// NewStash captures a resource manifest prior to its deletion. Orphans gvr:fqn paths
+// resolve to the orphaned resource.
+func NewStash(f Factory, gvr, path string) (*Stash, error) {
+	if gvr == orphansGVR {
+		var err error
+		if gvr, path, err = orphanPath(path); err != nil {
+			return nil, err
+		}
+	}
+	o, err := f.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+
+	return &Stash{GVR: gvr, Object: u.DeepCopy()}, nil
+}
+
+// Save stashes the manifest once the resource got deleted so it can be restored.
+func (s *Stash) Save(f Factory, retention time.Duration) error {
+	return stash(TrashDir(f), s.GVR, s.Object, time.Now(), retention)
+}
+
+// TrashEntries returns the restorable deleted objects, most recent first. Expired
+// entries are purged.
+func TrashEntries(dir string, now time.Time) ([]TrashEntry, error) {
+	ff, err := os.ReadDir(dir)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ee := make([]TrashEntry, 0, len(ff))
+	for _, f := range ff {
+		if f.IsDir() || filepath.Ext(f.Name()) != trashExt {
+			continue
+		}
+		p := filepath.Join(dir, f.Name())
+		e, err := loadTrashEntry(p)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid trash entry %s", p)
+			continue
+		}
+		if now.After(e.ExpiresAt) {
+			if err := os.Remove(p); err != nil {
+				log.Warn().Err(err).Msgf("Purging trash entry %s", p)
+			}
+			continue
+		}
+		ee = append(ee, e)
+	}
+	sort.Slice(ee, func(i, j int) bool {
+		return ee[i].DeletedAt.After(ee[j].DeletedAt)
+	})
+
+	return ee, nil
+}
+
+// RestoreDeleted recreates a stashed object and discards it from the trash.
+// An empty id restores the most recently deleted object.
+func RestoreDeleted(ctx context.Context, f Factory, id string) (TrashEntry, error) {
+	dir := TrashDir(f)
+	ee, err := TrashEntries(dir, time.Now())
+	if err != nil {
+		return TrashEntry{}, err
+	}
+	var (
+		e  TrashEntry
+		ok bool
+	)
+	for _, entry := range ee {
+		if id == "" || entry.ID == id {
+			e, ok = entry, true
+			break
+		}
+	}
+	if !ok {
+		return e, errors.New("nothing to restore. Deleted objects may have expired")
+	}
+
+	var g Generic
+	g.Init(f, client.NewGVR(e.GVR))
+	if _, err := g.Create(ctx, restorable(e.Object)); err != nil {
+		return e, err
+	}
+
+	return e, os.Remove(filepath.Join(dir, e.ID+trashExt))
+}
+
+// TrashDir returns the deleted objects stash directory for the active cluster.
+func TrashDir(f Factory) string {
+	return config.TrashDir(f.Client().ActiveCluster())
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func stash(dir, gvr string, o *unstructured.Unstructured, now time.Time, retention time.Duration) error {
+	e := TrashEntry{
+		GVR:       gvr,
+		DeletedAt: now,
+		ExpiresAt: now.Add(retention),
+		Object:    o.DeepCopy().Object,
+	}
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+
+	return os.WriteFile(filepath.Join(dir, strconv.FormatInt(now.UnixNano(), 10)+trashExt), raw, 0600)
+}
+
+func loadTrashEntry(p string) (TrashEntry, error) {
+	var e TrashEntry
+	raw, err := os.ReadFile(p)
+	if err != nil {
+		return e, err
+	}
+	if err := json.Unmarshal(raw, &e); err != nil {
+		return e, err
+	}
+	e.ID = strings.TrimSuffix(filepath.Base(p), trashExt)
+
+	return e, nil
+}
+
+// restorable returns a copy of an object without its server populated fields.
+func restorable(m map[string]interface{}) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: runtime.DeepCopyJSON(m)}
+	for _, f := range serverFields {
+		unstructured.RemoveNestedField(o.Object, "metadata", f)
+	}
+	unstructured.RemoveNestedField(o.Object, "status")
+
+	switch o.GetKind() {
+	case "Service":
+		// Cluster IPs are reallocated unless the service is headless.
+		if ip, _, _ := unstructured.NestedString(o.Object, "spec", "clusterIP"); ip != "None" {
+			unstructured.RemoveNestedField(o.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(o.Object, "spec", "clusterIPs")
+		}
+	case "Job":
+		// Generated selectors are rejected on create.
+		if manual, _, _ := unstructured.NestedBool(o.Object, "spec", "manualSelector"); !manual {
+			unstructured.RemoveNestedField(o.Object, "spec", "selector")
+			for _, l := range jobControllerLabels {
+				unstructured.RemoveNestedField(o.Object, "spec", "template", "metadata", "labels", l)
+				unstructured.RemoveNestedField(o.Object, "metadata", "labels", l)
+			}
+		}
+	}
+
+	return &o
+}
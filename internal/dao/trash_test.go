@@ -0,0 +1,140 @@
+package dao
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestTrashEntries(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	assert.Nil(t, stash(dir, "v1/configmaps", makeTrashObj("ConfigMap", "cm1"), now.Add(-2*time.Hour), time.Hour))
+	assert.Nil(t, stash(dir, "v1/configmaps", makeTrashObj("ConfigMap", "cm2"), now.Add(-time.Minute), time.Hour))
+	assert.Nil(t, stash(dir, "v1/configmaps", makeTrashObj("ConfigMap", "cm3"), now, time.Hour))
+
+	ee, err := TrashEntries(dir, now)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ee))
+	assert.Equal(t, "fred/cm3", ee[0].Path())
+	assert.Equal(t, "fred/cm2", ee[1].Path())
+
+	ff, err := os.ReadDir(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(ff))
+
+	ee, err = TrashEntries(filepath.Join(dir, "blee"), now)
+	assert.Nil(t, err)
+	assert.Empty(t, ee)
+}
+
+func TestNewStash(t *testing.T) {
+	f := stashFactory{o: makeTrashObj("PersistentVolumeClaim", "pvc1")}
+
+	s, err := NewStash(&f, "orphans", "v1/persistentvolumeclaims:fred/pvc1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1/persistentvolumeclaims", s.GVR)
+	assert.Equal(t, "v1/persistentvolumeclaims:fred/pvc1", f.key)
+
+	s, err = NewStash(&f, "v1/configmaps", "fred/cm1")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1/configmaps", s.GVR)
+	assert.Equal(t, "v1/configmaps:fred/cm1", f.key)
+
+	_, err = NewStash(&f, "orphans", "fred/pvc1")
+	assert.NotNil(t, err)
+}
+
+func TestRestorable(t *testing.T) {
+	uu := map[string]struct {
+		o    *unstructured.Unstructured
+		gone [][]string
+		kept [][]string
+	}{
+		"meta": {
+			o: makeTrashObj("ConfigMap", "cm1"),
+			gone: [][]string{
+				{"metadata", "uid"},
+				{"metadata", "resourceVersion"},
+				{"metadata", "ownerReferences"},
+				{"status"},
+			},
+			kept: [][]string{{"metadata", "labels", "app"}, {"data", "a"}},
+		},
+		"svc": {
+			o:    withSpec(makeTrashObj("Service", "s1"), map[string]interface{}{"clusterIP": "10.0.0.1", "clusterIPs": []interface{}{"10.0.0.1"}}),
+			gone: [][]string{{"spec", "clusterIP"}, {"spec", "clusterIPs"}},
+		},
+		"headless": {
+			o:    withSpec(makeTrashObj("Service", "s1"), map[string]interface{}{"clusterIP": "None"}),
+			kept: [][]string{{"spec", "clusterIP"}},
+		},
+		"job": {
+			o: withSpec(makeTrashObj("Job", "j1"), map[string]interface{}{
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"controller-uid": "x"}},
+				"template": map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"controller-uid": "x", "job-name": "j1"}}},
+			}),
+			gone: [][]string{{"spec", "selector"}, {"spec", "template", "metadata", "labels", "controller-uid"}},
+			kept: [][]string{{"spec", "template", "metadata", "labels", "job-name"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := restorable(u.o.Object)
+			for _, f := range u.gone {
+				_, ok, _ := unstructured.NestedFieldNoCopy(o.Object, f...)
+				assert.False(t, ok, f)
+			}
+			for _, f := range u.kept {
+				_, ok, _ := unstructured.NestedFieldNoCopy(o.Object, f...)
+				assert.True(t, ok, f)
+			}
+			_, ok, _ := unstructured.NestedString(u.o.Object, "metadata", "uid")
+			assert.True(t, ok)
+		})
+	}
+}
+
+// Helpers...
+
+func makeTrashObj(kind, n string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       kind,
+		"metadata": map[string]interface{}{
+			"name":            n,
+			"namespace":       "fred",
+			"uid":             "1234",
+			"resourceVersion": "42",
+			"labels":          map[string]interface{}{"app": "blee"},
+			"ownerReferences": []interface{}{map[string]interface{}{"kind": "Blee"}},
+		},
+		"data":   map[string]interface{}{"a": "b"},
+		"status": map[string]interface{}{"phase": "Active"},
+	}}
+}
+
+func withSpec(o *unstructured.Unstructured, spec map[string]interface{}) *unstructured.Unstructured {
+	o.Object["spec"] = spec
+	return o
+}
+
+type stashFactory struct {
+	Factory
+
+	o   *unstructured.Unstructured
+	key string
+}
+
+func (f *stashFactory) Get(gvr, path string, _ bool, _ labels.Selector) (runtime.Object, error) {
+	f.key = gvr + ":" + path
+	return f.o, nil
+}
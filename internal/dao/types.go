@@ -86,6 +86,9 @@ type NodeMaintainer interface {
 	// ToggleCordon toggles cordon/uncordon a node.
 	ToggleCordon(path string, cordon bool) error
 
+	// Cordon cordons a node and records why.
+	Cordon(path, reason string) error
+
 	// Drain drains the given node.
 	Drain(path string, opts DrainOptions, w io.Writer) error
 }
@@ -135,6 +138,12 @@ type Restartable interface {
 	Restart(ctx context.Context, path string) error
 }
 
+// Pausable represents a resource which autoscaling or rollouts can be paused.
+type Pausable interface {
+	// Pause pauses or resumes the resource.
+	Pause(ctx context.Context, path string, pause bool) error
+}
+
 // Runnable represents a runnable resource.
 type Runnable interface {
 	// Run triggers a run.
@@ -0,0 +1,272 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	appsv1 "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/version"
+)
+
+const (
+	// SeverityCritical tracks findings that block or break an upgrade.
+	SeverityCritical = "critical"
+	// SeverityWarning tracks findings that degrade an upgrade.
+	SeverityWarning = "warning"
+)
+
+// UpgradeFinding represents a cluster upgrade readiness issue.
+type UpgradeFinding struct {
+	Severity string
+	Check    string
+	GVR, FQN string
+	Message  string
+}
+
+// UpgradeReadiness represents a cluster pre-upgrade readiness report.
+type UpgradeReadiness struct {
+	NonResource
+}
+
+// List returns all upgrade readiness findings.
+func (u *UpgradeReadiness) List(ctx context.Context, ns string) ([]runtime.Object, error) {
+	ff, err := CheckUpgradeReadiness(u.Factory, ServerMinor(u.Client()))
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(ff))
+	for _, f := range ff {
+		fns, n := client.Namespaced(f.FQN)
+		if !client.IsClusterWide(ns) && fns != ns {
+			continue
+		}
+		oo = append(oo, render.UpgradeCheck{
+			Severity:  f.Severity,
+			Check:     f.Check,
+			Namespace: fns,
+			Name:      n,
+			GVR:       f.GVR,
+			Message:   f.Message,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given finding.
+func (u *UpgradeReadiness) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
+
+// CheckUpgradeReadiness collects deprecated api usages, kubelet version skews,
+// disruption budget gaps and single replica workloads given the cluster minor version.
+func CheckUpgradeReadiness(f Factory, minor int) ([]UpgradeFinding, error) {
+	uu, err := ScanDeprecatedAPIs(f)
+	if err != nil {
+		return nil, err
+	}
+	ff := deprecationFindings(uu, minor)
+
+	skews, err := kubeletSkews(f, minor)
+	if err != nil {
+		return nil, err
+	}
+	ff = append(ff, skews...)
+
+	avail, err := availabilityFindings(f)
+	if err != nil {
+		return nil, err
+	}
+	ff = append(ff, avail...)
+
+	sort.SliceStable(ff, func(i, j int) bool {
+		if ff[i].Severity != ff[j].Severity {
+			return ff[i].Severity == SeverityCritical
+		}
+		if ff[i].Check != ff[j].Check {
+			return ff[i].Check < ff[j].Check
+		}
+		return ff[i].FQN < ff[j].FQN
+	})
+
+	return ff, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func deprecationFindings(uu []DeprecatedUsage, minor int) []UpgradeFinding {
+	ff := make([]UpgradeFinding, 0, len(uu))
+	for _, u := range uu {
+		f := UpgradeFinding{
+			Severity: SeverityWarning,
+			Check:    "deprecated-api",
+			GVR:      u.GVR,
+			FQN:      u.FQN,
+			Message:  fmt.Sprintf("%s %s is deprecated", u.Kind, u.APIVersion),
+		}
+		if u.Replacement != "" {
+			f.Message += ", use " + u.Replacement
+		}
+		if issue := u.Issue(minor); issue != "" {
+			f.Severity, f.Message = SeverityCritical, f.Message+" ("+issue+")"
+		}
+		ff = append(ff, f)
+	}
+
+	return ff
+}
+
+func kubeletSkews(f Factory, minor int) ([]UpgradeFinding, error) {
+	if minor == 0 {
+		return nil, nil
+	}
+	oo, err := f.List("v1/nodes", client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var ff []UpgradeFinding
+	for _, o := range oo {
+		var no v1.Node
+		if err := fromUnstructured(o, &no); err != nil {
+			return nil, err
+		}
+		kv := no.Status.NodeInfo.KubeletVersion
+		v, err := version.ParseGeneric(kv)
+		if err != nil {
+			continue
+		}
+		f := UpgradeFinding{Check: "kubelet-skew", GVR: "v1/nodes", FQN: no.Name}
+		km, next := int(v.Minor()), minor+1
+		switch {
+		case km > minor:
+			f.Severity, f.Message = SeverityCritical, fmt.Sprintf("kubelet %s is newer than the api server 1.%d", kv, minor)
+		case next-km > render.MaxKubeletSkew(next):
+			f.Severity, f.Message = SeverityCritical, fmt.Sprintf("kubelet %s exceeds the supported skew of %d minors once upgraded to 1.%d", kv, render.MaxKubeletSkew(next), next)
+		case km < minor:
+			f.Severity, f.Message = SeverityWarning, fmt.Sprintf("kubelet %s lags the api server 1.%d", kv, minor)
+		default:
+			continue
+		}
+		ff = append(ff, f)
+	}
+
+	return ff, nil
+}
+
+// availabilityFindings flags PDBs blocking node drains and workloads that go down while draining.
+func availabilityFindings(f Factory) ([]UpgradeFinding, error) {
+	gvr := pdbGVR()
+	oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		ff   []UpgradeFinding
+		pdbs []policyv1.PodDisruptionBudget
+	)
+	for _, o := range oo {
+		var pdb policyv1.PodDisruptionBudget
+		if err := fromUnstructured(o, &pdb); err != nil {
+			return nil, err
+		}
+		pdbs = append(pdbs, pdb)
+		if pdb.Status.ExpectedPods > 0 && pdb.Status.DisruptionsAllowed == 0 {
+			ff = append(ff, UpgradeFinding{
+				Severity: SeverityCritical,
+				Check:    "pdb",
+				GVR:      gvr,
+				FQN:      client.FQN(pdb.Namespace, pdb.Name),
+				Message:  fmt.Sprintf("allows no disruptions (%d/%d healthy), node drains will block", pdb.Status.CurrentHealthy, pdb.Status.ExpectedPods),
+			})
+		}
+	}
+
+	for _, gvr := range []string{"apps/v1/deployments", "apps/v1/statefulsets"} {
+		oo, err := f.List(gvr, client.AllNamespaces, true, labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, o := range oo {
+			ns, n, replicas, tpl, err := workloadSpec(gvr, o)
+			if err != nil {
+				return nil, err
+			}
+			fqn := client.FQN(ns, n)
+			switch {
+			case replicas == 1:
+				ff = append(ff, UpgradeFinding{
+					Severity: SeverityWarning,
+					Check:    "single-replica",
+					GVR:      gvr,
+					FQN:      fqn,
+					Message:  "single replica, unavailable while its node drains",
+				})
+			case replicas > 1 && !coveredByPDB(pdbs, ns, tpl):
+				ff = append(ff, UpgradeFinding{
+					Severity: SeverityWarning,
+					Check:    "pdb",
+					GVR:      gvr,
+					FQN:      fqn,
+					Message:  fmt.Sprintf("%d replicas not covered by a PDB", replicas),
+				})
+			}
+		}
+	}
+
+	return ff, nil
+}
+
+func workloadSpec(gvr string, o runtime.Object) (string, string, int32, labels.Set, error) {
+	var (
+		meta     metav1.ObjectMeta
+		replicas *int32
+		tpl      map[string]string
+	)
+	switch gvr {
+	case "apps/v1/statefulsets":
+		var sts appsv1.StatefulSet
+		if err := fromUnstructured(o, &sts); err != nil {
+			return "", "", 0, nil, err
+		}
+		meta, replicas, tpl = sts.ObjectMeta, sts.Spec.Replicas, sts.Spec.Template.Labels
+	default:
+		var dp appsv1.Deployment
+		if err := fromUnstructured(o, &dp); err != nil {
+			return "", "", 0, nil, err
+		}
+		meta, replicas, tpl = dp.ObjectMeta, dp.Spec.Replicas, dp.Spec.Template.Labels
+	}
+	r := int32(1)
+	if replicas != nil {
+		r = *replicas
+	}
+
+	return meta.Namespace, meta.Name, r, labels.Set(tpl), nil
+}
+
+func coveredByPDB(pdbs []policyv1.PodDisruptionBudget, ns string, ll labels.Set) bool {
+	for _, pdb := range pdbs {
+		if pdb.Namespace != ns || pdb.Spec.Selector == nil {
+			continue
+		}
+		sel, err := metav1.LabelSelectorAsSelector(pdb.Spec.Selector)
+		if err == nil && !sel.Empty() && sel.Matches(ll) {
+			return true
+		}
+	}
+
+	return false
+}
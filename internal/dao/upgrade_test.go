@@ -0,0 +1,93 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestDeprecationFindings(t *testing.T) {
+	uu := []DeprecatedUsage{
+		{GVR: "batch/v1/cronjobs", FQN: "default/cj", Kind: "CronJob", APIVersion: "batch/v1beta1", Replacement: "batch/v1", Removed: 25},
+		{GVR: "policy/v1beta1/podsecuritypolicies", FQN: "psp", Kind: "PodSecurityPolicy", APIVersion: "policy/v1beta1", Removed: 25},
+		{GVR: "autoscaling/v2/horizontalpodautoscalers", FQN: "default/hpa", Kind: "HorizontalPodAutoscaler", APIVersion: "autoscaling/v2beta2", Replacement: "autoscaling/v2", Removed: 26},
+	}
+
+	assert.Equal(t, []UpgradeFinding{
+		{Severity: SeverityCritical, Check: "deprecated-api", GVR: "batch/v1/cronjobs", FQN: "default/cj", Message: "CronJob batch/v1beta1 is deprecated, use batch/v1 (removed in next release 1.25)"},
+		{Severity: SeverityCritical, Check: "deprecated-api", GVR: "policy/v1beta1/podsecuritypolicies", FQN: "psp", Message: "PodSecurityPolicy policy/v1beta1 is deprecated (removed in next release 1.25)"},
+		{Severity: SeverityWarning, Check: "deprecated-api", GVR: "autoscaling/v2/horizontalpodautoscalers", FQN: "default/hpa", Message: "HorizontalPodAutoscaler autoscaling/v2beta2 is deprecated, use autoscaling/v2"},
+	}, deprecationFindings(uu, 24))
+}
+
+func TestKubeletSkews(t *testing.T) {
+	node := func(n, v string) runtime.Object {
+		return relObj("v1", "Node", "", n, nil, map[string]interface{}{
+			"status": map[string]interface{}{"nodeInfo": map[string]interface{}{"kubeletVersion": v}},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"v1/nodes": {
+			node("current", "v1.26.3"),
+			node("lagging", "v1.25.1-eks-1234"),
+			node("ancient", "v1.24.9"),
+			node("newer", "v1.27.0"),
+			node("junk", "dev"),
+		},
+	}}
+
+	ff, err := kubeletSkews(f, 26)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradeFinding{
+		{Severity: SeverityWarning, Check: "kubelet-skew", GVR: "v1/nodes", FQN: "lagging", Message: "kubelet v1.25.1-eks-1234 lags the api server 1.26"},
+		{Severity: SeverityCritical, Check: "kubelet-skew", GVR: "v1/nodes", FQN: "ancient", Message: "kubelet v1.24.9 exceeds the supported skew of 2 minors once upgraded to 1.27"},
+		{Severity: SeverityCritical, Check: "kubelet-skew", GVR: "v1/nodes", FQN: "newer", Message: "kubelet v1.27.0 is newer than the api server 1.26"},
+	}, ff)
+
+	ff, err = kubeletSkews(f, 0)
+	assert.Nil(t, err)
+	assert.Empty(t, ff)
+}
+
+func TestAvailabilityFindings(t *testing.T) {
+	workload := func(kind, n string, replicas int64, app string) runtime.Object {
+		return relObj("apps/v1", kind, "default", n, nil, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"replicas": replicas,
+				"template": map[string]interface{}{"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": app}}},
+			},
+		})
+	}
+	pdb := func(n, app string, expected, allowed int64) runtime.Object {
+		return relObj("policy/v1", "PodDisruptionBudget", "default", n, nil, map[string]interface{}{
+			"spec": map[string]interface{}{
+				"selector": map[string]interface{}{"matchLabels": map[string]interface{}{"app": app}},
+			},
+			"status": map[string]interface{}{"expectedPods": expected, "currentHealthy": expected, "disruptionsAllowed": allowed},
+		})
+	}
+	f := relFactory{rows: map[string][]runtime.Object{
+		"policy/v1/poddisruptionbudgets": {
+			pdb("web", "web", 3, 1),
+			pdb("db", "db", 2, 0),
+		},
+		"apps/v1/deployments": {
+			workload("Deployment", "web", 3, "web"),
+			workload("Deployment", "api", 2, "api"),
+			workload("Deployment", "cron", 1, "cron"),
+			workload("Deployment", "off", 0, "off"),
+		},
+		"apps/v1/statefulsets": {
+			workload("StatefulSet", "db", 2, "db"),
+		},
+	}}
+
+	ff, err := availabilityFindings(f)
+	assert.Nil(t, err)
+	assert.Equal(t, []UpgradeFinding{
+		{Severity: SeverityCritical, Check: "pdb", GVR: "policy/v1/poddisruptionbudgets", FQN: "default/db", Message: "allows no disruptions (2/2 healthy), node drains will block"},
+		{Severity: SeverityWarning, Check: "pdb", GVR: "apps/v1/deployments", FQN: "default/api", Message: "2 replicas not covered by a PDB"},
+		{Severity: SeverityWarning, Check: "single-replica", GVR: "apps/v1/deployments", FQN: "default/cron", Message: "single replica, unavailable while its node drains"},
+	}, ff)
+}
@@ -0,0 +1,205 @@
+package dao
+
+import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	veleroAPIVersion     = "velero.io/v1"
+	veleroRestoreGVR     = "velero.io/v1/restores"
+	veleroBackupGVR      = "velero.io/v1/backups"
+	veleroDownloadGVR    = "velero.io/v1/downloadrequests"
+	veleroStampFmt       = "20060102150405"
+	veleroDownloadWait   = 30 * time.Second
+	veleroDownloadPoll   = 500 * time.Millisecond
+	veleroProcessedPhase = "Processed"
+)
+
+var (
+	_ Accessor = (*Backup)(nil)
+	_ Accessor = (*Schedule)(nil)
+)
+
+// Backup represents a Velero Backup.
+type Backup struct {
+	Resource
+}
+
+// Restore triggers a new restore from a given backup.
+func (b *Backup) Restore(ctx context.Context, path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	r := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": veleroAPIVersion,
+		"kind":       "Restore",
+		"metadata": map[string]interface{}{
+			"name":      veleroName(n),
+			"namespace": ns,
+		},
+		"spec": map[string]interface{}{
+			"backupName": n,
+		},
+	}}
+
+	var g Generic
+	g.Init(b.GetFactory(), client.NewGVR(veleroRestoreGVR))
+	o, err := g.Create(ctx, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(o.GetNamespace(), o.GetName()), nil
+}
+
+// Logs retrieves a backup logs from object storage.
+func (b *Backup) Logs(ctx context.Context, path string) (string, error) {
+	ns, n := client.Namespaced(path)
+	dr := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": veleroAPIVersion,
+		"kind":       "DownloadRequest",
+		"metadata": map[string]interface{}{
+			"name":      veleroName(n),
+			"namespace": ns,
+		},
+		"spec": map[string]interface{}{
+			"target": map[string]interface{}{
+				"kind": "BackupLog",
+				"name": n,
+			},
+		},
+	}}
+
+	var g Generic
+	g.Init(b.GetFactory(), client.NewGVR(veleroDownloadGVR))
+	o, err := g.Create(ctx, &dr)
+	if err != nil {
+		return "", err
+	}
+	fqn := client.FQN(o.GetNamespace(), o.GetName())
+	defer func() {
+		if err := g.Delete(context.Background(), fqn, nil, DefaultGrace); err != nil {
+			log.Warn().Err(err).Msgf("Unable to delete download request %s", fqn)
+		}
+	}()
+
+	url, err := veleroDownloadURL(ctx, &g, fqn)
+	if err != nil {
+		return "", err
+	}
+
+	return veleroFetch(ctx, url)
+}
+
+// Schedule represents a Velero Schedule.
+type Schedule struct {
+	Resource
+}
+
+// Backup triggers an on-demand backup using a schedule template.
+func (s *Schedule) Backup(ctx context.Context, path string) (string, error) {
+	o, err := s.Generic.Get(ctx, path)
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	tpl, _, err := unstructured.NestedMap(u.Object, "spec", "template")
+	if err != nil {
+		return "", err
+	}
+
+	b := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": veleroAPIVersion,
+		"kind":       "Backup",
+		"metadata": map[string]interface{}{
+			"name":      veleroName(u.GetName()),
+			"namespace": u.GetNamespace(),
+			"labels": map[string]interface{}{
+				render.VeleroScheduleLabel: u.GetName(),
+			},
+		},
+		"spec": tpl,
+	}}
+
+	var g Generic
+	g.Init(s.GetFactory(), client.NewGVR(veleroBackupGVR))
+	nb, err := g.Create(ctx, &b)
+	if err != nil {
+		return "", err
+	}
+
+	return client.FQN(nb.GetNamespace(), nb.GetName()), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func veleroName(prefix string) string {
+	return prefix + "-" + time.Now().UTC().Format(veleroStampFmt)
+}
+
+func veleroDownloadURL(ctx context.Context, g *Generic, path string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, veleroDownloadWait)
+	defer cancel()
+	for {
+		o, err := g.Get(ctx, path)
+		if err != nil {
+			return "", err
+		}
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+		}
+		phase, _, _ := unstructured.NestedString(u.Object, "status", "phase")
+		url, _, _ := unstructured.NestedString(u.Object, "status", "downloadURL")
+		if phase == veleroProcessedPhase && url != "" {
+			return url, nil
+		}
+		select {
+		case <-ctx.Done():
+			return "", errors.New("timed out waiting for velero download request. Is velero running?")
+		case <-time.After(veleroDownloadPoll):
+		}
+	}
+}
+
+func veleroFetch(ctx context.Context, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, veleroDownloadWait)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
+	if err != nil {
+		return "", err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("log download failed with status %s", resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	defer gz.Close()
+	bb, err := io.ReadAll(gz)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bb), nil
+}
@@ -0,0 +1,207 @@
+package dao
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	// VolumeSnapshotGVR tracks the CSI volume snapshots resource.
+	VolumeSnapshotGVR = "snapshot.storage.k8s.io/v1/volumesnapshots"
+
+	// VolumeSnapshotContentGVR tracks the CSI volume snapshot contents resource.
+	VolumeSnapshotContentGVR = "snapshot.storage.k8s.io/v1/volumesnapshotcontents"
+
+	volumeSnapshotClassGVR    = "snapshot.storage.k8s.io/v1/volumesnapshotclasses"
+	defaultSnapshotClassAnn   = "snapshot.storage.kubernetes.io/is-default-class"
+	snapshotAPIGroup          = "snapshot.storage.k8s.io"
+	defaultRestoredAccessMode = v1.ReadWriteOnce
+)
+
+var errNoSnapshotCRDs = errors.New("volume snapshot CRDs are not installed on this cluster")
+
+// SnapshotClasses returns the volume snapshot classes along with the default class if any.
+func SnapshotClasses(f Factory) ([]string, string, error) {
+	if _, err := MetaAccess.MetaFor(client.NewGVR(volumeSnapshotClassGVR)); err != nil {
+		return nil, "", errNoSnapshotCRDs
+	}
+	oo, err := f.List(volumeSnapshotClassGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, "", err
+	}
+	var (
+		nn  = make([]string, 0, len(oo))
+		def string
+	)
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		nn = append(nn, u.GetName())
+		if u.GetAnnotations()[defaultSnapshotClassAnn] == "true" {
+			def = u.GetName()
+		}
+	}
+	sort.Strings(nn)
+
+	return nn, def, nil
+}
+
+// SnapshotPVC creates a volume snapshot of a given claim. An empty class uses the default snapshot class.
+func SnapshotPVC(ctx context.Context, f Factory, pvcPath, name, class string) error {
+	if _, err := MetaAccess.MetaFor(client.NewGVR(VolumeSnapshotGVR)); err != nil {
+		return errNoSnapshotCRDs
+	}
+	ns, pvc := client.Namespaced(pvcPath)
+	var g Generic
+	g.Init(f, client.NewGVR(VolumeSnapshotGVR))
+	_, err := g.Create(ctx, newVolumeSnapshot(ns, name, pvc, class))
+
+	return err
+}
+
+// RestoreSnapshot creates a new claim provisioned from a given volume snapshot. An empty
+// storage class or size defaults to the snapshot source claim ones.
+func RestoreSnapshot(ctx context.Context, f Factory, snapPath, name, storageClass, size string) error {
+	o, err := f.Get(VolumeSnapshotGVR, snapPath, true, labels.Everything())
+	if err != nil {
+		return err
+	}
+	snap, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	var src *v1.PersistentVolumeClaim
+	if n, _, _ := unstructured.NestedString(snap.Object, "spec", "source", "persistentVolumeClaimName"); n != "" {
+		if o, err := f.Get("v1/persistentvolumeclaims", client.FQN(snap.GetNamespace(), n), true, labels.Everything()); err == nil {
+			var pvc v1.PersistentVolumeClaim
+			if err := fromUnstructured(o, &pvc); err == nil {
+				src = &pvc
+			}
+		}
+	}
+	pvc, err := restoredPVC(snap, src, name, storageClass, size)
+	if err != nil {
+		return err
+	}
+	var g Generic
+	g.Init(f, client.NewGVR("v1/persistentvolumeclaims"))
+	_, err = g.Create(ctx, pvc)
+
+	return err
+}
+
+// SnapshotContent returns the content path bound to a given volume snapshot.
+func SnapshotContent(f Factory, snapPath string) (string, error) {
+	o, err := f.Get(VolumeSnapshotGVR, snapPath, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	n, _, _ := unstructured.NestedString(u.Object, "status", "boundVolumeSnapshotContentName")
+	if n == "" {
+		return "", fmt.Errorf("volume snapshot %s is not bound", snapPath)
+	}
+
+	return client.FQN(client.ClusterScope, n), nil
+}
+
+// ContentSnapshot returns the volume snapshot path bound to a given snapshot content.
+func ContentSnapshot(f Factory, contentPath string) (string, error) {
+	o, err := f.Get(VolumeSnapshotContentGVR, contentPath, true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return "", fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	ns, _, _ := unstructured.NestedString(u.Object, "spec", "volumeSnapshotRef", "namespace")
+	n, _, _ := unstructured.NestedString(u.Object, "spec", "volumeSnapshotRef", "name")
+	if n == "" {
+		return "", fmt.Errorf("volume snapshot content %s has no snapshot reference", contentPath)
+	}
+
+	return client.FQN(ns, n), nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newVolumeSnapshot(ns, name, pvc, class string) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{"persistentVolumeClaimName": pvc},
+	}
+	if class != "" {
+		spec["volumeSnapshotClassName"] = class
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": snapshotAPIGroup + "/v1",
+		"kind":       "VolumeSnapshot",
+		"metadata":   map[string]interface{}{"name": name, "namespace": ns},
+		"spec":       spec,
+	}}
+}
+
+// restoredPVC builds a claim provisioned from a volume snapshot.
+func restoredPVC(snap *unstructured.Unstructured, src *v1.PersistentVolumeClaim, name, storageClass, size string) (*unstructured.Unstructured, error) {
+	if size == "" {
+		size, _, _ = unstructured.NestedString(snap.Object, "status", "restoreSize")
+	}
+	if size == "" && src != nil {
+		q := src.Spec.Resources.Requests[v1.ResourceStorage]
+		size = q.String()
+	}
+	q, err := resource.ParseQuantity(size)
+	if err != nil {
+		return nil, fmt.Errorf("invalid size %q", size)
+	}
+
+	modes := []interface{}{string(defaultRestoredAccessMode)}
+	if src != nil && len(src.Spec.AccessModes) > 0 {
+		modes = modes[:0]
+		for _, m := range src.Spec.AccessModes {
+			modes = append(modes, string(m))
+		}
+	}
+	spec := map[string]interface{}{
+		"accessModes": modes,
+		"dataSource": map[string]interface{}{
+			"apiGroup": snapshotAPIGroup,
+			"kind":     "VolumeSnapshot",
+			"name":     snap.GetName(),
+		},
+		"resources": map[string]interface{}{
+			"requests": map[string]interface{}{string(v1.ResourceStorage): q.String()},
+		},
+	}
+	if storageClass == "" && src != nil && src.Spec.StorageClassName != nil {
+		storageClass = *src.Spec.StorageClassName
+	}
+	if storageClass != "" {
+		spec["storageClassName"] = storageClass
+	}
+	if src != nil && src.Spec.VolumeMode != nil {
+		spec["volumeMode"] = string(*src.Spec.VolumeMode)
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "PersistentVolumeClaim",
+		"metadata":   map[string]interface{}{"name": name, "namespace": snap.GetNamespace()},
+		"spec":       spec,
+	}}, nil
+}
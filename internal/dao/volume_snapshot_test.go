@@ -0,0 +1,120 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNewVolumeSnapshot(t *testing.T) {
+	o := newVolumeSnapshot("default", "data-1", "data", "csi-snap")
+
+	assert.Equal(t, "VolumeSnapshot", o.GetKind())
+	assert.Equal(t, "default", o.GetNamespace())
+	pvc, _, _ := unstructured.NestedString(o.Object, "spec", "source", "persistentVolumeClaimName")
+	assert.Equal(t, "data", pvc)
+	class, _, _ := unstructured.NestedString(o.Object, "spec", "volumeSnapshotClassName")
+	assert.Equal(t, "csi-snap", class)
+
+	o = newVolumeSnapshot("default", "data-1", "data", "")
+	_, ok, _ := unstructured.NestedString(o.Object, "spec", "volumeSnapshotClassName")
+	assert.False(t, ok)
+}
+
+func TestRestoredPVC(t *testing.T) {
+	sc, block := "fast", v1.PersistentVolumeBlock
+	src := v1.PersistentVolumeClaim{
+		Spec: v1.PersistentVolumeClaimSpec{
+			AccessModes:      []v1.PersistentVolumeAccessMode{v1.ReadWriteMany},
+			StorageClassName: &sc,
+			VolumeMode:       &block,
+			Resources: v1.ResourceRequirements{
+				Requests: v1.ResourceList{v1.ResourceStorage: resource.MustParse("5Gi")},
+			},
+		},
+	}
+
+	uu := map[string]struct {
+		snap        *unstructured.Unstructured
+		src         *v1.PersistentVolumeClaim
+		class, size string
+		eClass      string
+		eSize       string
+		eModes      []interface{}
+		err         string
+	}{
+		"restore-size": {
+			snap:   makeSnapshot("8Gi"),
+			src:    &src,
+			eClass: "fast",
+			eSize:  "8Gi",
+			eModes: []interface{}{"ReadWriteMany"},
+		},
+		"overrides": {
+			snap:   makeSnapshot("8Gi"),
+			src:    &src,
+			class:  "slow",
+			size:   "10Gi",
+			eClass: "slow",
+			eSize:  "10Gi",
+			eModes: []interface{}{"ReadWriteMany"},
+		},
+		"source-size": {
+			snap:   makeSnapshot(""),
+			src:    &src,
+			eClass: "fast",
+			eSize:  "5Gi",
+			eModes: []interface{}{"ReadWriteMany"},
+		},
+		"no-source": {
+			snap:   makeSnapshot("1Gi"),
+			eSize:  "1Gi",
+			eModes: []interface{}{"ReadWriteOnce"},
+		},
+		"no-size": {
+			snap: makeSnapshot(""),
+			err:  `invalid size ""`,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o, err := restoredPVC(u.snap, u.src, "restored", u.class, u.size)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, "restored", o.GetName())
+			assert.Equal(t, "default", o.GetNamespace())
+			class, _, _ := unstructured.NestedString(o.Object, "spec", "storageClassName")
+			assert.Equal(t, u.eClass, class)
+			size, _, _ := unstructured.NestedString(o.Object, "spec", "resources", "requests", "storage")
+			assert.Equal(t, u.eSize, size)
+			modes, _, _ := unstructured.NestedSlice(o.Object, "spec", "accessModes")
+			assert.Equal(t, u.eModes, modes)
+			ds, _, _ := unstructured.NestedString(o.Object, "spec", "dataSource", "name")
+			assert.Equal(t, "snap", ds)
+		})
+	}
+}
+
+// Helpers...
+
+func makeSnapshot(restoreSize string) *unstructured.Unstructured {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{"name": "snap", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"source": map[string]interface{}{"persistentVolumeClaimName": "data"},
+		},
+	}}
+	if restoreSize != "" {
+		_ = unstructured.SetNestedField(o.Object, restoreSize, "status", "restoreSize")
+	}
+
+	return &o
+}
@@ -0,0 +1,265 @@
+package dao
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"path"
+	"sort"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	admv1 "k8s.io/api/admissionregistration/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	validatingWebhooksGVR = "admissionregistration.k8s.io/v1/validatingwebhookconfigurations"
+	mutatingWebhooksGVR   = "admissionregistration.k8s.io/v1/mutatingwebhookconfigurations"
+
+	// webhookCAExpiryWarning tracks how soon before expiry a CA bundle gets flagged.
+	webhookCAExpiryWarning = 30 * 24 * time.Hour
+	defaultWebhookPort     = 443
+)
+
+// WebhookHealth represents an admission webhook backend health.
+type WebhookHealth struct {
+	GVR           string
+	Kind          string
+	Config        string
+	Webhook       string
+	Backend       string
+	FailurePolicy string
+	CAExpiry      time.Time
+	Issues        []string
+	Created       metav1.Time
+}
+
+// IsBlocking checks if a broken webhook rejects the requests it intercepts.
+func (w WebhookHealth) IsBlocking() bool {
+	return len(w.Issues) > 0 && w.FailurePolicy == string(admv1.Fail)
+}
+
+// Webhooks represents the admission webhooks health report.
+type Webhooks struct {
+	NonResource
+}
+
+// List returns all validating and mutating webhooks health.
+func (w *Webhooks) List(ctx context.Context, _ string) ([]runtime.Object, error) {
+	hh, err := CheckWebhooks(w.Factory, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	oo := make([]runtime.Object, 0, len(hh))
+	for _, h := range hh {
+		var expiry string
+		if !h.CAExpiry.IsZero() {
+			expiry = h.CAExpiry.Format("2006-01-02")
+		}
+		oo = append(oo, render.WebhookRes{
+			GVR:           h.GVR,
+			Kind:          h.Kind,
+			Config:        h.Config,
+			Webhook:       h.Webhook,
+			Backend:       h.Backend,
+			FailurePolicy: h.FailurePolicy,
+			CAExpiry:      expiry,
+			Issues:        h.Issues,
+			Created:       h.Created,
+		})
+	}
+
+	return oo, nil
+}
+
+// Get fetch a given webhook.
+func (w *Webhooks) Get(ctx context.Context, path string) (runtime.Object, error) {
+	return nil, errors.New("NYI")
+}
+
+// CheckWebhooks resolves each admission webhook backend service and CA bundle
+// and flags the webhooks that would fail the requests they intercept.
+func CheckWebhooks(f Factory, now time.Time) ([]WebhookHealth, error) {
+	var hh []WebhookHealth
+	oo, err := f.List(validatingWebhooksGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var cfg admv1.ValidatingWebhookConfiguration
+		if err := fromUnstructured(o, &cfg); err != nil {
+			return nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			h := webhookHealth(f, w.ClientConfig, w.FailurePolicy, now)
+			h.GVR, h.Kind, h.Config, h.Webhook, h.Created = validatingWebhooksGVR, "validating", cfg.Name, w.Name, cfg.CreationTimestamp
+			hh = append(hh, h)
+		}
+	}
+
+	oo, err = f.List(mutatingWebhooksGVR, client.ClusterScope, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	for _, o := range oo {
+		var cfg admv1.MutatingWebhookConfiguration
+		if err := fromUnstructured(o, &cfg); err != nil {
+			return nil, err
+		}
+		for _, w := range cfg.Webhooks {
+			h := webhookHealth(f, w.ClientConfig, w.FailurePolicy, now)
+			h.GVR, h.Kind, h.Config, h.Webhook, h.Created = mutatingWebhooksGVR, "mutating", cfg.Name, w.Name, cfg.CreationTimestamp
+			hh = append(hh, h)
+		}
+	}
+	sort.SliceStable(hh, func(i, j int) bool {
+		if hh[i].IsBlocking() != hh[j].IsBlocking() {
+			return hh[i].IsBlocking()
+		}
+		if hh[i].Config != hh[j].Config {
+			return hh[i].Config < hh[j].Config
+		}
+		return hh[i].Webhook < hh[j].Webhook
+	})
+
+	return hh, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func webhookHealth(f Factory, cc admv1.WebhookClientConfig, policy *admv1.FailurePolicyType, now time.Time) WebhookHealth {
+	h := WebhookHealth{FailurePolicy: string(admv1.Fail)}
+	if policy != nil {
+		h.FailurePolicy = string(*policy)
+	}
+
+	switch {
+	case cc.Service != nil:
+		h.Backend = webhookServiceBackend(cc.Service)
+		h.Issues = append(h.Issues, webhookServiceIssues(f, cc.Service)...)
+		if len(cc.CABundle) == 0 {
+			h.Issues = append(h.Issues, "missing CA bundle")
+		}
+	case cc.URL != nil:
+		h.Backend = *cc.URL
+	default:
+		h.Issues = append(h.Issues, "no service or url backend")
+	}
+	if len(cc.CABundle) == 0 {
+		return h
+	}
+
+	expiry, err := bundleExpiry(cc.CABundle)
+	if err != nil {
+		h.Issues = append(h.Issues, fmt.Sprintf("invalid CA bundle: %s", err))
+		return h
+	}
+	h.CAExpiry = expiry
+	switch {
+	case now.After(expiry):
+		h.Issues = append(h.Issues, fmt.Sprintf("CA bundle expired on %s", expiry.Format("2006-01-02")))
+	case expiry.Sub(now) < webhookCAExpiryWarning:
+		h.Issues = append(h.Issues, fmt.Sprintf("CA bundle expires on %s", expiry.Format("2006-01-02")))
+	}
+
+	return h
+}
+
+func webhookServiceBackend(ref *admv1.ServiceReference) string {
+	port := int32(defaultWebhookPort)
+	if ref.Port != nil {
+		port = *ref.Port
+	}
+	be := fmt.Sprintf("%s:%d", client.FQN(ref.Namespace, ref.Name), port)
+	if ref.Path != nil {
+		be += path.Join("/", *ref.Path)
+	}
+
+	return be
+}
+
+// webhookServiceIssues checks the webhook service exists, exposes the webhook port and has ready endpoints.
+func webhookServiceIssues(f Factory, ref *admv1.ServiceReference) []string {
+	fqn := client.FQN(ref.Namespace, ref.Name)
+	o, err := f.Get("v1/services", fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return []string{fmt.Sprintf("service %s not found", fqn)}
+	}
+	var svc v1.Service
+	if err := fromUnstructured(o, &svc); err != nil {
+		return []string{err.Error()}
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		return nil
+	}
+
+	port := int32(defaultWebhookPort)
+	if ref.Port != nil {
+		port = *ref.Port
+	}
+	var ii []string
+	if !hasServicePort(&svc, port) {
+		ii = append(ii, fmt.Sprintf("service %s has no port %d", fqn, port))
+	}
+	o, err = f.Get("v1/endpoints", fqn, true, labels.Everything())
+	if err != nil || o == nil {
+		return append(ii, fmt.Sprintf("service %s has no endpoints", fqn))
+	}
+	var ep v1.Endpoints
+	if err := fromUnstructured(o, &ep); err != nil {
+		return append(ii, err.Error())
+	}
+	for _, s := range ep.Subsets {
+		if len(s.Addresses) > 0 {
+			return ii
+		}
+	}
+
+	return append(ii, fmt.Sprintf("service %s has no ready endpoints", fqn))
+}
+
+func hasServicePort(svc *v1.Service, port int32) bool {
+	for _, p := range svc.Spec.Ports {
+		if p.Port == port {
+			return true
+		}
+	}
+
+	return false
+}
+
+// bundleExpiry returns the earliest expiry of the certificates in a PEM bundle.
+func bundleExpiry(raw []byte) (time.Time, error) {
+	var expiry time.Time
+	for {
+		var block *pem.Block
+		block, raw = pem.Decode(raw)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return expiry, err
+		}
+		if expiry.IsZero() || cert.NotAfter.Before(expiry) {
+			expiry = cert.NotAfter
+		}
+	}
+	if expiry.IsZero() {
+		return expiry, fmt.Errorf("no certificate found")
+	}
+
+	return expiry, nil
+}
@@ -0,0 +1,103 @@
+package dao
+
+import (
+	"encoding/base64"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCheckWebhooks(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	ca := base64.StdEncoding.EncodeToString(testCert(t, now.Add(365*24*time.Hour)))
+	soon := base64.StdEncoding.EncodeToString(testCert(t, now.Add(10*24*time.Hour)))
+	hook := func(n, svc string, port int64, policy, bundle string) map[string]interface{} {
+		cc := map[string]interface{}{
+			"service": map[string]interface{}{"namespace": "fred", "name": svc, "port": port, "path": "validate"},
+		}
+		if bundle != "" {
+			cc["caBundle"] = bundle
+		}
+		h := map[string]interface{}{"name": n, "clientConfig": cc}
+		if policy != "" {
+			h["failurePolicy"] = policy
+		}
+		return h
+	}
+	url := "https://hooks.example.com/mutate"
+	f := relFactory{rows: map[string][]runtime.Object{
+		validatingWebhooksGVR: {
+			relObj("admissionregistration.k8s.io/v1", "ValidatingWebhookConfiguration", "", "vwc", nil, map[string]interface{}{
+				"webhooks": []interface{}{
+					hook("ok.example.com", "hook", 443, "Fail", ca),
+					hook("gone.example.com", "gone", 443, "Ignore", ca),
+					hook("port.example.com", "hook", 8443, "", soon),
+				},
+			}),
+		},
+		mutatingWebhooksGVR: {
+			relObj("admissionregistration.k8s.io/v1", "MutatingWebhookConfiguration", "", "mwc", nil, map[string]interface{}{
+				"webhooks": []interface{}{
+					map[string]interface{}{"name": "url.example.com", "clientConfig": map[string]interface{}{"url": url}},
+					hook("down.example.com", "down", 443, "Fail", ""),
+				},
+			}),
+		},
+		"v1/services": {
+			relObj("v1", "Service", "fred", "hook", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"ports": []interface{}{map[string]interface{}{"port": int64(443)}}},
+			}),
+			relObj("v1", "Service", "fred", "down", nil, map[string]interface{}{
+				"spec": map[string]interface{}{"ports": []interface{}{map[string]interface{}{"port": int64(443)}}},
+			}),
+		},
+		"v1/endpoints": {
+			relObj("v1", "Endpoints", "fred", "hook", nil, map[string]interface{}{
+				"subsets": []interface{}{map[string]interface{}{
+					"addresses": []interface{}{map[string]interface{}{"ip": "10.0.0.1"}},
+				}},
+			}),
+			relObj("v1", "Endpoints", "fred", "down", nil, map[string]interface{}{
+				"subsets": []interface{}{map[string]interface{}{
+					"notReadyAddresses": []interface{}{map[string]interface{}{"ip": "10.0.0.2"}},
+				}},
+			}),
+		},
+	}}
+
+	hh, err := CheckWebhooks(f, now)
+	assert.NoError(t, err)
+
+	type result struct {
+		hook, backend, policy string
+		issues                []string
+		blocking              bool
+	}
+	rr := make([]result, 0, len(hh))
+	for _, h := range hh {
+		rr = append(rr, result{h.Webhook, h.Backend, h.FailurePolicy, h.Issues, h.IsBlocking()})
+	}
+	assert.Equal(t, []result{
+		{"down.example.com", "fred/down:443/validate", "Fail", []string{"service fred/down has no ready endpoints", "missing CA bundle"}, true},
+		{"port.example.com", "fred/hook:8443/validate", "Fail", []string{"service fred/hook has no port 8443", "CA bundle expires on 2023-06-11"}, true},
+		{"url.example.com", url, "Fail", nil, false},
+		{"gone.example.com", "fred/gone:443/validate", "Ignore", []string{"service fred/gone not found"}, false},
+		{"ok.example.com", "fred/hook:443/validate", "Fail", nil, false},
+	}, rr)
+	assert.Equal(t, "mutating", hh[0].Kind)
+	assert.Equal(t, "2024-05-31", hh[4].CAExpiry.Format("2006-01-02"))
+}
+
+func TestBundleExpiry(t *testing.T) {
+	now := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	bundle := append(testCert(t, now.Add(48*time.Hour)), testCert(t, now.Add(24*time.Hour))...)
+
+	e, err := bundleExpiry(bundle)
+	assert.NoError(t, err)
+	assert.Equal(t, now.Add(24*time.Hour), e.UTC())
+
+	_, err = bundleExpiry([]byte("junk"))
+	assert.Error(t, err)
+}
@@ -0,0 +1,161 @@
+package dao
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	workflowCompletedLabel = "workflows.argoproj.io/completed"
+	workflowNodeAnnotation = "workflows.argoproj.io/node-id"
+)
+
+var (
+	_ Accessor = (*Workflow)(nil)
+	_ Loggable = (*Workflow)(nil)
+)
+
+// Workflow represents an Argo Workflow.
+type Workflow struct {
+	Resource
+}
+
+// TailLogs tail logs for all the step pods of a Workflow.
+func (w *Workflow) TailLogs(ctx context.Context, opts *LogOptions) ([]LogChan, error) {
+	_, n := client.Namespaced(opts.Path)
+
+	return podLogs(ctx, map[string]string{render.ArgoWorkflowLabel: n}, opts)
+}
+
+// Terminate stops a running Workflow without running exit handlers.
+func (w *Workflow) Terminate(ctx context.Context, path string) error {
+	wf, err := w.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if wf.Status.IsCompleted() {
+		return fmt.Errorf("workflow %s is already completed", path)
+	}
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{"shutdown": "Terminate"},
+	})
+	if err != nil {
+		return err
+	}
+
+	return w.Patch(ctx, path, types.MergePatchType, patch)
+}
+
+// Retry resets the failed nodes of a completed Workflow so the controller runs them again.
+func (w *Workflow) Retry(ctx context.Context, path string) error {
+	wf, err := w.GetInstance(path)
+	if err != nil {
+		return err
+	}
+	if wf.Status.Phase != "Failed" && wf.Status.Phase != "Error" {
+		return fmt.Errorf("only failed workflows can be retried. %s is %s", path, wf.Status.Phase)
+	}
+
+	nodes, failed := retryNodes(wf.Status.Nodes)
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{workflowCompletedLabel: "false"},
+		},
+		"spec": map[string]interface{}{"shutdown": nil},
+		"status": map[string]interface{}{
+			"phase":      "Running",
+			"message":    nil,
+			"finishedAt": nil,
+			"nodes":      nodes,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	if err := w.Patch(ctx, path, types.MergePatchType, patch); err != nil {
+		return err
+	}
+
+	return w.deletePods(ctx, wf, failed)
+}
+
+// deletePods clears out the step pods of the given nodes.
+func (w *Workflow) deletePods(ctx context.Context, wf *render.ArgoWorkflow, nodes map[string]struct{}) error {
+	sel := labels.Set{render.ArgoWorkflowLabel: wf.Name}.AsSelector()
+	oo, err := w.GetFactory().List("v1/pods", wf.Namespace, true, sel)
+	if err != nil {
+		return err
+	}
+
+	var po Pod
+	po.Init(w.Factory, client.NewGVR("v1/pods"))
+	for _, o := range oo {
+		u, ok := o.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		if _, ok := nodes[u.GetAnnotations()[workflowNodeAnnotation]]; !ok {
+			continue
+		}
+		fqn := client.FQN(u.GetNamespace(), u.GetName())
+		if err := po.Delete(ctx, fqn, nil, DefaultGrace); err != nil {
+			log.Warn().Err(err).Msgf("Unable to delete workflow pod %s", fqn)
+		}
+	}
+
+	return nil
+}
+
+// GetInstance returns a Workflow instance.
+func (w *Workflow) GetInstance(path string) (*render.ArgoWorkflow, error) {
+	o, err := w.Get(context.Background(), path)
+	if err != nil {
+		return nil, err
+	}
+
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting *unstructured.Unstructured but got `%T", o)
+	}
+	if v, _, _ := unstructured.NestedString(u.Object, "status", "offloadNodeStatusVersion"); v != "" {
+		return nil, errors.New("offloaded workflow node status is not supported")
+	}
+	var wf render.ArgoWorkflow
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.Object, &wf)
+	if err != nil {
+		return nil, fmt.Errorf("expecting Workflow resource: %w", err)
+	}
+
+	return &wf, nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// retryNodes computes a nodes status patch removing failed pod nodes and
+// resetting their failed parents. It returns the patch and the removed pod nodes.
+func retryNodes(nn map[string]render.ArgoWorkflowNode) (map[string]interface{}, map[string]struct{}) {
+	patch, pods := make(map[string]interface{}), make(map[string]struct{})
+	for id, n := range nn {
+		if n.Phase != "Failed" && n.Phase != "Error" {
+			continue
+		}
+		if n.Type == "Pod" {
+			patch[id], pods[id] = nil, struct{}{}
+			continue
+		}
+		patch[id] = map[string]interface{}{"phase": "Running", "message": nil, "finishedAt": nil}
+	}
+
+	return patch, pods
+}
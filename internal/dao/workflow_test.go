@@ -0,0 +1,24 @@
+package dao
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryNodes(t *testing.T) {
+	nn := map[string]render.ArgoWorkflowNode{
+		"wf":   {ID: "wf", Type: "DAG", Phase: "Failed", Message: "child failed"},
+		"wf-1": {ID: "wf-1", Type: "Pod", Phase: "Succeeded"},
+		"wf-2": {ID: "wf-2", Type: "Pod", Phase: "Error"},
+		"wf-3": {ID: "wf-3", Type: "Skipped", Phase: "Skipped"},
+	}
+
+	patch, pods := retryNodes(nn)
+	assert.Equal(t, map[string]interface{}{
+		"wf":   map[string]interface{}{"phase": "Running", "message": nil, "finishedAt": nil},
+		"wf-2": nil,
+	}, patch)
+	assert.Equal(t, map[string]struct{}{"wf-2": {}}, pods)
+}
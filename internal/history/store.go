@@ -0,0 +1,230 @@
+package history
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+const (
+	queueSize  = 1000
+	clusterDir = "_cluster"
+	revExt     = ".jsonl"
+)
+
+// skippedGVRs tracks resources that are either sensitive or too noisy to record.
+var skippedGVRs = map[string]struct{}{
+	"v1/secrets":                    {},
+	"v1/events":                     {},
+	"events.k8s.io/v1/events":       {},
+	"coordination.k8s.io/v1/leases": {},
+}
+
+// Revision represents a recorded object revision.
+type Revision struct {
+	ResourceVersion string                 `json:"resourceVersion"`
+	At              time.Time              `json:"at"`
+	Object          map[string]interface{} `json:"object"`
+}
+
+type entry struct {
+	gvr string
+	rev Revision
+}
+
+type fileState struct {
+	rv    string
+	count int
+}
+
+// Store records objects revisions in a local per cluster directory.
+type Store struct {
+	dir   string
+	max   int
+	queue chan entry
+	files map[string]*fileState
+	done  chan struct{}
+	stop  bool
+	mx    sync.RWMutex
+}
+
+// NewStore returns a new store keeping at most max revisions per object.
+func NewStore(dir string, max int) *Store {
+	s := Store{
+		dir:   dir,
+		max:   max,
+		queue: make(chan entry, queueSize),
+		files: make(map[string]*fileState),
+		done:  make(chan struct{}),
+	}
+	go s.run()
+
+	return &s
+}
+
+// Record queues an object revision. Revisions are dropped if the store can not keep up.
+func (s *Store) Record(gvr string, o *unstructured.Unstructured) {
+	if _, ok := skippedGVRs[gvr]; ok || o == nil || o.GetName() == "" {
+		return
+	}
+	c := o.DeepCopy()
+	c.SetManagedFields(nil)
+	e := entry{
+		gvr: gvr,
+		rev: Revision{ResourceVersion: o.GetResourceVersion(), At: time.Now(), Object: c.Object},
+	}
+	s.mx.RLock()
+	defer s.mx.RUnlock()
+	if s.stop {
+		return
+	}
+	select {
+	case s.queue <- e:
+	default:
+		log.Warn().Msgf("History queue full. Dropping %s revision %s", gvr, e.rev.ResourceVersion)
+	}
+}
+
+// Stop flushes the pending revisions and terminates the store.
+func (s *Store) Stop() {
+	s.mx.Lock()
+	if !s.stop {
+		s.stop = true
+		close(s.queue)
+	}
+	s.mx.Unlock()
+	<-s.done
+}
+
+// Revisions returns the recorded revisions for a given object, oldest first.
+func Revisions(dir, gvr, fqn string) ([]Revision, error) {
+	f, err := os.Open(revPath(dir, gvr, fqn))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Warn().Err(err).Msgf("Closing history %s", f.Name())
+		}
+	}()
+
+	var rr []Revision
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		var r Revision
+		if err := json.Unmarshal(sc.Bytes(), &r); err != nil {
+			log.Warn().Err(err).Msgf("Skipping invalid revision in %s", f.Name())
+			continue
+		}
+		rr = append(rr, r)
+	}
+
+	return rr, sc.Err()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (s *Store) run() {
+	defer close(s.done)
+	for e := range s.queue {
+		if err := s.write(e); err != nil {
+			log.Warn().Err(err).Msgf("History record failed for %s", e.gvr)
+		}
+	}
+}
+
+func (s *Store) write(e entry) error {
+	o := unstructured.Unstructured{Object: e.rev.Object}
+	p := revPath(s.dir, e.gvr, fqn(o.GetNamespace(), o.GetName()))
+	st, ok := s.files[p]
+	if !ok {
+		rr, err := Revisions(s.dir, e.gvr, fqn(o.GetNamespace(), o.GetName()))
+		if err != nil {
+			return err
+		}
+		st = &fileState{count: len(rr)}
+		if len(rr) > 0 {
+			st.rv = rr[len(rr)-1].ResourceVersion
+		}
+		s.files[p] = st
+	}
+	if st.rv == e.rev.ResourceVersion {
+		return nil
+	}
+
+	raw, err := json.Marshal(e.rev)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(p), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(p, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Write(append(raw, '\n')); err != nil {
+		_ = f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	st.rv, st.count = e.rev.ResourceVersion, st.count+1
+	if st.count <= s.max {
+		return nil
+	}
+
+	return s.trim(p, st)
+}
+
+// trim keeps the latest revisions only.
+func (s *Store) trim(p string, st *fileState) error {
+	raw, err := os.ReadFile(p)
+	if err != nil {
+		return err
+	}
+	ll := bytes.SplitAfter(bytes.TrimSuffix(raw, []byte("\n")), []byte("\n"))
+	if len(ll) > s.max {
+		ll = ll[len(ll)-s.max:]
+	}
+	out := bytes.Join(ll, nil)
+	if !bytes.HasSuffix(out, []byte("\n")) {
+		out = append(out, '\n')
+	}
+	st.count = len(ll)
+
+	return os.WriteFile(p, out, 0600)
+}
+
+func fqn(ns, n string) string {
+	if ns == "" {
+		return n
+	}
+
+	return ns + "/" + n
+}
+
+func revPath(dir, gvr, fqn string) string {
+	ns, n := clusterDir, fqn
+	if i := strings.Index(fqn, "/"); i >= 0 {
+		ns, n = fqn[:i], fqn[i+1:]
+	}
+
+	return filepath.Join(dir, strings.ReplaceAll(gvr, "/", "_"), ns, n+revExt)
+}
@@ -0,0 +1,73 @@
+package history_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/history"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestStoreRecord(t *testing.T) {
+	dir := t.TempDir()
+	s := history.NewStore(dir, 3)
+	for _, rv := range []string{"1", "1", "2", "3", "4", "5", "5"} {
+		s.Record("apps/v1/deployments", makeObj("fred", "d1", rv))
+	}
+	s.Record("v1/secrets", makeObj("fred", "s1", "1"))
+	s.Record("v1/nodes", makeObj("", "n1", "1"))
+	s.Stop()
+	s.Record("apps/v1/deployments", makeObj("fred", "d1", "6"))
+
+	rr, err := history.Revisions(dir, "apps/v1/deployments", "fred/d1")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(rr))
+	assert.Equal(t, "3", rr[0].ResourceVersion)
+	assert.Equal(t, "5", rr[2].ResourceVersion)
+	_, ok := rr[2].Object["metadata"].(map[string]interface{})["managedFields"]
+	assert.False(t, ok)
+
+	rr, err = history.Revisions(dir, "v1/secrets", "fred/s1")
+	assert.Nil(t, err)
+	assert.Empty(t, rr)
+
+	rr, err = history.Revisions(dir, "v1/nodes", "n1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(rr))
+}
+
+func TestStoreResume(t *testing.T) {
+	dir := t.TempDir()
+	s := history.NewStore(dir, 10)
+	s.Record("v1/pods", makeObj("fred", "p1", "1"))
+	s.Stop()
+
+	s = history.NewStore(dir, 10)
+	s.Record("v1/pods", makeObj("fred", "p1", "1"))
+	s.Record("v1/pods", makeObj("fred", "p1", "2"))
+	s.Stop()
+
+	rr, err := history.Revisions(dir, "v1/pods", "fred/p1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(rr))
+}
+
+// Helpers...
+
+func makeObj(ns, n, rv string) *unstructured.Unstructured {
+	m := map[string]interface{}{
+		"name":            n,
+		"resourceVersion": rv,
+		"managedFields":   []interface{}{map[string]interface{}{"manager": "kubectl"}},
+	}
+	if ns != "" {
+		m["namespace"] = ns
+	}
+
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Blee",
+		"metadata":   m,
+		"spec":       map[string]interface{}{"rv": rv},
+	}}
+}
@@ -0,0 +1,155 @@
+// Package i18n translates the TUI labels, prompts and messages. Bundles map the
+// english source strings to their translation so untranslated strings fall back
+// to english.
+package i18n
+
+import (
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"gopkg.in/yaml.v2"
+)
+
+// DefaultLang tracks the source language.
+const DefaultLang = "en"
+
+const (
+	bundleExt = ".yaml"
+
+	// verbFmt tracks a label template translating the first word only ie `Sort %s`.
+	verbFmt = "%s %%s"
+)
+
+//go:embed locales/*.yaml
+var builtins embed.FS
+
+var (
+	mx      sync.RWMutex
+	lang    = DefaultLang
+	catalog map[string]string
+)
+
+// Load activates a language. Bundles found in the given directory extend or override
+// the builtin ones.
+func Load(l, dir string) error {
+	l = strings.ToLower(strings.TrimSpace(l))
+	if l == "" || l == DefaultLang {
+		set(DefaultLang, nil)
+		return nil
+	}
+
+	c := make(map[string]string)
+	found, err := loadBundle(c, func() ([]byte, error) {
+		return builtins.ReadFile("locales/" + l + bundleExt)
+	})
+	if err != nil {
+		return err
+	}
+	if dir != "" {
+		ok, err := loadBundle(c, func() ([]byte, error) {
+			return os.ReadFile(filepath.Join(dir, l+bundleExt))
+		})
+		if err != nil {
+			return err
+		}
+		found = found || ok
+	}
+	if !found {
+		set(DefaultLang, nil)
+		return fmt.Errorf("no %q locale bundle found", l)
+	}
+	set(l, c)
+
+	return nil
+}
+
+// Lang returns the active language.
+func Lang() string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	return lang
+}
+
+// Languages returns the builtin languages.
+func Languages() []string {
+	ll := []string{DefaultLang}
+	ee, _ := builtins.ReadDir("locales")
+	for _, e := range ee {
+		ll = append(ll, strings.TrimSuffix(e.Name(), bundleExt))
+	}
+	sort.Strings(ll)
+
+	return ll
+}
+
+// T translates a message or returns it as is when no translation is available.
+func T(msg string) string {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	if t, ok := catalog[msg]; ok && t != "" {
+		return t
+	}
+
+	return msg
+}
+
+// Tf translates a message format prior to formatting it.
+func Tf(fmat string, args ...interface{}) string {
+	return fmt.Sprintf(T(fmat), args...)
+}
+
+// Label translates a menu label. Labels without a translation of their own fall back
+// to their verb template if any ie `Sort %s` for `Sort Name`.
+func Label(l string) string {
+	if t := T(l); t != l {
+		return t
+	}
+	i := strings.Index(l, " ")
+	if i <= 0 {
+		return l
+	}
+	tpl := fmt.Sprintf(verbFmt, l[:i])
+	if t := T(tpl); t != tpl {
+		return fmt.Sprintf(t, l[i+1:])
+	}
+
+	return l
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func set(l string, c map[string]string) {
+	mx.Lock()
+	defer mx.Unlock()
+
+	lang, catalog = l, c
+}
+
+func loadBundle(c map[string]string, read func() ([]byte, error)) (bool, error) {
+	raw, err := read()
+	if errors.Is(err, fs.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	var b map[string]string
+	if err := yaml.Unmarshal(raw, &b); err != nil {
+		return false, err
+	}
+	for k, v := range b {
+		c[k] = v
+	}
+
+	return true, nil
+}
@@ -0,0 +1,92 @@
+package i18n_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLoad(t *testing.T) {
+	defer func() { _ = i18n.Load(i18n.DefaultLang, "") }()
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "fr.yaml"), []byte("Describe: Décrire!\nZorg: Zorgue\n"), 0600))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "xx.yaml"), []byte("Describe: Dxx\n"), 0600))
+
+	uu := map[string]struct {
+		lang, dir string
+		e         string
+		err       string
+		tt        map[string]string
+	}{
+		"default": {
+			e:  "en",
+			tt: map[string]string{"Describe": "Describe"},
+		},
+		"builtin": {
+			lang: "FR",
+			e:    "fr",
+			tt:   map[string]string{"Describe": "Décrire", "Zorg": "Zorg"},
+		},
+		"override": {
+			lang: "fr",
+			dir:  dir,
+			e:    "fr",
+			tt:   map[string]string{"Describe": "Décrire!", "Zorg": "Zorgue", "Delete": "Supprimer"},
+		},
+		"community": {
+			lang: "xx",
+			dir:  dir,
+			e:    "xx",
+			tt:   map[string]string{"Describe": "Dxx", "Delete": "Delete"},
+		},
+		"missing": {
+			lang: "zz",
+			dir:  dir,
+			e:    "en",
+			err:  `no "zz" locale bundle found`,
+			tt:   map[string]string{"Describe": "Describe"},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			err := i18n.Load(u.lang, u.dir)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, u.e, i18n.Lang())
+			for s, e := range u.tt {
+				assert.Equal(t, e, i18n.T(s))
+			}
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	defer func() { _ = i18n.Load(i18n.DefaultLang, "") }()
+	assert.NoError(t, i18n.Load("fr", ""))
+
+	uu := map[string]string{
+		"Describe":      "Décrire",
+		"Sort Name":     "Trier Name",
+		"Toggle Wide":   "Basculer Wide",
+		"Logs Previous": "Journaux précédents",
+		"Zorg Blee":     "Zorg Blee",
+		"":              "",
+	}
+	for l, e := range uu {
+		assert.Equal(t, e, i18n.Label(l))
+	}
+	assert.Equal(t, "Changement de contexte vers fred", i18n.Tf("Switching context to %s", "fred"))
+}
+
+func TestLanguages(t *testing.T) {
+	assert.Equal(t, []string{"en", "fr"}, i18n.Languages())
+}
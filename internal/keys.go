@@ -30,4 +30,9 @@ const (
 	KeyWithMetrics ContextKey = "withMetrics"
 	KeyViewConfig  ContextKey = "viewConfig"
 	KeyWait        ContextKey = "wait"
+	KeyLint        ContextKey = "lint"
+	KeyLogLevel    ContextKey = "logLevel"
+	KeyMaxRows     ContextKey = "maxRows"
+	KeyMaxHistory  ContextKey = "maxHistoryRows"
+	KeyColumns     ContextKey = "customColumns"
 )
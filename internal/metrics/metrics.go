@@ -0,0 +1,131 @@
+// Package metrics reports the k9s process own metrics in the prometheus format.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	namespace       = "k9s"
+	metricsPath     = "/metrics"
+	shutdownTimeout = 2 * time.Second
+)
+
+var (
+	registry = prometheus.NewRegistry()
+
+	apiRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "api_requests_total",
+		Help:      "Number of api server requests by method and status code.",
+	}, []string{"method", "code"})
+
+	apiLatency = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "api_request_duration_seconds",
+		Help:      "Api server requests latency by method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+
+	refreshDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "refresh_duration_seconds",
+		Help:      "Views refresh duration by resource.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"gvr"})
+
+	watchDisconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "watch_disconnects_total",
+		Help:      "Number of watches lost by resource.",
+	}, []string{"gvr"})
+
+	watchReconnects = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "watch_reconnects_total",
+		Help:      "Number of watches recovered by resource.",
+	}, []string{"gvr"})
+
+	watchDowntime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "watch_downtime_seconds",
+		Help:      "Watches downtime by resource.",
+		Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600},
+	}, []string{"gvr"})
+)
+
+func init() {
+	registry.MustRegister(
+		apiRequests,
+		apiLatency,
+		refreshDuration,
+		watchDisconnects,
+		watchReconnects,
+		watchDowntime,
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// ObserveAPIRequest records an api server request. Failed requests without a response
+// are reported with a code of 0.
+func ObserveAPIRequest(method string, code int, latency time.Duration) {
+	apiRequests.WithLabelValues(method, strconv.Itoa(code)).Inc()
+	apiLatency.WithLabelValues(method).Observe(latency.Seconds())
+}
+
+// ObserveRefresh records a view refresh.
+func ObserveRefresh(gvr string, elapsed time.Duration) {
+	refreshDuration.WithLabelValues(gvr).Observe(elapsed.Seconds())
+}
+
+// ObserveWatchDisconnect records a lost resource watch.
+func ObserveWatchDisconnect(gvr string) {
+	watchDisconnects.WithLabelValues(gvr).Inc()
+}
+
+// ObserveWatchReconnect records a recovered resource watch.
+func ObserveWatchReconnect(gvr string, downtime time.Duration) {
+	watchReconnects.WithLabelValues(gvr).Inc()
+	watchDowntime.WithLabelValues(gvr).Observe(downtime.Seconds())
+}
+
+// Handler returns the metrics http handler.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(registry, promhttp.HandlerOpts{})
+}
+
+// Serve exposes the metrics on a given address. The returned function stops the server.
+func Serve(addr string) (func(), error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(metricsPath, Handler())
+	srv := http.Server{Handler: mux, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msgf("Metrics endpoint failed")
+		}
+	}()
+	log.Info().Msgf("Serving k9s metrics on http://%s%s", l.Addr(), metricsPath)
+
+	return func() {
+		ctx, cancel := context.WithTimeout(context.Background(), shutdownTimeout)
+		defer cancel()
+		if err := srv.Shutdown(ctx); err != nil {
+			log.Warn().Err(err).Msgf("Metrics endpoint shutdown")
+		}
+	}, nil
+}
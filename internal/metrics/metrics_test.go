@@ -0,0 +1,48 @@
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/metrics"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandler(t *testing.T) {
+	metrics.ObserveAPIRequest(http.MethodGet, 200, 50*time.Millisecond)
+	metrics.ObserveAPIRequest(http.MethodGet, 0, time.Second)
+	metrics.ObserveRefresh("v1/pods", 200*time.Millisecond)
+	metrics.ObserveWatchDisconnect("v1/pods")
+	metrics.ObserveWatchReconnect("v1/pods", 3*time.Second)
+
+	srv := httptest.NewServer(metrics.Handler())
+	defer srv.Close()
+
+	resp, err := http.Get(srv.URL)
+	assert.NoError(t, err)
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	assert.NoError(t, err)
+
+	body := string(raw)
+	assert.Contains(t, body, `k9s_api_requests_total{code="200",method="GET"} 1`)
+	assert.Contains(t, body, `k9s_api_requests_total{code="0",method="GET"} 1`)
+	assert.Contains(t, body, `k9s_api_request_duration_seconds_count{method="GET"} 2`)
+	assert.Contains(t, body, `k9s_refresh_duration_seconds_count{gvr="v1/pods"} 1`)
+	assert.Contains(t, body, `k9s_watch_disconnects_total{gvr="v1/pods"} 1`)
+	assert.Contains(t, body, `k9s_watch_reconnects_total{gvr="v1/pods"} 1`)
+	assert.Contains(t, body, `k9s_watch_downtime_seconds_count{gvr="v1/pods"} 1`)
+	assert.Contains(t, body, "go_memstats_alloc_bytes")
+}
+
+func TestServe(t *testing.T) {
+	stop, err := metrics.Serve("127.0.0.1:0")
+	assert.NoError(t, err)
+	stop()
+
+	_, err = metrics.Serve("bozo")
+	assert.Error(t, err)
+}
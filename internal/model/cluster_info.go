@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/util/cache"
@@ -37,6 +38,9 @@ type ClusterMeta struct {
 	K9sVer, K9sLatest   string
 	K8sVer              string
 	Cpu, Mem, Ephemeral int
+	// Lines tracks the user defined header lines values.
+	Lines []string
+	API   client.APIStatsSnapshot
 }
 
 // NewClusterMeta returns a new instance.
@@ -59,6 +63,18 @@ func (c ClusterMeta) Deltas(n ClusterMeta) bool {
 		return true
 	}
 
+	if c.API != n.API {
+		return true
+	}
+	if len(c.Lines) != len(n.Lines) {
+		return true
+	}
+	for i := range c.Lines {
+		if c.Lines[i] != n.Lines[i] {
+			return true
+		}
+	}
+
 	return c.Context != n.Context ||
 		c.Cluster != n.Cluster ||
 		c.User != n.User ||
@@ -76,6 +92,7 @@ type ClusterInfo struct {
 	skipLatestRevCheck bool
 	listeners          []ClusterInfoListener
 	cache              *cache.LRUExpireCache
+	lines              []config.HeaderLine
 }
 
 // NewClusterInfo returns a new instance.
@@ -108,6 +125,11 @@ func (c *ClusterInfo) fetchK9sLatestRev() string {
 	return latestRev
 }
 
+// SetHeaderLines sets the user defined header lines.
+func (c *ClusterInfo) SetHeaderLines(ll []config.HeaderLine) {
+	c.lines = ll
+}
+
 // Reset resets context and reload.
 func (c *ClusterInfo) Reset(f dao.Factory) {
 	c.cluster, c.data = NewCluster(f), NewClusterMeta()
@@ -144,6 +166,8 @@ func (c *ClusterInfo) Refresh() {
 	if v1.IsCurrent(v2) {
 		data.K9sLatest = ""
 	}
+	data.API = client.APIRequestStats().Snapshot(time.Now())
+	data.Lines = EvalHeaderLines(c.lines, data)
 
 	if c.data.Deltas(data) {
 		c.fireMetaChanged(c.data, data)
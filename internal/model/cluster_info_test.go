@@ -30,6 +30,15 @@ func TestClusterMetaDelta(t *testing.T) {
 			n: makeClusterMeta("freddie"),
 			e: true,
 		},
+		"lines": {
+			o: makeClusterMeta("fred"),
+			n: withLines(makeClusterMeta("fred"), "prod"),
+			e: true,
+		},
+		"sameLines": {
+			o: withLines(makeClusterMeta("fred"), "prod"),
+			n: withLines(makeClusterMeta("fred"), "prod"),
+		},
 	}
 
 	for k := range uu {
@@ -49,3 +58,9 @@ func makeClusterMeta(cluster string) model.ClusterMeta {
 
 	return m
 }
+
+func withLines(m model.ClusterMeta, ll ...string) model.ClusterMeta {
+	m.Lines = ll
+
+	return m
+}
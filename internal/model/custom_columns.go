@@ -0,0 +1,126 @@
+package model
+
+import (
+	"bytes"
+	"strings"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// customColumn represents a compiled user defined column.
+type customColumn struct {
+	config.CustomColumn
+	path *jsonpath.JSONPath
+}
+
+// CustomColumns decorates resource tables with user defined columns.
+type CustomColumns struct {
+	cols []customColumn
+}
+
+// NewCustomColumns returns a new instance. Columns with invalid json paths are skipped.
+func NewCustomColumns(cc []config.CustomColumn) *CustomColumns {
+	var c CustomColumns
+	for _, col := range cc {
+		cl := customColumn{CustomColumn: col}
+		if col.JSONPath != "" {
+			cl.path = jsonpath.New(col.Name).AllowMissingKeys(true)
+			if err := cl.path.Parse(jsonPathTemplate(col.JSONPath)); err != nil {
+				log.Warn().Err(err).Msgf("Skipping custom column %q", col.Name)
+				continue
+			}
+		}
+		c.cols = append(c.cols, cl)
+	}
+
+	return &c
+}
+
+// Decorate adds the custom columns ahead of the age column. Columns clashing with
+// existing ones are skipped. Rows must line up with the objects they were rendered from.
+func (c *CustomColumns) Decorate(h render.Header, oo []runtime.Object, rr render.Rows) render.Header {
+	cols := make([]customColumn, 0, len(c.cols))
+	for _, col := range c.cols {
+		if h.IndexOf(col.Name, true) < 0 {
+			cols = append(cols, col)
+		}
+	}
+	if len(cols) == 0 {
+		return h
+	}
+
+	idx := len(h)
+	if idx > 0 && h[idx-1].Time {
+		idx--
+	}
+	header := make(render.Header, 0, len(h)+len(cols))
+	header = append(header, h[:idx]...)
+	for _, col := range cols {
+		header = append(header, render.HeaderColumn{Name: col.Name, Wide: col.Wide})
+	}
+	header = append(header, h[idx:]...)
+
+	for i := range rr {
+		if len(rr[i].Fields) < idx || i >= len(oo) {
+			continue
+		}
+		obj := rawObject(oo[i])
+		ff := make(render.Fields, 0, len(rr[i].Fields)+len(cols))
+		ff = append(ff, rr[i].Fields[:idx]...)
+		for _, col := range cols {
+			ff = append(ff, col.value(obj))
+		}
+		rr[i].Fields = append(ff, rr[i].Fields[idx:]...)
+	}
+
+	return header
+}
+
+func (c customColumn) value(o map[string]interface{}) string {
+	if o == nil {
+		return ""
+	}
+	switch {
+	case c.Label != "":
+		v, _, _ := unstructured.NestedString(o, "metadata", "labels", c.Label)
+		return v
+	case c.Annotation != "":
+		v, _, _ := unstructured.NestedString(o, "metadata", "annotations", c.Annotation)
+		return v
+	case c.path != nil:
+		var buff bytes.Buffer
+		if err := c.path.Execute(&buff, o); err != nil {
+			log.Debug().Err(err).Msgf("Custom column %q failed", c.Name)
+			return ""
+		}
+		return strings.TrimSpace(buff.String())
+	}
+
+	return ""
+}
+
+// rawObject returns the raw resource a row got rendered from if any.
+func rawObject(o runtime.Object) map[string]interface{} {
+	switch r := o.(type) {
+	case *unstructured.Unstructured:
+		return r.Object
+	case *render.PodWithMetrics:
+		return r.Raw.Object
+	}
+
+	return nil
+}
+
+// jsonPathTemplate wraps bare json paths ie .spec.nodeName in a template.
+func jsonPathTemplate(p string) string {
+	if strings.Contains(p, "{") {
+		return p
+	}
+
+	return "{" + p + "}"
+}
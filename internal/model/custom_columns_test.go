@@ -0,0 +1,49 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestCustomColumnsDecorate(t *testing.T) {
+	cc := model.NewCustomColumns([]config.CustomColumn{
+		{Name: "TEAM", Label: "team"},
+		{Name: "VERSION", Annotation: "app.kubernetes.io/version"},
+		{Name: "SA", JSONPath: ".spec.serviceAccountName", Wide: true},
+		{Name: "BAD", JSONPath: "{.spec["},
+		{Name: "NAME", Label: "name"},
+	})
+	po := &unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":        "p1",
+			"labels":      map[string]interface{}{"team": "blee"},
+			"annotations": map[string]interface{}{"app.kubernetes.io/version": "1.0"},
+		},
+		"spec": map[string]interface{}{"serviceAccountName": "fred"},
+	}}
+	h := render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "AGE", Time: true},
+	}
+	rr := render.Rows{
+		{ID: "p1", Fields: render.Fields{"p1", "1m"}},
+		{ID: "p2", Fields: render.Fields{"p2", "2m"}},
+	}
+
+	h = cc.Decorate(h, []runtime.Object{po, &render.PodWithMetrics{Raw: &unstructured.Unstructured{Object: map[string]interface{}{}}}}, rr)
+	assert.Equal(t, render.Header{
+		render.HeaderColumn{Name: "NAME"},
+		render.HeaderColumn{Name: "TEAM"},
+		render.HeaderColumn{Name: "VERSION"},
+		render.HeaderColumn{Name: "SA", Wide: true},
+		render.HeaderColumn{Name: "AGE", Time: true},
+	}, h)
+	assert.Equal(t, render.Fields{"p1", "blee", "1.0", "fred", "1m"}, rr[0].Fields)
+	assert.Equal(t, render.Fields{"p2", "", "", "", "2m"}, rr[1].Fields)
+}
@@ -2,9 +2,9 @@ package model
 
 import (
 	"context"
-	"fmt"
 	"time"
 
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/rs/zerolog/log"
 )
 
@@ -73,23 +73,24 @@ func (f *Flash) Channel() FlashChan {
 
 // Info displays an info flash message.
 func (f *Flash) Info(msg string) {
-	f.SetMessage(FlashInfo, msg)
+	f.SetMessage(FlashInfo, i18n.T(msg))
 }
 
 // Infof displays a formatted info flash message.
 func (f *Flash) Infof(fmat string, args ...interface{}) {
-	f.Info(fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashInfo, i18n.Tf(fmat, args...))
 }
 
 // Warn displays a warning flash message.
 func (f *Flash) Warn(msg string) {
 	log.Warn().Msg(msg)
-	f.SetMessage(FlashWarn, msg)
+	f.SetMessage(FlashWarn, i18n.T(msg))
 }
 
 // Warnf displays a formatted warning flash message.
 func (f *Flash) Warnf(fmat string, args ...interface{}) {
-	f.Warn(fmt.Sprintf(fmat, args...))
+	log.Warn().Msgf(fmat, args...)
+	f.SetMessage(FlashWarn, i18n.Tf(fmat, args...))
 }
 
 // Err displays an error flash message.
@@ -108,7 +109,7 @@ func (f *Flash) Errf(fmat string, args ...interface{}) {
 		}
 	}
 	log.Error().Err(err).Msgf(fmat, args...)
-	f.SetMessage(FlashErr, fmt.Sprintf(fmat, args...))
+	f.SetMessage(FlashErr, i18n.Tf(fmat, args...))
 }
 
 // Clear clears the flash message.
@@ -0,0 +1,102 @@
+package model
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"os/exec"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	headerLineTimeout = 2 * time.Second
+	headerLineErr     = "<error>"
+)
+
+// HeaderLineData represents the data available to header line templates.
+type HeaderLineData struct {
+	ClusterMeta
+
+	// Output tracks the line command output if any.
+	Output string
+}
+
+var headerLineFuncs = template.FuncMap{
+	"env":   os.Getenv,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// EvalHeaderLines evaluates user defined header lines against the cluster meta.
+func EvalHeaderLines(ll []config.HeaderLine, m ClusterMeta) []string {
+	if len(ll) == 0 {
+		return nil
+	}
+
+	vv := make([]string, 0, len(ll))
+	for _, l := range ll {
+		v, err := evalHeaderLine(l, m)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Header line %q failed", l.Name)
+			v = headerLineErr
+		}
+		vv = append(vv, v)
+	}
+
+	return vv
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func evalHeaderLine(l config.HeaderLine, m ClusterMeta) (string, error) {
+	data := HeaderLineData{ClusterMeta: m}
+	if l.Command != "" {
+		out, err := headerCommand(l.Command)
+		if err != nil {
+			return "", err
+		}
+		data.Output = out
+	}
+	if l.Template == "" {
+		return data.Output, nil
+	}
+
+	tpl, err := template.New(l.Name).Funcs(headerLineFuncs).Parse(l.Template)
+	if err != nil {
+		return "", err
+	}
+	var b bytes.Buffer
+	if err := tpl.Execute(&b, data); err != nil {
+		return "", err
+	}
+
+	return firstLine(b.String()), nil
+}
+
+func headerCommand(cmd string) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), headerLineTimeout)
+	defer cancel()
+
+	// nolint:gosec
+	out, err := exec.CommandContext(ctx, "sh", "-c", cmd).Output()
+	if err != nil {
+		return "", err
+	}
+
+	return firstLine(string(out)), nil
+}
+
+func firstLine(s string) string {
+	s = strings.TrimSpace(s)
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[:i]
+	}
+
+	return s
+}
@@ -0,0 +1,34 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvalHeaderLines(t *testing.T) {
+	t.Setenv("K9S_TEST_ENV", "prod")
+	m := makeClusterMeta("fred")
+	m.Context = "blee"
+
+	ll := []config.HeaderLine{
+		{Name: "Ctx", Template: "{{ .Context }}@{{ .Cluster }} cpu={{ .Cpu }}%"},
+		{Name: "Env", Template: `{{ env "K9S_TEST_ENV" | upper }}`},
+		{Name: "Cmd", Command: "printf 'one\ntwo\n'"},
+		{Name: "Both", Command: "echo hello", Template: "{{ .Output }} {{ .Context }}"},
+		{Name: "Bad", Template: "{{ .Blee }}"},
+		{Name: "Fail", Command: "exit 1"},
+	}
+
+	assert.Equal(t, []string{
+		"blee@fred cpu=10%",
+		"PROD",
+		"one",
+		"hello blee",
+		"<error>",
+		"<error>",
+	}, model.EvalHeaderLines(ll, m))
+	assert.Nil(t, model.EvalHeaderLines(nil, m))
+}
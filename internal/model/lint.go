@@ -0,0 +1,187 @@
+package model
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// LintCol represents the sanitizer grade column name.
+	LintCol = "LINT"
+
+	lintRefreshRate = 5 * time.Minute
+)
+
+// Lint tracks background sanitizer findings per resource.
+type Lint struct {
+	factory     dao.Factory
+	refreshRate time.Duration
+	issues      map[string]render.Issues
+	scanned     map[string]struct{}
+	lastScan    time.Time
+	mx          sync.RWMutex
+}
+
+// NewLint returns a new sanitizer tracker.
+func NewLint(f dao.Factory) *Lint {
+	return &Lint{
+		factory:     f,
+		refreshRate: lintRefreshRate,
+		issues:      make(map[string]render.Issues),
+		scanned:     make(map[string]struct{}),
+	}
+}
+
+// Watch periodically sanitizes the cluster until canceled. Findings survive
+// cancelation so a restarted watcher only rescans once they go stale.
+func (l *Lint) Watch(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Debug().Msg("Lint watcher canceled!")
+			return
+		case <-time.After(l.nextScan()):
+		}
+		if err := l.Refresh(ctx); err != nil {
+			log.Warn().Err(err).Msgf("Lint scan failed")
+		}
+	}
+}
+
+// Refresh runs a sanitizer pass across all namespaces.
+func (l *Lint) Refresh(ctx context.Context) error {
+	oo, err := dao.NewPopeye(l.factory).List(ctx, client.AllNamespaces)
+	if err != nil {
+		return err
+	}
+
+	issues, scanned := make(map[string]render.Issues), make(map[string]struct{})
+	for _, o := range oo {
+		s, ok := o.(render.Section)
+		if !ok {
+			continue
+		}
+		scanned[s.GVR] = struct{}{}
+		for fqn, ii := range s.Outcome {
+			issues[lintKey(s.GVR, fqn)] = ii
+		}
+	}
+
+	l.mx.Lock()
+	defer l.mx.Unlock()
+	l.issues, l.scanned, l.lastScan = issues, scanned, time.Now()
+
+	return nil
+}
+
+// IsScanned checks if a resource was covered by the last sanitizer pass.
+func (l *Lint) IsScanned(gvr string) bool {
+	if l == nil {
+		return false
+	}
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	_, ok := l.scanned[gvr]
+	return ok
+}
+
+// Issues returns the sanitizer findings for a given resource.
+func (l *Lint) Issues(gvr, fqn string) render.Issues {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	return l.issues[lintKey(gvr, fqn)]
+}
+
+// Grade returns a resource sanitizer grade.
+func (l *Lint) Grade(gvr, fqn string) string {
+	if !l.IsScanned(gvr) {
+		return render.NAValue
+	}
+
+	return LintGrade(l.Issues(gvr, fqn).MaxSeverity())
+}
+
+// Decorate augments a table with a sanitizer grade column.
+func (l *Lint) Decorate(gvr string, h render.Header, rr render.Rows) render.Header {
+	if !l.IsScanned(gvr) || h.IndexOf(LintCol, true) >= 0 {
+		return h
+	}
+
+	idx := len(h)
+	if idx > 0 && h[idx-1].Time {
+		idx--
+	}
+	header := make(render.Header, 0, len(h)+1)
+	header = append(header, h[:idx]...)
+	header = append(header, render.HeaderColumn{Name: LintCol})
+	header = append(header, h[idx:]...)
+	for i := range rr {
+		if len(rr[i].Fields) < idx {
+			continue
+		}
+		ff := make(render.Fields, 0, len(rr[i].Fields)+1)
+		ff = append(ff, rr[i].Fields[:idx]...)
+		ff = append(ff, l.Grade(gvr, rr[i].ID))
+		rr[i].Fields = append(ff, rr[i].Fields[idx:]...)
+	}
+
+	return header
+}
+
+// Reset clears all findings ie on context switch.
+func (l *Lint) Reset() {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	l.issues, l.scanned = make(map[string]render.Issues), make(map[string]struct{})
+	l.lastScan = time.Time{}
+}
+
+// nextScan returns how long until the current findings go stale.
+func (l *Lint) nextScan() time.Duration {
+	l.mx.RLock()
+	defer l.mx.RUnlock()
+
+	if l.lastScan.IsZero() {
+		return 0
+	}
+	if d := l.refreshRate - time.Since(l.lastScan); d > 0 {
+		return d
+	}
+
+	return 0
+}
+
+// LintGrade converts a sanitizer severity level to a letter grade.
+func LintGrade(l config.Level) string {
+	switch l {
+	case config.OkLevel:
+		return "A"
+	case config.InfoLevel:
+		return "B"
+	case config.WarnLevel:
+		return "C"
+	default:
+		return "F"
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func lintKey(gvr, fqn string) string {
+	if ns, n := client.Namespaced(fqn); client.IsClusterScoped(ns) {
+		fqn = n
+	}
+
+	return gvr + ":" + fqn
+}
@@ -0,0 +1,89 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/popeye/pkg/config"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLintDecorate(t *testing.T) {
+	l := NewLint(nil)
+	l.scanned["v1/pods"] = struct{}{}
+	l.issues[lintKey("v1/pods", "default/p1")] = render.Issues{
+		{Group: "__root__", Level: config.InfoLevel, Message: "blee"},
+		{Group: "c1", Level: config.WarnLevel, Message: "duh"},
+	}
+
+	uu := map[string]struct {
+		gvr string
+		h   render.Header
+		e   render.Header
+		ee  []render.Fields
+	}{
+		"age": {
+			gvr: "v1/pods",
+			h:   render.Header{{Name: "NAME"}, {Name: "AGE", Time: true}},
+			e:   render.Header{{Name: "NAME"}, {Name: LintCol}, {Name: "AGE", Time: true}},
+			ee:  []render.Fields{{"p1", "C", "1m"}, {"p2", "A", "2m"}},
+		},
+		"no-age": {
+			gvr: "v1/pods",
+			h:   render.Header{{Name: "NAME"}, {Name: "STATUS"}},
+			e:   render.Header{{Name: "NAME"}, {Name: "STATUS"}, {Name: LintCol}},
+			ee:  []render.Fields{{"p1", "1m", "C"}, {"p2", "2m", "A"}},
+		},
+		"not-scanned": {
+			gvr: "v1/services",
+			h:   render.Header{{Name: "NAME"}, {Name: "AGE", Time: true}},
+			e:   render.Header{{Name: "NAME"}, {Name: "AGE", Time: true}},
+			ee:  []render.Fields{{"p1", "1m"}, {"p2", "2m"}},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr := render.Rows{
+				{ID: "default/p1", Fields: render.Fields{"p1", "1m"}},
+				{ID: "default/p2", Fields: render.Fields{"p2", "2m"}},
+			}
+			assert.Equal(t, u.e, l.Decorate(u.gvr, u.h, rr))
+			for i := range rr {
+				assert.Equal(t, u.ee[i], rr[i].Fields)
+			}
+		})
+	}
+}
+
+func TestLintGrade(t *testing.T) {
+	l := NewLint(nil)
+	assert.Equal(t, render.NAValue, l.Grade("v1/nodes", "n1"))
+
+	l.scanned["v1/nodes"] = struct{}{}
+	l.issues[lintKey("v1/nodes", "n1")] = render.Issues{
+		{Group: "__root__", Level: config.ErrorLevel, Message: "blee"},
+	}
+	assert.Equal(t, "F", l.Grade("v1/nodes", "-/n1"))
+	assert.Equal(t, "A", l.Grade("v1/nodes", "-/n2"))
+}
+
+func TestLintNextScan(t *testing.T) {
+	l := NewLint(nil)
+	assert.Equal(t, time.Duration(0), l.nextScan())
+
+	l.lastScan = time.Now()
+	d := l.nextScan()
+	assert.True(t, d > lintRefreshRate-time.Minute && d <= lintRefreshRate)
+
+	l.lastScan = time.Now().Add(-2 * lintRefreshRate)
+	assert.Equal(t, time.Duration(0), l.nextScan())
+
+	l.lastScan = time.Now()
+	l.scanned["v1/pods"] = struct{}{}
+	l.Reset()
+	assert.Equal(t, time.Duration(0), l.nextScan())
+	assert.False(t, l.IsScanned("v1/pods"))
+}
@@ -14,6 +14,74 @@ var Registry = map[string]ResourceMeta{
 		DAO:      &dao.Reference{},
 		Renderer: &render.Reference{},
 	},
+	"related": {
+		DAO:      &dao.Related{},
+		Renderer: &render.Related{},
+	},
+	"podvolumes": {
+		DAO:      &dao.PodVolumes{},
+		Renderer: &render.PodVolume{},
+	},
+	"preemptions": {
+		DAO:      &dao.Preemptions{},
+		Renderer: &render.Preemption{},
+	},
+	"devices": {
+		DAO:      &dao.Devices{},
+		Renderer: &render.Device{},
+	},
+	"svcsplits": {
+		DAO:      &dao.ServiceSplits{},
+		Renderer: &render.ServiceSplit{},
+	},
+	"ips": {
+		DAO:      &dao.IPSearch{},
+		Renderer: &render.Related{},
+	},
+	"apihealth": {
+		DAO:      &dao.APIHealth{},
+		Renderer: &render.APIHealth{},
+	},
+	"deprecations": {
+		DAO:      &dao.DeprecatedAPIs{},
+		Renderer: &render.Deprecated{},
+	},
+	"upgrade": {
+		DAO:      &dao.UpgradeReadiness{},
+		Renderer: &render.Upgrade{},
+	},
+	dao.K9sLogsGVR: {
+		DAO:      &dao.K9sLogs{},
+		Renderer: &render.K9sLog{},
+	},
+	"churn": {
+		DAO:      &dao.Churn{},
+		Renderer: &render.Churn{},
+	},
+	"orphans": {
+		DAO:      &dao.Orphans{},
+		Renderer: &render.Orphan{},
+	},
+	"nsblockers": {
+		DAO:      &dao.NamespaceBlockers{},
+		Renderer: &render.NamespaceBlocker{},
+	},
+	"webhooks": {
+		DAO:      &dao.Webhooks{},
+		Renderer: &render.Webhook{},
+	},
+	"managedfields": {
+		DAO:      &dao.ManagedFields{},
+		Renderer: &render.ManagedField{},
+	},
+	"history": {
+		DAO:      &dao.History{},
+		Renderer: &render.History{},
+	},
+	"trash": {
+		DAO:      &dao.Trash{},
+		Renderer: &render.Trash{},
+	},
 	"dir": {
 		DAO:      &dao.Dir{},
 		Renderer: &render.Dir{},
@@ -108,6 +176,7 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.PersistentVolume{},
 	},
 	"v1/persistentvolumeclaims": {
+		DAO:      &dao.PersistentVolumeClaim{},
 		Renderer: &render.PersistentVolumeClaim{},
 	},
 	"v1/events": {
@@ -130,6 +199,11 @@ var Registry = map[string]ResourceMeta{
 		Renderer:     &render.StatefulSet{},
 		TreeRenderer: &xray.StatefulSet{},
 	},
+	"networking.k8s.io/v1/ingresses": {
+		DAO:          &dao.Ingress{},
+		Renderer:     &render.Ingress{},
+		TreeRenderer: &xray.Ingress{},
+	},
 	"apps/v1/daemonsets": {
 		DAO:          &dao.DaemonSet{},
 		Renderer:     &render.DaemonSet{},
@@ -141,6 +215,230 @@ var Registry = map[string]ResourceMeta{
 		Renderer: &render.NetworkPolicy{},
 	},
 
+	// Istio...
+	"networking.istio.io/v1beta1/virtualservices": {
+		DAO:      &dao.VirtualService{},
+		Renderer: &render.VirtualService{},
+	},
+	"networking.istio.io/v1beta1/destinationrules": {
+		DAO:      &dao.DestinationRule{},
+		Renderer: &render.DestinationRule{},
+	},
+	"networking.istio.io/v1beta1/gateways": {
+		Renderer: &render.Gateway{},
+	},
+	"networking.istio.io/v1/virtualservices": {
+		DAO:      &dao.VirtualService{},
+		Renderer: &render.VirtualService{},
+	},
+	"networking.istio.io/v1/destinationrules": {
+		DAO:      &dao.DestinationRule{},
+		Renderer: &render.DestinationRule{},
+	},
+	"networking.istio.io/v1/gateways": {
+		Renderer: &render.Gateway{},
+	},
+
+	// Argo...
+	"argoproj.io/v1alpha1/applications": {
+		DAO:      &dao.Application{},
+		Renderer: &render.Application{},
+	},
+	"argoproj.io/v1alpha1/rollouts": {
+		DAO:      &dao.Rollout{},
+		Renderer: &render.Rollout{},
+	},
+	"argoproj.io/v1alpha1/workflows": {
+		DAO:      &dao.Workflow{},
+		Renderer: &render.Workflow{},
+	},
+
+	// Flux...
+	"kustomize.toolkit.fluxcd.io/v1/kustomizations": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"kustomize.toolkit.fluxcd.io/v1beta2/kustomizations": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"helm.toolkit.fluxcd.io/v2/helmreleases": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"helm.toolkit.fluxcd.io/v2beta2/helmreleases": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"helm.toolkit.fluxcd.io/v2beta1/helmreleases": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"source.toolkit.fluxcd.io/v1/gitrepositories": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+	"source.toolkit.fluxcd.io/v1beta2/gitrepositories": {
+		DAO:      &dao.Flux{},
+		Renderer: &render.Flux{},
+	},
+
+	// Tekton...
+	"tekton.dev/v1/pipelineruns": {
+		DAO:      &dao.PipelineRun{},
+		Renderer: &render.PipelineRun{},
+	},
+	"tekton.dev/v1/taskruns": {
+		DAO:      &dao.TaskRun{},
+		Renderer: &render.TaskRun{},
+	},
+	"tekton.dev/v1beta1/pipelineruns": {
+		DAO:      &dao.PipelineRun{},
+		Renderer: &render.PipelineRun{},
+	},
+	"tekton.dev/v1beta1/taskruns": {
+		DAO:      &dao.TaskRun{},
+		Renderer: &render.TaskRun{},
+	},
+
+	// Knative...
+	"serving.knative.dev/v1/services": {
+		DAO:      &dao.KService{},
+		Renderer: &render.KService{},
+	},
+	"serving.knative.dev/v1/revisions": {
+		DAO:      &dao.Revision{},
+		Renderer: &render.Revision{},
+	},
+
+	// KEDA...
+	"keda.sh/v1alpha1/scaledobjects": {
+		DAO:      &dao.ScaledObject{},
+		Renderer: &render.ScaledObject{},
+	},
+	"keda.sh/v1alpha1/scaledjobs": {
+		DAO:      &dao.ScaledJob{},
+		Renderer: &render.ScaledJob{},
+	},
+
+	// Velero...
+	"velero.io/v1/backups": {
+		DAO:      &dao.Backup{},
+		Renderer: &render.Backup{},
+	},
+	"velero.io/v1/restores": {
+		Renderer: &render.Restore{},
+	},
+	"velero.io/v1/schedules": {
+		DAO:      &dao.Schedule{},
+		Renderer: &render.Schedule{},
+	},
+
+	// Cluster API...
+	"cluster.x-k8s.io/v1beta1/clusters": {
+		Renderer: &render.Cluster{},
+	},
+	"cluster.x-k8s.io/v1beta1/machinedeployments": {
+		Renderer: &render.MachineDeployment{},
+	},
+	"cluster.x-k8s.io/v1beta1/machines": {
+		DAO:      &dao.Machine{},
+		Renderer: &render.Machine{},
+	},
+
+	// Karpenter...
+	"karpenter.sh/v1/nodepools": {
+		DAO:      &dao.NodePool{},
+		Renderer: &render.NodePool{},
+	},
+	"karpenter.sh/v1/nodeclaims": {
+		DAO:      &dao.NodeClaim{},
+		Renderer: &render.NodeClaim{},
+	},
+	"karpenter.sh/v1beta1/nodepools": {
+		DAO:      &dao.NodePool{},
+		Renderer: &render.NodePool{},
+	},
+	"karpenter.sh/v1beta1/nodeclaims": {
+		DAO:      &dao.NodeClaim{},
+		Renderer: &render.NodeClaim{},
+	},
+
+	// Strimzi...
+	"kafka.strimzi.io/v1beta2/kafkas": {
+		Renderer: &render.Kafka{},
+	},
+	"kafka.strimzi.io/v1beta2/kafkatopics": {
+		DAO:      &dao.KafkaTopic{},
+		Renderer: &render.KafkaTopic{},
+	},
+	"kafka.strimzi.io/v1beta2/kafkausers": {
+		Renderer: &render.KafkaUser{},
+	},
+
+	// External Secrets...
+	"external-secrets.io/v1beta1/externalsecrets": {
+		DAO:      &dao.ExternalSecret{},
+		Renderer: &render.ExternalSecret{},
+	},
+	"external-secrets.io/v1beta1/secretstores": {
+		Renderer: &render.SecretStore{},
+	},
+	"external-secrets.io/v1beta1/clustersecretstores": {
+		Renderer: &render.SecretStore{},
+	},
+	"external-secrets.io/v1/externalsecrets": {
+		DAO:      &dao.ExternalSecret{},
+		Renderer: &render.ExternalSecret{},
+	},
+	"external-secrets.io/v1/secretstores": {
+		Renderer: &render.SecretStore{},
+	},
+	"external-secrets.io/v1/clustersecretstores": {
+		Renderer: &render.SecretStore{},
+	},
+
+	// OLM...
+	"operators.coreos.com/v1alpha1/subscriptions": {
+		DAO:      &dao.Subscription{},
+		Renderer: &render.Subscription{},
+	},
+	"operators.coreos.com/v1alpha1/clusterserviceversions": {
+		Renderer: &render.CSV{},
+	},
+	"operators.coreos.com/v1alpha1/installplans": {
+		DAO:      &dao.InstallPlan{},
+		Renderer: &render.InstallPlan{},
+	},
+
+	// Cilium...
+	"cilium.io/v2/ciliumendpoints": {
+		DAO:      &dao.CiliumEndpoint{},
+		Renderer: &render.CiliumEndpoint{},
+	},
+	"cilium.io/v2/ciliumnetworkpolicies": {
+		Renderer: &render.CiliumNetworkPolicy{},
+	},
+	"cilium.io/v2/ciliumclusterwidenetworkpolicies": {
+		Renderer: &render.CiliumNetworkPolicy{},
+	},
+
+	// CloudNativePG...
+	"postgresql.cnpg.io/v1/clusters": {
+		DAO:      &dao.PGCluster{},
+		Renderer: &render.PGCluster{},
+	},
+
+	// Longhorn...
+	"longhorn.io/v1beta2/volumes": {
+		DAO:      &dao.Volume{},
+		Renderer: &render.Volume{},
+	},
+	"longhorn.io/v1beta1/volumes": {
+		DAO:      &dao.Volume{},
+		Renderer: &render.Volume{},
+	},
+
 	// Batch...
 	"batch/v1/cronjobs": {
 		DAO:      &dao.CronJob{},
@@ -160,12 +458,49 @@ var Registry = map[string]ResourceMeta{
 	"storage.k8s.io/v1/storageclasses": {
 		Renderer: &render.StorageClass{},
 	},
+	"storage.k8s.io/v1/csidrivers": {
+		Renderer: &render.CSIDriver{},
+	},
+	"storage.k8s.io/v1/csinodes": {
+		Renderer: &render.CSINode{},
+	},
+
+	// Coordination...
+	"coordination.k8s.io/v1/leases": {
+		Renderer: &render.Lease{},
+	},
 
 	// Policy...
 	"policy/v1beta1/poddisruptionbudgets": {
 		Renderer: &render.PodDisruptionBudget{},
 	},
 
+	// API Priority and Fairness...
+	"flowcontrol.apiserver.k8s.io/v1/flowschemas": {
+		DAO:      &dao.FlowSchema{},
+		Renderer: &render.FlowSchema{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1/prioritylevelconfigurations": {
+		DAO:      &dao.PriorityLevel{},
+		Renderer: &render.PriorityLevel{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta3/flowschemas": {
+		DAO:      &dao.FlowSchema{},
+		Renderer: &render.FlowSchema{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta3/prioritylevelconfigurations": {
+		DAO:      &dao.PriorityLevel{},
+		Renderer: &render.PriorityLevel{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta2/flowschemas": {
+		DAO:      &dao.FlowSchema{},
+		Renderer: &render.FlowSchema{},
+	},
+	"flowcontrol.apiserver.k8s.io/v1beta2/prioritylevelconfigurations": {
+		DAO:      &dao.PriorityLevel{},
+		Renderer: &render.PriorityLevel{},
+	},
+
 	// RBAC...
 	"rbac.authorization.k8s.io/v1/clusterroles": {
 		DAO:      &dao.Rbac{},
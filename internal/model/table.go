@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"fmt"
+	"sort"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -11,8 +12,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/metrics"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/rs/zerolog/log"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	metav1beta1 "k8s.io/apimachinery/pkg/apis/meta/v1beta1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -40,6 +44,8 @@ type Table struct {
 	instance    string
 	mx          sync.RWMutex
 	labelFilter string
+	history     *TableHistory
+	rewind      int
 }
 
 // NewTable returns a new table model.
@@ -48,12 +54,17 @@ func NewTable(gvr client.GVR) *Table {
 		gvr:         gvr,
 		data:        render.NewTableData(),
 		refreshRate: 2 * time.Second,
+		history:     NewTableHistory(tableHistorySize, tableHistoryAge),
 	}
 }
 
 // SetLabelFilter sets the labels filter.
 func (t *Table) SetLabelFilter(f string) {
 	t.mx.Lock()
+	if t.labelFilter != f {
+		t.history.Clear()
+		t.rewind = 0
+	}
 	t.labelFilter = f
 	t.mx.Unlock()
 }
@@ -134,6 +145,10 @@ func (t *Table) GetNamespace() string {
 func (t *Table) SetNamespace(ns string) {
 	t.namespace = ns
 	t.data.Clear()
+	t.mx.Lock()
+	t.history.Clear()
+	t.rewind = 0
+	t.mx.Unlock()
 }
 
 // InNamespace checks if current namespace matches desired namespace.
@@ -200,14 +215,72 @@ func (t *Table) refresh(ctx context.Context) error {
 	}
 	defer atomic.StoreInt32(&t.inUpdate, 0)
 
+	start := time.Now()
+	ctx, span := tracing.Start(ctx, "table.refresh", tracing.String("gvr", t.gvr.String()))
+	defer span.End()
 	if err := t.reconcile(ctx); err != nil {
+		span.RecordError(err)
 		return err
 	}
-	t.fireTableChanged(t.Peek())
+	data := t.Peek()
+	elapsed := time.Since(start)
+	metrics.ObserveRefresh(t.gvr.String(), elapsed)
+	log.Debug().
+		Str("gvr", t.gvr.String()).
+		Int("rows", len(data.RowEvents)).
+		Dur("elapsed", elapsed).
+		Msg("Table refreshed")
+	t.mx.Lock()
+	if max, ok := ctx.Value(internal.KeyMaxHistory).(int); ok {
+		t.history.SetMaxRows(max)
+	}
+	if t.history.Push(time.Now(), data) && t.rewind > 0 {
+		t.rewind++
+	}
+	t.clampRewind()
+	if t.rewind > 0 {
+		data = t.history.At(t.rewind).Data
+	}
+	t.mx.Unlock()
+	t.fireTableChanged(data)
 
 	return nil
 }
 
+// Rewind steps the table back (positive) or forward (negative) through its recent
+// states and returns the displayed snapshot. Stepping past the latest state resumes
+// the live view.
+func (t *Table) Rewind(steps int) (TableSnapshot, bool) {
+	t.mx.Lock()
+	t.rewind += steps
+	t.clampRewind()
+	snap, rewound := t.history.At(t.rewind), t.rewind > 0
+	t.mx.Unlock()
+
+	if snap.Data != nil {
+		t.fireTableChanged(snap.Data)
+	}
+
+	return snap, rewound
+}
+
+func (t *Table) clampRewind() {
+	if t.rewind >= t.history.Len() {
+		t.rewind = t.history.Len() - 1
+	}
+	if t.rewind < 0 {
+		t.rewind = 0
+	}
+}
+
+// IsRewound checks if the table displays a past state.
+func (t *Table) IsRewound() bool {
+	t.mx.RLock()
+	defer t.mx.RUnlock()
+
+	return t.rewind > 0
+}
+
 func (t *Table) list(ctx context.Context, a dao.Accessor) ([]runtime.Object, error) {
 	factory, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
 	if !ok {
@@ -234,16 +307,24 @@ func (t *Table) reconcile(ctx context.Context) error {
 		oo  []runtime.Object
 		err error
 	)
+	_, span := tracing.Start(ctx, "dao.list", tracing.String("gvr", t.gvr.String()), tracing.String("namespace", t.namespace))
 	if t.instance == "" {
 		oo, err = t.list(ctx, meta.DAO)
 	} else {
 		o, e := t.Get(ctx, t.instance)
 		oo, err = []runtime.Object{o}, e
 	}
+	span.SetAttrs(tracing.Int("count", len(oo)))
+	span.RecordError(err)
+	span.End()
 	if err != nil {
 		return err
 	}
+	max, _ := ctx.Value(internal.KeyMaxRows).(int)
+	oo, total := truncateObjects(oo, max)
 
+	_, span = tracing.Start(ctx, "render", tracing.String("gvr", t.gvr.String()))
+	defer span.End()
 	var rows render.Rows
 	if len(oo) > 0 {
 		if meta.Renderer.IsGeneric() {
@@ -263,13 +344,26 @@ func (t *Table) reconcile(ctx context.Context) error {
 		}
 	}
 
+	header := meta.Renderer.Header(t.namespace)
+	if cc, ok := ctx.Value(internal.KeyColumns).(*CustomColumns); ok && !meta.Renderer.IsGeneric() {
+		header = cc.Decorate(header, oo, rows)
+	}
+	if l, ok := ctx.Value(internal.KeyLint).(*Lint); ok {
+		header = l.Decorate(t.gvr.String(), header, rows)
+	}
+
 	// if labelSelector in place might as well clear the model data.
 	sel, ok := ctx.Value(internal.KeyLabels).(string)
 	if ok && sel != "" {
 		t.data.Clear()
 	}
+	// if the header shape changed, stale rows can no longer be diffed.
+	if len(t.data.Header) != len(header) {
+		t.data.Clear()
+	}
 	t.data.Update(rows)
-	t.data.SetHeader(t.namespace, meta.Renderer.Header(t.namespace))
+	t.data.SetHeader(t.namespace, header)
+	t.data.Truncated = total
 
 	if len(t.data.Header) == 0 {
 		return fmt.Errorf("fail to list resource %s", t.gvr)
@@ -318,6 +412,41 @@ type Generic interface {
 	Render(o interface{}, ns string, row *render.Row) error
 }
 
+// truncateObjects caps the objects to a given max when set. Objects are sorted by path
+// first so the retained rows are stable across refreshes. The total count is returned
+// when objects were dropped, zero otherwise.
+func truncateObjects(oo []runtime.Object, max int) ([]runtime.Object, int) {
+	if max <= 0 {
+		return oo, 0
+	}
+	if len(oo) == 1 {
+		if table, ok := oo[0].(*metav1beta1.Table); ok && len(table.Rows) > max {
+			t := *table
+			t.Rows = t.Rows[:max]
+			return []runtime.Object{&t}, len(table.Rows)
+		}
+	}
+	if len(oo) <= max {
+		return oo, 0
+	}
+	ss := make([]runtime.Object, len(oo))
+	copy(ss, oo)
+	sort.SliceStable(ss, func(i, j int) bool {
+		return objectPath(ss[i]) < objectPath(ss[j])
+	})
+
+	return ss[:max], len(oo)
+}
+
+func objectPath(o runtime.Object) string {
+	m, err := apimeta.Accessor(o)
+	if err != nil {
+		return ""
+	}
+
+	return client.FQN(m.GetNamespace(), m.GetName())
+}
+
 func genericHydrate(ns string, table *metav1beta1.Table, rr render.Rows, re Renderer) error {
 	gr, ok := re.(Generic)
 	if !ok {
@@ -0,0 +1,155 @@
+package model
+
+import (
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+)
+
+const (
+	// tableHistorySize tracks the max number of table snapshots per view.
+	tableHistorySize = 300
+
+	// tableHistoryAge tracks how far back table snapshots are kept.
+	tableHistoryAge = 30 * time.Minute
+)
+
+// TableSnapshot represents a table state at a given time.
+type TableSnapshot struct {
+	At   time.Time
+	Data *render.TableData
+}
+
+// TableHistory tracks recent table states in a ring buffer.
+type TableHistory struct {
+	snaps   []TableSnapshot
+	head    int
+	count   int
+	maxAge  time.Duration
+	maxRows int
+	rows    int
+}
+
+// NewTableHistory returns a new table history.
+func NewTableHistory(size int, maxAge time.Duration) *TableHistory {
+	return &TableHistory{
+		snaps:  make([]TableSnapshot, size),
+		maxAge: maxAge,
+	}
+}
+
+// SetMaxRows caps the rows kept across all snapshots. Zero means no cap.
+func (h *TableHistory) SetMaxRows(n int) {
+	h.maxRows = n
+	h.evict(0)
+}
+
+// Rows returns the number of rows held across all snapshots.
+func (h *TableHistory) Rows() int {
+	return h.rows
+}
+
+// Len returns the number of snapshots.
+func (h *TableHistory) Len() int {
+	return h.count
+}
+
+// Clear drops all snapshots.
+func (h *TableHistory) Clear() {
+	for i := range h.snaps {
+		h.snaps[i] = TableSnapshot{}
+	}
+	h.head, h.count, h.rows = 0, 0, 0
+}
+
+// Push records a new table state unless it matches the latest one. Returns true
+// if a snapshot was added.
+func (h *TableHistory) Push(at time.Time, data *render.TableData) bool {
+	if len(h.snaps) == 0 {
+		return false
+	}
+	h.expire(at)
+	if h.count > 0 && sameTable(h.At(0).Data, data) {
+		return false
+	}
+	n := tableRows(data)
+	if h.maxRows > 0 && n > h.maxRows {
+		// A table over budget can not be rewound.
+		h.Clear()
+		return false
+	}
+	h.evict(n)
+	if h.count == len(h.snaps) {
+		h.drop()
+	}
+	h.snaps[h.head] = TableSnapshot{At: at, Data: data}
+	h.head = (h.head + 1) % len(h.snaps)
+	h.count++
+	h.rows += n
+
+	return true
+}
+
+// At returns the snapshot i steps back from the latest one.
+func (h *TableHistory) At(i int) TableSnapshot {
+	if i < 0 || i >= h.count {
+		return TableSnapshot{}
+	}
+
+	return h.snaps[(h.head-1-i+2*len(h.snaps))%len(h.snaps)]
+}
+
+func (h *TableHistory) expire(now time.Time) {
+	for h.count > 0 && now.Sub(h.At(h.count-1).At) > h.maxAge {
+		h.drop()
+	}
+}
+
+// evict drops the oldest snapshots until n more rows fit the budget.
+func (h *TableHistory) evict(n int) {
+	for h.maxRows > 0 && h.count > 0 && h.rows+n > h.maxRows {
+		h.drop()
+	}
+}
+
+// drop releases the oldest snapshot.
+func (h *TableHistory) drop() {
+	i := (h.head - h.count + len(h.snaps)) % len(h.snaps)
+	h.rows -= tableRows(h.snaps[i].Data)
+	h.snaps[i] = TableSnapshot{}
+	h.count--
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func tableRows(data *render.TableData) int {
+	if data == nil {
+		return 0
+	}
+
+	return len(data.RowEvents)
+}
+
+// sameTable checks if two tables hold the same rows, ignoring time and metrics columns.
+func sameTable(a, b *render.TableData) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	if len(a.Header) != len(b.Header) || len(a.RowEvents) != len(b.RowEvents) {
+		return false
+	}
+	for i := range a.RowEvents {
+		ra, rb := a.RowEvents[i].Row, b.RowEvents[i].Row
+		if ra.ID != rb.ID || len(ra.Fields) != len(rb.Fields) {
+			return false
+		}
+		for j := range ra.Fields {
+			if !b.Header.IsTimeCol(j) && !b.Header.IsMetricsCol(j) && ra.Fields[j] != rb.Fields[j] {
+				return false
+			}
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,103 @@
+package model_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTableHistoryPush(t *testing.T) {
+	h := model.NewTableHistory(3, time.Hour)
+	now := time.Now()
+
+	assert.True(t, h.Push(now, historyData("p1", "Running", "1m")))
+	assert.False(t, h.Push(now.Add(time.Second), historyData("p1", "Running", "2m")))
+	assert.True(t, h.Push(now.Add(2*time.Second), historyData("p1", "Failed", "2m")))
+	assert.Equal(t, 2, h.Len())
+	assert.Equal(t, "Failed", h.At(0).Data.RowEvents[0].Row.Fields[1])
+	assert.Equal(t, "Running", h.At(1).Data.RowEvents[0].Row.Fields[1])
+
+	h.Push(now.Add(3*time.Second), historyData("p1", "Pending", "2m"))
+	h.Push(now.Add(4*time.Second), historyData("p1", "Running", "2m"))
+	assert.Equal(t, 3, h.Len())
+	assert.Equal(t, "Running", h.At(0).Data.RowEvents[0].Row.Fields[1])
+	assert.Equal(t, "Failed", h.At(2).Data.RowEvents[0].Row.Fields[1])
+	assert.Nil(t, h.At(3).Data)
+}
+
+func TestTableHistoryExpire(t *testing.T) {
+	h := model.NewTableHistory(10, time.Minute)
+	now := time.Now()
+
+	h.Push(now, historyData("p1", "Running", "1m"))
+	h.Push(now.Add(30*time.Second), historyData("p1", "Failed", "1m"))
+	h.Push(now.Add(90*time.Second), historyData("p1", "Pending", "1m"))
+	assert.Equal(t, 2, h.Len())
+	assert.Equal(t, "Failed", h.At(1).Data.RowEvents[0].Row.Fields[1])
+}
+
+func TestTableHistoryMaxRows(t *testing.T) {
+	h := model.NewTableHistory(300, time.Hour)
+	h.SetMaxRows(1000)
+	now := time.Now()
+
+	for i := 0; i < 300; i++ {
+		assert.True(t, h.Push(now.Add(time.Duration(i)*time.Second), bigHistoryData(i, 150)))
+		assert.LessOrEqual(t, h.Rows(), 1000)
+	}
+	assert.Equal(t, 6, h.Len())
+	assert.Equal(t, 900, h.Rows())
+	assert.Equal(t, "299", h.At(0).Data.RowEvents[0].Row.Fields[1])
+
+	h.SetMaxRows(400)
+	assert.Equal(t, 2, h.Len())
+	assert.Equal(t, 300, h.Rows())
+
+	assert.False(t, h.Push(now.Add(time.Hour), bigHistoryData(0, 500)))
+	assert.Equal(t, 0, h.Len())
+	assert.Equal(t, 0, h.Rows())
+}
+
+func TestTableHistoryIgnoresMetrics(t *testing.T) {
+	h := model.NewTableHistory(10, time.Hour)
+	now := time.Now()
+
+	d1, d2 := historyData("p1", "Running", "1m"), historyData("p1", "Running", "1m")
+	d1.Header[1].MX, d2.Header[1].MX = true, true
+	d2.RowEvents[0].Row.Fields[1] = "Failed"
+	assert.True(t, h.Push(now, d1))
+	assert.False(t, h.Push(now.Add(time.Second), d2))
+}
+
+func bigHistoryData(gen, rows int) *render.TableData {
+	data := render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "GEN"},
+		},
+		RowEvents: make(render.RowEvents, 0, rows),
+	}
+	for i := 0; i < rows; i++ {
+		n := fmt.Sprintf("p%d", i)
+		data.RowEvents = append(data.RowEvents, render.RowEvent{Row: render.Row{ID: n, Fields: render.Fields{n, fmt.Sprintf("%d", gen)}}})
+	}
+
+	return &data
+}
+
+func historyData(n, status, age string) *render.TableData {
+	return &render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "STATUS"},
+			render.HeaderColumn{Name: "AGE", Time: true},
+		},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: n, Fields: render.Fields{n, status, age}}},
+		},
+	}
+}
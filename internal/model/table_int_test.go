@@ -32,7 +32,7 @@ func TestTableReconcile(t *testing.T) {
 	err := ta.reconcile(ctx)
 	assert.Nil(t, err)
 	data := ta.Peek()
-	assert.Equal(t, 22, len(data.Header))
+	assert.Equal(t, 25, len(data.Header))
 	assert.Equal(t, 1, len(data.RowEvents))
 	assert.Equal(t, client.NamespaceAll, data.Namespace)
 }
@@ -105,7 +105,53 @@ func TestTableHydrate(t *testing.T) {
 
 	assert.Nil(t, hydrate("blee", oo, rr, render.Pod{}))
 	assert.Equal(t, 1, len(rr))
-	assert.Equal(t, 22, len(rr[0].Fields))
+	assert.Equal(t, 25, len(rr[0].Fields))
+}
+
+func TestTableTruncateObjects(t *testing.T) {
+	oo := []runtime.Object{
+		&unstructured.Unstructured{Object: map[string]interface{}{"metadata": map[string]interface{}{"namespace": "ns2", "name": "a"}}},
+		&unstructured.Unstructured{Object: map[string]interface{}{"metadata": map[string]interface{}{"namespace": "ns1", "name": "b"}}},
+		&unstructured.Unstructured{Object: map[string]interface{}{"metadata": map[string]interface{}{"namespace": "ns1", "name": "a"}}},
+	}
+
+	uu := map[string]struct {
+		max   int
+		e     []string
+		total int
+	}{
+		"none":   {max: 0, e: []string{"ns2/a", "ns1/b", "ns1/a"}},
+		"within": {max: 3, e: []string{"ns2/a", "ns1/b", "ns1/a"}},
+		"over":   {max: 2, e: []string{"ns1/a", "ns1/b"}, total: 3},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, total := truncateObjects(oo, u.max)
+			assert.Equal(t, u.total, total)
+			pp := make([]string, 0, len(rr))
+			for _, r := range rr {
+				pp = append(pp, objectPath(r))
+			}
+			assert.Equal(t, u.e, pp)
+		})
+	}
+}
+
+func TestTableTruncateTable(t *testing.T) {
+	tt := metav1beta1.Table{
+		Rows: []metav1beta1.TableRow{
+			{Cells: []interface{}{"fred"}},
+			{Cells: []interface{}{"blee"}},
+			{Cells: []interface{}{"zorg"}},
+		},
+	}
+
+	oo, total := truncateObjects([]runtime.Object{&tt}, 2)
+	assert.Equal(t, 3, total)
+	assert.Equal(t, 2, len(oo[0].(*metav1beta1.Table).Rows))
+	assert.Equal(t, 3, len(tt.Rows))
 }
 
 func TestTableGenericHydrate(t *testing.T) {
@@ -0,0 +1,242 @@
+package model
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path"
+	"runtime"
+	"strings"
+)
+
+const (
+	checksumsAsset = "checksums.txt"
+
+	// maxReleaseAssetSize caps the size of a downloaded release asset.
+	maxReleaseAssetSize = 200 << 20
+)
+
+// Release represents a published k9s release.
+type Release struct {
+	Version string
+	// Assets tracks the download urls keyed by asset name.
+	Assets map[string]string
+}
+
+// FetchLatestRelease returns the latest published k9s release.
+func FetchLatestRelease(ctx context.Context) (*Release, error) {
+	return fetchRelease(ctx, k9sGitURL)
+}
+
+// ReleaseArchive returns the release archive name for a given platform.
+func ReleaseArchive(goos, goarch string) string {
+	switch goos {
+	case "darwin", "linux", "windows":
+		goos = strings.ToUpper(goos[:1]) + goos[1:]
+	}
+
+	return fmt.Sprintf("k9s_%s_%s.tar.gz", goos, goarch)
+}
+
+// ManagedInstall checks if a binary was installed by a package manager and returns its name.
+// Such installs must be upgraded via their package manager.
+func ManagedInstall(exe string) (string, bool) {
+	p := strings.ReplaceAll(strings.ToLower(exe), `\`, "/")
+	for _, m := range []struct{ marker, name string }{
+		{"/cellar/", "homebrew"},
+		{"/homebrew/", "homebrew"},
+		{"/linuxbrew/", "homebrew"},
+		{"/snap/", "snap"},
+		{"/nix/store/", "nix"},
+		{"/scoop/", "scoop"},
+		{"/chocolatey/", "chocolatey"},
+		{"/winget/", "winget"},
+		{"/macports/", "macports"},
+		{"/usr/bin/", "your system package manager"},
+	} {
+		if strings.Contains(p, m.marker) {
+			return m.name, true
+		}
+	}
+
+	return "", false
+}
+
+// SelfUpdate replaces a k9s binary with the one shipped with a given release once the
+// release archive checksum is verified.
+func SelfUpdate(ctx context.Context, r *Release, exe string) error {
+	archive := ReleaseArchive(runtime.GOOS, runtime.GOARCH)
+	url, ok := r.Assets[archive]
+	if !ok {
+		return fmt.Errorf("no %s archive available for %s/%s", r.Version, runtime.GOOS, runtime.GOARCH)
+	}
+	sumsURL, ok := r.Assets[checksumsAsset]
+	if !ok {
+		return fmt.Errorf("no checksums available for %s", r.Version)
+	}
+
+	sums, err := httpGet(ctx, sumsURL, 1<<20)
+	if err != nil {
+		return err
+	}
+	sum, err := releaseChecksum(sums, archive)
+	if err != nil {
+		return err
+	}
+	raw, err := httpGet(ctx, url, maxReleaseAssetSize)
+	if err != nil {
+		return err
+	}
+	if actual := sha256.Sum256(raw); hex.EncodeToString(actual[:]) != sum {
+		return fmt.Errorf("checksum mismatch for %s", archive)
+	}
+	bin, err := extractBinary(raw, binaryName(runtime.GOOS))
+	if err != nil {
+		return err
+	}
+
+	return swapBinary(exe, bin, runtime.GOOS)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func fetchRelease(ctx context.Context, url string) (*Release, error) {
+	raw, err := httpGet(ctx, url, 1<<20)
+	if err != nil {
+		return nil, err
+	}
+	var rel struct {
+		TagName string `json:"tag_name"`
+		Name    string `json:"name"`
+		Assets  []struct {
+			Name string `json:"name"`
+			URL  string `json:"browser_download_url"`
+		} `json:"assets"`
+	}
+	if err := json.Unmarshal(raw, &rel); err != nil {
+		return nil, err
+	}
+	r := Release{Version: rel.TagName, Assets: make(map[string]string, len(rel.Assets))}
+	if r.Version == "" {
+		r.Version = rel.Name
+	}
+	if r.Version == "" {
+		return nil, errors.New("No version found")
+	}
+	for _, a := range rel.Assets {
+		r.Assets[a.Name] = a.URL
+	}
+
+	return &r, nil
+}
+
+func httpGet(ctx context.Context, url string, max int64) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s failed: %s", url, resp.Status)
+	}
+	raw, err := io.ReadAll(io.LimitReader(resp.Body, max+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(raw)) > max {
+		return nil, fmt.Errorf("%s exceeds %d bytes", url, max)
+	}
+
+	return raw, nil
+}
+
+// releaseChecksum returns the sha256 checksum of an asset from a checksums file.
+func releaseChecksum(sums []byte, asset string) (string, error) {
+	scanner := bufio.NewScanner(bytes.NewReader(sums))
+	for scanner.Scan() {
+		ff := strings.Fields(scanner.Text())
+		if len(ff) == 2 && strings.TrimPrefix(ff[1], "*") == asset {
+			return strings.ToLower(ff[0]), nil
+		}
+	}
+
+	return "", fmt.Errorf("no checksum found for %s", asset)
+}
+
+func binaryName(goos string) string {
+	if goos == "windows" {
+		return "k9s.exe"
+	}
+
+	return "k9s"
+}
+
+// extractBinary returns the content of a file from a tar gz archive.
+func extractBinary(archive []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewReader(archive))
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = gz.Close()
+	}()
+
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, fmt.Errorf("%s not found in release archive", name)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if h.Typeflag != tar.TypeReg || path.Base(h.Name) != name {
+			continue
+		}
+		return io.ReadAll(io.LimitReader(tr, maxReleaseAssetSize))
+	}
+}
+
+// swapBinary replaces a binary in place. Windows does not allow replacing a running
+// binary, so it is moved aside first.
+func swapBinary(exe string, bin []byte, goos string) error {
+	info, err := os.Stat(exe)
+	if err != nil {
+		return err
+	}
+	tmp := exe + ".new"
+	if err := os.WriteFile(tmp, bin, info.Mode().Perm()); err != nil {
+		return err
+	}
+	if goos == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			_ = os.Remove(tmp)
+			return err
+		}
+	}
+	if err := os.Rename(tmp, exe); err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFetchRelease(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"tag_name":"v0.27.4","name":"Release v0.27.4","assets":[{"name":"checksums.txt","browser_download_url":"https://example.com/checksums.txt"}]}`))
+	}))
+	defer srv.Close()
+
+	r, err := fetchRelease(context.Background(), srv.URL)
+	assert.NoError(t, err)
+	assert.Equal(t, "v0.27.4", r.Version)
+	assert.Equal(t, map[string]string{"checksums.txt": "https://example.com/checksums.txt"}, r.Assets)
+}
+
+func TestReleaseChecksum(t *testing.T) {
+	sums := []byte("abc123  k9s_Linux_amd64.tar.gz\nDEF456 *k9s_Darwin_arm64.tar.gz\n")
+
+	s, err := releaseChecksum(sums, "k9s_Darwin_arm64.tar.gz")
+	assert.NoError(t, err)
+	assert.Equal(t, "def456", s)
+
+	_, err = releaseChecksum(sums, "k9s_Windows_amd64.tar.gz")
+	assert.Error(t, err)
+}
@@ -0,0 +1,147 @@
+package model_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReleaseArchive(t *testing.T) {
+	assert.Equal(t, "k9s_Linux_amd64.tar.gz", model.ReleaseArchive("linux", "amd64"))
+	assert.Equal(t, "k9s_Darwin_arm64.tar.gz", model.ReleaseArchive("darwin", "arm64"))
+	assert.Equal(t, "k9s_Windows_amd64.tar.gz", model.ReleaseArchive("windows", "amd64"))
+	assert.Equal(t, "k9s_freebsd_amd64.tar.gz", model.ReleaseArchive("freebsd", "amd64"))
+}
+
+func TestManagedInstall(t *testing.T) {
+	uu := map[string]struct {
+		exe, e string
+		ok     bool
+	}{
+		"brew": {
+			exe: "/opt/homebrew/Cellar/k9s/0.27.3/bin/k9s",
+			e:   "homebrew",
+			ok:  true,
+		},
+		"snap": {
+			exe: "/snap/k9s/155/bin/k9s",
+			e:   "snap",
+			ok:  true,
+		},
+		"scoop": {
+			exe: `C:\Users\fred\scoop\apps\k9s\current\k9s.exe`,
+			e:   "scoop",
+			ok:  true,
+		},
+		"local": {
+			exe: "/home/fred/bin/k9s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pm, ok := model.ManagedInstall(u.exe)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, pm)
+		})
+	}
+}
+
+func TestSelfUpdate(t *testing.T) {
+	archive := model.ReleaseArchive(runtime.GOOS, runtime.GOARCH)
+	name := "k9s"
+	if runtime.GOOS == "windows" {
+		name = "k9s.exe"
+	}
+	raw := makeArchive(t, name, "new-k9s")
+	sum := sha256.Sum256(raw)
+
+	uu := map[string]struct {
+		sums string
+		err  bool
+		e    string
+	}{
+		"happy": {
+			sums: fmt.Sprintf("%x  %s\n", sum, archive),
+			e:    "new-k9s",
+		},
+		"mismatch": {
+			sums: fmt.Sprintf("%x  %s\n", sha256.Sum256([]byte("bozo")), archive),
+			err:  true,
+			e:    "old-k9s",
+		},
+		"missing": {
+			sums: fmt.Sprintf("%x  k9s_Plan9_amd64.tar.gz\n", sum),
+			err:  true,
+			e:    "old-k9s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/" + archive:
+					_, _ = w.Write(raw)
+				case "/checksums.txt":
+					_, _ = w.Write([]byte(u.sums))
+				default:
+					http.NotFound(w, r)
+				}
+			}))
+			defer srv.Close()
+
+			exe := filepath.Join(t.TempDir(), name)
+			assert.NoError(t, os.WriteFile(exe, []byte("old-k9s"), 0755))
+			rel := model.Release{
+				Version: "v0.27.4",
+				Assets: map[string]string{
+					archive:         srv.URL + "/" + archive,
+					"checksums.txt": srv.URL + "/checksums.txt",
+				},
+			}
+
+			err := model.SelfUpdate(context.Background(), &rel, exe)
+			assert.Equal(t, u.err, err != nil)
+			bin, err := os.ReadFile(exe)
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, string(bin))
+		})
+	}
+}
+
+func TestSelfUpdateNoArchive(t *testing.T) {
+	rel := model.Release{Version: "v0.27.4"}
+	assert.Error(t, model.SelfUpdate(context.Background(), &rel, "/tmp/k9s"))
+}
+
+// Helpers...
+
+func makeArchive(t *testing.T, name, content string) []byte {
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	tw := tar.NewWriter(gz)
+	for n, c := range map[string]string{"README.md": "k9s", name: content} {
+		assert.NoError(t, tw.WriteHeader(&tar.Header{Name: n, Mode: 0755, Size: int64(len(c)), Typeflag: tar.TypeReg}))
+		_, err := tw.Write([]byte(c))
+		assert.NoError(t, err)
+	}
+	assert.NoError(t, tw.Close())
+	assert.NoError(t, gz.Close())
+
+	return b.Bytes()
+}
@@ -230,7 +230,14 @@ func getMeta(ctx context.Context, gvr client.GVR) (ResourceMeta, error) {
 
 func resourceMeta(gvr client.GVR) ResourceMeta {
 	meta, ok := Registry[gvr.String()]
-	if !ok {
+	switch {
+	case ok:
+	case dao.IsCrossplane(gvr):
+		meta = ResourceMeta{
+			DAO:      &dao.Crossplane{},
+			Renderer: &render.Crossplane{},
+		}
+	default:
 		meta = ResourceMeta{
 			DAO:      &dao.Table{},
 			Renderer: &render.Generic{},
@@ -0,0 +1,191 @@
+package perf
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	totalRx   = regexp.MustCompile(`Total:\s+([0-9.]+)\ssecs`)
+	slowestRx = regexp.MustCompile(`Slowest:\s+([0-9.]+)\ssecs`)
+	fastestRx = regexp.MustCompile(`Fastest:\s+([0-9.]+)\ssecs`)
+	averageRx = regexp.MustCompile(`Average:\s+([0-9.]+)\ssecs`)
+	reqRx     = regexp.MustCompile(`Requests/sec:\s+([0-9.]+)`)
+	latencyRx = regexp.MustCompile(`(\d+)%\s+in\s+([0-9.]+)\ssecs`)
+	okRx      = regexp.MustCompile(`\[2\d{2}\]\s+(\d+)\s+responses`)
+	errRx     = regexp.MustCompile(`\[[4-5]\d{2}\]\s+(\d+)\s+responses`)
+)
+
+// Report represents a benchmark run summary.
+type Report struct {
+	Total, Slowest, Fastest, Average float64
+	RPS                              float64
+	P50, P90, P99                    float64
+	OK, Errors                       int
+}
+
+// Delta represents a metric change between two benchmark runs.
+type Delta struct {
+	Metric     string
+	Prev, Curr float64
+	// Higher indicates a larger value is an improvement.
+	Higher bool
+}
+
+// Percent returns the relative change in percent.
+func (d Delta) Percent() float64 {
+	if d.Prev == 0 {
+		return 0
+	}
+	return (d.Curr - d.Prev) / d.Prev * 100
+}
+
+// Regressed checks if the latest run is worse off than the previous one.
+func (d Delta) Regressed() bool {
+	if d.Higher {
+		return d.Curr < d.Prev
+	}
+	return d.Curr > d.Prev
+}
+
+// NewReport parses a benchmark output into a report.
+func NewReport(data string) Report {
+	r := Report{
+		Total:   matchFloat(totalRx, data),
+		Slowest: matchFloat(slowestRx, data),
+		Fastest: matchFloat(fastestRx, data),
+		Average: matchFloat(averageRx, data),
+		RPS:     matchFloat(reqRx, data),
+		OK:      sumResponses(okRx, data),
+		Errors:  sumResponses(errRx, data),
+	}
+	for _, m := range latencyRx.FindAllStringSubmatch(data, -1) {
+		v, err := strconv.ParseFloat(m[2], 64)
+		if err != nil {
+			continue
+		}
+		switch m[1] {
+		case "50":
+			r.P50 = v
+		case "90":
+			r.P90 = v
+		case "99":
+			r.P99 = v
+		}
+	}
+
+	return r
+}
+
+// Compare returns the metric deltas between a previous run and this one.
+func (r Report) Compare(prev Report) []Delta {
+	return []Delta{
+		{Metric: "Requests/sec", Prev: prev.RPS, Curr: r.RPS, Higher: true},
+		{Metric: "Average", Prev: prev.Average, Curr: r.Average},
+		{Metric: "Fastest", Prev: prev.Fastest, Curr: r.Fastest},
+		{Metric: "Slowest", Prev: prev.Slowest, Curr: r.Slowest},
+		{Metric: "P50", Prev: prev.P50, Curr: r.P50},
+		{Metric: "P90", Prev: prev.P90, Curr: r.P90},
+		{Metric: "P99", Prev: prev.P99, Curr: r.P99},
+		{Metric: "2XX", Prev: float64(prev.OK), Curr: float64(r.OK), Higher: true},
+		{Metric: "4XX/5XX", Prev: float64(prev.Errors), Curr: float64(r.Errors)},
+	}
+}
+
+// History returns all benchmark runs for the same target as the given run file,
+// ordered from oldest to newest.
+func History(path string) ([]string, error) {
+	target, ok := benchTarget(filepath.Base(path))
+	if !ok {
+		return nil, fmt.Errorf("invalid benchmark file %q", path)
+	}
+	dir := filepath.Dir(path)
+	ee, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]string, 0, len(ee))
+	for _, e := range ee {
+		if t, ok := benchTarget(e.Name()); ok && t == target {
+			runs = append(runs, e.Name())
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		return benchStamp(runs[i]) < benchStamp(runs[j])
+	})
+	for i := range runs {
+		runs[i] = filepath.Join(dir, runs[i])
+	}
+
+	return runs, nil
+}
+
+// PreviousRun returns the benchmark run preceding the given one for the same target.
+func PreviousRun(path string) (string, error) {
+	runs, err := History(path)
+	if err != nil {
+		return "", err
+	}
+	for i, r := range runs {
+		if r == path && i > 0 {
+			return runs[i-1], nil
+		}
+	}
+
+	return "", fmt.Errorf("no previous benchmark run found for %s", filepath.Base(path))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func benchTarget(file string) (string, bool) {
+	i := strings.LastIndex(file, "_")
+	if i <= 0 || benchStamp(file) == 0 {
+		return "", false
+	}
+
+	return file[:i], true
+}
+
+func benchStamp(file string) int64 {
+	i := strings.LastIndex(file, "_")
+	if i < 0 {
+		return 0
+	}
+	n, err := strconv.ParseInt(strings.TrimSuffix(file[i+1:], filepath.Ext(file)), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return n
+}
+
+func matchFloat(rx *regexp.Regexp, data string) float64 {
+	mm := rx.FindStringSubmatch(data)
+	if len(mm) < 2 {
+		return 0
+	}
+	v, err := strconv.ParseFloat(mm[1], 64)
+	if err != nil {
+		return 0
+	}
+
+	return v
+}
+
+func sumResponses(rx *regexp.Regexp, data string) int {
+	var sum int
+	for _, m := range rx.FindAllStringSubmatch(data, -1) {
+		if n, err := strconv.Atoi(m[1]); err == nil {
+			sum += n
+		}
+	}
+
+	return sum
+}
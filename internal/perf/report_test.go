@@ -0,0 +1,78 @@
+package perf_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/perf"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewReport(t *testing.T) {
+	data, err := os.ReadFile("testdata/default_fred_200.txt")
+	assert.NoError(t, err)
+
+	r := perf.NewReport(string(data))
+	assert.Equal(t, 3.3544, r.Total)
+	assert.Equal(t, 29.8116, r.RPS)
+	assert.Equal(t, 0.0335, r.Average)
+	assert.Equal(t, 0.0320, r.P50)
+	assert.Equal(t, 0.0369, r.P90)
+	assert.Equal(t, 0.1031, r.P99)
+	assert.Equal(t, 100, r.OK)
+	assert.Equal(t, 12, r.Errors)
+}
+
+func TestReportCompare(t *testing.T) {
+	prev := perf.Report{RPS: 100, Average: 0.2, Errors: 0}
+	curr := perf.Report{RPS: 50, Average: 0.1, Errors: 2}
+
+	dd := curr.Compare(prev)
+	assert.Equal(t, 9, len(dd))
+	assert.Equal(t, "Requests/sec", dd[0].Metric)
+	assert.Equal(t, -50.0, dd[0].Percent())
+	assert.True(t, dd[0].Regressed())
+	assert.Equal(t, "Average", dd[1].Metric)
+	assert.False(t, dd[1].Regressed())
+	assert.Equal(t, "4XX/5XX", dd[8].Metric)
+	assert.True(t, dd[8].Regressed())
+	assert.Equal(t, 0.0, dd[8].Percent())
+}
+
+func TestPreviousRun(t *testing.T) {
+	uu := map[string]struct {
+		path, e string
+		err     bool
+	}{
+		"latest": {
+			path: filepath.Join("testdata", "default_fred_200.txt"),
+			e:    filepath.Join("testdata", "default_fred_100.txt"),
+		},
+		"first": {
+			path: filepath.Join("testdata", "default_fred_100.txt"),
+			err:  true,
+		},
+		"single": {
+			path: filepath.Join("testdata", "default_blee_150.txt"),
+			err:  true,
+		},
+		"invalid": {
+			path: filepath.Join("testdata", "fred.txt"),
+			err:  true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			prev, err := perf.PreviousRun(u.path)
+			if u.err {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, prev)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type (
+	// APFFlowSchema represents an API Priority and Fairness FlowSchema.
+	APFFlowSchema struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			PriorityLevelConfiguration struct {
+				Name string `json:"name"`
+			} `json:"priorityLevelConfiguration"`
+			MatchingPrecedence  int32 `json:"matchingPrecedence,omitempty"`
+			DistinguisherMethod *struct {
+				Type string `json:"type"`
+			} `json:"distinguisherMethod,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Conditions []APFCondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// APFPriorityLevel represents an API Priority and Fairness PriorityLevelConfiguration.
+	APFPriorityLevel struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Type    string `json:"type"`
+			Limited *struct {
+				NominalConcurrencyShares *int32 `json:"nominalConcurrencyShares,omitempty"`
+				AssuredConcurrencyShares *int32 `json:"assuredConcurrencyShares,omitempty"`
+				LimitResponse            struct {
+					Type string `json:"type"`
+				} `json:"limitResponse"`
+			} `json:"limited,omitempty"`
+		} `json:"spec"`
+	}
+
+	// APFCondition represents a flow control resource condition.
+	APFCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// APFLoad represents the current flow control load as reported by the api server metrics.
+type APFLoad struct {
+	InQueue, Executing int64
+	Rejected           int64
+	Limit              int64
+}
+
+// FlowSchemaWithLoad represents a FlowSchema along with its current load.
+type FlowSchemaWithLoad struct {
+	Raw  *unstructured.Unstructured
+	Load *APFLoad
+}
+
+// GetObjectKind returns a schema object.
+func (f *FlowSchemaWithLoad) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f *FlowSchemaWithLoad) DeepCopyObject() runtime.Object {
+	return f
+}
+
+// FlowSchema renders an API Priority and Fairness FlowSchema to screen.
+type FlowSchema struct {
+	Base
+}
+
+// Header returns a header row.
+func (FlowSchema) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PRIORITY-LEVEL"},
+		HeaderColumn{Name: "PRECEDENCE", Align: tview.AlignRight},
+		HeaderColumn{Name: "DISTINGUISHER"},
+		HeaderColumn{Name: "INQUEUE", Align: tview.AlignRight},
+		HeaderColumn{Name: "EXECUTING", Align: tview.AlignRight},
+		HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (FlowSchema) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw  *unstructured.Unstructured
+		load *APFLoad
+	)
+	switch fs := o.(type) {
+	case *FlowSchemaWithLoad:
+		raw, load = fs.Raw, fs.Load
+	case *unstructured.Unstructured:
+		raw = fs
+	default:
+		return fmt.Errorf("Expected FlowSchema, but got %T", o)
+	}
+	var fs APFFlowSchema
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &fs)
+	if err != nil {
+		return err
+	}
+
+	var distinguisher string
+	if fs.Spec.DistinguisherMethod != nil {
+		distinguisher = fs.Spec.DistinguisherMethod.Type
+	}
+	inQueue, executing, rejected := apfLoadCols(load)
+
+	r.ID = client.MetaFQN(fs.ObjectMeta)
+	r.Fields = Fields{
+		fs.Name,
+		fs.Spec.PriorityLevelConfiguration.Name,
+		strconv.Itoa(int(fs.Spec.MatchingPrecedence)),
+		na(distinguisher),
+		inQueue,
+		executing,
+		rejected,
+		asStatus(flowSchemaDiagnose(fs, load)),
+		toAge(fs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func flowSchemaDiagnose(fs APFFlowSchema, load *APFLoad) error {
+	for _, c := range fs.Status.Conditions {
+		if c.Type == "Dangling" && c.Status == string(metav1.ConditionTrue) {
+			return fmt.Errorf("priority level %s not found", fs.Spec.PriorityLevelConfiguration.Name)
+		}
+	}
+
+	return apfRejectedDiagnose(load)
+}
+
+// PriorityLevelWithLoad represents a PriorityLevelConfiguration along with its current load.
+type PriorityLevelWithLoad struct {
+	Raw  *unstructured.Unstructured
+	Load *APFLoad
+}
+
+// GetObjectKind returns a schema object.
+func (p *PriorityLevelWithLoad) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PriorityLevelWithLoad) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// PriorityLevel renders an API Priority and Fairness PriorityLevelConfiguration to screen.
+type PriorityLevel struct {
+	Base
+}
+
+// Header returns a header row.
+func (PriorityLevel) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "SHARES", Align: tview.AlignRight},
+		HeaderColumn{Name: "LIMIT-RESPONSE"},
+		HeaderColumn{Name: "SEATS", Align: tview.AlignRight},
+		HeaderColumn{Name: "EXECUTING", Align: tview.AlignRight},
+		HeaderColumn{Name: "INQUEUE", Align: tview.AlignRight},
+		HeaderColumn{Name: "%UTIL", Align: tview.AlignRight},
+		HeaderColumn{Name: "REJECTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PriorityLevel) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw  *unstructured.Unstructured
+		load *APFLoad
+	)
+	switch pl := o.(type) {
+	case *PriorityLevelWithLoad:
+		raw, load = pl.Raw, pl.Load
+	case *unstructured.Unstructured:
+		raw = pl
+	default:
+		return fmt.Errorf("Expected PriorityLevel, but got %T", o)
+	}
+	var pl APFPriorityLevel
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &pl)
+	if err != nil {
+		return err
+	}
+
+	shares, response := NAValue, NAValue
+	if l := pl.Spec.Limited; l != nil {
+		response = na(l.LimitResponse.Type)
+		switch {
+		case l.NominalConcurrencyShares != nil:
+			shares = strconv.Itoa(int(*l.NominalConcurrencyShares))
+		case l.AssuredConcurrencyShares != nil:
+			shares = strconv.Itoa(int(*l.AssuredConcurrencyShares))
+		}
+	}
+	inQueue, executing, rejected := apfLoadCols(load)
+	seats, util := NAValue, NAValue
+	if load != nil && load.Limit > 0 {
+		seats = strconv.FormatInt(load.Limit, 10)
+		util = strconv.Itoa(apfUtilization(load))
+	}
+
+	r.ID = client.MetaFQN(pl.ObjectMeta)
+	r.Fields = Fields{
+		pl.Name,
+		pl.Spec.Type,
+		shares,
+		response,
+		seats,
+		executing,
+		inQueue,
+		util,
+		rejected,
+		asStatus(priorityLevelDiagnose(load)),
+		toAge(pl.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func priorityLevelDiagnose(load *APFLoad) error {
+	if load != nil && load.Limit > 0 && apfUtilization(load) >= 100 {
+		return errors.New("concurrency limit saturated")
+	}
+
+	return apfRejectedDiagnose(load)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func apfLoadCols(load *APFLoad) (string, string, string) {
+	if load == nil {
+		return NAValue, NAValue, NAValue
+	}
+
+	return strconv.FormatInt(load.InQueue, 10),
+		strconv.FormatInt(load.Executing, 10),
+		strconv.FormatInt(load.Rejected, 10)
+}
+
+func apfUtilization(load *APFLoad) int {
+	return int(load.Executing * 100 / load.Limit)
+}
+
+func apfRejectedDiagnose(load *APFLoad) error {
+	if load != nil && load.Rejected > 0 {
+		return fmt.Errorf("%d requests rejected (429) since api server start", load.Rejected)
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestFlowSchemaRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "service-accounts",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"priorityLevelConfiguration": map[string]interface{}{"name": "workload-low"},
+			"matchingPrecedence":         int64(9000),
+			"distinguisherMethod":        map[string]interface{}{"type": "ByUser"},
+		},
+	}}
+	dangling := o.DeepCopy()
+	dangling.Object["status"] = map[string]interface{}{
+		"conditions": []interface{}{map[string]interface{}{"type": "Dangling", "status": "True"}},
+	}
+
+	uu := map[string]struct {
+		o     interface{}
+		e     render.Fields
+		valid string
+	}{
+		"plain": {
+			o:     &o,
+			e:     render.Fields{"service-accounts", "workload-low", "9000", "ByUser", "n/a", "n/a", "n/a"},
+			valid: "",
+		},
+		"loaded": {
+			o:     &render.FlowSchemaWithLoad{Raw: &o, Load: &render.APFLoad{InQueue: 3, Executing: 10, Rejected: 42}},
+			e:     render.Fields{"service-accounts", "workload-low", "9000", "ByUser", "3", "10", "42"},
+			valid: "42 requests rejected (429) since api server start",
+		},
+		"dangling": {
+			o:     &render.FlowSchemaWithLoad{Raw: dangling, Load: &render.APFLoad{}},
+			e:     render.Fields{"service-accounts", "workload-low", "9000", "ByUser", "0", "0", "0"},
+			valid: "priority level workload-low not found",
+		},
+	}
+
+	var f render.FlowSchema
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(9)
+			assert.NoError(t, f.Render(u.o, "", &r))
+			assert.Equal(t, "-/service-accounts", r.ID)
+			assert.Equal(t, u.e, r.Fields[:7])
+			assert.Equal(t, u.valid, r.Fields[7])
+		})
+	}
+}
+
+func TestPriorityLevelRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "workload-low",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"type": "Limited",
+			"limited": map[string]interface{}{
+				"nominalConcurrencyShares": int64(100),
+				"limitResponse":            map[string]interface{}{"type": "Queue"},
+			},
+		},
+	}}
+
+	uu := map[string]struct {
+		load  *render.APFLoad
+		e     render.Fields
+		valid string
+	}{
+		"no-metrics": {
+			e: render.Fields{"workload-low", "Limited", "100", "Queue", "n/a", "n/a", "n/a", "n/a", "n/a"},
+		},
+		"busy": {
+			load: &render.APFLoad{Limit: 40, Executing: 10, InQueue: 2},
+			e:    render.Fields{"workload-low", "Limited", "100", "Queue", "40", "10", "2", "25", "0"},
+		},
+		"saturated": {
+			load:  &render.APFLoad{Limit: 40, Executing: 40, InQueue: 50, Rejected: 3},
+			e:     render.Fields{"workload-low", "Limited", "100", "Queue", "40", "40", "50", "100", "3"},
+			valid: "concurrency limit saturated",
+		},
+	}
+
+	var p render.PriorityLevel
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(11)
+			assert.NoError(t, p.Render(&render.PriorityLevelWithLoad{Raw: &o, Load: u.load}, "", &r))
+			assert.Equal(t, u.e, r.Fields[:9])
+			assert.Equal(t, u.valid, r.Fields[9])
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// APIHealth renders an api server health check to screen.
+type APIHealth struct {
+	Base
+}
+
+// Header returns a header row.
+func (APIHealth) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SECTION"},
+		HeaderColumn{Name: "CHECK"},
+		HeaderColumn{Name: "VALUE"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (APIHealth) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(APICheck)
+	if !ok {
+		return fmt.Errorf("expected APICheck, but got %T", o)
+	}
+
+	r.ID = client.FQN(c.Section, c.Name)
+	r.Fields = append(r.Fields,
+		c.Section,
+		c.Name,
+		c.Value,
+		c.Issue,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// APICheck represents an api server health check or metric.
+type APICheck struct {
+	Section string
+	Name    string
+	Value   string
+	Issue   string
+}
+
+// GetObjectKind returns a schema object.
+func (APICheck) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c APICheck) DeepCopyObject() runtime.Object {
+	return c
+}
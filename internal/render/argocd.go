@@ -0,0 +1,183 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// ArgoCDOutOfSync represents an out of sync Argo CD resource status.
+const ArgoCDOutOfSync = "OutOfSync"
+
+type (
+	// ArgoCDApplication represents an Argo CD Application.
+	ArgoCDApplication struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              ArgoCDApplicationSpec   `json:"spec"`
+		Status            ArgoCDApplicationStatus `json:"status"`
+	}
+
+	// ArgoCDApplicationSpec represents an Application spec.
+	ArgoCDApplicationSpec struct {
+		Project     string `json:"project"`
+		Destination struct {
+			Server    string `json:"server,omitempty"`
+			Name      string `json:"name,omitempty"`
+			Namespace string `json:"namespace,omitempty"`
+		} `json:"destination"`
+		SyncPolicy *struct {
+			Automated *struct {
+				Prune    bool `json:"prune,omitempty"`
+				SelfHeal bool `json:"selfHeal,omitempty"`
+			} `json:"automated,omitempty"`
+		} `json:"syncPolicy,omitempty"`
+	}
+
+	// ArgoCDApplicationStatus represents an Application status.
+	ArgoCDApplicationStatus struct {
+		Sync struct {
+			Status   string `json:"status,omitempty"`
+			Revision string `json:"revision,omitempty"`
+		} `json:"sync"`
+		Health struct {
+			Status  string `json:"status,omitempty"`
+			Message string `json:"message,omitempty"`
+		} `json:"health"`
+		OperationState *struct {
+			Phase   string `json:"phase,omitempty"`
+			Message string `json:"message,omitempty"`
+		} `json:"operationState,omitempty"`
+		Resources  []ArgoCDResourceStatus `json:"resources,omitempty"`
+		Conditions []struct {
+			Type    string `json:"type"`
+			Message string `json:"message,omitempty"`
+		} `json:"conditions,omitempty"`
+	}
+
+	// ArgoCDResourceStatus represents the sync status of a managed resource.
+	ArgoCDResourceStatus struct {
+		Group     string `json:"group,omitempty"`
+		Version   string `json:"version,omitempty"`
+		Kind      string `json:"kind"`
+		Namespace string `json:"namespace,omitempty"`
+		Name      string `json:"name"`
+		Status    string `json:"status,omitempty"`
+		Health    *struct {
+			Status string `json:"status,omitempty"`
+		} `json:"health,omitempty"`
+	}
+)
+
+// OutOfSync returns all managed resources drifting from their desired state.
+func (s ArgoCDApplicationStatus) OutOfSync() []ArgoCDResourceStatus {
+	var rr []ArgoCDResourceStatus
+	for _, r := range s.Resources {
+		if r.Status == ArgoCDOutOfSync {
+			rr = append(rr, r)
+		}
+	}
+
+	return rr
+}
+
+// Application renders an Argo CD Application to screen.
+type Application struct {
+	Base
+}
+
+// Header returns a header row.
+func (Application) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PROJECT"},
+		HeaderColumn{Name: "SYNC"},
+		HeaderColumn{Name: "HEALTH"},
+		HeaderColumn{Name: "DRIFT", Align: tview.AlignRight},
+		HeaderColumn{Name: "AUTO-SYNC"},
+		HeaderColumn{Name: "REVISION"},
+		HeaderColumn{Name: "DESTINATION"},
+		HeaderColumn{Name: "OPERATION", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (a Application) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Application, but got %T", o)
+	}
+	var app ArgoCDApplication
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &app)
+	if err != nil {
+		return err
+	}
+
+	op := MissingValue
+	if app.Status.OperationState != nil {
+		op = app.Status.OperationState.Phase
+	}
+	dest := app.Spec.Destination.Name
+	if dest == "" {
+		dest = app.Spec.Destination.Server
+	}
+	if app.Spec.Destination.Namespace != "" {
+		dest += "/" + app.Spec.Destination.Namespace
+	}
+
+	r.ID = client.MetaFQN(app.ObjectMeta)
+	r.Fields = Fields{
+		app.Namespace,
+		app.Name,
+		app.Spec.Project,
+		na(app.Status.Sync.Status),
+		na(app.Status.Health.Status),
+		fmt.Sprintf("%d/%d", len(app.Status.OutOfSync()), len(app.Status.Resources)),
+		boolToStr(app.Spec.SyncPolicy != nil && app.Spec.SyncPolicy.Automated != nil),
+		na(shortRevision(app.Status.Sync.Revision)),
+		na(dest),
+		op,
+		mapToStr(app.Labels),
+		asStatus(a.diagnose(app.Status)),
+		toAge(app.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Application) diagnose(st ArgoCDApplicationStatus) error {
+	for _, c := range st.Conditions {
+		if strings.HasSuffix(c.Type, "Error") {
+			return errors.New(c.Message)
+		}
+	}
+	if st.OperationState != nil && (st.OperationState.Phase == "Failed" || st.OperationState.Phase == "Error") {
+		return errors.New(st.OperationState.Message)
+	}
+	switch st.Health.Status {
+	case "Degraded", "Missing":
+		return fmt.Errorf("application is %s", strings.ToLower(st.Health.Status))
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func shortRevision(rev string) string {
+	if len(rev) > 7 {
+		return rev[:7]
+	}
+
+	return rev
+}
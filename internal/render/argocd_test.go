@@ -0,0 +1,29 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplicationRender(t *testing.T) {
+	c := render.Application{}
+	r := render.NewRow(13)
+
+	assert.NoError(t, c.Render(load(t, "app"), "", &r))
+	assert.Equal(t, "argocd/guestbook", r.ID)
+	assert.Equal(t, render.Fields{
+		"argocd",
+		"guestbook",
+		"default",
+		"OutOfSync",
+		"Degraded",
+		"1/2",
+		"true",
+		"53e28ff",
+		"https://kubernetes.default.svc/guestbook",
+		"Succeeded",
+	}, r.Fields[:10])
+	assert.Equal(t, "application is degraded", r.Fields[11])
+}
@@ -0,0 +1,306 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CAPIClusterLabel tracks the Cluster owning a CAPI resource.
+	CAPIClusterLabel = "cluster.x-k8s.io/cluster-name"
+
+	// CAPIDeploymentLabel tracks the MachineDeployment owning a Machine.
+	CAPIDeploymentLabel = "cluster.x-k8s.io/deployment-name"
+
+	capiReadyCondition = "Ready"
+	capiSeverityError  = "Error"
+)
+
+type (
+	// CAPICluster represents a Cluster API Cluster.
+	CAPICluster struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			ControlPlaneRef   *corev1.ObjectReference `json:"controlPlaneRef,omitempty"`
+			InfrastructureRef *corev1.ObjectReference `json:"infrastructureRef,omitempty"`
+			Topology          *struct {
+				Version string `json:"version,omitempty"`
+			} `json:"topology,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Phase               string          `json:"phase,omitempty"`
+			InfrastructureReady bool            `json:"infrastructureReady,omitempty"`
+			ControlPlaneReady   bool            `json:"controlPlaneReady,omitempty"`
+			FailureMessage      *string         `json:"failureMessage,omitempty"`
+			Conditions          []CAPICondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// CAPIMachineDeployment represents a Cluster API MachineDeployment.
+	CAPIMachineDeployment struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			ClusterName string `json:"clusterName"`
+			Replicas    *int32 `json:"replicas,omitempty"`
+			Template    struct {
+				Spec struct {
+					Version *string `json:"version,omitempty"`
+				} `json:"spec"`
+			} `json:"template"`
+		} `json:"spec"`
+		Status struct {
+			Phase               string          `json:"phase,omitempty"`
+			Replicas            int32           `json:"replicas,omitempty"`
+			ReadyReplicas       int32           `json:"readyReplicas,omitempty"`
+			UpdatedReplicas     int32           `json:"updatedReplicas,omitempty"`
+			UnavailableReplicas int32           `json:"unavailableReplicas,omitempty"`
+			Conditions          []CAPICondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// CAPIMachine represents a Cluster API Machine.
+	CAPIMachine struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			ClusterName string  `json:"clusterName"`
+			ProviderID  *string `json:"providerID,omitempty"`
+			Version     *string `json:"version,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Phase          string                  `json:"phase,omitempty"`
+			NodeRef        *corev1.ObjectReference `json:"nodeRef,omitempty"`
+			FailureMessage *string                 `json:"failureMessage,omitempty"`
+			Conditions     []CAPICondition         `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// CAPICondition represents a Cluster API condition.
+	CAPICondition struct {
+		Type     string `json:"type"`
+		Status   string `json:"status"`
+		Severity string `json:"severity,omitempty"`
+		Reason   string `json:"reason,omitempty"`
+		Message  string `json:"message,omitempty"`
+	}
+)
+
+// NodeName returns the workload cluster node backing the machine if any.
+func (m CAPIMachine) NodeName() string {
+	if m.Status.NodeRef == nil {
+		return ""
+	}
+
+	return m.Status.NodeRef.Name
+}
+
+// Cluster renders a Cluster API Cluster to screen.
+type Cluster struct {
+	Base
+}
+
+// Header returns a header row.
+func (Cluster) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "INFRA-READY"},
+		HeaderColumn{Name: "CP-READY"},
+		HeaderColumn{Name: "CONTROL-PLANE"},
+		HeaderColumn{Name: "INFRASTRUCTURE"},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Cluster) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Cluster, but got %T", o)
+	}
+	var c CAPICluster
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &c)
+	if err != nil {
+		return err
+	}
+
+	version := MissingValue
+	if c.Spec.Topology != nil {
+		version = na(c.Spec.Topology.Version)
+	}
+
+	r.ID = client.MetaFQN(c.ObjectMeta)
+	r.Fields = Fields{
+		c.Namespace,
+		c.Name,
+		na(c.Status.Phase),
+		boolToStr(c.Status.InfrastructureReady),
+		boolToStr(c.Status.ControlPlaneReady),
+		capiRef(c.Spec.ControlPlaneRef),
+		capiRef(c.Spec.InfrastructureRef),
+		version,
+		mapToStr(c.Labels),
+		asStatus(capiDiagnose(c.Status.FailureMessage, c.Status.Conditions)),
+		toAge(c.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// MachineDeployment renders a Cluster API MachineDeployment to screen.
+type MachineDeployment struct {
+	Base
+}
+
+// Header returns a header row.
+func (MachineDeployment) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		HeaderColumn{Name: "UNAVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (MachineDeployment) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected MachineDeployment, but got %T", o)
+	}
+	var md CAPIMachineDeployment
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &md)
+	if err != nil {
+		return err
+	}
+
+	var desired int32 = 1
+	if md.Spec.Replicas != nil {
+		desired = *md.Spec.Replicas
+	}
+
+	r.ID = client.MetaFQN(md.ObjectMeta)
+	r.Fields = Fields{
+		md.Namespace,
+		md.Name,
+		md.Spec.ClusterName,
+		na(md.Status.Phase),
+		strconv.Itoa(int(md.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(desired)),
+		strconv.Itoa(int(md.Status.UpdatedReplicas)),
+		strconv.Itoa(int(md.Status.UnavailableReplicas)),
+		capiVersion(md.Spec.Template.Spec.Version),
+		mapToStr(md.Labels),
+		asStatus(capiDiagnose(nil, md.Status.Conditions)),
+		toAge(md.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Machine renders a Cluster API Machine to screen.
+type Machine struct {
+	Base
+}
+
+// Header returns a header row.
+func (Machine) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "PROVIDER-ID"},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Machine) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Machine, but got %T", o)
+	}
+	var m CAPIMachine
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &m)
+	if err != nil {
+		return err
+	}
+
+	providerID := MissingValue
+	if m.Spec.ProviderID != nil {
+		providerID = na(*m.Spec.ProviderID)
+	}
+
+	r.ID = client.MetaFQN(m.ObjectMeta)
+	r.Fields = Fields{
+		m.Namespace,
+		m.Name,
+		m.Spec.ClusterName,
+		na(m.NodeName()),
+		providerID,
+		na(m.Status.Phase),
+		capiVersion(m.Spec.Version),
+		mapToStr(m.Labels),
+		asStatus(capiDiagnose(m.Status.FailureMessage, m.Status.Conditions)),
+		toAge(m.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func capiRef(ref *corev1.ObjectReference) string {
+	if ref == nil {
+		return MissingValue
+	}
+
+	return ref.Kind + "/" + ref.Name
+}
+
+func capiVersion(v *string) string {
+	if v == nil {
+		return MissingValue
+	}
+
+	return na(*v)
+}
+
+func capiDiagnose(failure *string, cc []CAPICondition) error {
+	if failure != nil && *failure != "" {
+		return errors.New(*failure)
+	}
+	for _, c := range cc {
+		if c.Type != capiReadyCondition || c.Status != string(metav1.ConditionFalse) {
+			continue
+		}
+		if c.Severity == capiSeverityError {
+			return fmt.Errorf("%s: %s", c.Reason, c.Message)
+		}
+	}
+
+	return nil
+}
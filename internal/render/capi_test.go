@@ -0,0 +1,72 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCAPIClusterRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "c1",
+			"namespace":         "fleet",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"controlPlaneRef":   map[string]interface{}{"kind": "KubeadmControlPlane", "name": "c1-cp"},
+			"infrastructureRef": map[string]interface{}{"kind": "AWSCluster", "name": "c1"},
+		},
+		"status": map[string]interface{}{
+			"phase":               "Provisioned",
+			"infrastructureReady": true,
+		},
+	}}
+	c := render.Cluster{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "fleet/c1", r.ID)
+	assert.Equal(t, render.Fields{"fleet", "c1", "Provisioned", "true", "false", "KubeadmControlPlane/c1-cp", "AWSCluster/c1", "<none>"}, r.Fields[:8])
+	assert.Equal(t, "", r.Fields[9])
+}
+
+func TestMachineDeploymentRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "md-0",
+			"namespace":         "fleet",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"clusterName": "c1",
+			"replicas":    int64(3),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{"version": "v1.26.1"},
+			},
+		},
+		"status": map[string]interface{}{
+			"phase":               "ScalingUp",
+			"readyReplicas":       int64(2),
+			"updatedReplicas":     int64(3),
+			"unavailableReplicas": int64(1),
+		},
+	}}
+	c := render.MachineDeployment{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"fleet", "md-0", "c1", "ScalingUp", "2/3", "3", "1", "v1.26.1"}, r.Fields[:8])
+}
+
+func TestMachineRender(t *testing.T) {
+	c := render.Machine{}
+	r := render.NewRow(10)
+
+	assert.NoError(t, c.Render(load(t, "machine"), "", &r))
+	assert.Equal(t, "fleet/md-0-abc12", r.ID)
+	assert.Equal(t, render.Fields{"fleet", "md-0-abc12", "c1", "ip-10-0-0-1", "aws:///us-east-1a/i-0123456789", "Running", "v1.26.1"}, r.Fields[:7])
+	assert.Equal(t, "InstanceTerminated: instance is gone", r.Fields[8])
+}
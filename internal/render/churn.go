@@ -0,0 +1,91 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ChurnRateThreshold tracks the events per second rate flagging a thrashing resource.
+const ChurnRateThreshold = 10.0
+
+// Churn renders a resource watch activity to screen.
+type Churn struct {
+	Base
+}
+
+// Header returns a header row.
+func (Churn) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "OBJECTS", Align: tview.AlignRight},
+		HeaderColumn{Name: "ADDS", Align: tview.AlignRight},
+		HeaderColumn{Name: "UPDATES", Align: tview.AlignRight},
+		HeaderColumn{Name: "DELETES", Align: tview.AlignRight},
+		HeaderColumn{Name: "RATE/S", Align: tview.AlignRight},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Churn) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(ChurnRes)
+	if !ok {
+		return fmt.Errorf("expected ChurnRes, but got %T", o)
+	}
+
+	r.ID = c.Namespace + ":" + c.GVR
+	r.Fields = append(r.Fields,
+		c.GVR,
+		c.Namespace,
+		strconv.Itoa(c.Objects),
+		strconv.FormatInt(c.Adds, 10),
+		strconv.FormatInt(c.Updates, 10),
+		strconv.FormatInt(c.Deletes, 10),
+		strconv.FormatFloat(c.Rate, 'f', 2, 64),
+		asStatus(c.diagnose()),
+		toAge(metav1.NewTime(c.Since)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ChurnRes represents a resource watch activity.
+type ChurnRes struct {
+	GVR       string
+	Namespace string
+	Objects   int
+	Adds      int64
+	Updates   int64
+	Deletes   int64
+	Rate      float64
+	Since     time.Time
+}
+
+func (c ChurnRes) diagnose() error {
+	if c.Rate >= ChurnRateThreshold {
+		return fmt.Errorf("thrashing at %.2f events/s", c.Rate)
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (ChurnRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c ChurnRes) DeepCopyObject() runtime.Object {
+	return c
+}
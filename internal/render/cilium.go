@@ -0,0 +1,303 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type (
+	// CiliumEP represents a CiliumEndpoint.
+	CiliumEP struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Status            struct {
+			ID       int64  `json:"id,omitempty"`
+			State    string `json:"state,omitempty"`
+			Identity *struct {
+				ID     int64    `json:"id"`
+				Labels []string `json:"labels,omitempty"`
+			} `json:"identity,omitempty"`
+			Networking *struct {
+				Node       string `json:"node,omitempty"`
+				Addressing []struct {
+					IPV4 string `json:"ipv4,omitempty"`
+					IPV6 string `json:"ipv6,omitempty"`
+				} `json:"addressing,omitempty"`
+			} `json:"networking,omitempty"`
+			Policy *struct {
+				Ingress CiliumPolicyEnforcement `json:"ingress"`
+				Egress  CiliumPolicyEnforcement `json:"egress"`
+			} `json:"policy,omitempty"`
+		} `json:"status"`
+	}
+
+	// CiliumPolicyEnforcement represents an endpoint policy enforcement status.
+	CiliumPolicyEnforcement struct {
+		Enforcing bool   `json:"enforcing"`
+		State     string `json:"state,omitempty"`
+	}
+
+	// CiliumNP represents a Cilium(Clusterwide)NetworkPolicy.
+	CiliumNP struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              *CiliumRule  `json:"spec,omitempty"`
+		Specs             []CiliumRule `json:"specs,omitempty"`
+		Status            struct {
+			Conditions []struct {
+				Type    string `json:"type"`
+				Status  string `json:"status"`
+				Message string `json:"message,omitempty"`
+			} `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// CiliumRule represents a Cilium policy rule.
+	CiliumRule struct {
+		EndpointSelector *metav1.LabelSelector `json:"endpointSelector,omitempty"`
+		NodeSelector     *metav1.LabelSelector `json:"nodeSelector,omitempty"`
+		Ingress          []interface{}         `json:"ingress,omitempty"`
+		IngressDeny      []interface{}         `json:"ingressDeny,omitempty"`
+		Egress           []interface{}         `json:"egress,omitempty"`
+		EgressDeny       []interface{}         `json:"egressDeny,omitempty"`
+	}
+)
+
+// Node returns the node hosting the endpoint.
+func (c CiliumEP) Node() string {
+	if c.Status.Networking == nil {
+		return ""
+	}
+
+	return c.Status.Networking.Node
+}
+
+// String returns the endpoint policy enforcement mode.
+func (e CiliumPolicyEnforcement) String() string {
+	if e.State != "" {
+		return e.State
+	}
+
+	return boolToStr(e.Enforcing)
+}
+
+// Rules returns all the policy rules.
+func (c CiliumNP) Rules() []CiliumRule {
+	rr := make([]CiliumRule, 0, len(c.Specs)+1)
+	if c.Spec != nil {
+		rr = append(rr, *c.Spec)
+	}
+
+	return append(rr, c.Specs...)
+}
+
+// CiliumEndpointWithDrops represents a CiliumEndpoint along with its dropped packets count.
+type CiliumEndpointWithDrops struct {
+	Raw   *unstructured.Unstructured
+	Drops *int64
+}
+
+// GetObjectKind returns a schema object.
+func (c *CiliumEndpointWithDrops) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CiliumEndpointWithDrops) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// CiliumEndpoint renders a CiliumEndpoint to screen.
+type CiliumEndpoint struct {
+	Base
+}
+
+// Header returns a header row.
+func (CiliumEndpoint) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "ENDPOINT-ID", Align: tview.AlignRight},
+		HeaderColumn{Name: "IDENTITY", Align: tview.AlignRight},
+		HeaderColumn{Name: "INGRESS-ENFORCED"},
+		HeaderColumn{Name: "EGRESS-ENFORCED"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "IP"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "DROPS", Align: tview.AlignRight},
+		HeaderColumn{Name: "IDENTITY-LABELS", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CiliumEndpoint) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw   *unstructured.Unstructured
+		drops *int64
+	)
+	switch ep := o.(type) {
+	case *CiliumEndpointWithDrops:
+		raw, drops = ep.Raw, ep.Drops
+	case *unstructured.Unstructured:
+		raw = ep
+	default:
+		return fmt.Errorf("Expected CiliumEndpoint, but got %T", o)
+	}
+	var ep CiliumEP
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ep)
+	if err != nil {
+		return err
+	}
+
+	identity, idLabels := NAValue, []string{}
+	if ep.Status.Identity != nil {
+		identity, idLabels = strconv.FormatInt(ep.Status.Identity.ID, 10), ep.Status.Identity.Labels
+	}
+	ingress, egress := NAValue, NAValue
+	if ep.Status.Policy != nil {
+		ingress, egress = ep.Status.Policy.Ingress.String(), ep.Status.Policy.Egress.String()
+	}
+	var ips []string
+	if ep.Status.Networking != nil {
+		for _, a := range ep.Status.Networking.Addressing {
+			for _, ip := range []string{a.IPV4, a.IPV6} {
+				if ip != "" {
+					ips = append(ips, ip)
+				}
+			}
+		}
+	}
+	dropsCol := NAValue
+	if drops != nil {
+		dropsCol = strconv.FormatInt(*drops, 10)
+	}
+
+	r.ID = client.MetaFQN(ep.ObjectMeta)
+	r.Fields = Fields{
+		ep.Namespace,
+		ep.Name,
+		strconv.FormatInt(ep.Status.ID, 10),
+		identity,
+		ingress,
+		egress,
+		na(ep.Status.State),
+		naStrings(ips),
+		na(ep.Node()),
+		dropsCol,
+		naStrings(idLabels),
+		mapToStr(ep.Labels),
+		asStatus(ciliumEndpointDiagnose(ep.Status.State)),
+		toAge(ep.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CiliumNetworkPolicy renders a Cilium(Clusterwide)NetworkPolicy to screen.
+type CiliumNetworkPolicy struct {
+	Base
+}
+
+// Header returns a header row.
+func (CiliumNetworkPolicy) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "INGRESS", Align: tview.AlignRight},
+		HeaderColumn{Name: "EGRESS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DENY", Align: tview.AlignRight},
+		HeaderColumn{Name: "POLICY-VALID"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CiliumNetworkPolicy) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected CiliumNetworkPolicy, but got %T", o)
+	}
+	var p CiliumNP
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &p)
+	if err != nil {
+		return err
+	}
+
+	var (
+		sels                  []string
+		ingress, egress, deny int
+	)
+	for _, rule := range p.Rules() {
+		sel := rule.EndpointSelector
+		if sel == nil {
+			sel = rule.NodeSelector
+		}
+		if sel != nil {
+			s, err := metav1.LabelSelectorAsSelector(sel)
+			switch {
+			case err != nil:
+			case s.Empty():
+				sels = append(sels, "*")
+			default:
+				sels = append(sels, s.String())
+			}
+		}
+		ingress += len(rule.Ingress)
+		egress += len(rule.Egress)
+		deny += len(rule.IngressDeny) + len(rule.EgressDeny)
+	}
+	selector := NAValue
+	if len(sels) > 0 {
+		selector = strings.Join(sels, "|")
+	}
+	valid, verr := UnknownValue, error(nil)
+	for _, c := range p.Status.Conditions {
+		if c.Type != "Valid" {
+			continue
+		}
+		valid = c.Status
+		if c.Status == string(metav1.ConditionFalse) {
+			verr = fmt.Errorf("invalid policy: %s", c.Message)
+		}
+	}
+
+	r.ID = client.MetaFQN(p.ObjectMeta)
+	r.Fields = Fields{
+		p.Namespace,
+		p.Name,
+		selector,
+		strconv.Itoa(ingress),
+		strconv.Itoa(egress),
+		strconv.Itoa(deny),
+		valid,
+		mapToStr(p.Labels),
+		asStatus(verr),
+		toAge(p.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ciliumEndpointDiagnose(state string) error {
+	switch state {
+	case "", "ready", "waiting-to-regenerate", "regenerating", "restoring":
+		return nil
+	default:
+		return fmt.Errorf("endpoint is %s", state)
+	}
+}
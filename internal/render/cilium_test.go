@@ -0,0 +1,66 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestCiliumEndpointRender(t *testing.T) {
+	drops := int64(7)
+	uu := map[string]struct {
+		o     interface{}
+		drops string
+	}{
+		"plain": {o: load(t, "cep"), drops: "n/a"},
+		"drops": {o: &render.CiliumEndpointWithDrops{Raw: load(t, "cep"), Drops: &drops}, drops: "7"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.CiliumEndpoint{}
+			r := render.NewRow(14)
+
+			assert.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, "default/nginx-7d8b49557c-abcde", r.ID)
+			assert.Equal(t, render.Fields{"default", "nginx-7d8b49557c-abcde", "1234", "56789", "true", "false", "ready", "10.244.1.12", "10.0.0.5", u.drops, "k8s:app=nginx,k8s:io.kubernetes.pod.namespace=default"}, r.Fields[:11])
+			assert.Equal(t, "", r.Fields[12])
+		})
+	}
+}
+
+func TestCiliumNetworkPolicyRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "allow-fe",
+			"namespace":         "default",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"endpointSelector": map[string]interface{}{
+				"matchLabels": map[string]interface{}{"app": "be"},
+			},
+			"ingress": []interface{}{map[string]interface{}{}, map[string]interface{}{}},
+		},
+		"specs": []interface{}{
+			map[string]interface{}{
+				"endpointSelector": map[string]interface{}{},
+				"egressDeny":       []interface{}{map[string]interface{}{}},
+			},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Valid", "status": "False", "message": "bad port"},
+			},
+		},
+	}}
+	c := render.CiliumNetworkPolicy{}
+	r := render.NewRow(10)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"default", "allow-fe", "app=be|*", "2", "0", "1", "False"}, r.Fields[:7])
+	assert.Equal(t, "invalid policy: bad port", r.Fields[8])
+}
@@ -0,0 +1,193 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// CNPGClusterLabel tracks the Cluster owning an instance pod.
+const CNPGClusterLabel = "cnpg.io/cluster"
+
+// CNPGHealthyState tracks a healthy instance status.
+const CNPGHealthyState = "healthy"
+
+// CNPGCluster represents a CloudNativePG Cluster.
+type CNPGCluster struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		Instances int `json:"instances"`
+	} `json:"spec"`
+	Status struct {
+		Instances            int                 `json:"instances,omitempty"`
+		ReadyInstances       int                 `json:"readyInstances,omitempty"`
+		Phase                string              `json:"phase,omitempty"`
+		CurrentPrimary       string              `json:"currentPrimary,omitempty"`
+		TargetPrimary        string              `json:"targetPrimary,omitempty"`
+		InstanceNames        []string            `json:"instanceNames,omitempty"`
+		InstancesStatus      map[string][]string `json:"instancesStatus,omitempty"`
+		LastSuccessfulBackup string              `json:"lastSuccessfulBackup,omitempty"`
+		LastFailedBackup     string              `json:"lastFailedBackup,omitempty"`
+		Conditions           []metav1.Condition  `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+// Replicas returns the cluster replica instances.
+func (c CNPGCluster) Replicas() []string {
+	rr := make([]string, 0, len(c.Status.InstanceNames))
+	for _, n := range c.Status.InstanceNames {
+		if n != c.Status.CurrentPrimary {
+			rr = append(rr, n)
+		}
+	}
+	sort.Strings(rr)
+
+	return rr
+}
+
+// IsHealthy checks if a given instance is reported healthy.
+func (c CNPGCluster) IsHealthy(instance string) bool {
+	return in(c.Status.InstancesStatus[CNPGHealthyState], instance)
+}
+
+// BackupStatus returns the outcome of the last backup if any.
+func (c CNPGCluster) BackupStatus() string {
+	for _, cond := range c.Status.Conditions {
+		if cond.Type != "LastBackupSucceeded" {
+			continue
+		}
+		if cond.Status == metav1.ConditionTrue {
+			return "Succeeded"
+		}
+		return "Failed"
+	}
+
+	return NAValue
+}
+
+// CNPGClusterWithLag represents a Cluster along with its replicas lag.
+type CNPGClusterWithLag struct {
+	Raw *unstructured.Unstructured
+	// Lags tracks replay lag in seconds per replica.
+	Lags map[string]int64
+}
+
+// GetObjectKind returns a schema object.
+func (c *CNPGClusterWithLag) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CNPGClusterWithLag) DeepCopyObject() runtime.Object {
+	return c
+}
+
+// PGCluster renders a CloudNativePG Cluster to screen.
+type PGCluster struct {
+	Base
+}
+
+// Header returns a header row.
+func (PGCluster) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "PRIMARY"},
+		HeaderColumn{Name: "REPLICAS"},
+		HeaderColumn{Name: "LAG", Time: true},
+		HeaderColumn{Name: "BACKUP"},
+		HeaderColumn{Name: "LAST-BACKUP", Time: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PGCluster) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw  *unstructured.Unstructured
+		lags map[string]int64
+	)
+	switch c := o.(type) {
+	case *CNPGClusterWithLag:
+		raw, lags = c.Raw, c.Lags
+	case *unstructured.Unstructured:
+		raw = c
+	default:
+		return fmt.Errorf("Expected Cluster, but got %T", o)
+	}
+	var c CNPGCluster
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &c)
+	if err != nil {
+		return err
+	}
+
+	lastBackup := NAValue
+	if c.Status.LastSuccessfulBackup != "" {
+		lastBackup = toAgeHuman(c.Status.LastSuccessfulBackup)
+	}
+
+	r.ID = client.MetaFQN(c.ObjectMeta)
+	r.Fields = Fields{
+		c.Namespace,
+		c.Name,
+		strconv.Itoa(c.Status.ReadyInstances) + "/" + strconv.Itoa(c.Spec.Instances),
+		na(c.Status.Phase),
+		na(c.Status.CurrentPrimary),
+		naStrings(c.Replicas()),
+		cnpgLag(c.Replicas(), lags),
+		c.BackupStatus(),
+		lastBackup,
+		mapToStr(c.Labels),
+		asStatus(cnpgDiagnose(c)),
+		toAge(c.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// cnpgLag returns the worst replay lag across replicas.
+func cnpgLag(replicas []string, lags map[string]int64) string {
+	if lags == nil || len(replicas) == 0 {
+		return NAValue
+	}
+	var max int64
+	for _, r := range replicas {
+		if lags[r] > max {
+			max = lags[r]
+		}
+	}
+
+	return duration.HumanDuration(time.Duration(max) * time.Second)
+}
+
+func cnpgDiagnose(c CNPGCluster) error {
+	if c.Status.ReadyInstances < c.Spec.Instances {
+		return fmt.Errorf("%d/%d instances ready", c.Status.ReadyInstances, c.Spec.Instances)
+	}
+	for _, cond := range c.Status.Conditions {
+		if cond.Type != "ContinuousArchiving" && cond.Type != "LastBackupSucceeded" {
+			continue
+		}
+		if cond.Status == metav1.ConditionFalse {
+			return errors.New(cond.Message)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPGClusterRender(t *testing.T) {
+	uu := map[string]struct {
+		o   interface{}
+		lag string
+	}{
+		"plain": {o: load(t, "pgcluster"), lag: "n/a"},
+		"lags": {
+			o:   &render.CNPGClusterWithLag{Raw: load(t, "pgcluster"), Lags: map[string]int64{"pg-2": 3, "pg-3": 75}},
+			lag: "75s",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.PGCluster{}
+			r := render.NewRow(12)
+
+			assert.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, "db/pg", r.ID)
+			assert.Equal(t, render.Fields{"db", "pg", "3/3", "Cluster in healthy state", "pg-1", "pg-2,pg-3", u.lag, "Failed", "n/a"}, r.Fields[:9])
+			assert.Equal(t, "backup failed: bucket not found", r.Fields[10])
+		})
+	}
+}
@@ -4,12 +4,15 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // CronJob renders a K8s CronJob to screen.
@@ -26,6 +29,10 @@ func (CronJob) Header(ns string) Header {
 		HeaderColumn{Name: "SUSPEND"},
 		HeaderColumn{Name: "ACTIVE"},
 		HeaderColumn{Name: "LAST_SCHEDULE", Time: true},
+		HeaderColumn{Name: "NEXT_RUN"},
+		HeaderColumn{Name: "TIMEZONE", Wide: true},
+		HeaderColumn{Name: "LAST_SUCCESS", Time: true},
+		HeaderColumn{Name: "LAST_RESULT"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
 		HeaderColumn{Name: "IMAGES", Wide: true},
@@ -37,8 +44,16 @@ func (CronJob) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (c CronJob) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw  *unstructured.Unstructured
+		last string
+	)
+	switch cj := o.(type) {
+	case *CronJobWithLastRun:
+		raw, last = cj.Raw, cj.Result
+	case *unstructured.Unstructured:
+		raw = cj
+	default:
 		return fmt.Errorf("Expected CronJob, but got %T", o)
 	}
 	var cj batchv1.CronJob
@@ -51,6 +66,12 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 	if cj.Status.LastScheduleTime != nil {
 		lastScheduled = toAge(*cj.Status.LastScheduleTime)
 	}
+	lastSuccess := "<none>"
+	if cj.Status.LastSuccessfulTime != nil {
+		lastSuccess = toAge(*cj.Status.LastSuccessfulTime)
+	}
+	sched, err := parseCronSchedule(cj.Spec.Schedule, cj.Spec.TimeZone)
+	now := time.Now()
 
 	r.ID = client.MetaFQN(cj.ObjectMeta)
 	r.Fields = Fields{
@@ -60,19 +81,109 @@ func (c CronJob) Render(o interface{}, ns string, r *Row) error {
 		boolPtrToStr(cj.Spec.Suspend),
 		strconv.Itoa(len(cj.Status.Active)),
 		lastScheduled,
+		nextRun(&cj, sched, now),
+		cronTimezone(&cj, sched),
+		lastSuccess,
+		na(last),
 		jobSelector(cj.Spec.JobTemplate.Spec),
 		podContainerNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		podImageNames(cj.Spec.JobTemplate.Spec.Template.Spec, true),
 		mapToStr(cj.Labels),
-		"",
+		asStatus(c.diagnose(&cj, sched, err, last, now)),
 		toAge(cj.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
+func (CronJob) diagnose(cj *batchv1.CronJob, sched *cronSchedule, err error, last string, now time.Time) error {
+	if err != nil {
+		return err
+	}
+	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+		return nil
+	}
+	if missed := missedRun(cj, sched, now); !missed.IsZero() {
+		return fmt.Errorf("missed schedule at %s", missed.Format(time.RFC3339))
+	}
+	if last == string(batchv1.JobFailed) {
+		return fmt.Errorf("last run failed")
+	}
+
+	return nil
+}
+
 // Helpers
 
+// cronMissedGrace tolerates controller lag before flagging a run as missed.
+const cronMissedGrace = 2 * time.Minute
+
+// CronJobWithLastRun represents a cronjob along with its last job outcome.
+type CronJobWithLastRun struct {
+	Raw    *unstructured.Unstructured
+	Result string
+}
+
+// GetObjectKind returns a schema object.
+func (c *CronJobWithLastRun) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c *CronJobWithLastRun) DeepCopyObject() runtime.Object {
+	return c
+}
+
+func nextRun(cj *batchv1.CronJob, sched *cronSchedule, now time.Time) string {
+	if sched == nil {
+		return NAValue
+	}
+	if cj.Spec.Suspend != nil && *cj.Spec.Suspend {
+		return "<suspended>"
+	}
+	next := sched.Next(now)
+	if next.IsZero() {
+		return "<never>"
+	}
+
+	return "in " + duration.HumanDuration(next.Sub(now))
+}
+
+func cronTimezone(cj *batchv1.CronJob, sched *cronSchedule) string {
+	if sched == nil {
+		if cj.Spec.TimeZone != nil {
+			return *cj.Spec.TimeZone
+		}
+		return NAValue
+	}
+
+	return sched.loc.String()
+}
+
+// missedRun returns the first scheduled run that never happened since the last schedule.
+func missedRun(cj *batchv1.CronJob, sched *cronSchedule, now time.Time) time.Time {
+	if sched == nil {
+		return time.Time{}
+	}
+	from := cj.CreationTimestamp.Time
+	if cj.Status.LastScheduleTime != nil {
+		from = cj.Status.LastScheduleTime.Time
+	}
+	if from.IsZero() {
+		return time.Time{}
+	}
+	grace := cronMissedGrace
+	if d := cj.Spec.StartingDeadlineSeconds; d != nil && time.Duration(*d)*time.Second < grace {
+		grace = time.Duration(*d) * time.Second
+	}
+	next := sched.Next(from)
+	if next.IsZero() || next.Add(grace).After(now) {
+		return time.Time{}
+	}
+
+	return next
+}
+
 func jobSelector(spec batchv1.JobSpec) string {
 	if spec.Selector == nil {
 		return MissingValue
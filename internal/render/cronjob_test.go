@@ -15,3 +15,14 @@ func TestCronJobRender(t *testing.T) {
 	assert.Equal(t, "default/hello", r.ID)
 	assert.Equal(t, render.Fields{"default", "hello", "*/1 * * * *", "false", "0"}, r.Fields[:5])
 }
+
+func TestCronJobRenderLastRun(t *testing.T) {
+	c := render.CronJob{}
+	r := render.NewRow(6)
+
+	o := render.CronJobWithLastRun{Raw: load(t, "cj"), Result: "Failed"}
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "default/hello", r.ID)
+	assert.Equal(t, render.Fields{"UTC", "<none>", "Failed"}, r.Fields[7:10])
+	assert.Equal(t, "missed schedule at 2019-08-30T17:02:00Z", r.Fields[14])
+}
@@ -0,0 +1,242 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	// CrossplaneExternalNameAnnotation tracks a managed resource external name.
+	CrossplaneExternalNameAnnotation = "crossplane.io/external-name"
+
+	crossplaneReady  = "Ready"
+	crossplaneSynced = "Synced"
+)
+
+type (
+	// CrossplaneObject represents a Crossplane claim, composite or managed resource.
+	CrossplaneObject struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			ResourceRef       *CrossplaneRef    `json:"resourceRef,omitempty"`
+			ResourceRefs      []CrossplaneRef   `json:"resourceRefs,omitempty"`
+			ClaimRef          *CrossplaneRef    `json:"claimRef,omitempty"`
+			CompositionRef    *CrossplaneRef    `json:"compositionRef,omitempty"`
+			ProviderConfigRef *CrossplaneRef    `json:"providerConfigRef,omitempty"`
+			Crossplane        *CrossplaneSpecV2 `json:"crossplane,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Conditions []CrossplaneCondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// CrossplaneSpecV2 represents the Crossplane v2 nested composite spec.
+	CrossplaneSpecV2 struct {
+		ResourceRefs   []CrossplaneRef `json:"resourceRefs,omitempty"`
+		CompositionRef *CrossplaneRef  `json:"compositionRef,omitempty"`
+	}
+
+	// CrossplaneRef represents a Crossplane object reference.
+	CrossplaneRef struct {
+		APIVersion string `json:"apiVersion,omitempty"`
+		Kind       string `json:"kind,omitempty"`
+		Name       string `json:"name"`
+		Namespace  string `json:"namespace,omitempty"`
+	}
+
+	// CrossplaneCondition represents a Crossplane status condition.
+	CrossplaneCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+
+	// CrossplaneNode represents a node in a Crossplane composition tree.
+	CrossplaneNode struct {
+		Kind, Name    string
+		Ready, Synced string
+		Message       string
+		Children      []*CrossplaneNode
+	}
+)
+
+// Refs returns the resources composed by this object.
+func (c CrossplaneObject) Refs() []CrossplaneRef {
+	if c.Spec.Crossplane != nil && len(c.Spec.Crossplane.ResourceRefs) > 0 {
+		return c.Spec.Crossplane.ResourceRefs
+	}
+
+	return c.Spec.ResourceRefs
+}
+
+// Composition returns the object composition name if any.
+func (c CrossplaneObject) Composition() string {
+	if c.Spec.Crossplane != nil && c.Spec.Crossplane.CompositionRef != nil {
+		return c.Spec.Crossplane.CompositionRef.Name
+	}
+	if c.Spec.CompositionRef != nil {
+		return c.Spec.CompositionRef.Name
+	}
+
+	return ""
+}
+
+// Condition returns the status of a given condition type.
+func (c CrossplaneObject) Condition(t string) (CrossplaneCondition, bool) {
+	for _, cond := range c.Status.Conditions {
+		if cond.Type == t {
+			return cond, true
+		}
+	}
+
+	return CrossplaneCondition{}, false
+}
+
+// NewCrossplaneNode returns a new tree node for a given object.
+func NewCrossplaneNode(kind string, c CrossplaneObject) *CrossplaneNode {
+	n := CrossplaneNode{
+		Kind:   kind,
+		Name:   client.FQN(c.Namespace, c.Name),
+		Ready:  crossplaneStatus(c, crossplaneReady),
+		Synced: crossplaneStatus(c, crossplaneSynced),
+	}
+	if err := crossplaneDiagnose(c); err != nil {
+		n.Message = err.Error()
+	}
+
+	return &n
+}
+
+// Tree returns an ASCII representation of the composition tree.
+func (n *CrossplaneNode) Tree() string {
+	var b strings.Builder
+	b.WriteString(n.label() + "\n")
+	n.walk(&b, "")
+
+	return b.String()
+}
+
+func (n *CrossplaneNode) walk(b *strings.Builder, indent string) {
+	for i, c := range n.Children {
+		branch, next := "├─ ", "│  "
+		if i == len(n.Children)-1 {
+			branch, next = "└─ ", "   "
+		}
+		b.WriteString(indent + branch + c.label() + "\n")
+		c.walk(b, indent+next)
+	}
+}
+
+func (n *CrossplaneNode) label() string {
+	label := fmt.Sprintf("%s/%s [Ready=%s Synced=%s]", n.Kind, n.Name, n.Ready, n.Synced)
+	if n.Message != "" {
+		label += " - " + n.Message
+	}
+
+	return label
+}
+
+// Crossplane renders Crossplane claims, composites and managed resources to screen.
+type Crossplane struct {
+	Base
+}
+
+// Header returns a header row.
+func (Crossplane) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SYNCED"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "EXTERNAL-NAME"},
+		HeaderColumn{Name: "COMPOSITION"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Crossplane) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Crossplane resource, but got %T", o)
+	}
+	var c CrossplaneObject
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &c)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(c.ObjectMeta)
+	r.Fields = Fields{
+		c.Namespace,
+		c.Name,
+		crossplaneStatus(c, crossplaneSynced),
+		crossplaneStatus(c, crossplaneReady),
+		na(c.Annotations[CrossplaneExternalNameAnnotation]),
+		na(c.Composition()),
+		crossplaneResource(c),
+		mapToStr(c.Labels),
+		asStatus(crossplaneDiagnose(c)),
+		toAge(c.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func crossplaneStatus(c CrossplaneObject, t string) string {
+	cond, ok := c.Condition(t)
+	if !ok {
+		return UnknownValue
+	}
+
+	return cond.Status
+}
+
+// crossplaneResource describes what a claim binds to, what a composite is made of
+// or which provider config a managed resource uses.
+func crossplaneResource(c CrossplaneObject) string {
+	switch {
+	case c.Spec.ResourceRef != nil:
+		return c.Spec.ResourceRef.Kind + "/" + c.Spec.ResourceRef.Name
+	case len(c.Refs()) > 0:
+		return strconv.Itoa(len(c.Refs())) + " resource(s)"
+	case c.Spec.ProviderConfigRef != nil:
+		return "ProviderConfig/" + c.Spec.ProviderConfigRef.Name
+	default:
+		return NAValue
+	}
+}
+
+func crossplaneDiagnose(c CrossplaneObject) error {
+	var errs []string
+	for _, t := range []string{crossplaneSynced, crossplaneReady} {
+		cond, ok := c.Condition(t)
+		if !ok || cond.Status != string(metav1.ConditionFalse) {
+			continue
+		}
+		msg := cond.Reason
+		if cond.Message != "" {
+			msg = cond.Message
+		}
+		errs = append(errs, msg)
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, ", "))
+}
@@ -0,0 +1,39 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrossplaneRender(t *testing.T) {
+	c := render.Crossplane{}
+	r := render.NewRow(10)
+
+	assert.NoError(t, c.Render(load(t, "xr"), "", &r))
+	assert.Equal(t, "-/db-x7k", r.ID)
+	assert.Equal(t, render.Fields{"", "db-x7k", "True", "False", "db-x7k", "xpostgresqlinstances.aws.database.example.org", "2 resource(s)"}, r.Fields[:7])
+	assert.Equal(t, "Unready resources: db-x7k-abc", r.Fields[8])
+}
+
+func TestCrossplaneNodeTree(t *testing.T) {
+	root := render.CrossplaneNode{
+		Kind: "PostgreSQLInstance", Name: "default/db", Ready: "False", Synced: "True",
+		Children: []*render.CrossplaneNode{
+			{
+				Kind: "XPostgreSQLInstance", Name: "db-x7k", Ready: "False", Synced: "True",
+				Children: []*render.CrossplaneNode{
+					{Kind: "Instance", Name: "db-x7k-abc", Ready: "False", Synced: "True", Message: "creating"},
+					{Kind: "SecurityGroup", Name: "db-x7k-sg", Ready: "True", Synced: "True"},
+				},
+			},
+		},
+	}
+
+	assert.Equal(t, `PostgreSQLInstance/default/db [Ready=False Synced=True]
+└─ XPostgreSQLInstance/db-x7k [Ready=False Synced=True]
+   ├─ Instance/db-x7k-abc [Ready=False Synced=True] - creating
+   └─ SecurityGroup/db-x7k-sg [Ready=True Synced=True]
+`, root.Tree())
+}
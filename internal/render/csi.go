@@ -0,0 +1,124 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// CSIDriver renders a K8s CSIDriver to screen.
+type CSIDriver struct {
+	Base
+}
+
+// Header returns a header row.
+func (CSIDriver) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "ATTACHREQUIRED"},
+		HeaderColumn{Name: "PODINFOONMOUNT"},
+		HeaderColumn{Name: "STORAGECAPACITY"},
+		HeaderColumn{Name: "FSGROUPPOLICY"},
+		HeaderColumn{Name: "MODES"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CSIDriver) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected CSIDriver, but got %T", o)
+	}
+	var d storagev1.CSIDriver
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &d)
+	if err != nil {
+		return err
+	}
+
+	attach := true
+	if d.Spec.AttachRequired != nil {
+		attach = *d.Spec.AttachRequired
+	}
+	policy := string(storagev1.ReadWriteOnceWithFSTypeFSGroupPolicy)
+	if d.Spec.FSGroupPolicy != nil {
+		policy = string(*d.Spec.FSGroupPolicy)
+	}
+	modes := make([]string, 0, len(d.Spec.VolumeLifecycleModes))
+	for _, m := range d.Spec.VolumeLifecycleModes {
+		modes = append(modes, string(m))
+	}
+	if len(modes) == 0 {
+		modes = append(modes, string(storagev1.VolumeLifecyclePersistent))
+	}
+
+	r.ID = client.FQN(client.ClusterScope, d.Name)
+	r.Fields = Fields{
+		d.Name,
+		boolToStr(attach),
+		boolPtrToStr(d.Spec.PodInfoOnMount),
+		boolPtrToStr(d.Spec.StorageCapacity),
+		policy,
+		strings.Join(modes, ","),
+		mapToStr(d.Labels),
+		"",
+		toAge(d.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CSINode renders a K8s CSINode to screen.
+type CSINode struct {
+	Base
+}
+
+// Header returns a header row.
+func (CSINode) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "DRIVERS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DRIVER-NAMES"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CSINode) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected CSINode, but got %T", o)
+	}
+	var n storagev1.CSINode
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &n)
+	if err != nil {
+		return err
+	}
+
+	names := make([]string, 0, len(n.Spec.Drivers))
+	for _, d := range n.Spec.Drivers {
+		names = append(names, d.Name)
+	}
+
+	r.ID = client.FQN(client.ClusterScope, n.Name)
+	r.Fields = Fields{
+		n.Name,
+		strconv.Itoa(len(n.Spec.Drivers)),
+		naStrings(names),
+		mapToStr(n.Labels),
+		"",
+		toAge(n.GetCreationTimestamp()),
+	}
+
+	return nil
+}
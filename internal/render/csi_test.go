@@ -0,0 +1,26 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCSIDriverRender(t *testing.T) {
+	c := render.CSIDriver{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "csidriver"), "", &r))
+	assert.Equal(t, "-/ebs.csi.aws.com", r.ID)
+	assert.Equal(t, render.Fields{"ebs.csi.aws.com", "true", "false", "false", "ReadWriteOnceWithFSType", "Persistent"}, r.Fields[:6])
+}
+
+func TestCSINodeRender(t *testing.T) {
+	c := render.CSINode{}
+	r := render.NewRow(6)
+
+	assert.NoError(t, c.Render(load(t, "csinode"), "", &r))
+	assert.Equal(t, "-/ip-10-0-1-12.ec2.internal", r.ID)
+	assert.Equal(t, render.Fields{"ip-10-0-1-12.ec2.internal", "2", "ebs.csi.aws.com,efs.csi.aws.com"}, r.Fields[:3])
+}
@@ -0,0 +1,81 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Deprecated renders a resource relying on a deprecated api version to screen.
+type Deprecated struct {
+	Base
+}
+
+// Header returns a header row.
+func (Deprecated) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "API"},
+		HeaderColumn{Name: "REPLACEMENT"},
+		HeaderColumn{Name: "REMOVED"},
+		HeaderColumn{Name: "OWNER"},
+		HeaderColumn{Name: "SOURCE", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Deprecated) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DeprecatedRes)
+	if !ok {
+		return fmt.Errorf("expected DeprecatedRes, but got %T", o)
+	}
+
+	r.ID = d.GVR + ":" + client.FQN(d.Namespace, d.Name)
+	r.Fields = append(r.Fields,
+		d.Namespace,
+		d.Name,
+		d.GVR,
+		d.Kind,
+		d.APIVersion,
+		na(d.Replacement),
+		na(d.Removed),
+		na(d.Owner),
+		d.Source,
+		d.Issue,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DeprecatedRes represents a resource relying on a deprecated api version.
+type DeprecatedRes struct {
+	Namespace   string
+	Name        string
+	GVR         string
+	Kind        string
+	APIVersion  string
+	Replacement string
+	Removed     string
+	Source      string
+	Owner       string
+	Issue       string
+}
+
+// GetObjectKind returns a schema object.
+func (DeprecatedRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DeprecatedRes) DeepCopyObject() runtime.Object {
+	return d
+}
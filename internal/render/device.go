@@ -0,0 +1,105 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Device renders a node extended resources ie GPUs, SR-IOV or hugepages to screen.
+type Device struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Device) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Device) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "RESOURCE"},
+		HeaderColumn{Name: "CAPACITY", Align: tview.AlignRight},
+		HeaderColumn{Name: "ALLOCATABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "REQUESTED", Align: tview.AlignRight},
+		HeaderColumn{Name: "FREE", Align: tview.AlignRight},
+		HeaderColumn{Name: "PLUGIN"},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Device) Render(o interface{}, ns string, r *Row) error {
+	d, ok := o.(DeviceRes)
+	if !ok {
+		return fmt.Errorf("expected DeviceRes, but got %T", o)
+	}
+
+	free := d.Allocatable.DeepCopy()
+	free.Sub(d.Requested)
+	r.ID = d.Node + ":" + d.Resource + ":" + d.Plugin
+	r.Fields = Fields{
+		d.Node,
+		na(d.Resource),
+		d.Capacity.String(),
+		d.Allocatable.String(),
+		d.Requested.String(),
+		free.String(),
+		d.Plugin,
+		asStatus(d.diagnose()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// DeviceRes represents a node extended resource along with the device plugin advertising it.
+type DeviceRes struct {
+	Node string
+	// Resource is blank when a device plugin runs on a node without advertising anything.
+	Resource                         string
+	Capacity, Allocatable, Requested resource.Quantity
+	// Plugin tracks the device plugin pod running on the node if any.
+	Plugin      string
+	PluginReady bool
+	// PluginExpected flags resources a known device plugin daemonset serves.
+	PluginExpected bool
+}
+
+func (d DeviceRes) diagnose() error {
+	switch {
+	case d.Resource == "":
+		return fmt.Errorf("device plugin %s registered no resources", d.Plugin)
+	case d.PluginExpected && d.Plugin == "":
+		return fmt.Errorf("no device plugin pod running")
+	case d.Plugin != "" && !d.PluginReady:
+		return fmt.Errorf("device plugin %s not ready", d.Plugin)
+	case !d.Capacity.IsZero() && d.Allocatable.IsZero():
+		return fmt.Errorf("no allocatable devices out of %s", d.Capacity.String())
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (DeviceRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d DeviceRes) DeepCopyObject() runtime.Object {
+	return d
+}
@@ -0,0 +1,83 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// DestinationRule renders an Istio DestinationRule to screen.
+type DestinationRule struct {
+	Base
+}
+
+// Header returns a header row.
+func (DestinationRule) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "HOST"},
+		HeaderColumn{Name: "SUBSETS"},
+		HeaderColumn{Name: "LB"},
+		HeaderColumn{Name: "TLS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (d DestinationRule) Render(o interface{}, ns string, r *Row) error {
+	drh, ok := o.(*DestinationRuleWithHealth)
+	if !ok {
+		return fmt.Errorf("Expected DestinationRuleWithHealth, but got %T", o)
+	}
+	var dr IstioDestinationRule
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(drh.Raw.Object, &dr)
+	if err != nil {
+		return err
+	}
+
+	lb, tls := NAValue, NAValue
+	if tp := dr.Spec.TrafficPolicy; tp != nil {
+		if tp.LoadBalancer != nil && tp.LoadBalancer.Simple != "" {
+			lb = tp.LoadBalancer.Simple
+		}
+		if tp.TLS != nil && tp.TLS.Mode != "" {
+			tls = tp.TLS.Mode
+		}
+	}
+
+	r.ID = client.MetaFQN(dr.ObjectMeta)
+	r.Fields = Fields{
+		dr.Namespace,
+		dr.Name,
+		IstioFQDN(dr.Spec.Host, dr.Namespace),
+		istioSubsets(dr.Spec.Subsets, drh.Subsets),
+		lb,
+		tls,
+		mapToStr(dr.Labels),
+		asStatus(d.diagnose(dr.Spec.Subsets, drh.Subsets)),
+		toAge(dr.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (DestinationRule) diagnose(ss []IstioSubset, hh map[string]SubsetHealth) error {
+	for _, s := range ss {
+		h, ok := hh[s.Name]
+		if !ok {
+			continue
+		}
+		if h.Total == 0 {
+			return fmt.Errorf("subset %s has no matching pods", s.Name)
+		}
+		if h.Ready == 0 {
+			return fmt.Errorf("subset %s has no ready pods", s.Name)
+		}
+	}
+
+	return nil
+}
@@ -3,12 +3,14 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/tview"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // DaemonSet renders a K8s DaemonSet to screen.
@@ -26,6 +28,7 @@ func (DaemonSet) Header(ns string) Header {
 		HeaderColumn{Name: "READY", Align: tview.AlignRight},
 		HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
 		HeaderColumn{Name: "AVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "GAPS", Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
@@ -34,8 +37,16 @@ func (DaemonSet) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw  *unstructured.Unstructured
+		gaps []CoverageGap
+	)
+	switch ds := o.(type) {
+	case *DaemonSetWithGaps:
+		raw, gaps = ds.Raw, ds.Gaps
+	case *unstructured.Unstructured:
+		raw = ds
+	default:
 		return fmt.Errorf("Expected DaemonSet, but got %T", o)
 	}
 	var ds appsv1.DaemonSet
@@ -53,8 +64,9 @@ func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
 		strconv.Itoa(int(ds.Status.NumberReady)),
 		strconv.Itoa(int(ds.Status.UpdatedNumberScheduled)),
 		strconv.Itoa(int(ds.Status.NumberAvailable)),
+		gapsToStr(gaps),
 		mapToStr(ds.Labels),
-		asStatus(d.diagnose(ds.Status.DesiredNumberScheduled, ds.Status.NumberReady)),
+		asStatus(d.diagnose(ds.Status.DesiredNumberScheduled, ds.Status.NumberReady, gaps)),
 		toAge(ds.GetCreationTimestamp()),
 	}
 
@@ -62,9 +74,46 @@ func (d DaemonSet) Render(o interface{}, ns string, r *Row) error {
 }
 
 // Happy returns true if resource is happy, false otherwise.
-func (DaemonSet) diagnose(d, r int32) error {
+func (DaemonSet) diagnose(d, r int32, gaps []CoverageGap) error {
 	if d != r {
 		return fmt.Errorf("desiring %d replicas but %d ready", d, r)
 	}
+	if len(gaps) > 0 {
+		return fmt.Errorf("coverage gaps on %d nodes", len(gaps))
+	}
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// CoverageGap represents a node a daemonset pod is not running on.
+type CoverageGap struct {
+	Node   string
+	Reason string
+}
+
+// DaemonSetWithGaps represents a daemonset along with its node coverage gaps.
+type DaemonSetWithGaps struct {
+	Raw  *unstructured.Unstructured
+	Gaps []CoverageGap
+}
+
+// GetObjectKind returns a schema object.
+func (d *DaemonSetWithGaps) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d *DaemonSetWithGaps) DeepCopyObject() runtime.Object {
+	return d
+}
+
+func gapsToStr(gg []CoverageGap) string {
+	ss := make([]string, 0, len(gg))
+	for _, g := range gg {
+		ss = append(ss, g.Node+"("+g.Reason+")")
+	}
+
+	return strings.Join(ss, ",")
+}
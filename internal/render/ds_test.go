@@ -13,5 +13,21 @@ func TestDaemonSetRender(t *testing.T) {
 
 	assert.NoError(t, c.Render(load(t, "ds"), "", &r))
 	assert.Equal(t, "kube-system/fluentd-gcp-v3.2.0", r.ID)
-	assert.Equal(t, render.Fields{"kube-system", "fluentd-gcp-v3.2.0", "2", "2", "2", "2", "2"}, r.Fields[:7])
+	assert.Equal(t, render.Fields{"kube-system", "fluentd-gcp-v3.2.0", "2", "2", "2", "2", "2", ""}, r.Fields[:8])
+}
+
+func TestDaemonSetRenderGaps(t *testing.T) {
+	c := render.DaemonSet{}
+	r := render.NewRow(10)
+
+	ds := render.DaemonSetWithGaps{
+		Raw: load(t, "ds"),
+		Gaps: []render.CoverageGap{
+			{Node: "n2", Reason: "missing"},
+			{Node: "n4", Reason: "untolerated taint gpu=true:NoSchedule"},
+		},
+	}
+	assert.NoError(t, c.Render(&ds, "", &r))
+	assert.Equal(t, "n2(missing),n4(untolerated taint gpu=true:NoSchedule)", r.Fields[7])
+	assert.Equal(t, "coverage gaps on 2 nodes", r.Fields[9])
 }
@@ -0,0 +1,224 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const esoReadyCondition = "Ready"
+
+type (
+	// ESOExternalSecret represents an External Secrets Operator ExternalSecret.
+	ESOExternalSecret struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			RefreshInterval string `json:"refreshInterval,omitempty"`
+			SecretStoreRef  struct {
+				Name string `json:"name"`
+				Kind string `json:"kind,omitempty"`
+			} `json:"secretStoreRef"`
+			Target struct {
+				Name string `json:"name,omitempty"`
+			} `json:"target"`
+		} `json:"spec"`
+		Status struct {
+			RefreshTime *metav1.Time      `json:"refreshTime,omitempty"`
+			Conditions  []ESOCondition    `json:"conditions,omitempty"`
+			Binding     map[string]string `json:"binding,omitempty"`
+		} `json:"status"`
+	}
+
+	// ESOSecretStore represents an External Secrets Operator (Cluster)SecretStore.
+	ESOSecretStore struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Provider        map[string]interface{} `json:"provider"`
+			RefreshInterval int                    `json:"refreshInterval,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Capabilities string         `json:"capabilities,omitempty"`
+			Conditions   []ESOCondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// ESOCondition represents an External Secrets Operator status condition.
+	ESOCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// ExternalSecret renders an ExternalSecret to screen.
+type ExternalSecret struct {
+	Base
+}
+
+// Header returns a header row.
+func (ExternalSecret) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STORE"},
+		HeaderColumn{Name: "TARGET"},
+		HeaderColumn{Name: "REFRESH"},
+		HeaderColumn{Name: "LAST-SYNC"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LAST-ERROR", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ExternalSecret) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ExternalSecret, but got %T", o)
+	}
+	var es ESOExternalSecret
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &es)
+	if err != nil {
+		return err
+	}
+
+	kind := es.Spec.SecretStoreRef.Kind
+	if kind == "" {
+		kind = "SecretStore"
+	}
+	target := es.Spec.Target.Name
+	if target == "" {
+		target = es.Name
+	}
+	lastSync := NAValue
+	if es.Status.RefreshTime != nil && !es.Status.RefreshTime.IsZero() {
+		lastSync = toAge(*es.Status.RefreshTime)
+	}
+	status, ready, lastErr := UnknownValue, UnknownValue, ""
+	if c := esoCondition(es.Status.Conditions); c != nil {
+		status, ready = na(c.Reason), c.Status
+		if c.Status == string(metav1.ConditionFalse) {
+			lastErr = c.Message
+		}
+	}
+
+	r.ID = client.MetaFQN(es.ObjectMeta)
+	r.Fields = Fields{
+		es.Namespace,
+		es.Name,
+		kind + "/" + es.Spec.SecretStoreRef.Name,
+		target,
+		na(es.Spec.RefreshInterval),
+		lastSync,
+		status,
+		ready,
+		na(lastErr),
+		mapToStr(es.Labels),
+		asStatus(esoDiagnose(es.Status.Conditions)),
+		toAge(es.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// SecretStore renders a (Cluster)SecretStore to screen.
+type SecretStore struct {
+	Base
+}
+
+// Header returns a header row.
+func (SecretStore) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PROVIDER"},
+		HeaderColumn{Name: "CAPABILITIES"},
+		HeaderColumn{Name: "REFRESH"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LAST-ERROR", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (SecretStore) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected SecretStore, but got %T", o)
+	}
+	var ss ESOSecretStore
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ss)
+	if err != nil {
+		return err
+	}
+
+	providers := make([]string, 0, len(ss.Spec.Provider))
+	for k := range ss.Spec.Provider {
+		providers = append(providers, k)
+	}
+	sort.Strings(providers)
+	refresh := NAValue
+	if ss.Spec.RefreshInterval > 0 {
+		refresh = strconv.Itoa(ss.Spec.RefreshInterval) + "s"
+	}
+	status, ready, lastErr := UnknownValue, UnknownValue, ""
+	if c := esoCondition(ss.Status.Conditions); c != nil {
+		status, ready = na(c.Reason), c.Status
+		if c.Status == string(metav1.ConditionFalse) {
+			lastErr = c.Message
+		}
+	}
+
+	r.ID = client.MetaFQN(ss.ObjectMeta)
+	r.Fields = Fields{
+		ss.Namespace,
+		ss.Name,
+		naStrings(providers),
+		na(ss.Status.Capabilities),
+		refresh,
+		status,
+		ready,
+		na(lastErr),
+		mapToStr(ss.Labels),
+		asStatus(esoDiagnose(ss.Status.Conditions)),
+		toAge(ss.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func esoCondition(cc []ESOCondition) *ESOCondition {
+	for i := range cc {
+		if cc[i].Type == esoReadyCondition {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+func esoDiagnose(cc []ESOCondition) error {
+	c := esoCondition(cc)
+	if c == nil || c.Status != string(metav1.ConditionFalse) {
+		return nil
+	}
+
+	return errors.New(strings.TrimSpace(c.Reason + ": " + c.Message))
+}
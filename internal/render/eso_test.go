@@ -0,0 +1,45 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestExternalSecretRender(t *testing.T) {
+	c := render.ExternalSecret{}
+	r := render.NewRow(12)
+
+	assert.NoError(t, c.Render(load(t, "es"), "", &r))
+	assert.Equal(t, "default/db-creds", r.ID)
+	assert.Equal(t, render.Fields{"default", "db-creds", "ClusterSecretStore/vault", "db", "1h"}, r.Fields[:5])
+	assert.Equal(t, render.Fields{"SecretSyncedError", "False", "could not get secret data from provider"}, r.Fields[6:9])
+	assert.Equal(t, "SecretSyncedError: could not get secret data from provider", r.Fields[10])
+}
+
+func TestSecretStoreRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "vault",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"provider":        map[string]interface{}{"vault": map[string]interface{}{"server": "https://vault"}},
+			"refreshInterval": int64(60),
+		},
+		"status": map[string]interface{}{
+			"capabilities": "ReadWrite",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True", "reason": "Valid"},
+			},
+		},
+	}}
+	c := render.SecretStore{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"", "vault", "vault", "ReadWrite", "60s", "Valid", "True", "n/a"}, r.Fields[:8])
+	assert.Equal(t, "", r.Fields[9])
+}
@@ -0,0 +1,120 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type (
+	// FluxObject represents a Flux toolkit reconciled resource.
+	FluxObject struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Suspend bool `json:"suspend,omitempty"`
+		} `json:"spec"`
+		Status FluxStatus `json:"status"`
+	}
+
+	// FluxStatus represents a Flux toolkit resource status.
+	FluxStatus struct {
+		Conditions            []metav1.Condition `json:"conditions,omitempty"`
+		LastAppliedRevision   string             `json:"lastAppliedRevision,omitempty"`
+		LastAttemptedRevision string             `json:"lastAttemptedRevision,omitempty"`
+		Artifact              *struct {
+			Revision string `json:"revision,omitempty"`
+		} `json:"artifact,omitempty"`
+		LastHandledReconcileAt string `json:"lastHandledReconcileAt,omitempty"`
+	}
+)
+
+// Ready returns the resource Ready condition if any.
+func (s FluxStatus) Ready() *metav1.Condition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == "Ready" {
+			return &s.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// Revision returns the last applied or fetched revision.
+func (s FluxStatus) Revision() string {
+	switch {
+	case s.LastAppliedRevision != "":
+		return s.LastAppliedRevision
+	case s.Artifact != nil && s.Artifact.Revision != "":
+		return s.Artifact.Revision
+	default:
+		return s.LastAttemptedRevision
+	}
+}
+
+// Flux renders Flux toolkit resources to screen.
+type Flux struct {
+	Base
+}
+
+// Header returns a header row.
+func (Flux) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "SUSPENDED"},
+		HeaderColumn{Name: "REVISION"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (f Flux) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Flux resource, but got %T", o)
+	}
+	var fo FluxObject
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &fo)
+	if err != nil {
+		return err
+	}
+
+	ready, msg := MissingValue, ""
+	if c := fo.Status.Ready(); c != nil {
+		ready, msg = string(c.Status), c.Message
+	}
+
+	r.ID = client.MetaFQN(fo.ObjectMeta)
+	r.Fields = Fields{
+		fo.Namespace,
+		fo.Name,
+		ready,
+		boolToStr(fo.Spec.Suspend),
+		na(fo.Status.Revision()),
+		msg,
+		mapToStr(fo.Labels),
+		asStatus(f.diagnose(fo.Status.Ready())),
+		toAge(fo.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Flux) diagnose(c *metav1.Condition) error {
+	if c == nil || c.Status != metav1.ConditionFalse {
+		return nil
+	}
+	if c.Message == "" {
+		return errors.New(c.Reason)
+	}
+
+	return errors.New(c.Message)
+}
@@ -0,0 +1,56 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFluxRender(t *testing.T) {
+	uu := map[string]struct {
+		file  string
+		id    string
+		e     render.Fields
+		valid string
+	}{
+		"kustomization": {
+			file: "ks",
+			id:   "flux-system/apps",
+			e: render.Fields{
+				"flux-system",
+				"apps",
+				"False",
+				"true",
+				"main@sha1:0d7a3b9e",
+				"kustomize build failed: accumulating resources",
+			},
+			valid: "kustomize build failed: accumulating resources",
+		},
+		"gitrepository": {
+			file: "gitrepo",
+			id:   "flux-system/flux-system",
+			e: render.Fields{
+				"flux-system",
+				"flux-system",
+				"True",
+				"false",
+				"main@sha1:5d1e8f1c",
+				"stored artifact for revision 'main@sha1:5d1e8f1c'",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.Flux{}
+			r := render.NewRow(9)
+
+			assert.NoError(t, c.Render(load(t, u.file), "", &r))
+			assert.Equal(t, u.id, r.ID)
+			assert.Equal(t, u.e, r.Fields[:6])
+			assert.Equal(t, u.valid, r.Fields[7])
+		})
+	}
+}
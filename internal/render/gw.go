@@ -0,0 +1,79 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Gateway renders an Istio Gateway to screen.
+type Gateway struct {
+	Base
+}
+
+// Header returns a header row.
+func (Gateway) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SELECTOR"},
+		HeaderColumn{Name: "SERVERS"},
+		HeaderColumn{Name: "HOSTS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (g Gateway) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Gateway, but got %T", o)
+	}
+	var gw IstioGateway
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &gw)
+	if err != nil {
+		return err
+	}
+
+	servers, hosts := make([]string, 0, len(gw.Spec.Servers)), make([]string, 0, len(gw.Spec.Servers))
+	for _, s := range gw.Spec.Servers {
+		server := strings.ToLower(s.Port.Protocol) + "/" + strconv.Itoa(int(s.Port.Number))
+		if s.TLS != nil && s.TLS.Mode != "" {
+			server += "(" + strings.ToLower(s.TLS.Mode) + ")"
+		}
+		servers = append(servers, server)
+		hosts = append(hosts, s.Hosts...)
+	}
+
+	r.ID = client.MetaFQN(gw.ObjectMeta)
+	r.Fields = Fields{
+		gw.Namespace,
+		gw.Name,
+		mapToStr(gw.Spec.Selector),
+		naStrings(servers),
+		naStrings(hosts),
+		mapToStr(gw.Labels),
+		asStatus(g.diagnose(gw.Spec)),
+		toAge(gw.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Gateway) diagnose(spec IstioGatewaySpec) error {
+	if len(spec.Selector) == 0 {
+		return errors.New("no gateway selector")
+	}
+	if len(spec.Servers) == 0 {
+		return errors.New("no servers defined")
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// History renders an object recorded revision to screen.
+type History struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (History) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (History) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "REVISION", Align: tview.AlignRight},
+		HeaderColumn{Name: "RESOURCE-VERSION"},
+		HeaderColumn{Name: "CHANGES", Align: tview.AlignRight},
+		HeaderColumn{Name: "FIELDS"},
+		HeaderColumn{Name: "RECORDED", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (History) Render(o interface{}, ns string, r *Row) error {
+	h, ok := o.(HistoryRes)
+	if !ok {
+		return fmt.Errorf("expected HistoryRes, but got %T", o)
+	}
+
+	r.ID = h.ResourceVersion
+	r.Fields = append(r.Fields,
+		strconv.Itoa(h.Revision),
+		h.ResourceVersion,
+		strconv.Itoa(h.Changes),
+		strings.Join(h.Fields, ","),
+		toAge(metav1.NewTime(h.Recorded)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// HistoryRes represents an object revision recorded locally.
+type HistoryRes struct {
+	Revision        int
+	ResourceVersion string
+	Changes         int
+	Fields          []string
+	Recorded        time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (HistoryRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (h HistoryRes) DeepCopyObject() runtime.Object {
+	return h
+}
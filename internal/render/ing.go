@@ -0,0 +1,134 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// IngressWithIssues represents an ingress along with its broken backends and certs.
+type IngressWithIssues struct {
+	Raw    *unstructured.Unstructured
+	Issues []string
+}
+
+// GetObjectKind returns a schema object.
+func (i *IngressWithIssues) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (i *IngressWithIssues) DeepCopyObject() runtime.Object {
+	return i
+}
+
+// Ingress renders a K8s Ingress to screen.
+type Ingress struct {
+	Base
+}
+
+// Header returns a header row.
+func (Ingress) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLASS"},
+		HeaderColumn{Name: "HOSTS"},
+		HeaderColumn{Name: "ADDRESS"},
+		HeaderColumn{Name: "PORTS"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (i Ingress) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw    *unstructured.Unstructured
+		issues []string
+	)
+	switch c := o.(type) {
+	case *IngressWithIssues:
+		raw, issues = c.Raw, c.Issues
+	case *unstructured.Unstructured:
+		raw = c
+	default:
+		return fmt.Errorf("Expected Ingress, but got %T", o)
+	}
+	var ing netv1.Ingress
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ing)
+	if err != nil {
+		return err
+	}
+
+	class := ing.Annotations["kubernetes.io/ingress.class"]
+	if ing.Spec.IngressClassName != nil {
+		class = *ing.Spec.IngressClassName
+	}
+
+	r.ID = client.MetaFQN(ing.ObjectMeta)
+	r.Fields = Fields{
+		ing.Namespace,
+		ing.Name,
+		na(class),
+		ingHosts(ing.Spec.Rules),
+		ingAddress(ing.Status.LoadBalancer),
+		ingPorts(ing.Spec.TLS),
+		mapToStr(ing.Labels),
+		asStatus(i.diagnose(issues)),
+		toAge(ing.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Ingress) diagnose(issues []string) error {
+	if len(issues) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(issues, ", "))
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func ingHosts(rr []netv1.IngressRule) string {
+	hh := make([]string, 0, len(rr))
+	for _, r := range rr {
+		if r.Host == "" {
+			r.Host = "*"
+		}
+		hh = append(hh, r.Host)
+	}
+
+	return strings.Join(hh, ",")
+}
+
+func ingAddress(lbs netv1.IngressLoadBalancerStatus) string {
+	ss := make([]string, 0, len(lbs.Ingress))
+	for _, lb := range lbs.Ingress {
+		if len(lb.IP) > 0 {
+			ss = append(ss, lb.IP)
+		} else if len(lb.Hostname) > 0 {
+			ss = append(ss, lb.Hostname)
+		}
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func ingPorts(tt []netv1.IngressTLS) string {
+	if len(tt) > 0 {
+		return "80, 443"
+	}
+
+	return "80"
+}
@@ -0,0 +1,29 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIngressRender(t *testing.T) {
+	c := render.Ingress{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "ing"), "", &r))
+	assert.Equal(t, "default/test-ingress", r.ID)
+	assert.Equal(t, render.Fields{"default", "test-ingress", "n/a", "*", "", "80", "role=ingress"}, r.Fields[:7])
+}
+
+func TestIngressIssuesRender(t *testing.T) {
+	c := render.Ingress{}
+	r := render.NewRow(9)
+	o := render.IngressWithIssues{
+		Raw:    load(t, "ing"),
+		Issues: []string{"service fred not found", "tls secret blee not found"},
+	}
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "service fred not found, tls secret blee not found", r.Fields[7])
+}
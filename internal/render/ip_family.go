@@ -0,0 +1,98 @@
+package render
+
+import (
+	"fmt"
+	"net"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+// IPFamily returns the family of an ip address or blank if not an ip.
+func IPFamily(ip string) string {
+	addr := net.ParseIP(ip)
+	switch {
+	case addr == nil:
+		return ""
+	case addr.To4() != nil:
+		return string(v1.IPv4Protocol)
+	default:
+		return string(v1.IPv6Protocol)
+	}
+}
+
+// FilterIPs keeps the comma separated ip addresses matching a given family.
+func FilterIPs(ips, family string) string {
+	ff := strings.Split(ips, ",")
+	kk := make([]string, 0, len(ff))
+	for _, ip := range ff {
+		if IPFamily(ip) == family {
+			kk = append(kk, ip)
+		}
+	}
+
+	return strings.Join(kk, ",")
+}
+
+func podIPs(po *v1.Pod) string {
+	if len(po.Status.PodIPs) == 0 {
+		return po.Status.PodIP
+	}
+	ips := make([]string, 0, len(po.Status.PodIPs))
+	for _, ip := range po.Status.PodIPs {
+		ips = append(ips, ip.IP)
+	}
+
+	return strings.Join(ips, ",")
+}
+
+func clusterIPs(svc *v1.Service) []string {
+	if len(svc.Spec.ClusterIPs) == 0 {
+		if toIP(svc.Spec.ClusterIP) == "" {
+			return nil
+		}
+		return []string{svc.Spec.ClusterIP}
+	}
+	ips := make([]string, 0, len(svc.Spec.ClusterIPs))
+	for _, ip := range svc.Spec.ClusterIPs {
+		if ip = toIP(ip); ip != "" {
+			ips = append(ips, ip)
+		}
+	}
+
+	return ips
+}
+
+func asIPFamilies(svc *v1.Service) string {
+	ff := make([]string, 0, len(svc.Spec.IPFamilies))
+	for _, f := range svc.Spec.IPFamilies {
+		ff = append(ff, string(f))
+	}
+	s := strings.Join(ff, ",")
+	if p := svc.Spec.IPFamilyPolicy; p != nil && *p != v1.IPFamilyPolicySingleStack {
+		s += " (" + string(*p) + ")"
+	}
+
+	return na(s)
+}
+
+// checkIPFamilies ensures a service cluster ips match its requested ip families.
+func checkIPFamilies(svc *v1.Service) error {
+	ips := clusterIPs(svc)
+	if svc.Spec.Type == v1.ServiceTypeExternalName || len(ips) == 0 {
+		return nil
+	}
+	if p := svc.Spec.IPFamilyPolicy; p != nil && *p == v1.IPFamilyPolicyRequireDualStack && len(ips) < 2 {
+		return fmt.Errorf("dual-stack required but only %s assigned", strings.Join(ips, ","))
+	}
+	for i, ip := range ips {
+		if i >= len(svc.Spec.IPFamilies) {
+			break
+		}
+		if f := svc.Spec.IPFamilies[i]; IPFamily(ip) != string(f) {
+			return fmt.Errorf("cluster ip %s does not match family %s", ip, f)
+		}
+	}
+
+	return nil
+}
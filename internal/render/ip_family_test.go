@@ -0,0 +1,104 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIPFamily(t *testing.T) {
+	assert.Equal(t, "IPv4", render.IPFamily("10.0.0.1"))
+	assert.Equal(t, "IPv6", render.IPFamily("fd00::1"))
+	assert.Equal(t, "", render.IPFamily("n/a"))
+	assert.Equal(t, "fd00::1,fd00::2", render.FilterIPs("10.0.0.1,fd00::1,fd00::2", "IPv6"))
+	assert.Equal(t, "", render.FilterIPs("", "IPv4"))
+}
+
+func TestPodRenderDualStack(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: "p1", Namespace: "default"},
+		Status: v1.PodStatus{
+			PodIP:  "10.0.0.1",
+			PodIPs: []v1.PodIP{{IP: "10.0.0.1"}, {IP: "fd00::1"}},
+		},
+	}
+	raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&po)
+	assert.NoError(t, err)
+
+	var p render.Pod
+	r := render.NewRow(24)
+	assert.NoError(t, p.Render(&render.PodWithMetrics{Raw: &unstructured.Unstructured{Object: raw}}, "", &r))
+	assert.Equal(t, "10.0.0.1,fd00::1", r.Fields[p.Header("").IndexOf("IP", true)])
+}
+
+func TestServiceRenderDualStack(t *testing.T) {
+	require, single := v1.IPFamilyPolicyRequireDualStack, v1.IPFamilyPolicySingleStack
+	uu := map[string]struct {
+		ips      []string
+		families []v1.IPFamily
+		policy   *v1.IPFamilyPolicy
+		ip, fam  string
+		diag     string
+	}{
+		"single": {
+			ips:      []string{"10.0.0.1"},
+			families: []v1.IPFamily{v1.IPv4Protocol},
+			policy:   &single,
+			ip:       "10.0.0.1",
+			fam:      "IPv4",
+		},
+		"dual": {
+			ips:      []string{"10.0.0.1", "fd00::1"},
+			families: []v1.IPFamily{v1.IPv4Protocol, v1.IPv6Protocol},
+			policy:   &require,
+			ip:       "10.0.0.1,fd00::1",
+			fam:      "IPv4,IPv6 (RequireDualStack)",
+		},
+		"missing": {
+			ips:      []string{"10.0.0.1"},
+			families: []v1.IPFamily{v1.IPv4Protocol},
+			policy:   &require,
+			ip:       "10.0.0.1",
+			fam:      "IPv4 (RequireDualStack)",
+			diag:     "dual-stack required but only 10.0.0.1 assigned",
+		},
+		"mismatch": {
+			ips:      []string{"fd00::1"},
+			families: []v1.IPFamily{v1.IPv4Protocol},
+			ip:       "fd00::1",
+			fam:      "IPv4",
+			diag:     "cluster ip fd00::1 does not match family IPv4",
+		},
+	}
+
+	var s render.Service
+	h := s.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			svc := v1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "s1", Namespace: "default"},
+				Spec: v1.ServiceSpec{
+					Type:           v1.ServiceTypeClusterIP,
+					ClusterIP:      u.ips[0],
+					ClusterIPs:     u.ips,
+					IPFamilies:     u.families,
+					IPFamilyPolicy: u.policy,
+				},
+			}
+			raw, err := runtime.DefaultUnstructuredConverter.ToUnstructured(&svc)
+			assert.NoError(t, err)
+
+			r := render.NewRow(len(h))
+			assert.NoError(t, s.Render(&unstructured.Unstructured{Object: raw}, "", &r))
+			assert.Equal(t, u.ip, r.Fields[h.IndexOf("CLUSTER-IP", true)])
+			assert.Equal(t, u.fam, r.Fields[h.IndexOf("IP-FAMILIES", true)])
+			assert.Equal(t, u.diag, r.Fields[h.IndexOf("VALID", true)])
+		})
+	}
+}
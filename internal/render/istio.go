@@ -0,0 +1,230 @@
+package render
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const istioClusterDomain = "svc.cluster.local"
+
+type (
+	// IstioVirtualService represents an Istio VirtualService.
+	IstioVirtualService struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              IstioVirtualServiceSpec `json:"spec"`
+	}
+
+	// IstioVirtualServiceSpec represents a VirtualService spec.
+	IstioVirtualServiceSpec struct {
+		Hosts    []string     `json:"hosts,omitempty"`
+		Gateways []string     `json:"gateways,omitempty"`
+		HTTP     []IstioRoute `json:"http,omitempty"`
+		TCP      []IstioRoute `json:"tcp,omitempty"`
+		TLS      []IstioRoute `json:"tls,omitempty"`
+	}
+
+	// IstioRoute represents a VirtualService route.
+	IstioRoute struct {
+		Name  string                  `json:"name,omitempty"`
+		Route []IstioRouteDestination `json:"route,omitempty"`
+	}
+
+	// IstioRouteDestination represents a weighted route destination.
+	IstioRouteDestination struct {
+		Destination IstioDestination `json:"destination"`
+		Weight      int32            `json:"weight,omitempty"`
+	}
+
+	// IstioDestination represents a route destination.
+	IstioDestination struct {
+		Host   string `json:"host"`
+		Subset string `json:"subset,omitempty"`
+	}
+
+	// IstioDestinationRule represents an Istio DestinationRule.
+	IstioDestinationRule struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              IstioDestinationRuleSpec `json:"spec"`
+	}
+
+	// IstioDestinationRuleSpec represents a DestinationRule spec.
+	IstioDestinationRuleSpec struct {
+		Host          string              `json:"host"`
+		Subsets       []IstioSubset       `json:"subsets,omitempty"`
+		TrafficPolicy *IstioTrafficPolicy `json:"trafficPolicy,omitempty"`
+	}
+
+	// IstioSubset represents a named set of service endpoints.
+	IstioSubset struct {
+		Name   string            `json:"name"`
+		Labels map[string]string `json:"labels,omitempty"`
+	}
+
+	// IstioTrafficPolicy represents a DestinationRule traffic policy.
+	IstioTrafficPolicy struct {
+		LoadBalancer *struct {
+			Simple string `json:"simple,omitempty"`
+		} `json:"loadBalancer,omitempty"`
+		TLS *IstioTLS `json:"tls,omitempty"`
+	}
+
+	// IstioTLS represents a TLS setting.
+	IstioTLS struct {
+		Mode string `json:"mode,omitempty"`
+	}
+
+	// IstioGateway represents an Istio Gateway.
+	IstioGateway struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              IstioGatewaySpec `json:"spec"`
+	}
+
+	// IstioGatewaySpec represents a Gateway spec.
+	IstioGatewaySpec struct {
+		Selector map[string]string `json:"selector,omitempty"`
+		Servers  []IstioServer     `json:"servers,omitempty"`
+	}
+
+	// IstioServer represents a Gateway server.
+	IstioServer struct {
+		Port struct {
+			Number   int32  `json:"number"`
+			Protocol string `json:"protocol,omitempty"`
+		} `json:"port"`
+		Hosts []string  `json:"hosts,omitempty"`
+		TLS   *IstioTLS `json:"tls,omitempty"`
+	}
+
+	// SubsetHealth tracks the ready pods backing a DestinationRule subset.
+	SubsetHealth struct {
+		Ready, Total int
+	}
+)
+
+// Destinations returns all weighted route destinations.
+func (s IstioVirtualServiceSpec) Destinations() []IstioRouteDestination {
+	var dd []IstioRouteDestination
+	for _, rr := range [][]IstioRoute{s.HTTP, s.TCP, s.TLS} {
+		for _, r := range rr {
+			for _, d := range r.Route {
+				if d.Weight == 0 && len(r.Route) == 1 {
+					d.Weight = 100
+				}
+				dd = append(dd, d)
+			}
+		}
+	}
+
+	return dd
+}
+
+// Primary returns the destination receiving the most traffic.
+func (s IstioVirtualServiceSpec) Primary() (IstioDestination, bool) {
+	dd := s.Destinations()
+	if len(dd) == 0 {
+		return IstioDestination{}, false
+	}
+	primary := dd[0]
+	for _, d := range dd[1:] {
+		if d.Weight > primary.Weight {
+			primary = d
+		}
+	}
+
+	return primary.Destination, true
+}
+
+// IstioFQDN resolves a short Istio host name against a given namespace.
+func IstioFQDN(host, ns string) string {
+	if host == "" || strings.HasPrefix(host, "*") {
+		return host
+	}
+	switch strings.Count(host, ".") {
+	case 0:
+		return host + "." + ns + "." + istioClusterDomain
+	case 1:
+		return host + "." + istioClusterDomain
+	default:
+		return host
+	}
+}
+
+// IstioServiceFQN returns the in-cluster service matching an Istio host if any.
+func IstioServiceFQN(host, ns string) (string, bool) {
+	fqdn := IstioFQDN(host, ns)
+	if !strings.HasSuffix(fqdn, "."+istioClusterDomain) {
+		return "", false
+	}
+	tokens := strings.Split(strings.TrimSuffix(fqdn, "."+istioClusterDomain), ".")
+	if len(tokens) != 2 {
+		return "", false
+	}
+
+	return client.FQN(tokens[1], tokens[0]), true
+}
+
+// DestinationRuleWithHealth represents a DestinationRule along with its subsets health.
+type DestinationRuleWithHealth struct {
+	Raw     *unstructured.Unstructured
+	Subsets map[string]SubsetHealth
+}
+
+// GetObjectKind returns a schema object.
+func (d *DestinationRuleWithHealth) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (d *DestinationRuleWithHealth) DeepCopyObject() runtime.Object {
+	return d
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func istioHosts(hh []string, ns string) string {
+	ss := make([]string, 0, len(hh))
+	for _, h := range hh {
+		ss = append(ss, IstioFQDN(h, ns))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func istioRoutes(dd []IstioRouteDestination) string {
+	ss := make([]string, 0, len(dd))
+	for _, d := range dd {
+		host := d.Destination.Host
+		if d.Destination.Subset != "" {
+			host += "/" + d.Destination.Subset
+		}
+		ss = append(ss, fmt.Sprintf("%s=%d%%", host, d.Weight))
+	}
+
+	return strings.Join(ss, ",")
+}
+
+func istioSubsets(ss []IstioSubset, hh map[string]SubsetHealth) string {
+	if len(ss) == 0 {
+		return NAValue
+	}
+	out := make([]string, 0, len(ss))
+	for _, s := range ss {
+		h, ok := hh[s.Name]
+		if !ok {
+			out = append(out, s.Name)
+			continue
+		}
+		out = append(out, fmt.Sprintf("%s(%d/%d)", s.Name, h.Ready, h.Total))
+	}
+	sort.Strings(out)
+
+	return strings.Join(out, ",")
+}
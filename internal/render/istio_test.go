@@ -0,0 +1,108 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVirtualServiceRender(t *testing.T) {
+	c := render.VirtualService{}
+	r := render.NewRow(8)
+
+	assert.NoError(t, c.Render(load(t, "vs"), "", &r))
+	assert.Equal(t, "default/reviews", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"reviews",
+		"bookinfo-gateway",
+		"reviews.default.svc.cluster.local,reviews.bookinfo.com",
+		"reviews/v1=90%,reviews/v2=10%",
+	}, r.Fields[:5])
+	assert.Equal(t, "", r.Fields[6])
+}
+
+func TestDestinationRuleRender(t *testing.T) {
+	uu := map[string]struct {
+		hh    map[string]render.SubsetHealth
+		e     string
+		valid string
+	}{
+		"healthy": {
+			hh:    map[string]render.SubsetHealth{"v1": {Ready: 2, Total: 2}, "v2": {Ready: 1, Total: 1}},
+			e:     "v1(2/2),v2(1/1)",
+			valid: "",
+		},
+		"no-ready": {
+			hh:    map[string]render.SubsetHealth{"v1": {Ready: 2, Total: 2}, "v2": {Ready: 0, Total: 1}},
+			e:     "v1(2/2),v2(0/1)",
+			valid: "subset v2 has no ready pods",
+		},
+		"unknown": {
+			e:     "v1,v2",
+			valid: "",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.DestinationRule{}
+			r := render.NewRow(9)
+			o := render.DestinationRuleWithHealth{
+				Raw:     load(t, "dr"),
+				Subsets: u.hh,
+			}
+
+			assert.NoError(t, c.Render(&o, "", &r))
+			assert.Equal(t, "default/reviews", r.ID)
+			assert.Equal(t, render.Fields{
+				"default",
+				"reviews",
+				"reviews.default.svc.cluster.local",
+				u.e,
+				"ROUND_ROBIN",
+				"ISTIO_MUTUAL",
+			}, r.Fields[:6])
+			assert.Equal(t, u.valid, r.Fields[7])
+		})
+	}
+}
+
+func TestGatewayRender(t *testing.T) {
+	c := render.Gateway{}
+	r := render.NewRow(8)
+
+	assert.NoError(t, c.Render(load(t, "gw"), "", &r))
+	assert.Equal(t, "default/bookinfo-gateway", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"bookinfo-gateway",
+		"istio=ingressgateway",
+		"http/80,https/443(simple)",
+		"bookinfo.com,secure.bookinfo.com",
+	}, r.Fields[:5])
+}
+
+func TestIstioServiceFQN(t *testing.T) {
+	uu := map[string]struct {
+		host, ns, e string
+		ok          bool
+	}{
+		"short":     {host: "reviews", ns: "default", e: "default/reviews", ok: true},
+		"namespace": {host: "reviews.prod", ns: "default", e: "prod/reviews", ok: true},
+		"fqdn":      {host: "reviews.prod.svc.cluster.local", ns: "default", e: "prod/reviews", ok: true},
+		"external":  {host: "www.google.com", ns: "default"},
+		"wildcard":  {host: "*.bookinfo.com", ns: "default"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			fqn, ok := render.IstioServiceFQN(u.host, u.ns)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, fqn)
+		})
+	}
+}
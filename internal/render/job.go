@@ -7,11 +7,13 @@ import (
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	batchv1 "k8s.io/api/batch/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/duration"
 )
 
@@ -27,6 +29,10 @@ func (Job) Header(ns string) Header {
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "COMPLETIONS"},
 		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "MODE", Wide: true},
+		HeaderColumn{Name: "BACKOFF", Align: tview.AlignRight},
+		HeaderColumn{Name: "DEADLINE", Wide: true},
+		HeaderColumn{Name: "REASON"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
 		HeaderColumn{Name: "IMAGES", Wide: true},
@@ -37,8 +43,16 @@ func (Job) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (j Job) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw    *unstructured.Unstructured
+		reason string
+	)
+	switch job := o.(type) {
+	case *JobWithFailures:
+		raw, reason = job.Raw, job.Reason
+	case *unstructured.Unstructured:
+		raw = job
+	default:
 		return fmt.Errorf("Expected Job, but got %T", o)
 	}
 	var job batchv1.Job
@@ -49,6 +63,10 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 	ready := toCompletion(job.Spec, job.Status)
 
 	cc, ii := toContainers(job.Spec.Template.Spec)
+	failed := jobFailedCondition(job.Status)
+	if reason == "" && failed != nil {
+		reason = failed.Reason
+	}
 
 	r.ID = client.MetaFQN(job.ObjectMeta)
 	r.Fields = Fields{
@@ -56,17 +74,24 @@ func (j Job) Render(o interface{}, ns string, r *Row) error {
 		job.Name,
 		ready,
 		toDuration(job.Status),
+		toCompletionMode(job.Spec),
+		toBackoff(job.Spec, job.Status),
+		toDeadline(job.Spec, job.Status, time.Now()),
+		na(reason),
 		jobSelector(job.Spec),
 		cc,
 		ii,
-		asStatus(j.diagnose(ready, job.Status.CompletionTime)),
+		asStatus(j.diagnose(ready, job.Status.CompletionTime, failed)),
 		toAge(job.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (Job) diagnose(ready string, completed *metav1.Time) error {
+func (Job) diagnose(ready string, completed *metav1.Time, failed *batchv1.JobCondition) error {
+	if failed != nil {
+		return fmt.Errorf("job failed: %s", failed.Message)
+	}
 	if completed == nil {
 		return nil
 	}
@@ -80,6 +105,22 @@ func (Job) diagnose(ready string, completed *metav1.Time) error {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// JobWithFailures represents a job along with its pods dominant failure reason.
+type JobWithFailures struct {
+	Raw    *unstructured.Unstructured
+	Reason string
+}
+
+// GetObjectKind returns a schema object.
+func (j *JobWithFailures) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (j *JobWithFailures) DeepCopyObject() runtime.Object {
+	return j
+}
+
 const maxShow = 2
 
 func toContainers(p v1.PodSpec) (string, string) {
@@ -140,3 +181,52 @@ func toDuration(status batchv1.JobStatus) string {
 
 	return duration.HumanDuration(d)
 }
+
+func toCompletionMode(spec batchv1.JobSpec) string {
+	if spec.CompletionMode == nil {
+		return string(batchv1.NonIndexedCompletion)
+	}
+
+	return string(*spec.CompletionMode)
+}
+
+// toBackoff returns the number of failed pods against the job backoff limit.
+func toBackoff(spec batchv1.JobSpec, status batchv1.JobStatus) string {
+	limit := int32(6)
+	if spec.BackoffLimit != nil {
+		limit = *spec.BackoffLimit
+	}
+
+	return strconv.Itoa(int(status.Failed)) + "/" + strconv.Itoa(int(limit))
+}
+
+// toDeadline returns the time left before the job active deadline kicks in.
+func toDeadline(spec batchv1.JobSpec, status batchv1.JobStatus, now time.Time) string {
+	if spec.ActiveDeadlineSeconds == nil {
+		return NAValue
+	}
+	deadline := time.Duration(*spec.ActiveDeadlineSeconds) * time.Second
+	if status.StartTime == nil {
+		return duration.HumanDuration(deadline)
+	}
+	if status.CompletionTime != nil {
+		return "done"
+	}
+	left := deadline - now.Sub(status.StartTime.Time)
+	if left <= 0 {
+		return "exceeded"
+	}
+
+	return duration.HumanDuration(left)
+}
+
+func jobFailedCondition(status batchv1.JobStatus) *batchv1.JobCondition {
+	for i := range status.Conditions {
+		c := status.Conditions[i]
+		if c.Type == batchv1.JobFailed && c.Status == v1.ConditionTrue {
+			return &c
+		}
+	}
+
+	return nil
+}
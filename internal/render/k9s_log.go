@@ -0,0 +1,90 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/applog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const k9sLogTimeFmt = "01-02 15:04:05.000"
+
+// K9sLog renders a k9s log entry to screen.
+type K9sLog struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (K9sLog) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		levelCol := h.IndexOf("LEVEL", true)
+		if levelCol == -1 {
+			return StdColor
+		}
+		l, _ := zerolog.ParseLevel(strings.TrimSpace(re.Row.Fields[levelCol]))
+		switch {
+		case l >= zerolog.ErrorLevel && l < zerolog.NoLevel:
+			return ErrColor
+		case l == zerolog.WarnLevel:
+			return PendingColor
+		case l <= zerolog.DebugLevel:
+			return CompletedColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (K9sLog) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SEQ", Align: tview.AlignRight, Wide: true},
+		HeaderColumn{Name: "TIME"},
+		HeaderColumn{Name: "LEVEL"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "FIELDS"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (K9sLog) Render(o interface{}, ns string, r *Row) error {
+	l, ok := o.(K9sLogRes)
+	if !ok {
+		return fmt.Errorf("expected K9sLogRes, but got %T", o)
+	}
+
+	r.ID = strconv.FormatInt(l.Seq, 10)
+	r.Fields = append(r.Fields,
+		r.ID,
+		l.Time.Format(k9sLogTimeFmt),
+		l.Level.String(),
+		l.Message,
+		strings.Join(l.Fields, " "),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// K9sLogRes represents a k9s log entry.
+type K9sLogRes struct {
+	applog.Entry
+}
+
+// GetObjectKind returns a schema object.
+func (K9sLogRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (l K9sLogRes) DeepCopyObject() runtime.Object {
+	return l
+}
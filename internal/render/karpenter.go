@@ -0,0 +1,281 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// KarpenterNodePoolLabel tracks the NodePool owning a NodeClaim.
+	KarpenterNodePoolLabel = "karpenter.sh/nodepool"
+
+	// KarpenterCapacityTypeLabel tracks a NodeClaim capacity type.
+	KarpenterCapacityTypeLabel = "karpenter.sh/capacity-type"
+
+	karpenterReadyCondition = "Ready"
+)
+
+// karpenterDisruptions tracks the conditions flagging a NodeClaim for disruption.
+var karpenterDisruptions = []string{"Drifted", "Empty", "Expired", "Consolidatable", "Disrupting"}
+
+type (
+	// KarpenterNodePool represents a Karpenter NodePool.
+	KarpenterNodePool struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Weight   *int32 `json:"weight,omitempty"`
+			Template struct {
+				Spec struct {
+					NodeClassRef *struct {
+						Kind string `json:"kind,omitempty"`
+						Name string `json:"name"`
+					} `json:"nodeClassRef,omitempty"`
+				} `json:"spec"`
+			} `json:"template"`
+			Disruption struct {
+				ConsolidationPolicy string `json:"consolidationPolicy,omitempty"`
+				ConsolidateAfter    string `json:"consolidateAfter,omitempty"`
+				Budgets             []struct {
+					Nodes string `json:"nodes"`
+				} `json:"budgets,omitempty"`
+			} `json:"disruption"`
+			Limits v1.ResourceList `json:"limits,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Resources  v1.ResourceList    `json:"resources,omitempty"`
+			Conditions []metav1.Condition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// KarpenterNodeClaim represents a Karpenter NodeClaim.
+	KarpenterNodeClaim struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Status            struct {
+			NodeName   string             `json:"nodeName,omitempty"`
+			ProviderID string             `json:"providerID,omitempty"`
+			Capacity   v1.ResourceList    `json:"capacity,omitempty"`
+			Conditions []metav1.Condition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+)
+
+// Disruptions returns the disruption conditions currently set on the NodeClaim.
+func (n KarpenterNodeClaim) Disruptions() []string {
+	var dd []string
+	for _, d := range karpenterDisruptions {
+		if c := karpenterCondition(n.Status.Conditions, d); c != nil && c.Status == metav1.ConditionTrue {
+			dd = append(dd, d)
+		}
+	}
+
+	return dd
+}
+
+// NodePoolWithClaims represents a NodePool along with its provisioned NodeClaims count.
+type NodePoolWithClaims struct {
+	Raw    *unstructured.Unstructured
+	Claims int
+}
+
+// GetObjectKind returns a schema object.
+func (n *NodePoolWithClaims) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n *NodePoolWithClaims) DeepCopyObject() runtime.Object {
+	return n
+}
+
+// NodePool renders a Karpenter NodePool to screen.
+type NodePool struct {
+	Base
+}
+
+// Header returns a header row.
+func (NodePool) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "NODECLASS"},
+		HeaderColumn{Name: "WEIGHT", Align: tview.AlignRight},
+		HeaderColumn{Name: "NODES", Align: tview.AlignRight},
+		HeaderColumn{Name: "CPU", Align: tview.AlignRight},
+		HeaderColumn{Name: "MEM", Align: tview.AlignRight},
+		HeaderColumn{Name: "CONSOLIDATION"},
+		HeaderColumn{Name: "BUDGETS", Wide: true},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NodePool) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw    *unstructured.Unstructured
+		claims = -1
+	)
+	switch np := o.(type) {
+	case *NodePoolWithClaims:
+		raw, claims = np.Raw, np.Claims
+	case *unstructured.Unstructured:
+		raw = np
+	default:
+		return fmt.Errorf("Expected NodePool, but got %T", o)
+	}
+	var np KarpenterNodePool
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &np)
+	if err != nil {
+		return err
+	}
+
+	class := MissingValue
+	if ref := np.Spec.Template.Spec.NodeClassRef; ref != nil {
+		class = ref.Name
+	}
+	weight := "0"
+	if np.Spec.Weight != nil {
+		weight = strconv.Itoa(int(*np.Spec.Weight))
+	}
+	nodes := NAValue
+	if claims >= 0 {
+		nodes = strconv.Itoa(claims)
+	}
+	consolidation := na(np.Spec.Disruption.ConsolidationPolicy)
+	if np.Spec.Disruption.ConsolidateAfter != "" {
+		consolidation += "/" + np.Spec.Disruption.ConsolidateAfter
+	}
+	budgets := make([]string, 0, len(np.Spec.Disruption.Budgets))
+	for _, b := range np.Spec.Disruption.Budgets {
+		budgets = append(budgets, b.Nodes)
+	}
+
+	r.ID = client.MetaFQN(np.ObjectMeta)
+	r.Fields = Fields{
+		np.Name,
+		class,
+		weight,
+		nodes,
+		karpenterUsage(np.Status.Resources, np.Spec.Limits, v1.ResourceCPU),
+		karpenterUsage(np.Status.Resources, np.Spec.Limits, v1.ResourceMemory),
+		consolidation,
+		naStrings(budgets),
+		karpenterReady(np.Status.Conditions),
+		mapToStr(np.Labels),
+		asStatus(karpenterDiagnose(np.Status.Conditions)),
+		toAge(np.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// NodeClaim renders a Karpenter NodeClaim to screen.
+type NodeClaim struct {
+	Base
+}
+
+// Header returns a header row.
+func (NodeClaim) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "NODEPOOL"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "CAPACITY"},
+		HeaderColumn{Name: "ZONE"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "DISRUPTION"},
+		HeaderColumn{Name: "PROVIDER-ID", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NodeClaim) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected NodeClaim, but got %T", o)
+	}
+	var nc KarpenterNodeClaim
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &nc)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(nc.ObjectMeta)
+	r.Fields = Fields{
+		nc.Name,
+		na(nc.Labels[KarpenterNodePoolLabel]),
+		na(nc.Labels[v1.LabelInstanceTypeStable]),
+		na(nc.Labels[KarpenterCapacityTypeLabel]),
+		na(nc.Labels[v1.LabelTopologyZone]),
+		na(nc.Status.NodeName),
+		karpenterReady(nc.Status.Conditions),
+		naStrings(nc.Disruptions()),
+		na(nc.Status.ProviderID),
+		mapToStr(nc.Labels),
+		asStatus(karpenterDiagnose(nc.Status.Conditions)),
+		toAge(nc.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func karpenterCondition(cc []metav1.Condition, t string) *metav1.Condition {
+	for i := range cc {
+		if cc[i].Type == t {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+func karpenterReady(cc []metav1.Condition) string {
+	c := karpenterCondition(cc, karpenterReadyCondition)
+	if c == nil {
+		return UnknownValue
+	}
+
+	return string(c.Status)
+}
+
+func karpenterDiagnose(cc []metav1.Condition) error {
+	c := karpenterCondition(cc, karpenterReadyCondition)
+	if c == nil || c.Status != metav1.ConditionFalse {
+		return nil
+	}
+	if c.Message == "" {
+		return errors.New(c.Reason)
+	}
+
+	return errors.New(c.Message)
+}
+
+func karpenterUsage(used, limits v1.ResourceList, n v1.ResourceName) string {
+	u, ok := used[n]
+	if !ok {
+		return NAValue
+	}
+	l, ok := limits[n]
+	if !ok {
+		return u.String()
+	}
+
+	return u.String() + "/" + l.String()
+}
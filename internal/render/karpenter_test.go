@@ -0,0 +1,64 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestNodeClaimRender(t *testing.T) {
+	c := render.NodeClaim{}
+	r := render.NewRow(12)
+
+	assert.NoError(t, c.Render(load(t, "nodeclaim"), "", &r))
+	assert.Equal(t, "-/default-x7k2p", r.ID)
+	assert.Equal(t, render.Fields{"default-x7k2p", "default", "m5.large", "spot", "us-east-1a", "ip-10-0-1-12.ec2.internal", "True", "Drifted,Consolidatable", "aws:///us-east-1a/i-0abc"}, r.Fields[:9])
+	assert.Equal(t, "", r.Fields[10])
+}
+
+func TestNodePoolRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "default",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"weight": int64(10),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"nodeClassRef": map[string]interface{}{"kind": "EC2NodeClass", "name": "default"},
+				},
+			},
+			"disruption": map[string]interface{}{
+				"consolidationPolicy": "WhenEmptyOrUnderutilized",
+				"consolidateAfter":    "1m",
+				"budgets":             []interface{}{map[string]interface{}{"nodes": "10%"}},
+			},
+			"limits": map[string]interface{}{"cpu": "100"},
+		},
+		"status": map[string]interface{}{
+			"resources": map[string]interface{}{"cpu": "4", "memory": "16Gi"},
+		},
+	}}
+
+	uu := map[string]struct {
+		o     interface{}
+		nodes string
+	}{
+		"plain":  {o: &o, nodes: "n/a"},
+		"claims": {o: &render.NodePoolWithClaims{Raw: &o, Claims: 2}, nodes: "2"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.NodePool{}
+			r := render.NewRow(12)
+
+			assert.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, render.Fields{"default", "default", "10", u.nodes, "4/100", "16Gi", "WhenEmptyOrUnderutilized/1m", "10%", "<unknown>"}, r.Fields[:9])
+		})
+	}
+}
@@ -0,0 +1,304 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// KedaPausedAnnotation pauses autoscaling on a KEDA resource.
+	KedaPausedAnnotation = "autoscaling.keda.sh/paused"
+	// KedaPausedReplicasAnnotation pauses autoscaling at a given replica count.
+	KedaPausedReplicasAnnotation = "autoscaling.keda.sh/paused-replicas"
+)
+
+type (
+	// KedaScaledObject represents a KEDA ScaledObject or ScaledJob.
+	KedaScaledObject struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			ScaleTargetRef *struct {
+				Kind string `json:"kind,omitempty"`
+				Name string `json:"name"`
+			} `json:"scaleTargetRef,omitempty"`
+			MinReplicaCount *int32        `json:"minReplicaCount,omitempty"`
+			MaxReplicaCount *int32        `json:"maxReplicaCount,omitempty"`
+			Triggers        []KedaTrigger `json:"triggers,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			HPAName    string             `json:"hpaName,omitempty"`
+			Conditions []metav1.Condition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// KedaTrigger represents a scaler trigger.
+	KedaTrigger struct {
+		Type string `json:"type"`
+		Name string `json:"name,omitempty"`
+	}
+)
+
+// IsPaused checks if autoscaling is paused.
+func (s *KedaScaledObject) IsPaused() bool {
+	if v, ok := s.Annotations[KedaPausedAnnotation]; ok && v != "false" {
+		return true
+	}
+	_, ok := s.Annotations[KedaPausedReplicasAnnotation]
+
+	return ok
+}
+
+// Condition returns a given status condition if any.
+func (s *KedaScaledObject) Condition(t string) *metav1.Condition {
+	for i := range s.Status.Conditions {
+		if s.Status.Conditions[i].Type == t {
+			return &s.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// ScaledObjectWithHPA represents a ScaledObject along with its generated HPA.
+type ScaledObjectWithHPA struct {
+	Raw *unstructured.Unstructured
+	HPA *autoscalingv2.HorizontalPodAutoscaler
+}
+
+// GetObjectKind returns a schema object.
+func (s *ScaledObjectWithHPA) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *ScaledObjectWithHPA) DeepCopyObject() runtime.Object {
+	return s
+}
+
+// ScaledObject renders a KEDA ScaledObject to screen.
+type ScaledObject struct {
+	Base
+}
+
+// Header returns a header row.
+func (ScaledObject) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TARGET"},
+		HeaderColumn{Name: "MIN", Align: tview.AlignRight},
+		HeaderColumn{Name: "MAX", Align: tview.AlignRight},
+		HeaderColumn{Name: "TRIGGERS"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "ACTIVE"},
+		HeaderColumn{Name: "PAUSED"},
+		HeaderColumn{Name: "HPA"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (s ScaledObject) Render(o interface{}, ns string, r *Row) error {
+	soh, ok := o.(*ScaledObjectWithHPA)
+	if !ok {
+		return fmt.Errorf("Expected ScaledObjectWithHPA, but got %T", o)
+	}
+	var so KedaScaledObject
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(soh.Raw.Object, &so)
+	if err != nil {
+		return err
+	}
+
+	target := MissingValue
+	if ref := so.Spec.ScaleTargetRef; ref != nil {
+		kind := ref.Kind
+		if kind == "" {
+			kind = "Deployment"
+		}
+		target = kind + "/" + ref.Name
+	}
+
+	r.ID = client.MetaFQN(so.ObjectMeta)
+	r.Fields = Fields{
+		so.Namespace,
+		so.Name,
+		target,
+		kedaReplicas(so.Spec.MinReplicaCount),
+		kedaReplicas(so.Spec.MaxReplicaCount),
+		strings.Join(KedaTriggerMetrics(so.Spec.Triggers, soh.HPA), ","),
+		kedaCondition(so.Condition("Ready")),
+		kedaCondition(so.Condition("Active")),
+		boolToStr(so.IsPaused()),
+		na(so.Status.HPAName),
+		mapToStr(so.Labels),
+		asStatus(kedaDiagnose(&so)),
+		toAge(so.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ScaledJob renders a KEDA ScaledJob to screen.
+type ScaledJob struct {
+	Base
+}
+
+// Header returns a header row.
+func (ScaledJob) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "MIN", Align: tview.AlignRight},
+		HeaderColumn{Name: "MAX", Align: tview.AlignRight},
+		HeaderColumn{Name: "TRIGGERS"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "ACTIVE"},
+		HeaderColumn{Name: "PAUSED"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (s ScaledJob) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ScaledJob, but got %T", o)
+	}
+	var sj KedaScaledObject
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &sj)
+	if err != nil {
+		return err
+	}
+
+	r.ID = client.MetaFQN(sj.ObjectMeta)
+	r.Fields = Fields{
+		sj.Namespace,
+		sj.Name,
+		kedaReplicas(sj.Spec.MinReplicaCount),
+		kedaReplicas(sj.Spec.MaxReplicaCount),
+		strings.Join(KedaTriggerMetrics(sj.Spec.Triggers, nil), ","),
+		kedaCondition(sj.Condition("Ready")),
+		kedaCondition(sj.Condition("Active")),
+		boolToStr(sj.IsPaused()),
+		mapToStr(sj.Labels),
+		asStatus(kedaDiagnose(&sj)),
+		toAge(sj.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// KedaTriggerMetrics returns the current and target metric values per trigger.
+func KedaTriggerMetrics(tt []KedaTrigger, hpa *autoscalingv2.HorizontalPodAutoscaler) []string {
+	ss := make([]string, 0, len(tt))
+	for i, t := range tt {
+		name := t.Type
+		if t.Name != "" {
+			name += "(" + t.Name + ")"
+		}
+		if hpa == nil {
+			ss = append(ss, name)
+			continue
+		}
+		curr, target := kedaMetric(hpa, i, t.Type)
+		ss = append(ss, name+"="+curr+"/"+target)
+	}
+
+	return ss
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// kedaMetric locates a trigger metric in the generated HPA. External metrics
+// are prefixed by their trigger index while resource metrics use their resource name.
+func kedaMetric(hpa *autoscalingv2.HorizontalPodAutoscaler, idx int, kind string) (string, string) {
+	curr, target := UnknownValue, UnknownValue
+	prefix := "s" + strconv.Itoa(idx) + "-"
+	for _, m := range hpa.Spec.Metrics {
+		switch {
+		case m.External != nil && strings.HasPrefix(m.External.Metric.Name, prefix):
+			target = kedaTarget(m.External.Target)
+		case m.Resource != nil && string(m.Resource.Name) == kind:
+			target = kedaTarget(m.Resource.Target)
+		}
+	}
+	for _, m := range hpa.Status.CurrentMetrics {
+		switch {
+		case m.External != nil && strings.HasPrefix(m.External.Metric.Name, prefix):
+			curr = kedaCurrent(m.External.Current)
+		case m.Resource != nil && string(m.Resource.Name) == kind:
+			curr = kedaCurrent(m.Resource.Current)
+		}
+	}
+
+	return curr, target
+}
+
+func kedaTarget(t autoscalingv2.MetricTarget) string {
+	switch {
+	case t.AverageUtilization != nil:
+		return strconv.Itoa(int(*t.AverageUtilization)) + "%"
+	case t.AverageValue != nil:
+		return t.AverageValue.String()
+	case t.Value != nil:
+		return t.Value.String()
+	default:
+		return UnknownValue
+	}
+}
+
+func kedaCurrent(c autoscalingv2.MetricValueStatus) string {
+	switch {
+	case c.AverageUtilization != nil:
+		return strconv.Itoa(int(*c.AverageUtilization)) + "%"
+	case c.AverageValue != nil:
+		return c.AverageValue.String()
+	case c.Value != nil:
+		return c.Value.String()
+	default:
+		return UnknownValue
+	}
+}
+
+func kedaReplicas(r *int32) string {
+	if r == nil {
+		return NAValue
+	}
+
+	return strconv.Itoa(int(*r))
+}
+
+func kedaCondition(c *metav1.Condition) string {
+	if c == nil {
+		return MissingValue
+	}
+
+	return string(c.Status)
+}
+
+func kedaDiagnose(s *KedaScaledObject) error {
+	c := s.Condition("Ready")
+	if c == nil || c.Status != metav1.ConditionFalse {
+		return nil
+	}
+	if c.Message == "" {
+		return errors.New(c.Reason)
+	}
+
+	return errors.New(c.Message)
+}
@@ -0,0 +1,94 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	autoscalingv2 "k8s.io/api/autoscaling/v2"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestScaledObjectRender(t *testing.T) {
+	c := render.ScaledObject{}
+	r := render.NewRow(13)
+
+	assert.NoError(t, c.Render(&render.ScaledObjectWithHPA{Raw: load(t, "so"), HPA: makeKedaHPA()}, "", &r))
+	assert.Equal(t, "default/worker", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"worker",
+		"Deployment/worker",
+		"1",
+		"10",
+		"cpu=42%/80%,rabbitmq(jobs)=12/20",
+		"True",
+		"False",
+		"true",
+		"keda-hpa-worker",
+	}, r.Fields[:10])
+}
+
+func TestScaledJobRender(t *testing.T) {
+	c := render.ScaledJob{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "sj"), "", &r))
+	assert.Equal(t, "default/importer", r.ID)
+	assert.Equal(t, render.Fields{"default", "importer", "n/a", "5", "aws-sqs-queue", "False", "<none>", "false"}, r.Fields[:8])
+	assert.Equal(t, "failed to ensure HPA is correctly created", r.Fields[9])
+}
+
+func TestKedaTriggerMetrics(t *testing.T) {
+	tt := []render.KedaTrigger{{Type: "cpu"}, {Type: "rabbitmq", Name: "jobs"}, {Type: "cron"}}
+
+	assert.Equal(t, []string{"cpu", "rabbitmq(jobs)", "cron"}, render.KedaTriggerMetrics(tt, nil))
+	assert.Equal(t, []string{"cpu=42%/80%", "rabbitmq(jobs)=12/20", "cron=<unknown>/<unknown>"}, render.KedaTriggerMetrics(tt, makeKedaHPA()))
+}
+
+// Helpers...
+
+func makeKedaHPA() *autoscalingv2.HorizontalPodAutoscaler {
+	util, curr := int32(80), int32(42)
+	target, value := resource.MustParse("20"), resource.MustParse("12")
+
+	return &autoscalingv2.HorizontalPodAutoscaler{
+		Spec: autoscalingv2.HorizontalPodAutoscalerSpec{
+			Metrics: []autoscalingv2.MetricSpec{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricSource{
+						Name:   v1.ResourceCPU,
+						Target: autoscalingv2.MetricTarget{AverageUtilization: &util},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricSource{
+						Metric: autoscalingv2.MetricIdentifier{Name: "s1-rabbitmq-jobs"},
+						Target: autoscalingv2.MetricTarget{AverageValue: &target},
+					},
+				},
+			},
+		},
+		Status: autoscalingv2.HorizontalPodAutoscalerStatus{
+			CurrentMetrics: []autoscalingv2.MetricStatus{
+				{
+					Type: autoscalingv2.ResourceMetricSourceType,
+					Resource: &autoscalingv2.ResourceMetricStatus{
+						Name:    v1.ResourceCPU,
+						Current: autoscalingv2.MetricValueStatus{AverageUtilization: &curr},
+					},
+				},
+				{
+					Type: autoscalingv2.ExternalMetricSourceType,
+					External: &autoscalingv2.ExternalMetricStatus{
+						Metric:  autoscalingv2.MetricIdentifier{Name: "s1-rabbitmq-jobs"},
+						Current: autoscalingv2.MetricValueStatus{AverageValue: &value},
+					},
+				},
+			},
+		},
+	}
+}
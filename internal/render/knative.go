@@ -0,0 +1,294 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// KnativeServiceLabel tracks the Knative Service owning a Revision.
+const KnativeServiceLabel = "serving.knative.dev/service"
+
+type (
+	// KnativeService represents a Knative Service.
+	KnativeService struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Traffic []KnativeTraffic `json:"traffic,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			URL                       string             `json:"url,omitempty"`
+			LatestReadyRevisionName   string             `json:"latestReadyRevisionName,omitempty"`
+			LatestCreatedRevisionName string             `json:"latestCreatedRevisionName,omitempty"`
+			Traffic                   []KnativeTraffic   `json:"traffic,omitempty"`
+			Conditions                []KnativeCondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// KnativeRevision represents a Knative Revision.
+	KnativeRevision struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Status            struct {
+			ActualReplicas  *int32             `json:"actualReplicas,omitempty"`
+			DesiredReplicas *int32             `json:"desiredReplicas,omitempty"`
+			Conditions      []KnativeCondition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// KnativeTraffic represents a traffic target.
+	KnativeTraffic struct {
+		RevisionName   string `json:"revisionName,omitempty"`
+		LatestRevision *bool  `json:"latestRevision,omitempty"`
+		Percent        *int64 `json:"percent,omitempty"`
+		Tag            string `json:"tag,omitempty"`
+	}
+
+	// KnativeCondition represents a Knative resource condition.
+	KnativeCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// KnativeReady returns the Ready condition if any.
+func KnativeReady(cc []KnativeCondition) *KnativeCondition {
+	for i := range cc {
+		if cc[i].Type == "Ready" {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+// ShiftTraffic routes a percentage of traffic to a given revision, scaling
+// the other targets down proportionally. The results are spec ready targets.
+func ShiftTraffic(tt []KnativeTraffic, rev string, percent int64) []KnativeTraffic {
+	others := make([]KnativeTraffic, 0, len(tt))
+	var rest int64
+	for _, t := range tt {
+		if t.isLatest() {
+			t.RevisionName = ""
+		} else if t.RevisionName == rev {
+			continue
+		}
+		if t.Percent != nil {
+			rest += *t.Percent
+		}
+		others = append(others, t)
+	}
+	if rest == 0 {
+		percent = 100
+	}
+
+	res := make([]KnativeTraffic, 0, len(others)+1)
+	res = append(res, KnativeTraffic{RevisionName: rev, Percent: int64Ptr(percent)})
+	left, maxIdx := 100-percent, -1
+	for _, t := range others {
+		var p int64
+		if t.Percent != nil && rest > 0 {
+			p = *t.Percent * (100 - percent) / rest
+		}
+		if p == 0 && t.Tag == "" {
+			continue
+		}
+		left -= p
+		t.Percent = int64Ptr(p)
+		res = append(res, t)
+		if maxIdx < 0 || p > *res[maxIdx].Percent {
+			maxIdx = len(res) - 1
+		}
+	}
+	if left > 0 {
+		if maxIdx < 0 {
+			maxIdx = 0
+		}
+		*res[maxIdx].Percent += left
+	}
+
+	return res
+}
+
+func (t KnativeTraffic) isLatest() bool {
+	return t.LatestRevision != nil && *t.LatestRevision
+}
+
+// RevisionWithTraffic represents a Revision along with its service traffic share.
+type RevisionWithTraffic struct {
+	Raw     *unstructured.Unstructured
+	Percent *int64
+}
+
+// GetObjectKind returns a schema object.
+func (r *RevisionWithTraffic) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r *RevisionWithTraffic) DeepCopyObject() runtime.Object {
+	return r
+}
+
+// KService renders a Knative Service to screen.
+type KService struct {
+	Base
+}
+
+// Header returns a header row.
+func (KService) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "URL"},
+		HeaderColumn{Name: "LATEST-READY"},
+		HeaderColumn{Name: "TRAFFIC"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (k KService) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected KnativeService, but got %T", o)
+	}
+	var svc KnativeService
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &svc)
+	if err != nil {
+		return err
+	}
+
+	ready := KnativeReady(svc.Status.Conditions)
+	r.ID = client.MetaFQN(svc.ObjectMeta)
+	r.Fields = Fields{
+		svc.Namespace,
+		svc.Name,
+		na(svc.Status.URL),
+		na(svc.Status.LatestReadyRevisionName),
+		knativeTraffic(svc.Status.Traffic),
+		knativeStatus(ready),
+		mapToStr(svc.Labels),
+		asStatus(knativeDiagnose(ready)),
+		toAge(svc.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Revision renders a Knative Revision to screen.
+type Revision struct {
+	Base
+}
+
+// Header returns a header row.
+func (Revision) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "SERVICE"},
+		HeaderColumn{Name: "TRAFFIC", Align: tview.AlignRight},
+		HeaderColumn{Name: "REPLICAS", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Revision) Render(o interface{}, ns string, r *Row) error {
+	rwt, ok := o.(*RevisionWithTraffic)
+	if !ok {
+		return fmt.Errorf("Expected RevisionWithTraffic, but got %T", o)
+	}
+	var rev KnativeRevision
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(rwt.Raw.Object, &rev)
+	if err != nil {
+		return err
+	}
+
+	traffic := "0%"
+	if rwt.Percent != nil {
+		traffic = strconv.Itoa(int(*rwt.Percent)) + "%"
+	}
+	replicas := NAValue
+	if rev.Status.ActualReplicas != nil && rev.Status.DesiredReplicas != nil {
+		replicas = strconv.Itoa(int(*rev.Status.ActualReplicas)) + "/" + strconv.Itoa(int(*rev.Status.DesiredReplicas))
+	}
+
+	ready := KnativeReady(rev.Status.Conditions)
+	r.ID = client.MetaFQN(rev.ObjectMeta)
+	r.Fields = Fields{
+		rev.Namespace,
+		rev.Name,
+		na(rev.Labels[KnativeServiceLabel]),
+		traffic,
+		replicas,
+		knativeStatus(ready),
+		mapToStr(rev.Labels),
+		asStatus(knativeDiagnose(ready)),
+		toAge(rev.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func knativeTraffic(tt []KnativeTraffic) string {
+	ss := make([]string, 0, len(tt))
+	for _, t := range tt {
+		if t.Percent == nil || *t.Percent == 0 {
+			continue
+		}
+		name := t.RevisionName
+		if t.isLatest() {
+			name += "@latest"
+		}
+		ss = append(ss, fmt.Sprintf("%s=%d%%", name, *t.Percent))
+	}
+	if len(ss) == 0 {
+		return NAValue
+	}
+	sort.Strings(ss)
+
+	return strings.Join(ss, ",")
+}
+
+func knativeStatus(c *KnativeCondition) string {
+	if c == nil {
+		return MissingValue
+	}
+
+	return c.Status
+}
+
+func knativeDiagnose(c *KnativeCondition) error {
+	if c == nil || c.Status != "False" {
+		return nil
+	}
+	if c.Message == "" {
+		return errors.New(c.Reason)
+	}
+
+	return errors.New(c.Message)
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
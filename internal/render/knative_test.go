@@ -0,0 +1,113 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKServiceRender(t *testing.T) {
+	c := render.KService{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "ksvc"), "", &r))
+	assert.Equal(t, "default/hello", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"hello",
+		"http://hello.default.example.com",
+		"hello-00002",
+		"hello-00001=20%,hello-00002@latest=80%",
+		"True",
+	}, r.Fields[:6])
+}
+
+func TestRevisionRender(t *testing.T) {
+	c := render.Revision{}
+	r := render.NewRow(9)
+	p := int64(20)
+
+	assert.NoError(t, c.Render(&render.RevisionWithTraffic{Raw: load(t, "rev"), Percent: &p}, "", &r))
+	assert.Equal(t, "default/hello-00001", r.ID)
+	assert.Equal(t, render.Fields{"default", "hello-00001", "hello", "20%", "1/1", "False"}, r.Fields[:6])
+	assert.Equal(t, "The target is not receiving traffic.", r.Fields[7])
+}
+
+func TestShiftTraffic(t *testing.T) {
+	latest, pinned := true, false
+	uu := map[string]struct {
+		tt      []render.KnativeTraffic
+		rev     string
+		percent int64
+		e       map[string]int64
+	}{
+		"canary": {
+			tt: []render.KnativeTraffic{
+				{RevisionName: "r2", LatestRevision: &latest, Percent: int64Ptr(100)},
+			},
+			rev:     "r1",
+			percent: 10,
+			e:       map[string]int64{"r1": 10, "@latest": 90},
+		},
+		"rebalance": {
+			tt: []render.KnativeTraffic{
+				{RevisionName: "r1", LatestRevision: &pinned, Percent: int64Ptr(50)},
+				{RevisionName: "r2", LatestRevision: &pinned, Percent: int64Ptr(25)},
+				{RevisionName: "r3", LatestRevision: &pinned, Percent: int64Ptr(25)},
+			},
+			rev:     "r1",
+			percent: 0,
+			e:       map[string]int64{"r1": 0, "r2": 50, "r3": 50},
+		},
+		"rounding": {
+			tt: []render.KnativeTraffic{
+				{RevisionName: "r1", Percent: int64Ptr(34)},
+				{RevisionName: "r2", Percent: int64Ptr(33)},
+				{RevisionName: "r3", Percent: int64Ptr(33)},
+			},
+			rev:     "r4",
+			percent: 50,
+			e:       map[string]int64{"r4": 50, "r1": 18, "r2": 16, "r3": 16},
+		},
+		"all": {
+			tt: []render.KnativeTraffic{
+				{RevisionName: "r1", Percent: int64Ptr(100)},
+			},
+			rev:     "r2",
+			percent: 100,
+			e:       map[string]int64{"r2": 100},
+		},
+		"sole": {
+			tt: []render.KnativeTraffic{
+				{RevisionName: "r1", Percent: int64Ptr(100)},
+			},
+			rev:     "r1",
+			percent: 30,
+			e:       map[string]int64{"r1": 100},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tt := render.ShiftTraffic(u.tt, u.rev, u.percent)
+			var total int64
+			res := make(map[string]int64, len(tt))
+			for _, t := range tt {
+				name := t.RevisionName
+				if name == "" {
+					name = "@latest"
+				}
+				res[name] = *t.Percent
+				total += *t.Percent
+			}
+			assert.Equal(t, u.e, res)
+			assert.Equal(t, int64(100), total)
+		})
+	}
+}
+
+func int64Ptr(i int64) *int64 {
+	return &i
+}
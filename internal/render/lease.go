@@ -0,0 +1,119 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	coordv1 "k8s.io/api/coordination/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	nodeLeaseNamespace     = "kube-node-lease"
+	apiserverIdentityLabel = "apiserver.kubernetes.io/identity"
+)
+
+// Lease renders a K8s Lease to screen.
+type Lease struct {
+	Base
+}
+
+// Header returns a header row.
+func (Lease) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "HOLDER"},
+		HeaderColumn{Name: "RENEWED", Time: true},
+		HeaderColumn{Name: "DURATION", Align: tview.AlignRight},
+		HeaderColumn{Name: "TRANSITIONS", Align: tview.AlignRight},
+		HeaderColumn{Name: "ACQUIRED", Time: true, Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (l Lease) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Lease, but got %T", o)
+	}
+	var lease coordv1.Lease
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &lease)
+	if err != nil {
+		return err
+	}
+
+	holder, renewed, acquired := MissingValue, UnknownValue, UnknownValue
+	leaseDuration, transitions := NAValue, "0"
+	spec := lease.Spec
+	if spec.HolderIdentity != nil && *spec.HolderIdentity != "" {
+		holder = *spec.HolderIdentity
+	}
+	if spec.RenewTime != nil {
+		renewed = duration.HumanDuration(time.Since(spec.RenewTime.Time))
+	}
+	if spec.AcquireTime != nil {
+		acquired = duration.HumanDuration(time.Since(spec.AcquireTime.Time))
+	}
+	if spec.LeaseDurationSeconds != nil {
+		leaseDuration = strconv.Itoa(int(*spec.LeaseDurationSeconds)) + "s"
+	}
+	if spec.LeaseTransitions != nil {
+		transitions = strconv.Itoa(int(*spec.LeaseTransitions))
+	}
+
+	r.ID = client.MetaFQN(lease.ObjectMeta)
+	r.Fields = Fields{
+		lease.Namespace,
+		lease.Name,
+		leaseType(&lease),
+		holder,
+		renewed,
+		leaseDuration,
+		transitions,
+		acquired,
+		mapToStr(lease.Labels),
+		asStatus(l.diagnose(&lease.Spec, time.Now())),
+		toAge(lease.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Lease) diagnose(spec *coordv1.LeaseSpec, now time.Time) error {
+	if spec.HolderIdentity == nil || *spec.HolderIdentity == "" {
+		return fmt.Errorf("no current holder")
+	}
+	if spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return nil
+	}
+	since := now.Sub(spec.RenewTime.Time)
+	if ttl := time.Duration(*spec.LeaseDurationSeconds) * time.Second; since > ttl {
+		return fmt.Errorf("stale lease not renewed for %s (duration %s)", duration.HumanDuration(since), ttl)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func leaseType(l *coordv1.Lease) string {
+	switch {
+	case l.Namespace == nodeLeaseNamespace:
+		return "node"
+	case l.Labels[apiserverIdentityLabel] != "":
+		return "apiserver"
+	default:
+		return "leader"
+	}
+}
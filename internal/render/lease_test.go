@@ -0,0 +1,57 @@
+package render_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestLeaseRender(t *testing.T) {
+	uu := map[string]struct {
+		update func(spec map[string]interface{})
+		holder string
+		valid  string
+	}{
+		"stale": {
+			holder: "cp-1_6a3c9e52-0d7b-4b8f-a1f3-2a4c8f0e6d11",
+			valid:  "stale lease not renewed for",
+		},
+		"fresh": {
+			update: func(spec map[string]interface{}) {
+				spec["renewTime"] = metav1.NewMicroTime(time.Now()).UTC().Format(metav1.RFC3339Micro)
+			},
+			holder: "cp-1_6a3c9e52-0d7b-4b8f-a1f3-2a4c8f0e6d11",
+		},
+		"released": {
+			update: func(spec map[string]interface{}) {
+				delete(spec, "holderIdentity")
+			},
+			holder: render.MissingValue,
+			valid:  "no current holder",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			o := load(t, "lease")
+			if u.update != nil {
+				u.update(o.Object["spec"].(map[string]interface{}))
+			}
+			c, r := render.Lease{}, render.NewRow(11)
+
+			assert.NoError(t, c.Render(o, "", &r))
+			assert.Equal(t, "kube-system/kube-controller-manager", r.ID)
+			assert.Equal(t, render.Fields{"kube-system", "kube-controller-manager", "leader", u.holder}, r.Fields[:4])
+			assert.Equal(t, render.Fields{"15s", "3"}, r.Fields[5:7])
+			if u.valid == "" {
+				assert.Empty(t, r.Fields[9])
+				return
+			}
+			assert.Contains(t, r.Fields[9], u.valid)
+		})
+	}
+}
@@ -0,0 +1,150 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// LonghornVolumeLabel tracks the Volume owning a Longhorn Replica.
+const LonghornVolumeLabel = "longhornvolume"
+
+// LonghornVolume represents a Longhorn Volume.
+type LonghornVolume struct {
+	metav1.ObjectMeta `json:"metadata"`
+	Spec              struct {
+		Size             string `json:"size,omitempty"`
+		NumberOfReplicas int    `json:"numberOfReplicas"`
+		Frontend         string `json:"frontend,omitempty"`
+	} `json:"spec"`
+	Status struct {
+		State            string `json:"state,omitempty"`
+		Robustness       string `json:"robustness,omitempty"`
+		CurrentNodeID    string `json:"currentNodeID,omitempty"`
+		KubernetesStatus struct {
+			PVName    string `json:"pvName,omitempty"`
+			PVCName   string `json:"pvcName,omitempty"`
+			Namespace string `json:"namespace,omitempty"`
+		} `json:"kubernetesStatus"`
+	} `json:"status"`
+}
+
+// PVC returns the claim bound to the volume if any.
+func (l LonghornVolume) PVC() string {
+	s := l.Status.KubernetesStatus
+	if s.PVCName == "" {
+		return ""
+	}
+
+	return client.FQN(s.Namespace, s.PVCName)
+}
+
+// LonghornVolumeWithReplicas represents a Longhorn Volume along with its running replicas count.
+type LonghornVolumeWithReplicas struct {
+	Raw      *unstructured.Unstructured
+	Replicas int
+}
+
+// GetObjectKind returns a schema object.
+func (l *LonghornVolumeWithReplicas) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (l *LonghornVolumeWithReplicas) DeepCopyObject() runtime.Object {
+	return l
+}
+
+// Volume renders a Longhorn Volume to screen.
+type Volume struct {
+	Base
+}
+
+// Header returns a header row.
+func (Volume) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "ROBUSTNESS"},
+		HeaderColumn{Name: "REPLICAS"},
+		HeaderColumn{Name: "SIZE"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "PVC"},
+		HeaderColumn{Name: "FRONTEND", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Volume) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw      *unstructured.Unstructured
+		replicas = -1
+	)
+	switch v := o.(type) {
+	case *LonghornVolumeWithReplicas:
+		raw, replicas = v.Raw, v.Replicas
+	case *unstructured.Unstructured:
+		raw = v
+	default:
+		return fmt.Errorf("Expected Volume, but got %T", o)
+	}
+	var v LonghornVolume
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &v)
+	if err != nil {
+		return err
+	}
+
+	rr := strconv.Itoa(v.Spec.NumberOfReplicas)
+	if replicas >= 0 {
+		rr = strconv.Itoa(replicas) + "/" + rr
+	}
+
+	r.ID = client.MetaFQN(v.ObjectMeta)
+	r.Fields = Fields{
+		v.Namespace,
+		v.Name,
+		na(v.Status.State),
+		na(v.Status.Robustness),
+		rr,
+		longhornSize(v.Spec.Size),
+		na(v.Status.CurrentNodeID),
+		na(v.PVC()),
+		na(v.Spec.Frontend),
+		mapToStr(v.Labels),
+		asStatus(longhornDiagnose(v)),
+		toAge(v.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func longhornSize(s string) string {
+	q, err := resource.ParseQuantity(s)
+	if err != nil {
+		return NAValue
+	}
+
+	return resource.NewQuantity(q.Value(), resource.BinarySI).String()
+}
+
+func longhornDiagnose(v LonghornVolume) error {
+	switch v.Status.Robustness {
+	case "degraded", "faulted":
+		return fmt.Errorf("volume is %s", v.Status.Robustness)
+	}
+
+	return nil
+}
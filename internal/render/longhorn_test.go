@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVolumeRender(t *testing.T) {
+	uu := map[string]struct {
+		o        interface{}
+		replicas string
+	}{
+		"plain":    {o: load(t, "lhvolume"), replicas: "3"},
+		"replicas": {o: &render.LonghornVolumeWithReplicas{Raw: load(t, "lhvolume"), Replicas: 2}, replicas: "2/3"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.Volume{}
+			r := render.NewRow(12)
+
+			assert.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, "longhorn-system/pvc-0a1b2c3d", r.ID)
+			assert.Equal(t, render.Fields{"longhorn-system", "pvc-0a1b2c3d", "attached", "degraded", u.replicas, "10Gi", "worker-1", "db/data-pg-1", "blockdev"}, r.Fields[:9])
+			assert.Equal(t, "volume is degraded", r.Fields[10])
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ManagedField renders an object managed field to screen.
+type ManagedField struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ManagedField) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		c := tcell.ColorCadetBlue
+		if col := h.IndexOf("CONFLICTS", true); col >= 0 && strings.TrimSpace(re.Row.Fields[col]) != "" {
+			c = ErrColor
+		}
+		return c
+	}
+}
+
+// Header returns a header row.
+func (ManagedField) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "FIELD"},
+		HeaderColumn{Name: "MANAGER"},
+		HeaderColumn{Name: "OPERATION"},
+		HeaderColumn{Name: "SUBRESOURCE", Wide: true},
+		HeaderColumn{Name: "API-VERSION", Wide: true},
+		HeaderColumn{Name: "CONFLICTS"},
+		HeaderColumn{Name: "UPDATED", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ManagedField) Render(o interface{}, ns string, r *Row) error {
+	f, ok := o.(ManagedFieldRes)
+	if !ok {
+		return fmt.Errorf("expected ManagedFieldRes, but got %T", o)
+	}
+
+	r.ID = f.Field + "@" + f.Manager + "/" + f.Operation + "/" + f.Subresource
+	r.Fields = append(r.Fields,
+		f.Field,
+		f.Manager,
+		f.Operation,
+		f.Subresource,
+		f.APIVersion,
+		strings.Join(f.Conflicts, ","),
+		toAge(f.Updated),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ManagedFieldRes represents a field owned by a given manager.
+type ManagedFieldRes struct {
+	Field       string
+	Manager     string
+	Operation   string
+	Subresource string
+	APIVersion  string
+	Conflicts   []string
+	Updated     metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (ManagedFieldRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (f ManagedFieldRes) DeepCopyObject() runtime.Object {
+	return f
+}
@@ -0,0 +1,154 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	v1 "k8s.io/api/core/v1"
+)
+
+const (
+	// IstioMesh represents the Istio service mesh.
+	IstioMesh = "istio"
+	// LinkerdMesh represents the Linkerd service mesh.
+	LinkerdMesh = "linkerd"
+
+	istioProxy                    = "istio-proxy"
+	istioInjectionLabel           = "istio-injection"
+	istioRevLabel                 = "istio.io/rev"
+	istioInjectKey                = "sidecar.istio.io/inject"
+	linkerdProxy                  = "linkerd-proxy"
+	linkerdInjectAnnotation       = "linkerd.io/inject"
+	linkerdProxyVersionAnnotation = "linkerd.io/proxy-version"
+)
+
+// PodMesh tracks the service mesh settings relevant to a pod.
+type PodMesh struct {
+	// Namespace tracks the mesh the pod namespace opts into for sidecar injection.
+	Namespace string
+	// Versions tracks the meshes control planes versions. A mesh may run several
+	// revisions while upgrading.
+	Versions map[string][]string
+}
+
+// NamespaceMesh returns the mesh a namespace opts into for sidecar injection if any.
+func NamespaceMesh(ns *v1.Namespace) string {
+	if v, ok := ns.Labels[istioInjectionLabel]; ok {
+		if v == "enabled" {
+			return IstioMesh
+		}
+		return ""
+	}
+	if _, ok := ns.Labels[istioRevLabel]; ok {
+		return IstioMesh
+	}
+	if ns.Annotations[linkerdInjectAnnotation] == "enabled" {
+		return LinkerdMesh
+	}
+
+	return ""
+}
+
+// ProxyVersion returns a mesh proxy version given its pod annotations and image.
+func ProxyVersion(mesh string, annotations map[string]string, image string) string {
+	if v, ok := annotations[linkerdProxyVersionAnnotation]; ok && mesh == LinkerdMesh {
+		return v
+	}
+
+	return ImageTag(image)
+}
+
+// PodSidecar returns the mesh proxy injected in a pod along with its version.
+func PodSidecar(po *v1.Pod) (string, string, bool) {
+	for _, cc := range [][]v1.Container{po.Spec.Containers, po.Spec.InitContainers} {
+		for _, co := range cc {
+			switch co.Name {
+			case istioProxy:
+				return IstioMesh, ProxyVersion(IstioMesh, po.Annotations, co.Image), true
+			case linkerdProxy:
+				return LinkerdMesh, ProxyVersion(LinkerdMesh, po.Annotations, co.Image), true
+			}
+		}
+	}
+
+	return "", "", false
+}
+
+// ImageTag returns a container image tag or an empty string if none.
+func ImageTag(image string) string {
+	repo := strings.SplitN(image, "@", 2)[0]
+	if i := strings.LastIndex(repo, ":"); i > strings.LastIndex(repo, "/") {
+		return repo[i+1:]
+	}
+
+	return ""
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// expectedMesh returns the mesh expected to inject a pod given its namespace
+// settings and the pod overrides.
+func expectedMesh(po *v1.Pod, nsMesh string) string {
+	if po.Spec.HostNetwork {
+		return ""
+	}
+	inject, ok := po.Labels[istioInjectKey]
+	if !ok {
+		inject, ok = po.Annotations[istioInjectKey]
+	}
+	if ok {
+		if inject == "true" {
+			return IstioMesh
+		}
+		if nsMesh == IstioMesh {
+			return ""
+		}
+	}
+	if _, ok := po.Labels[istioRevLabel]; ok {
+		return IstioMesh
+	}
+	switch po.Annotations[linkerdInjectAnnotation] {
+	case "enabled", "ingress":
+		return LinkerdMesh
+	case "disabled":
+		if nsMesh == LinkerdMesh {
+			return ""
+		}
+	}
+
+	return nsMesh
+}
+
+func asInjected(po *v1.Pod, pm PodMesh) string {
+	if mesh, version, ok := PodSidecar(po); ok {
+		return mesh + ":" + na(version)
+	}
+	if expectedMesh(po, pm.Namespace) != "" {
+		return "no"
+	}
+
+	return MissingValue
+}
+
+// checkSidecar ensures pods expecting a mesh sidecar got one running the control plane version.
+func checkSidecar(po *v1.Pod, pm PodMesh) error {
+	mesh, version, ok := PodSidecar(po)
+	if !ok {
+		if m := expectedMesh(po, pm.Namespace); m != "" {
+			return fmt.Errorf("expected %s sidecar not injected", m)
+		}
+		return nil
+	}
+	vv := pm.Versions[mesh]
+	if version == "" || len(vv) == 0 {
+		return nil
+	}
+	for _, v := range vv {
+		if v == version {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s proxy %s outdated, control plane runs %s", mesh, version, strings.Join(vv, ","))
+}
@@ -0,0 +1,59 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestNamespaceMesh(t *testing.T) {
+	uu := map[string]struct {
+		labels, annotations map[string]string
+		e                   string
+	}{
+		"none": {},
+		"istio": {
+			labels: map[string]string{"istio-injection": "enabled"},
+			e:      render.IstioMesh,
+		},
+		"istio-disabled": {
+			labels: map[string]string{"istio-injection": "disabled", "istio.io/rev": "1-18"},
+		},
+		"istio-revision": {
+			labels: map[string]string{"istio.io/rev": "1-18"},
+			e:      render.IstioMesh,
+		},
+		"linkerd": {
+			annotations: map[string]string{"linkerd.io/inject": "enabled"},
+			e:           render.LinkerdMesh,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ns := v1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "fred", Labels: u.labels, Annotations: u.annotations}}
+			assert.Equal(t, u.e, render.NamespaceMesh(&ns))
+		})
+	}
+}
+
+func TestPodSidecar(t *testing.T) {
+	po := v1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"linkerd.io/proxy-version": "stable-2.14.1"}},
+		Spec: v1.PodSpec{
+			Containers: []v1.Container{
+				{Name: "app", Image: "fred:1.0"},
+				{Name: "linkerd-proxy", Image: "cr.l5d.io/linkerd/proxy:stable-2.14.0"},
+			},
+		},
+	}
+
+	mesh, version, ok := render.PodSidecar(&po)
+	assert.True(t, ok)
+	assert.Equal(t, render.LinkerdMesh, mesh)
+	assert.Equal(t, "stable-2.14.1", version)
+}
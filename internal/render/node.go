@@ -13,10 +13,14 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/version"
 	mv1beta1 "k8s.io/metrics/pkg/apis/metrics/v1beta1"
 )
 
 const (
+	// CordonReasonAnnotation tracks why a node was cordoned.
+	CordonReasonAnnotation = "k9s.io/cordon-reason"
+
 	labelNodeRolePrefix = "node-role.kubernetes.io/"
 	nodeLabelRole       = "kubernetes.io/role"
 )
@@ -32,8 +36,12 @@ func (Node) Header(_ string) Header {
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "STATUS"},
 		HeaderColumn{Name: "ROLE"},
+		HeaderColumn{Name: "REASON"},
 		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "OS"},
 		HeaderColumn{Name: "KERNEL", Wide: true},
+		HeaderColumn{Name: "OS-IMAGE", Wide: true},
+		HeaderColumn{Name: "RUNTIME", Wide: true},
 		HeaderColumn{Name: "INTERNAL-IP", Wide: true},
 		HeaderColumn{Name: "EXTERNAL-IP", Wide: true},
 		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
@@ -82,8 +90,12 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		no.Name,
 		join(statuses, ","),
 		join(roles, ","),
+		cordonReason(&no),
 		no.Status.NodeInfo.KubeletVersion,
+		check(NodeOS(&no), NAValue),
 		no.Status.NodeInfo.KernelVersion,
+		check(no.Status.NodeInfo.OSImage, NAValue),
+		check(no.Status.NodeInfo.ContainerRuntimeVersion, NAValue),
 		iIP,
 		eIP,
 		strconv.Itoa(oo.PodCount),
@@ -94,14 +106,14 @@ func (n Node) Render(o interface{}, ns string, r *Row) error {
 		toMc(a.cpu),
 		toMi(a.mem),
 		mapToStr(no.Labels),
-		asStatus(n.diagnose(statuses)),
+		asStatus(n.diagnose(statuses, no.Status.NodeInfo.KubeletVersion, oo.ServerMinor)),
 		toAge(no.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (Node) diagnose(ss []string) error {
+func (Node) diagnose(ss []string, kubelet string, minor int) error {
 	if len(ss) == 0 {
 		return nil
 	}
@@ -123,6 +135,52 @@ func (Node) diagnose(ss []string) error {
 		return errors.New("node is not ready")
 	}
 
+	return kubeletSkew(kubelet, minor)
+}
+
+// NodeOS returns the operating system a node runs.
+func NodeOS(no *v1.Node) string {
+	if os, ok := no.Labels[v1.LabelOSStable]; ok {
+		return os
+	}
+
+	return no.Status.NodeInfo.OperatingSystem
+}
+
+// cordonReason returns why a node was cordoned if it is still unschedulable.
+func cordonReason(no *v1.Node) string {
+	if !no.Spec.Unschedulable {
+		return ""
+	}
+
+	return no.Annotations[CordonReasonAnnotation]
+}
+
+// MaxKubeletSkew returns the supported kubelet minor version lag for a given api server.
+func MaxKubeletSkew(minor int) int {
+	if minor >= 28 {
+		return 3
+	}
+
+	return 2
+}
+
+// kubeletSkew checks a kubelet version against the api server version skew policy.
+func kubeletSkew(kubelet string, minor int) error {
+	if minor == 0 {
+		return nil
+	}
+	v, err := version.ParseGeneric(kubelet)
+	if err != nil {
+		return nil
+	}
+	switch km := int(v.Minor()); {
+	case km > minor:
+		return fmt.Errorf("kubelet %s is newer than the api server 1.%d", kubelet, minor)
+	case minor-km > MaxKubeletSkew(minor):
+		return fmt.Errorf("kubelet %s is %d minors behind the api server 1.%d (max %d)", kubelet, minor-km, minor, MaxKubeletSkew(minor))
+	}
+
 	return nil
 }
 
@@ -131,9 +189,10 @@ func (Node) diagnose(ss []string) error {
 
 // NodeWithMetrics represents a node with its associated metrics.
 type NodeWithMetrics struct {
-	Raw      *unstructured.Unstructured
-	MX       *mv1beta1.NodeMetrics
-	PodCount int
+	Raw         *unstructured.Unstructured
+	MX          *mv1beta1.NodeMetrics
+	PodCount    int
+	ServerMinor int
 }
 
 // GetObjectKind returns a schema object.
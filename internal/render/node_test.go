@@ -16,13 +16,54 @@ func TestNodeRender(t *testing.T) {
 	}
 
 	var no render.Node
-	r := render.NewRow(14)
+	r := render.NewRow(17)
 	err := no.Render(&pom, "", &r)
 	assert.Nil(t, err)
 
 	assert.Equal(t, "minikube", r.ID)
-	e := render.Fields{"minikube", "Ready", "master", "v1.15.2", "4.15.0", "192.168.64.107", "<none>", "0", "10", "20", "0", "0", "4000", "7874"}
-	assert.Equal(t, e, r.Fields[:14])
+	e := render.Fields{"minikube", "Ready", "master", "", "v1.15.2", "linux", "4.15.0", "Buildroot 2018.05.3", "docker://18.9.8", "192.168.64.107", "<none>", "0", "10", "20", "0", "0", "4000", "7874"}
+	assert.Equal(t, e, r.Fields[:18])
+	assert.Equal(t, "", r.Fields[19])
+}
+
+func TestNodeRenderCordonReason(t *testing.T) {
+	raw := load(t, "no")
+	raw.SetAnnotations(map[string]string{render.CordonReasonAnnotation: "disk replacement"})
+
+	var no render.Node
+	r := render.NewRow(20)
+	assert.NoError(t, no.Render(&render.NodeWithMetrics{Raw: raw}, "", &r))
+	assert.Equal(t, "", r.Fields[3])
+
+	raw.Object["spec"].(map[string]interface{})["unschedulable"] = true
+	r = render.NewRow(20)
+	assert.NoError(t, no.Render(&render.NodeWithMetrics{Raw: raw}, "", &r))
+	assert.Equal(t, "Ready,SchedulingDisabled", r.Fields[1])
+	assert.Equal(t, "disk replacement", r.Fields[3])
+}
+
+func TestNodeRenderSkew(t *testing.T) {
+	uu := map[string]struct {
+		minor int
+		e     string
+	}{
+		"unknown":   {},
+		"same":      {minor: 15},
+		"supported": {minor: 17},
+		"behind":    {minor: 18, e: "kubelet v1.15.2 is 3 minors behind the api server 1.18 (max 2)"},
+		"newer":     {minor: 14, e: "kubelet v1.15.2 is newer than the api server 1.14"},
+		"extended":  {minor: 28, e: "kubelet v1.15.2 is 13 minors behind the api server 1.28 (max 3)"},
+	}
+
+	var no render.Node
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			r := render.NewRow(20)
+			assert.NoError(t, no.Render(&render.NodeWithMetrics{Raw: load(t, "no"), ServerMinor: u.minor}, "", &r))
+			assert.Equal(t, u.e, r.Fields[19])
+		})
+	}
 }
 
 func BenchmarkNodeRender(b *testing.B) {
@@ -31,7 +72,7 @@ func BenchmarkNodeRender(b *testing.B) {
 		MX:  makeNodeMX("n1", "10m", "10Mi"),
 	}
 	var no render.Node
-	r := render.NewRow(14)
+	r := render.NewRow(17)
 	b.ResetTimer()
 	b.ReportAllocs()
 	for i := 0; i < b.N; i++ {
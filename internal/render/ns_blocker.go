@@ -0,0 +1,63 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// NamespaceBlocker renders a resource blocking a namespace deletion to screen.
+type NamespaceBlocker struct {
+	Base
+}
+
+// Header returns a header row.
+func (NamespaceBlocker) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "REASON"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (NamespaceBlocker) Render(o interface{}, ns string, r *Row) error {
+	b, ok := o.(NamespaceBlockerRes)
+	if !ok {
+		return fmt.Errorf("expected NamespaceBlockerRes, but got %T", o)
+	}
+
+	r.ID = b.GVR + ":" + client.FQN(b.Namespace, b.Name) + ":" + b.Reason
+	r.Fields = append(r.Fields,
+		b.Namespace,
+		b.Name,
+		b.GVR,
+		b.Reason,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// NamespaceBlockerRes represents a resource blocking a namespace deletion.
+type NamespaceBlockerRes struct {
+	Namespace string
+	Name      string
+	GVR       string
+	Reason    string
+}
+
+// GetObjectKind returns a schema object.
+func (NamespaceBlockerRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (n NamespaceBlockerRes) DeepCopyObject() runtime.Object {
+	return n
+}
@@ -0,0 +1,287 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// OLMRequiresApproval tracks an InstallPlan awaiting manual approval.
+const OLMRequiresApproval = "RequiresApproval"
+
+type (
+	// OLMSubscription represents an OLM Subscription.
+	OLMSubscription struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Package             string `json:"name"`
+			Channel             string `json:"channel,omitempty"`
+			CatalogSource       string `json:"source"`
+			InstallPlanApproval string `json:"installPlanApproval,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			State          string             `json:"state,omitempty"`
+			CurrentCSV     string             `json:"currentCSV,omitempty"`
+			InstalledCSV   string             `json:"installedCSV,omitempty"`
+			InstallPlanRef *OLMRef            `json:"installPlanRef,omitempty"`
+			Conditions     []metav1.Condition `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// OLMCSV represents an OLM ClusterServiceVersion.
+	OLMCSV struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			DisplayName string `json:"displayName,omitempty"`
+			Version     string `json:"version,omitempty"`
+			Replaces    string `json:"replaces,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			Phase   string `json:"phase,omitempty"`
+			Reason  string `json:"reason,omitempty"`
+			Message string `json:"message,omitempty"`
+		} `json:"status"`
+	}
+
+	// OLMInstallPlan represents an OLM InstallPlan.
+	OLMInstallPlan struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Approval string   `json:"approval"`
+			Approved bool     `json:"approved"`
+			CSVNames []string `json:"clusterServiceVersionNames"`
+		} `json:"spec"`
+		Status struct {
+			Phase      string `json:"phase,omitempty"`
+			Message    string `json:"message,omitempty"`
+			Conditions []struct {
+				Type    string `json:"type"`
+				Status  string `json:"status"`
+				Reason  string `json:"reason,omitempty"`
+				Message string `json:"message,omitempty"`
+			} `json:"conditions,omitempty"`
+		} `json:"status"`
+	}
+
+	// OLMRef represents an OLM object reference.
+	OLMRef struct {
+		Name      string `json:"name"`
+		Namespace string `json:"namespace,omitempty"`
+	}
+)
+
+// UpgradeAvailable checks if a newer CSV is available but not yet installed.
+func (s OLMSubscription) UpgradeAvailable() bool {
+	return s.Status.CurrentCSV != "" && s.Status.InstalledCSV != "" && s.Status.CurrentCSV != s.Status.InstalledCSV
+}
+
+// PendingApproval checks if the install plan is awaiting a manual approval.
+func (p OLMInstallPlan) PendingApproval() bool {
+	return p.Status.Phase == OLMRequiresApproval && !p.Spec.Approved
+}
+
+// Subscription renders an OLM Subscription to screen.
+type Subscription struct {
+	Base
+}
+
+// Header returns a header row.
+func (Subscription) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PACKAGE"},
+		HeaderColumn{Name: "CHANNEL"},
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "APPROVAL"},
+		HeaderColumn{Name: "INSTALLED"},
+		HeaderColumn{Name: "UPGRADE"},
+		HeaderColumn{Name: "STATE"},
+		HeaderColumn{Name: "INSTALLPLAN", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Subscription) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Subscription, but got %T", o)
+	}
+	var s OLMSubscription
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &s)
+	if err != nil {
+		return err
+	}
+
+	upgrade := NAValue
+	if s.UpgradeAvailable() {
+		upgrade = s.Status.CurrentCSV
+	}
+	approval := s.Spec.InstallPlanApproval
+	if approval == "" {
+		approval = "Automatic"
+	}
+	plan := NAValue
+	if s.Status.InstallPlanRef != nil {
+		plan = s.Status.InstallPlanRef.Name
+	}
+
+	r.ID = client.MetaFQN(s.ObjectMeta)
+	r.Fields = Fields{
+		s.Namespace,
+		s.Name,
+		s.Spec.Package,
+		na(s.Spec.Channel),
+		s.Spec.CatalogSource,
+		approval,
+		na(s.Status.InstalledCSV),
+		upgrade,
+		na(s.Status.State),
+		plan,
+		mapToStr(s.Labels),
+		asStatus(olmSubscriptionDiagnose(s)),
+		toAge(s.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// CSV renders an OLM ClusterServiceVersion to screen.
+type CSV struct {
+	Base
+}
+
+// Header returns a header row.
+func (CSV) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "DISPLAY"},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "REPLACES"},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "REASON", Wide: true},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (CSV) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected ClusterServiceVersion, but got %T", o)
+	}
+	var c OLMCSV
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &c)
+	if err != nil {
+		return err
+	}
+
+	var valid error
+	if c.Status.Phase == "Failed" {
+		valid = errors.New(na(c.Status.Message))
+	}
+
+	r.ID = client.MetaFQN(c.ObjectMeta)
+	r.Fields = Fields{
+		c.Namespace,
+		c.Name,
+		na(c.Spec.DisplayName),
+		na(c.Spec.Version),
+		na(c.Spec.Replaces),
+		na(c.Status.Phase),
+		na(c.Status.Reason),
+		mapToStr(c.Labels),
+		asStatus(valid),
+		toAge(c.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// InstallPlan renders an OLM InstallPlan to screen.
+type InstallPlan struct {
+	Base
+}
+
+// Header returns a header row.
+func (InstallPlan) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CSV"},
+		HeaderColumn{Name: "APPROVAL"},
+		HeaderColumn{Name: "APPROVED"},
+		HeaderColumn{Name: "PHASE"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (InstallPlan) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected InstallPlan, but got %T", o)
+	}
+	var p OLMInstallPlan
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &p)
+	if err != nil {
+		return err
+	}
+
+	var valid error
+	if p.Status.Phase == "Failed" {
+		msg := p.Status.Message
+		for _, c := range p.Status.Conditions {
+			if c.Status == string(metav1.ConditionFalse) && c.Message != "" {
+				msg = c.Message
+			}
+		}
+		valid = errors.New(na(msg))
+	}
+
+	r.ID = client.MetaFQN(p.ObjectMeta)
+	r.Fields = Fields{
+		p.Namespace,
+		p.Name,
+		naStrings(p.Spec.CSVNames),
+		p.Spec.Approval,
+		boolToStr(p.Spec.Approved),
+		na(p.Status.Phase),
+		mapToStr(p.Labels),
+		asStatus(valid),
+		toAge(p.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func olmSubscriptionDiagnose(s OLMSubscription) error {
+	var errs []string
+	for _, c := range s.Status.Conditions {
+		if c.Status != metav1.ConditionTrue || !strings.HasSuffix(c.Type, "Unhealthy") && !strings.HasSuffix(c.Type, "Failed") && !strings.HasSuffix(c.Type, "Missing") {
+			continue
+		}
+		errs = append(errs, na(c.Message))
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, ", "))
+}
@@ -0,0 +1,73 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestSubscriptionRender(t *testing.T) {
+	c := render.Subscription{}
+	r := render.NewRow(13)
+
+	assert.NoError(t, c.Render(load(t, "sub"), "", &r))
+	assert.Equal(t, "operators/etcd", r.ID)
+	assert.Equal(t, render.Fields{"operators", "etcd", "etcd", "singlenamespace-alpha", "operatorhubio-catalog", "Manual", "etcdoperator.v0.9.2", "etcdoperator.v0.9.4", "UpgradePending", "install-abcde"}, r.Fields[:10])
+	assert.Equal(t, "", r.Fields[11])
+}
+
+func TestCSVRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "etcdoperator.v0.9.2",
+			"namespace":         "operators",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"displayName": "etcd",
+			"version":     "0.9.2",
+		},
+		"status": map[string]interface{}{
+			"phase":   "Failed",
+			"reason":  "InstallCheckFailed",
+			"message": "install timeout",
+		},
+	}}
+	c := render.CSV{}
+	r := render.NewRow(10)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"operators", "etcdoperator.v0.9.2", "etcd", "0.9.2", "n/a", "Failed", "InstallCheckFailed"}, r.Fields[:7])
+	assert.Equal(t, "install timeout", r.Fields[8])
+}
+
+func TestInstallPlanRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "install-abcde",
+			"namespace":         "operators",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"approval":                   "Manual",
+			"approved":                   false,
+			"clusterServiceVersionNames": []interface{}{"etcdoperator.v0.9.4"},
+		},
+		"status": map[string]interface{}{
+			"phase": "RequiresApproval",
+		},
+	}}
+	c := render.InstallPlan{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"operators", "install-abcde", "etcdoperator.v0.9.4", "Manual", "false", "RequiresApproval"}, r.Fields[:6])
+
+	var p render.OLMInstallPlan
+	p.Spec.Approved, p.Status.Phase = false, render.OLMRequiresApproval
+	assert.True(t, p.PendingApproval())
+	p.Spec.Approved = true
+	assert.False(t, p.PendingApproval())
+}
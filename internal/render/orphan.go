@@ -0,0 +1,67 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Orphan renders an orphaned resource to screen.
+type Orphan struct {
+	Base
+}
+
+// Header returns a header row.
+func (Orphan) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "REASON"},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Orphan) Render(o interface{}, ns string, r *Row) error {
+	orphan, ok := o.(OrphanRes)
+	if !ok {
+		return fmt.Errorf("expected OrphanRes, but got %T", o)
+	}
+
+	r.ID = orphan.GVR + ":" + client.FQN(orphan.Namespace, orphan.Name)
+	r.Fields = append(r.Fields,
+		orphan.Namespace,
+		orphan.Name,
+		orphan.GVR,
+		orphan.Reason,
+		toAge(orphan.Created),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OrphanRes represents an orphaned resource.
+type OrphanRes struct {
+	Namespace string
+	Name      string
+	GVR       string
+	Reason    string
+	Created   metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (OrphanRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (o OrphanRes) DeepCopyObject() runtime.Object {
+	return o
+}
@@ -80,6 +80,9 @@ func (Pod) Header(ns string) Header {
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "NOMINATED NODE", Wide: true},
 		HeaderColumn{Name: "READINESS GATES", Wide: true},
+		HeaderColumn{Name: "PROBES", Wide: true},
+		HeaderColumn{Name: "INIT", Wide: true},
+		HeaderColumn{Name: "INJECTED", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
@@ -101,12 +104,17 @@ func (p Pod) Render(o interface{}, ns string, row *Row) error {
 
 	c, r := p.gatherPodMX(&po, pwm.MX)
 	phase := p.Phase(&po)
+	ready, initCo := strconv.Itoa(cr)+"/"+strconv.Itoa(len(ss)), MissingValue
+	if done, co, ok := initProgress(&po, phase); ok {
+		ready = "Init " + strconv.Itoa(done) + "/" + strconv.Itoa(len(po.Spec.InitContainers))
+		initCo = na(co)
+	}
 	row.ID = client.MetaFQN(po.ObjectMeta)
 	row.Fields = Fields{
 		po.Namespace,
 		po.ObjectMeta.Name,
 		"●",
-		strconv.Itoa(cr) + "/" + strconv.Itoa(len(ss)),
+		ready,
 		strconv.Itoa(rc),
 		phase,
 		toMc(c.cpu),
@@ -117,23 +125,38 @@ func (p Pod) Render(o interface{}, ns string, row *Row) error {
 		client.ToPercentageStr(c.cpu, r.lcpu),
 		client.ToPercentageStr(c.mem, r.mem),
 		client.ToPercentageStr(c.mem, r.lmem),
-		na(po.Status.PodIP),
+		na(podIPs(&po)),
 		na(po.Spec.NodeName),
 		p.mapQOS(po.Status.QOSClass),
 		mapToStr(po.Labels),
-		asStatus(p.diagnose(phase, cr, len(ss))),
+		asStatus(p.diagnose(&po, phase, cr, len(ss), pwm)),
 		asNominated(po.Status.NominatedNodeName),
 		asReadinessGate(po),
+		asProbes(po.Spec, pwm.ProbeFailure),
+		initCo,
+		asInjected(&po, pwm.Mesh),
 		toAge(po.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (p Pod) diagnose(phase string, cr, ct int) error {
+func (p Pod) diagnose(po *v1.Pod, phase string, cr, ct int, pwm *PodWithMetrics) error {
 	if phase == Completed {
 		return nil
 	}
+	if len(pwm.MissingRefs) > 0 {
+		return fmt.Errorf("missing %s", strings.Join(pwm.MissingRefs, ", "))
+	}
+	if err := checkPodOS(po, pwm.NodeOS); err != nil {
+		return err
+	}
+	if err := checkSidecar(po, pwm.Mesh); err != nil {
+		return err
+	}
+	if (cr != ct || ct == 0) && pwm.ProbeFailure != "" {
+		return fmt.Errorf("container ready check failed: %d of %d (%s)", cr, ct, probeError(pwm.ProbeFailure))
+	}
 	if cr != ct || ct == 0 {
 		return fmt.Errorf("container ready check failed: %d of %d", cr, ct)
 	}
@@ -141,9 +164,33 @@ func (p Pod) diagnose(phase string, cr, ct int) error {
 	return nil
 }
 
+// osBetaLabel tracks the deprecated node os label.
+const osBetaLabel = "beta.kubernetes.io/os"
+
+// PodOS returns the operating system a pod requires or blank if unspecified.
+func PodOS(po *v1.Pod) string {
+	if po.Spec.OS != nil {
+		return string(po.Spec.OS.Name)
+	}
+	if os, ok := po.Spec.NodeSelector[v1.LabelOSStable]; ok {
+		return os
+	}
+
+	return po.Spec.NodeSelector[osBetaLabel]
+}
+
 // ----------------------------------------------------------------------------
 // Helpers...
 
+// checkPodOS ensures a pod landed on a node running its required operating system.
+func checkPodOS(po *v1.Pod, nodeOS string) error {
+	if os := PodOS(po); os != "" && nodeOS != "" && os != nodeOS {
+		return fmt.Errorf("%s pod scheduled on %s node %s", os, nodeOS, po.Spec.NodeName)
+	}
+
+	return nil
+}
+
 func asNominated(n string) string {
 	if n == "" {
 		return MissingValue
@@ -172,10 +219,99 @@ func asReadinessGate(pod v1.Pod) string {
 	return strconv.Itoa(trueConditions) + "/" + strconv.Itoa(len(pod.Spec.ReadinessGates))
 }
 
+// asProbes returns the most recent probe failure if any or the configured probes.
+func asProbes(spec v1.PodSpec, failure string) string {
+	if failure != "" {
+		return probeError(failure)
+	}
+
+	var ll, rr, ss []string
+	for _, co := range spec.Containers {
+		ll = appendProbe(ll, co.LivenessProbe)
+		rr = appendProbe(rr, co.ReadinessProbe)
+		ss = appendProbe(ss, co.StartupProbe)
+	}
+	pp := make([]string, 0, 3)
+	for _, p := range []struct {
+		kind string
+		hh   []string
+	}{{"L", ll}, {"R", rr}, {"S", ss}} {
+		if len(p.hh) > 0 {
+			pp = append(pp, p.kind+":"+strings.Join(p.hh, "|"))
+		}
+	}
+	if len(pp) == 0 {
+		return MissingValue
+	}
+
+	return strings.Join(pp, " ")
+}
+
+func appendProbe(hh []string, p *v1.Probe) []string {
+	if p == nil {
+		return hh
+	}
+	var h string
+	switch {
+	case p.HTTPGet != nil:
+		h = "http"
+	case p.TCPSocket != nil:
+		h = "tcp"
+	case p.GRPC != nil:
+		h = "grpc"
+	case p.Exec != nil:
+		h = "exec"
+	default:
+		return hh
+	}
+	if in(hh, h) {
+		return hh
+	}
+
+	return append(hh, h)
+}
+
+// probeError extracts the failing probe and its cause from a kubelet Unhealthy event message.
+func probeError(msg string) string {
+	kind := "probe"
+	for _, k := range []string{"Liveness", "Readiness", "Startup"} {
+		if strings.HasPrefix(msg, k+" probe") {
+			kind = strings.ToLower(k)
+			break
+		}
+	}
+
+	lmsg := strings.ToLower(msg)
+	switch {
+	case strings.Contains(lmsg, "connection refused"):
+		return kind + ": connection refused"
+	case strings.Contains(lmsg, "statuscode: "):
+		code := msg[strings.Index(lmsg, "statuscode: ")+len("statuscode: "):]
+		if ff := strings.Fields(code); len(ff) > 0 {
+			code = ff[0]
+		}
+		return kind + ": HTTP " + code
+	case strings.Contains(lmsg, "timeout"), strings.Contains(lmsg, "deadline exceeded"), strings.Contains(lmsg, "timed out"):
+		return kind + ": timeout"
+	case strings.Contains(lmsg, "no route to host"):
+		return kind + ": no route to host"
+	case strings.Contains(lmsg, "connection reset"):
+		return kind + ": connection reset"
+	default:
+		return kind + ": failed"
+	}
+}
+
 // PodWithMetrics represents a pod and its metrics.
 type PodWithMetrics struct {
-	Raw *unstructured.Unstructured
-	MX  *mv1beta1.PodMetrics
+	Raw          *unstructured.Unstructured
+	MX           *mv1beta1.PodMetrics
+	ProbeFailure string
+	MissingRefs  []string
+	// NodeOS tracks the operating system of the node the pod is bound to.
+	NodeOS string
+	// Mesh tracks the pod service mesh settings.
+	Mesh PodMesh
 }
 
 // GetObjectKind returns a schema object.
@@ -349,6 +485,33 @@ func (*Pod) initContainerPhase(st v1.PodStatus, initCount int, status string) (s
 // ----------------------------------------------------------------------------
 // Helpers..
 
+// initProgress returns the number of completed init containers and the one currently running
+// while a pod is initializing.
+func initProgress(po *v1.Pod, phase string) (int, string, bool) {
+	if !strings.HasPrefix(phase, "Init:") || po.DeletionTimestamp != nil {
+		return 0, "", false
+	}
+
+	var (
+		done int
+		co   string
+	)
+	for _, cs := range po.Status.InitContainerStatuses {
+		if cs.State.Terminated != nil && cs.State.Terminated.ExitCode == 0 {
+			done++
+			continue
+		}
+		if co == "" {
+			co = cs.Name
+		}
+	}
+	if co == "" && done < len(po.Spec.InitContainers) {
+		co = po.Spec.InitContainers[done].Name
+	}
+
+	return done, co, true
+}
+
 func checkContainerStatus(cs v1.ContainerStatus, i, initCount int) string {
 	switch {
 	case cs.State.Terminated != nil:
@@ -163,6 +163,165 @@ func TestPodRender(t *testing.T) {
 	assert.Equal(t, e, r.Fields[:17])
 }
 
+func TestPodProbesRender(t *testing.T) {
+	uu := map[string]struct {
+		failure string
+		e       string
+	}{
+		"none": {
+			e: render.MissingValue,
+		},
+		"refused": {
+			failure: `Readiness probe failed: Get "http://172.17.0.6:8080/ready": dial tcp 172.17.0.6:8080: connect: connection refused`,
+			e:       "readiness: connection refused",
+		},
+		"status": {
+			failure: "Liveness probe failed: HTTP probe failed with statuscode: 503",
+			e:       "liveness: HTTP 503",
+		},
+		"timeout": {
+			failure: `Startup probe failed: Get "http://172.17.0.6:8080/": context deadline exceeded (Client.Timeout exceeded while awaiting headers)`,
+			e:       "startup: timeout",
+		},
+	}
+
+	var po render.Pod
+	h := po.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			pom := render.PodWithMetrics{Raw: load(t, "po"), ProbeFailure: u.failure}
+			r := render.NewRow(len(h))
+			assert.Nil(t, po.Render(&pom, "", &r))
+			assert.Equal(t, u.e, r.Fields[h.IndexOf("PROBES", true)])
+		})
+	}
+}
+
+func TestPodMissingRefsRender(t *testing.T) {
+	pom := render.PodWithMetrics{
+		Raw:         load(t, "po"),
+		MissingRefs: []string{"configmap/fred", "secret/blee"},
+	}
+
+	var po render.Pod
+	h := po.Header("")
+	r := render.NewRow(len(h))
+	assert.Nil(t, po.Render(&pom, "", &r))
+	assert.Equal(t, "missing configmap/fred, secret/blee", r.Fields[h.IndexOf("VALID", true)])
+}
+
+func TestPodOSRender(t *testing.T) {
+	uu := map[string]struct {
+		selector map[string]string
+		nodeOS   string
+		e        string
+	}{
+		"unconstrained": {
+			nodeOS: "windows",
+		},
+		"unknown-node": {
+			selector: map[string]string{"kubernetes.io/os": "linux"},
+		},
+		"match": {
+			selector: map[string]string{"kubernetes.io/os": "windows"},
+			nodeOS:   "windows",
+		},
+		"linux-on-windows": {
+			selector: map[string]string{"kubernetes.io/os": "linux"},
+			nodeOS:   "windows",
+			e:        "linux pod scheduled on windows node minikube",
+		},
+		"windows-on-linux": {
+			selector: map[string]string{"beta.kubernetes.io/os": "windows"},
+			nodeOS:   "linux",
+			e:        "windows pod scheduled on linux node minikube",
+		},
+	}
+
+	var po render.Pod
+	h := po.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw := load(t, "po")
+			if u.selector != nil {
+				sel := make(map[string]interface{}, len(u.selector))
+				for k, v := range u.selector {
+					sel[k] = v
+				}
+				raw.Object["spec"].(map[string]interface{})["nodeSelector"] = sel
+			}
+			pom := render.PodWithMetrics{Raw: raw, NodeOS: u.nodeOS}
+			r := render.NewRow(len(h))
+			assert.Nil(t, po.Render(&pom, "", &r))
+			assert.Equal(t, u.e, r.Fields[h.IndexOf("VALID", true)])
+		})
+	}
+}
+
+func TestPodSidecarRender(t *testing.T) {
+	uu := map[string]struct {
+		proxy    string
+		nsMesh   string
+		versions []string
+		injected string
+		e        string
+	}{
+		"no-mesh": {
+			injected: render.MissingValue,
+		},
+		"missing": {
+			nsMesh:   "istio",
+			injected: "no",
+			e:        "expected istio sidecar not injected",
+		},
+		"injected": {
+			proxy:    "docker.io/istio/proxyv2:1.18.2",
+			nsMesh:   "istio",
+			versions: []string{"1.18.2"},
+			injected: "istio:1.18.2",
+		},
+		"canary": {
+			proxy:    "docker.io/istio/proxyv2:1.17.1",
+			nsMesh:   "istio",
+			versions: []string{"1.17.1", "1.18.2"},
+			injected: "istio:1.17.1",
+		},
+		"outdated": {
+			proxy:    "docker.io/istio/proxyv2:1.17.1",
+			nsMesh:   "istio",
+			versions: []string{"1.18.2"},
+			injected: "istio:1.17.1",
+			e:        "istio proxy 1.17.1 outdated, control plane runs 1.18.2",
+		},
+	}
+
+	var po render.Pod
+	h := po.Header("")
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			raw := load(t, "po")
+			if u.proxy != "" {
+				spec := raw.Object["spec"].(map[string]interface{})
+				spec["containers"] = append(spec["containers"].([]interface{}), map[string]interface{}{
+					"name":  "istio-proxy",
+					"image": u.proxy,
+				})
+			}
+			pom := render.PodWithMetrics{
+				Raw:  raw,
+				Mesh: render.PodMesh{Namespace: u.nsMesh, Versions: map[string][]string{"istio": u.versions}},
+			}
+			r := render.NewRow(len(h))
+			assert.Nil(t, po.Render(&pom, "", &r))
+			assert.Equal(t, u.injected, r.Fields[h.IndexOf("INJECTED", true)])
+			assert.Equal(t, u.e, r.Fields[h.IndexOf("VALID", true)])
+		})
+	}
+}
+
 func BenchmarkPodRender(b *testing.B) {
 	pom := render.PodWithMetrics{
 		Raw: load(b, "po"),
@@ -190,8 +349,9 @@ func TestPodInitRender(t *testing.T) {
 	assert.Nil(t, err)
 
 	assert.Equal(t, "default/nginx", r.ID)
-	e := render.Fields{"default", "nginx", "●", "1/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "172.17.0.6", "minikube", "BE"}
+	e := render.Fields{"default", "nginx", "●", "Init 0/1", "0", "Init:0/1", "10", "10", "100:0", "70:170", "10", "n/a", "14", "5", "172.17.0.6", "minikube", "BE"}
 	assert.Equal(t, e, r.Fields[:17])
+	assert.Equal(t, "ic1", r.Fields[po.Header("").IndexOf("INIT", true)])
 }
 
 // ----------------------------------------------------------------------------
@@ -0,0 +1,97 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PodVolume renders a pod volume mount to screen.
+type PodVolume struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (PodVolume) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if strings.TrimSpace(re.Row.Fields[h.IndexOf("CONTAINER", true)]) == "" {
+			return tcell.ColorDimGray
+		}
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (PodVolume) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "VOLUME"},
+		HeaderColumn{Name: "TYPE"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "SOURCE"},
+		HeaderColumn{Name: "PV"},
+		HeaderColumn{Name: "CONTAINER"},
+		HeaderColumn{Name: "MOUNT-PATH"},
+		HeaderColumn{Name: "SUB-PATH", Wide: true},
+		HeaderColumn{Name: "READ-ONLY"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (PodVolume) Render(o interface{}, ns string, r *Row) error {
+	v, ok := o.(PodVolumeRes)
+	if !ok {
+		return fmt.Errorf("expected PodVolumeRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(v.Namespace, v.Volume+":"+v.Container+":"+v.MountPath)
+	r.Fields = Fields{
+		v.Volume,
+		v.Type,
+		v.GVR,
+		v.Source,
+		v.PV,
+		v.Container,
+		v.MountPath,
+		v.SubPath,
+		readOnly(v),
+	}
+
+	return nil
+}
+
+func readOnly(v PodVolumeRes) string {
+	if v.Container == "" {
+		return ""
+	}
+
+	return boolToStr(v.ReadOnly)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PodVolumeRes represents a pod volume mount.
+type PodVolumeRes struct {
+	Namespace, Pod string
+	Volume, Type   string
+	// GVR and Source track the volume backing object if any.
+	GVR, Source string
+	// PV tracks the persistent volume bound to a claim backed volume.
+	PV                            string
+	Container, MountPath, SubPath string
+	ReadOnly                      bool
+}
+
+// GetObjectKind returns a schema object.
+func (PodVolumeRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (v PodVolumeRes) DeepCopyObject() runtime.Object {
+	return v
+}
@@ -0,0 +1,90 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Preemption renders pods preempted by higher priority pods to screen.
+type Preemption struct {
+	Base
+}
+
+// Header returns a header row.
+func (Preemption) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "VICTIM"},
+		HeaderColumn{Name: "PREEMPTOR"},
+		HeaderColumn{Name: "PRIORITY"},
+		HeaderColumn{Name: "NODE"},
+		HeaderColumn{Name: "COUNT", Align: tview.AlignRight},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Preemption) Render(o interface{}, ns string, r *Row) error {
+	p, ok := o.(PreemptionRes)
+	if !ok {
+		return fmt.Errorf("expected PreemptionRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(p.Namespace, p.Victim) + ":" + p.Preemptor
+	r.Fields = Fields{
+		p.Namespace,
+		p.Victim,
+		na(p.Preemptor),
+		p.priority(),
+		na(p.Node),
+		strconv.Itoa(int(p.Count)),
+		toAge(metav1.NewTime(p.Last)),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// PreemptionRes represents a pod preempted by a higher priority pod.
+type PreemptionRes struct {
+	Namespace, Victim string
+	// Preemptor tracks the preempting pod fully qualified name if known.
+	Preemptor string
+	// PriorityClass and Priority track the preemptor priority when the pod is still around.
+	PriorityClass string
+	Priority      *int32
+	Node          string
+	Count         int32
+	Last          time.Time
+}
+
+func (p PreemptionRes) priority() string {
+	if p.Priority == nil {
+		return MissingValue
+	}
+	prio := strconv.Itoa(int(*p.Priority))
+	if p.PriorityClass == "" {
+		return prio
+	}
+
+	return p.PriorityClass + "(" + prio + ")"
+}
+
+// GetObjectKind returns a schema object.
+func (PreemptionRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p PreemptionRes) DeepCopyObject() runtime.Object {
+	return p
+}
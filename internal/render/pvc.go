@@ -2,13 +2,51 @@ package render
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// PVCUsageThreshold flags volumes filled above this percentage.
+const PVCUsageThreshold = 90
+
+// VolumeUsage represents a volume filesystem usage as reported by the kubelet.
+type VolumeUsage struct {
+	Used, Capacity int64
+}
+
+// Perc returns the volume usage percentage.
+func (v VolumeUsage) Perc() int {
+	if v.Capacity <= 0 {
+		return 0
+	}
+
+	return int(v.Used * 100 / v.Capacity)
+}
+
+// PVCWithUsage represents a PVC along with its actual volume usage.
+type PVCWithUsage struct {
+	Raw   *unstructured.Unstructured
+	Usage *VolumeUsage
+	// Warning tracks the claim latest warning event i.e. provisioning failures.
+	Warning string
+}
+
+// GetObjectKind returns a schema object.
+func (p *PVCWithUsage) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PVCWithUsage) DeepCopyObject() runtime.Object {
+	return p
+}
+
 // PersistentVolumeClaim renders a K8s PersistentVolumeClaim to screen.
 type PersistentVolumeClaim struct {
 	Base
@@ -22,6 +60,8 @@ func (PersistentVolumeClaim) Header(ns string) Header {
 		HeaderColumn{Name: "STATUS"},
 		HeaderColumn{Name: "VOLUME"},
 		HeaderColumn{Name: "CAPACITY"},
+		HeaderColumn{Name: "USED", Align: tview.AlignRight},
+		HeaderColumn{Name: "%USED", Align: tview.AlignRight},
 		HeaderColumn{Name: "ACCESS MODES"},
 		HeaderColumn{Name: "STORAGECLASS"},
 		HeaderColumn{Name: "LABELS", Wide: true},
@@ -32,8 +72,17 @@ func (PersistentVolumeClaim) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw     *unstructured.Unstructured
+		usage   *VolumeUsage
+		warning string
+	)
+	switch c := o.(type) {
+	case *PVCWithUsage:
+		raw, usage, warning = c.Raw, c.Usage, c.Warning
+	case *unstructured.Unstructured:
+		raw = c
+	default:
 		return fmt.Errorf("Expected PersistentVolumeClaim, but got %T", o)
 	}
 	var pvc v1.PersistentVolumeClaim
@@ -68,19 +117,50 @@ func (p PersistentVolumeClaim) Render(o interface{}, ns string, r *Row) error {
 		string(phase),
 		pvc.Spec.VolumeName,
 		capacity,
+		asUsed(usage),
+		asUsedPerc(usage),
 		accessModes,
 		class,
 		mapToStr(pvc.Labels),
-		asStatus(p.diagnose(string(phase))),
+		asStatus(p.diagnose(string(phase), usage, warning)),
 		toAge(pvc.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (PersistentVolumeClaim) diagnose(r string) error {
+func (PersistentVolumeClaim) diagnose(r string, usage *VolumeUsage, warning string) error {
 	if r != "Bound" && r != "Available" {
+		if warning != "" {
+			return fmt.Errorf("%s: %s", r, warning)
+		}
 		return fmt.Errorf("unexpected status %s", r)
 	}
+	if usage != nil && usage.Perc() >= PVCUsageThreshold {
+		return fmt.Errorf("volume is %d%% full", usage.Perc())
+	}
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func asUsed(u *VolumeUsage) string {
+	if u == nil {
+		return NAValue
+	}
+
+	if gi := float64(u.Used) / (1 << 30); gi >= 1 {
+		return strconv.FormatFloat(gi, 'f', 1, 64) + "Gi"
+	}
+
+	return strconv.Itoa(int(client.ToMB(u.Used))) + "Mi"
+}
+
+func asUsedPerc(u *VolumeUsage) string {
+	if u == nil {
+		return NAValue
+	}
+
+	return PrintPerc(u.Perc())
+}
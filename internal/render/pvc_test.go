@@ -13,5 +13,58 @@ func TestPersistentVolumeClaimRender(t *testing.T) {
 
 	assert.NoError(t, c.Render(load(t, "pvc"), "", &r))
 	assert.Equal(t, "default/www-nginx-sts-0", r.ID)
-	assert.Equal(t, render.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", "RWO", "standard"}, r.Fields[:7])
+	assert.Equal(t, render.Fields{"default", "www-nginx-sts-0", "Bound", "pvc-fbabd470-8725-11e9-a8e8-42010a80015b", "1Gi", render.NAValue, render.NAValue, "RWO", "standard"}, r.Fields[:9])
+}
+
+func TestPersistentVolumeClaimUsageRender(t *testing.T) {
+	uu := map[string]struct {
+		usage          *render.VolumeUsage
+		used, perc, ok string
+	}{
+		"unknown": {
+			used: render.NAValue,
+			perc: render.NAValue,
+		},
+		"mi": {
+			usage: &render.VolumeUsage{Used: 256 << 20, Capacity: 1 << 30},
+			used:  "256Mi",
+			perc:  "25%",
+		},
+		"full": {
+			usage: &render.VolumeUsage{Used: 950 << 20, Capacity: 1000 << 20},
+			used:  "950Mi",
+			perc:  "95%",
+			ok:    "volume is 95% full",
+		},
+		"gi": {
+			usage: &render.VolumeUsage{Used: 3 << 29, Capacity: 10 << 30},
+			used:  "1.5Gi",
+			perc:  "15%",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.PersistentVolumeClaim{}
+			r := render.NewRow(12)
+			o := render.PVCWithUsage{Raw: load(t, "pvc"), Usage: u.usage}
+
+			assert.NoError(t, c.Render(&o, "", &r))
+			assert.Equal(t, u.used, r.Fields[5])
+			assert.Equal(t, u.perc, r.Fields[6])
+			assert.Equal(t, u.ok, r.Fields[10])
+		})
+	}
+}
+
+func TestPersistentVolumeClaimWarningRender(t *testing.T) {
+	raw := load(t, "pvc")
+	raw.Object["status"] = map[string]interface{}{"phase": "Pending"}
+	o := render.PVCWithUsage{Raw: raw, Warning: `ProvisioningFailed: storageclass.storage.k8s.io "fast" not found`}
+
+	c := render.PersistentVolumeClaim{}
+	r := render.NewRow(12)
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, `Pending: ProvisioningFailed: storageclass.storage.k8s.io "fast" not found`, r.Fields[10])
 }
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Related renders a related resource to screen.
+type Related struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Related) ColorerFunc() ColorerFunc {
+	return func(ns string, _ Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Related) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "RELATION"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Related) Render(o interface{}, ns string, r *Row) error {
+	rel, ok := o.(RelatedRes)
+	if !ok {
+		return fmt.Errorf("expected RelatedRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(rel.Namespace, rel.Name)
+	r.Fields = append(r.Fields,
+		rel.Namespace,
+		rel.Name,
+		rel.GVR,
+		rel.Relation,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// RelatedRes represents a related resource.
+type RelatedRes struct {
+	Namespace string
+	Name      string
+	GVR       string
+	Relation  string
+}
+
+// GetObjectKind returns a schema object.
+func (RelatedRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (r RelatedRes) DeepCopyObject() runtime.Object {
+	return r
+}
@@ -0,0 +1,212 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+type (
+	// ArgoRollout represents an Argo Rollout.
+	ArgoRollout struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              ArgoRolloutSpec   `json:"spec"`
+		Status            ArgoRolloutStatus `json:"status"`
+	}
+
+	// ArgoRolloutSpec represents a Rollout spec.
+	ArgoRolloutSpec struct {
+		Replicas *int32                `json:"replicas,omitempty"`
+		Selector *metav1.LabelSelector `json:"selector,omitempty"`
+		Paused   bool                  `json:"paused,omitempty"`
+		Strategy struct {
+			Canary *struct {
+				Steps []ArgoRolloutStep `json:"steps,omitempty"`
+			} `json:"canary,omitempty"`
+			BlueGreen *struct {
+				ActiveService  string `json:"activeService,omitempty"`
+				PreviewService string `json:"previewService,omitempty"`
+			} `json:"blueGreen,omitempty"`
+		} `json:"strategy"`
+	}
+
+	// ArgoRolloutStep represents a canary step.
+	ArgoRolloutStep struct {
+		SetWeight *int32 `json:"setWeight,omitempty"`
+	}
+
+	// ArgoRolloutStatus represents a Rollout status.
+	ArgoRolloutStatus struct {
+		Phase             string `json:"phase,omitempty"`
+		Message           string `json:"message,omitempty"`
+		Abort             bool   `json:"abort,omitempty"`
+		CurrentStepIndex  *int32 `json:"currentStepIndex,omitempty"`
+		Replicas          int32  `json:"replicas,omitempty"`
+		UpdatedReplicas   int32  `json:"updatedReplicas,omitempty"`
+		ReadyReplicas     int32  `json:"readyReplicas,omitempty"`
+		AvailableReplicas int32  `json:"availableReplicas,omitempty"`
+		PauseConditions   []struct {
+			Reason string `json:"reason"`
+		} `json:"pauseConditions,omitempty"`
+		Canary struct {
+			Weights *struct {
+				Canary struct {
+					Weight int32 `json:"weight"`
+				} `json:"canary"`
+			} `json:"weights,omitempty"`
+			CurrentStepAnalysisRunStatus *ArgoRolloutAnalysis `json:"currentStepAnalysisRunStatus,omitempty"`
+			CurrentBackgroundAnalysisRun *ArgoRolloutAnalysis `json:"currentBackgroundAnalysisRunStatus,omitempty"`
+		} `json:"canary"`
+	}
+
+	// ArgoRolloutAnalysis represents an analysis run status.
+	ArgoRolloutAnalysis struct {
+		Name   string `json:"name"`
+		Status string `json:"status"`
+	}
+)
+
+// Strategy returns the rollout strategy name.
+func (r *ArgoRollout) Strategy() string {
+	switch {
+	case r.Spec.Strategy.Canary != nil:
+		return "Canary"
+	case r.Spec.Strategy.BlueGreen != nil:
+		return "BlueGreen"
+	default:
+		return MissingValue
+	}
+}
+
+// Steps returns the canary steps if any.
+func (r *ArgoRollout) Steps() []ArgoRolloutStep {
+	if r.Spec.Strategy.Canary == nil {
+		return nil
+	}
+
+	return r.Spec.Strategy.Canary.Steps
+}
+
+// IsPaused checks if the rollout is waiting to be promoted.
+func (r *ArgoRollout) IsPaused() bool {
+	return r.Spec.Paused || len(r.Status.PauseConditions) > 0
+}
+
+// Weight returns the current canary traffic weight.
+func (r *ArgoRollout) Weight() (int32, bool) {
+	if r.Spec.Strategy.Canary == nil {
+		return 0, false
+	}
+	if w := r.Status.Canary.Weights; w != nil {
+		return w.Canary.Weight, true
+	}
+	steps := r.Steps()
+	if len(steps) == 0 || r.Status.CurrentStepIndex == nil {
+		return 100, true
+	}
+	var weight int32
+	for i := 0; i < len(steps) && i <= int(*r.Status.CurrentStepIndex); i++ {
+		if steps[i].SetWeight != nil {
+			weight = *steps[i].SetWeight
+		}
+	}
+	if int(*r.Status.CurrentStepIndex) >= len(steps) {
+		weight = 100
+	}
+
+	return weight, true
+}
+
+// Rollout renders an Argo Rollout to screen.
+type Rollout struct {
+	Base
+}
+
+// Header returns a header row.
+func (Rollout) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STRATEGY"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "STEP", Align: tview.AlignRight},
+		HeaderColumn{Name: "WEIGHT", Align: tview.AlignRight},
+		HeaderColumn{Name: "ANALYSIS"},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "UP-TO-DATE", Align: tview.AlignRight},
+		HeaderColumn{Name: "AVAILABLE", Align: tview.AlignRight},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ro Rollout) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Rollout, but got %T", o)
+	}
+	var rollout ArgoRollout
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rollout)
+	if err != nil {
+		return err
+	}
+
+	step := NAValue
+	if steps := rollout.Steps(); len(steps) > 0 && rollout.Status.CurrentStepIndex != nil {
+		step = strconv.Itoa(int(*rollout.Status.CurrentStepIndex)) + "/" + strconv.Itoa(len(steps))
+	}
+	weight := NAValue
+	if w, ok := rollout.Weight(); ok {
+		weight = strconv.Itoa(int(w)) + "%"
+	}
+	analysis := NAValue
+	if a := rollout.Status.Canary.CurrentStepAnalysisRunStatus; a != nil {
+		analysis = a.Status
+	} else if a := rollout.Status.Canary.CurrentBackgroundAnalysisRun; a != nil {
+		analysis = a.Status
+	}
+
+	r.ID = client.MetaFQN(rollout.ObjectMeta)
+	r.Fields = Fields{
+		rollout.Namespace,
+		rollout.Name,
+		rollout.Strategy(),
+		na(rollout.Status.Phase),
+		step,
+		weight,
+		analysis,
+		strconv.Itoa(int(rollout.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(rollout.Status.Replicas)),
+		strconv.Itoa(int(rollout.Status.UpdatedReplicas)),
+		strconv.Itoa(int(rollout.Status.AvailableReplicas)),
+		mapToStr(rollout.Labels),
+		asStatus(ro.diagnose(rollout.Status)),
+		toAge(rollout.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Rollout) diagnose(st ArgoRolloutStatus) error {
+	if st.Abort {
+		if st.Message != "" {
+			return errors.New(st.Message)
+		}
+		return errors.New("rollout aborted")
+	}
+	if st.Phase == "Degraded" {
+		return errors.New(st.Message)
+	}
+	if st.Replicas != st.AvailableReplicas {
+		return fmt.Errorf("desiring %d replicas got %d available", st.Replicas, st.AvailableReplicas)
+	}
+
+	return nil
+}
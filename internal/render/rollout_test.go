@@ -0,0 +1,64 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutRender(t *testing.T) {
+	c := render.Rollout{}
+	r := render.NewRow(13)
+
+	assert.NoError(t, c.Render(load(t, "rollout"), "", &r))
+	assert.Equal(t, "default/canary-demo", r.ID)
+	assert.Equal(t, render.Fields{
+		"default",
+		"canary-demo",
+		"Canary",
+		"Paused",
+		"1/5",
+		"20%",
+		"Running",
+		"5/5",
+		"1",
+		"5",
+	}, r.Fields[:10])
+	assert.Equal(t, "", r.Fields[11])
+}
+
+func TestRolloutWeight(t *testing.T) {
+	w20, w50 := int32(20), int32(50)
+	steps := []render.ArgoRolloutStep{{SetWeight: &w20}, {}, {SetWeight: &w50}, {}}
+
+	uu := map[string]struct {
+		idx *int32
+		e   int32
+	}{
+		"first":  {idx: int32Ptr(0), e: 20},
+		"pause":  {idx: int32Ptr(1), e: 20},
+		"second": {idx: int32Ptr(3), e: 50},
+		"done":   {idx: int32Ptr(4), e: 100},
+		"none":   {e: 100},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var ro render.ArgoRollout
+			ro.Spec.Strategy.Canary = &struct {
+				Steps []render.ArgoRolloutStep `json:"steps,omitempty"`
+			}{Steps: steps}
+			ro.Status.CurrentStepIndex = u.idx
+
+			w, ok := ro.Weight()
+			assert.True(t, ok)
+			assert.Equal(t, u.e, w)
+		})
+	}
+}
+
+func int32Ptr(i int32) *int32 {
+	return &i
+}
@@ -4,11 +4,17 @@ import (
 	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
+	v1 "k8s.io/api/core/v1"
 	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 )
 
+const (
+	defaultClassAnnotation     = "storageclass.kubernetes.io/is-default-class"
+	betaDefaultClassAnnotation = "storageclass.beta.kubernetes.io/is-default-class"
+)
+
 // StorageClass renders a K8s StorageClass to screen.
 type StorageClass struct {
 	Base
@@ -18,7 +24,12 @@ type StorageClass struct {
 func (StorageClass) Header(ns string) Header {
 	return Header{
 		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "DEFAULT"},
 		HeaderColumn{Name: "PROVISIONER"},
+		HeaderColumn{Name: "RECLAIMPOLICY"},
+		HeaderColumn{Name: "VOLUMEBINDINGMODE"},
+		HeaderColumn{Name: "ALLOWVOLUMEEXPANSION"},
+		HeaderColumn{Name: "PARAMETERS", Wide: true},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
@@ -37,10 +48,24 @@ func (StorageClass) Render(o interface{}, ns string, r *Row) error {
 		return err
 	}
 
+	reclaim := string(v1.PersistentVolumeReclaimDelete)
+	if sc.ReclaimPolicy != nil {
+		reclaim = string(*sc.ReclaimPolicy)
+	}
+	binding := string(storagev1.VolumeBindingImmediate)
+	if sc.VolumeBindingMode != nil {
+		binding = string(*sc.VolumeBindingMode)
+	}
+
 	r.ID = client.FQN(client.ClusterScope, sc.ObjectMeta.Name)
 	r.Fields = Fields{
 		sc.Name,
+		boolToStr(IsDefaultStorageClass(sc.Annotations)),
 		string(sc.Provisioner),
+		reclaim,
+		binding,
+		boolPtrToStr(sc.AllowVolumeExpansion),
+		mapToStr(sc.Parameters),
 		mapToStr(sc.Labels),
 		"",
 		toAge(sc.GetCreationTimestamp()),
@@ -48,3 +73,12 @@ func (StorageClass) Render(o interface{}, ns string, r *Row) error {
 
 	return nil
 }
+
+// IsDefaultStorageClass checks if a storage class is annotated as the cluster default.
+func IsDefaultStorageClass(aa map[string]string) bool {
+	if v, ok := aa[defaultClassAnnotation]; ok {
+		return v == "true"
+	}
+
+	return aa[betaDefaultClassAnnotation] == "true"
+}
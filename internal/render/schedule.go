@@ -0,0 +1,201 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears caps how far ahead a schedule is looked up.
+const cronSearchYears = 5
+
+var (
+	cronMacros = map[string]string{
+		"@yearly":   "0 0 1 1 *",
+		"@annually": "0 0 1 1 *",
+		"@monthly":  "0 0 1 * *",
+		"@weekly":   "0 0 * * 0",
+		"@daily":    "0 0 * * *",
+		"@midnight": "0 0 * * *",
+		"@hourly":   "0 * * * *",
+	}
+
+	cronMonths = map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}
+
+	cronDays = map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}
+)
+
+type cronBounds struct {
+	min, max int
+	names    map[string]int
+}
+
+var (
+	minuteBounds = cronBounds{0, 59, nil}
+	hourBounds   = cronBounds{0, 23, nil}
+	domBounds    = cronBounds{1, 31, nil}
+	monthBounds  = cronBounds{1, 12, cronMonths}
+	dowBounds    = cronBounds{0, 7, cronDays}
+)
+
+// cronSchedule represents a parsed standard cron schedule.
+type cronSchedule struct {
+	minute, hour, dom, month, dow uint64
+	domStar, dowStar              bool
+	loc                           *time.Location
+}
+
+// parseCronSchedule parses a cronjob schedule in a given timezone. The schedule may carry
+// a CRON_TZ or TZ prefix. Without any timezone the schedule is evaluated in UTC.
+func parseCronSchedule(spec string, tz *string) (*cronSchedule, error) {
+	spec = strings.TrimSpace(spec)
+	zone := "UTC"
+	if strings.HasPrefix(spec, "CRON_TZ=") || strings.HasPrefix(spec, "TZ=") {
+		i := strings.Index(spec, " ")
+		if i < 0 {
+			return nil, fmt.Errorf("invalid schedule %q", spec)
+		}
+		zone = spec[strings.Index(spec, "=")+1 : i]
+		spec = strings.TrimSpace(spec[i:])
+	}
+	if tz != nil && *tz != "" {
+		zone = *tz
+	}
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q", zone)
+	}
+	if m, ok := cronMacros[spec]; ok {
+		spec = m
+	}
+
+	ff := strings.Fields(spec)
+	if len(ff) != 5 {
+		return nil, fmt.Errorf("expecting 5 fields in schedule %q", spec)
+	}
+	s := cronSchedule{loc: loc}
+	for i, f := range []struct {
+		bits *uint64
+		b    cronBounds
+	}{
+		{&s.minute, minuteBounds},
+		{&s.hour, hourBounds},
+		{&s.dom, domBounds},
+		{&s.month, monthBounds},
+		{&s.dow, dowBounds},
+	} {
+		if *f.bits, err = parseCronField(ff[i], f.b); err != nil {
+			return nil, err
+		}
+	}
+	s.domStar, s.dowStar = isCronStar(ff[2]), isCronStar(ff[4])
+	if s.dow&(1<<7) != 0 {
+		s.dow |= 1
+	}
+
+	return &s, nil
+}
+
+// Next returns the first activation time strictly after t or a zero time if none.
+func (s *cronSchedule) Next(t time.Time) time.Time {
+	t = t.In(s.loc).Truncate(time.Minute).Add(time.Minute)
+	limit := t.Year() + cronSearchYears
+
+	for t.Year() <= limit {
+		if !hasBit(s.month, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !hasBit(s.hour, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if !hasBit(s.minute, t.Minute()) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+
+	return time.Time{}
+}
+
+func (s *cronSchedule) dayMatches(t time.Time) bool {
+	dom, dow := hasBit(s.dom, t.Day()), hasBit(s.dow, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return dom && dow
+	}
+
+	return dom || dow
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func hasBit(bits uint64, i int) bool {
+	return bits&(1<<uint(i)) != 0
+}
+
+func isCronStar(f string) bool {
+	return f == "*" || f == "?"
+}
+
+func parseCronField(f string, b cronBounds) (uint64, error) {
+	var bits uint64
+	for _, expr := range strings.Split(f, ",") {
+		lo, hi, step := b.min, b.max, 1
+		rng := expr
+		if i := strings.Index(expr, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(expr[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid step in %q", expr)
+			}
+			rng = expr[:i]
+		}
+		if !isCronStar(rng) {
+			var err error
+			bounds := strings.SplitN(rng, "-", 2)
+			if lo, err = cronValue(bounds[0], b); err != nil {
+				return 0, err
+			}
+			hi = lo
+			if len(bounds) == 2 {
+				if hi, err = cronValue(bounds[1], b); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				hi = b.max
+			}
+		}
+		if lo < b.min || hi > b.max || lo > hi {
+			return 0, fmt.Errorf("out of range value in %q", expr)
+		}
+		for i := lo; i <= hi; i += step {
+			bits |= 1 << uint(i)
+		}
+	}
+
+	return bits, nil
+}
+
+func cronValue(s string, b cronBounds) (int, error) {
+	if v, ok := b.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cron value %q", s)
+	}
+
+	return v, nil
+}
@@ -0,0 +1,93 @@
+package render
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCronScheduleNext(t *testing.T) {
+	ny := "America/New_York"
+	uu := map[string]struct {
+		spec string
+		tz   *string
+		from string
+		e    string
+	}{
+		"every-minute": {
+			spec: "*/1 * * * *",
+			from: "2023-01-01T10:00:30Z",
+			e:    "2023-01-01T10:01:00Z",
+		},
+		"steps": {
+			spec: "*/15 9-17 * * mon-fri",
+			from: "2023-01-06T17:50:00Z",
+			e:    "2023-01-09T09:00:00Z",
+		},
+		"dom-or-dow": {
+			spec: "0 0 13 * 5",
+			from: "2023-01-01T00:00:00Z",
+			e:    "2023-01-06T00:00:00Z",
+		},
+		"macro": {
+			spec: "@monthly",
+			from: "2023-01-31T12:00:00Z",
+			e:    "2023-02-01T00:00:00Z",
+		},
+		"leap": {
+			spec: "0 12 29 feb *",
+			from: "2023-03-01T00:00:00Z",
+			e:    "2024-02-29T12:00:00Z",
+		},
+		"sunday-7": {
+			spec: "30 4 * * 7",
+			from: "2023-01-02T00:00:00Z",
+			e:    "2023-01-08T04:30:00Z",
+		},
+		"timezone": {
+			spec: "0 9 * * *",
+			tz:   &ny,
+			from: "2023-01-01T15:00:00Z",
+			e:    "2023-01-02T14:00:00Z",
+		},
+		"prefix": {
+			spec: "CRON_TZ=America/New_York 0 9 * * *",
+			from: "2023-01-01T13:00:00Z",
+			e:    "2023-01-01T14:00:00Z",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, err := parseCronSchedule(u.spec, u.tz)
+			assert.NoError(t, err)
+			from, _ := time.Parse(time.RFC3339, u.from)
+			e, _ := time.Parse(time.RFC3339, u.e)
+			assert.True(t, e.Equal(s.Next(from)), "got %s", s.Next(from).UTC())
+		})
+	}
+}
+
+func TestCronScheduleInvalid(t *testing.T) {
+	bad := "Mars/Olympus"
+	uu := map[string]struct {
+		spec string
+		tz   *string
+	}{
+		"fields":   {spec: "* * * *"},
+		"range":    {spec: "61 * * * *"},
+		"step":     {spec: "*/0 * * * *"},
+		"name":     {spec: "0 0 * foo *"},
+		"timezone": {spec: "0 0 * * *", tz: &bad},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			_, err := parseCronSchedule(u.spec, u.tz)
+			assert.Error(t, err)
+		})
+	}
+}
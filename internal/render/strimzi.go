@@ -0,0 +1,342 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// StrimziClusterLabel tracks the Kafka cluster owning a Strimzi resource.
+	StrimziClusterLabel = "strimzi.io/cluster"
+
+	// StrimziKindLabel tracks the kind of the Strimzi resource owning a pod.
+	StrimziKindLabel = "strimzi.io/kind"
+
+	// StrimziComponentLabel tracks a Strimzi pod component type.
+	StrimziComponentLabel = "strimzi.io/component-type"
+
+	strimziReadyCondition = "Ready"
+)
+
+type (
+	// StrimziKafka represents a Strimzi Kafka cluster.
+	StrimziKafka struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Kafka struct {
+				Version  string `json:"version,omitempty"`
+				Replicas int32  `json:"replicas,omitempty"`
+			} `json:"kafka"`
+			KafkaExporter map[string]interface{} `json:"kafkaExporter,omitempty"`
+		} `json:"spec"`
+		Status StrimziStatus `json:"status"`
+	}
+
+	// StrimziTopic represents a Strimzi KafkaTopic.
+	StrimziTopic struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			TopicName  string `json:"topicName,omitempty"`
+			Partitions int32  `json:"partitions,omitempty"`
+			Replicas   int32  `json:"replicas,omitempty"`
+		} `json:"spec"`
+		Status StrimziStatus `json:"status"`
+	}
+
+	// StrimziUser represents a Strimzi KafkaUser.
+	StrimziUser struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Authentication *struct {
+				Type string `json:"type"`
+			} `json:"authentication,omitempty"`
+			Authorization *struct {
+				Type string        `json:"type"`
+				ACLs []interface{} `json:"acls,omitempty"`
+			} `json:"authorization,omitempty"`
+		} `json:"spec"`
+		Status struct {
+			StrimziStatus `json:",inline"`
+			Username      string `json:"username,omitempty"`
+			Secret        string `json:"secret,omitempty"`
+		} `json:"status"`
+	}
+
+	// StrimziStatus represents a Strimzi resource status.
+	StrimziStatus struct {
+		Conditions   []StrimziCondition `json:"conditions,omitempty"`
+		KafkaVersion string             `json:"kafkaVersion,omitempty"`
+		TopicName    string             `json:"topicName,omitempty"`
+	}
+
+	// StrimziCondition represents a Strimzi status condition.
+	StrimziCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+)
+
+// Name returns the Kafka topic name.
+func (t StrimziTopic) Name() string {
+	switch {
+	case t.Status.TopicName != "":
+		return t.Status.TopicName
+	case t.Spec.TopicName != "":
+		return t.Spec.TopicName
+	default:
+		return t.ObjectMeta.Name
+	}
+}
+
+// KafkaTopicWithLag represents a KafkaTopic along with its consumer lag.
+type KafkaTopicWithLag struct {
+	Raw *unstructured.Unstructured
+	Lag *int64
+}
+
+// GetObjectKind returns a schema object.
+func (k *KafkaTopicWithLag) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (k *KafkaTopicWithLag) DeepCopyObject() runtime.Object {
+	return k
+}
+
+// Kafka renders a Strimzi Kafka cluster to screen.
+type Kafka struct {
+	Base
+}
+
+// Header returns a header row.
+func (Kafka) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "BROKERS", Align: tview.AlignRight},
+		HeaderColumn{Name: "VERSION"},
+		HeaderColumn{Name: "EXPORTER"},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Kafka) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Kafka, but got %T", o)
+	}
+	var k StrimziKafka
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &k)
+	if err != nil {
+		return err
+	}
+
+	brokers := NAValue
+	if k.Spec.Kafka.Replicas > 0 {
+		brokers = strconv.Itoa(int(k.Spec.Kafka.Replicas))
+	}
+	version := k.Status.KafkaVersion
+	if version == "" {
+		version = k.Spec.Kafka.Version
+	}
+
+	r.ID = client.MetaFQN(k.ObjectMeta)
+	r.Fields = Fields{
+		k.Namespace,
+		k.Name,
+		brokers,
+		na(version),
+		boolToStr(k.Spec.KafkaExporter != nil),
+		strimziReady(k.Status.Conditions),
+		mapToStr(k.Labels),
+		asStatus(strimziDiagnose(k.Status.Conditions)),
+		toAge(k.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// KafkaTopic renders a Strimzi KafkaTopic to screen.
+type KafkaTopic struct {
+	Base
+}
+
+// Header returns a header row.
+func (KafkaTopic) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "TOPIC"},
+		HeaderColumn{Name: "PARTITIONS", Align: tview.AlignRight},
+		HeaderColumn{Name: "REPLICATION", Align: tview.AlignRight},
+		HeaderColumn{Name: "LAG", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (KafkaTopic) Render(o interface{}, ns string, r *Row) error {
+	var (
+		raw *unstructured.Unstructured
+		lag *int64
+	)
+	switch t := o.(type) {
+	case *KafkaTopicWithLag:
+		raw, lag = t.Raw, t.Lag
+	case *unstructured.Unstructured:
+		raw = t
+	default:
+		return fmt.Errorf("Expected KafkaTopic, but got %T", o)
+	}
+	var t StrimziTopic
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &t)
+	if err != nil {
+		return err
+	}
+
+	lagCol := NAValue
+	if lag != nil {
+		lagCol = strconv.FormatInt(*lag, 10)
+	}
+
+	r.ID = client.MetaFQN(t.ObjectMeta)
+	r.Fields = Fields{
+		t.Namespace,
+		t.ObjectMeta.Name,
+		na(t.Labels[StrimziClusterLabel]),
+		t.Name(),
+		strimziCount(t.Spec.Partitions),
+		strimziCount(t.Spec.Replicas),
+		lagCol,
+		strimziReady(t.Status.Conditions),
+		mapToStr(t.Labels),
+		asStatus(strimziDiagnose(t.Status.Conditions)),
+		toAge(t.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// KafkaUser renders a Strimzi KafkaUser to screen.
+type KafkaUser struct {
+	Base
+}
+
+// Header returns a header row.
+func (KafkaUser) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "CLUSTER"},
+		HeaderColumn{Name: "AUTHENTICATION"},
+		HeaderColumn{Name: "AUTHORIZATION"},
+		HeaderColumn{Name: "ACLS", Align: tview.AlignRight},
+		HeaderColumn{Name: "USERNAME", Wide: true},
+		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (KafkaUser) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected KafkaUser, but got %T", o)
+	}
+	var u StrimziUser
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &u)
+	if err != nil {
+		return err
+	}
+
+	authn, authz, acls := MissingValue, MissingValue, 0
+	if u.Spec.Authentication != nil {
+		authn = u.Spec.Authentication.Type
+	}
+	if u.Spec.Authorization != nil {
+		authz, acls = u.Spec.Authorization.Type, len(u.Spec.Authorization.ACLs)
+	}
+
+	r.ID = client.MetaFQN(u.ObjectMeta)
+	r.Fields = Fields{
+		u.Namespace,
+		u.Name,
+		na(u.Labels[StrimziClusterLabel]),
+		authn,
+		authz,
+		strconv.Itoa(acls),
+		na(u.Status.Username),
+		strimziReady(u.Status.Conditions),
+		mapToStr(u.Labels),
+		asStatus(strimziDiagnose(u.Status.Conditions)),
+		toAge(u.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func strimziCount(n int32) string {
+	if n <= 0 {
+		return NAValue
+	}
+
+	return strconv.Itoa(int(n))
+}
+
+func strimziCondition(cc []StrimziCondition, t string) *StrimziCondition {
+	for i := range cc {
+		if cc[i].Type == t {
+			return &cc[i]
+		}
+	}
+
+	return nil
+}
+
+func strimziReady(cc []StrimziCondition) string {
+	c := strimziCondition(cc, strimziReadyCondition)
+	if c == nil {
+		return UnknownValue
+	}
+
+	return c.Status
+}
+
+func strimziDiagnose(cc []StrimziCondition) error {
+	var errs []string
+	for _, c := range cc {
+		if c.Type == strimziReadyCondition && c.Status == string(metav1.ConditionFalse) || c.Type == "NotReady" && c.Status == string(metav1.ConditionTrue) {
+			errs = append(errs, na(c.Message))
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errors.New(strings.Join(errs, ", "))
+}
@@ -0,0 +1,86 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestKafkaRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "my-cluster",
+			"namespace":         "kafka",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"kafka":         map[string]interface{}{"replicas": int64(3), "version": "3.4.0"},
+			"kafkaExporter": map[string]interface{}{},
+		},
+		"status": map[string]interface{}{
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "NotReady", "status": "True", "message": "brokers down"},
+			},
+		},
+	}}
+	c := render.Kafka{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"kafka", "my-cluster", "3", "3.4.0", "true", "<unknown>"}, r.Fields[:6])
+	assert.Equal(t, "brokers down", r.Fields[7])
+}
+
+func TestKafkaTopicRender(t *testing.T) {
+	lag := int64(42)
+	uu := map[string]struct {
+		o   interface{}
+		lag string
+	}{
+		"no-exporter": {o: load(t, "kafkatopic"), lag: "n/a"},
+		"lag":         {o: &render.KafkaTopicWithLag{Raw: load(t, "kafkatopic"), Lag: &lag}, lag: "42"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			c := render.KafkaTopic{}
+			r := render.NewRow(11)
+
+			assert.NoError(t, c.Render(u.o, "", &r))
+			assert.Equal(t, "kafka/orders", r.ID)
+			assert.Equal(t, render.Fields{"kafka", "orders", "my-cluster", "shop.orders", "12", "3", u.lag, "True"}, r.Fields[:8])
+		})
+	}
+}
+
+func TestKafkaUserRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "app",
+			"namespace":         "kafka",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+			"labels":            map[string]interface{}{"strimzi.io/cluster": "my-cluster"},
+		},
+		"spec": map[string]interface{}{
+			"authentication": map[string]interface{}{"type": "scram-sha-512"},
+			"authorization": map[string]interface{}{
+				"type": "simple",
+				"acls": []interface{}{map[string]interface{}{}, map[string]interface{}{}},
+			},
+		},
+		"status": map[string]interface{}{
+			"username": "app",
+			"conditions": []interface{}{
+				map[string]interface{}{"type": "Ready", "status": "True"},
+			},
+		},
+	}}
+	c := render.KafkaUser{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"kafka", "app", "my-cluster", "scram-sha-512", "simple", "2", "app", "True"}, r.Fields[:8])
+}
@@ -3,11 +3,14 @@ package render
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // StatefulSet renders a K8s StatefulSet to screen.
@@ -21,6 +24,10 @@ func (StatefulSet) Header(ns string) Header {
 		HeaderColumn{Name: "NAMESPACE"},
 		HeaderColumn{Name: "NAME"},
 		HeaderColumn{Name: "READY"},
+		HeaderColumn{Name: "UPDATED", Align: tview.AlignRight},
+		HeaderColumn{Name: "STRATEGY", Wide: true},
+		HeaderColumn{Name: "PARTITION", Align: tview.AlignRight},
+		HeaderColumn{Name: "REVISIONS", Wide: true},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "SERVICE"},
 		HeaderColumn{Name: "CONTAINERS", Wide: true},
@@ -33,8 +40,16 @@ func (StatefulSet) Header(ns string) Header {
 
 // Render renders a K8s resource to screen.
 func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw *unstructured.Unstructured
+		rr  []OrdinalRevision
+	)
+	switch sts := o.(type) {
+	case *StatefulSetWithRevisions:
+		raw, rr = sts.Raw, sts.Ordinals
+	case *unstructured.Unstructured:
+		raw = sts
+	default:
 		return fmt.Errorf("Expected StatefulSet, but got %T", o)
 	}
 	var sts appsv1.StatefulSet
@@ -48,6 +63,10 @@ func (s StatefulSet) Render(o interface{}, ns string, r *Row) error {
 		sts.Namespace,
 		sts.Name,
 		strconv.Itoa(int(sts.Status.ReadyReplicas)) + "/" + strconv.Itoa(int(sts.Status.Replicas)),
+		strconv.Itoa(int(sts.Status.UpdatedReplicas)),
+		stsStrategy(&sts),
+		stsPartition(&sts),
+		revisionsToStr(rr),
 		asSelector(sts.Spec.Selector),
 		na(sts.Spec.ServiceName),
 		podContainerNames(sts.Spec.Template.Spec, true),
@@ -66,3 +85,73 @@ func (StatefulSet) diagnose(d, r int32) error {
 	}
 	return nil
 }
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// OrdinalRevision represents whether a statefulset pod runs the update revision.
+type OrdinalRevision struct {
+	Ordinal int
+	Updated bool
+	Ready   bool
+}
+
+// StatefulSetWithRevisions represents a statefulset along with its pods revisions.
+type StatefulSetWithRevisions struct {
+	Raw      *unstructured.Unstructured
+	Ordinals []OrdinalRevision
+}
+
+// GetObjectKind returns a schema object.
+func (s *StatefulSetWithRevisions) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *StatefulSetWithRevisions) DeepCopyObject() runtime.Object {
+	return s
+}
+
+func stsStrategy(sts *appsv1.StatefulSet) string {
+	if sts.Spec.UpdateStrategy.Type == "" {
+		return string(appsv1.RollingUpdateStatefulSetStrategyType)
+	}
+
+	return string(sts.Spec.UpdateStrategy.Type)
+}
+
+func stsPartition(sts *appsv1.StatefulSet) string {
+	if sts.Spec.UpdateStrategy.Type == appsv1.OnDeleteStatefulSetStrategyType {
+		return NAValue
+	}
+	if ru := sts.Spec.UpdateStrategy.RollingUpdate; ru != nil && ru.Partition != nil {
+		return strconv.Itoa(int(*ru.Partition))
+	}
+
+	return "0"
+}
+
+// revisionsToStr lists the ordinals running the update and current revisions. Not ready ordinals are marked with a !.
+func revisionsToStr(rr []OrdinalRevision) string {
+	var updated, current []string
+	for _, r := range rr {
+		o := strconv.Itoa(r.Ordinal)
+		if !r.Ready {
+			o += "!"
+		}
+		if r.Updated {
+			updated = append(updated, o)
+			continue
+		}
+		current = append(current, o)
+	}
+	var ss []string
+	if len(updated) > 0 {
+		ss = append(ss, "updated="+strings.Join(updated, ","))
+	}
+	if len(current) > 0 {
+		ss = append(ss, "current="+strings.Join(current, ","))
+	}
+
+	return strings.Join(ss, " ")
+}
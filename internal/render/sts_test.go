@@ -13,5 +13,22 @@ func TestStatefulSetRender(t *testing.T) {
 
 	assert.Nil(t, c.Render(load(t, "sts"), "", &r))
 	assert.Equal(t, "default/nginx-sts", r.ID)
-	assert.Equal(t, render.Fields{"default", "nginx-sts", "4/4", "app=nginx-sts", "nginx-sts", "nginx", "k8s.gcr.io/nginx-slim:0.8", "app=nginx-sts", ""}, r.Fields[:len(r.Fields)-1])
+	assert.Equal(t, render.Fields{"default", "nginx-sts", "4/4", "4", "RollingUpdate", "0", "", "app=nginx-sts", "nginx-sts", "nginx", "k8s.gcr.io/nginx-slim:0.8", "app=nginx-sts", ""}, r.Fields[:len(r.Fields)-1])
+}
+
+func TestStatefulSetRenderRevisions(t *testing.T) {
+	c := render.StatefulSet{}
+	r := render.NewRow(4)
+
+	o := render.StatefulSetWithRevisions{
+		Raw: load(t, "sts"),
+		Ordinals: []render.OrdinalRevision{
+			{Ordinal: 0, Ready: true},
+			{Ordinal: 1, Ready: true},
+			{Ordinal: 2, Updated: true},
+			{Ordinal: 3, Updated: true, Ready: true},
+		},
+	}
+	assert.Nil(t, c.Render(&o, "", &r))
+	assert.Equal(t, "updated=2!,3 current=0,1", r.Fields[6])
 }
@@ -7,11 +7,34 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
+// ServiceWithEndpoints represents a service along with its backends.
+type ServiceWithEndpoints struct {
+	Raw *unstructured.Unstructured
+	// Ready and Total track the service endpoints addresses.
+	Ready, Total int
+	// Pods tracks the number of pods matching the service selector.
+	Pods int
+	// UnmatchedPorts lists the target ports no selected pod exposes.
+	UnmatchedPorts []string
+}
+
+// GetObjectKind returns a schema object.
+func (s *ServiceWithEndpoints) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s *ServiceWithEndpoints) DeepCopyObject() runtime.Object {
+	return s
+}
+
 // Service renders a K8s Service to screen.
 type Service struct {
 	Base
@@ -27,16 +50,26 @@ func (Service) Header(ns string) Header {
 		HeaderColumn{Name: "EXTERNAL-IP"},
 		HeaderColumn{Name: "SELECTOR", Wide: true},
 		HeaderColumn{Name: "PORTS", Wide: false},
+		HeaderColumn{Name: "ENDPOINTS", Align: tview.AlignRight},
 		HeaderColumn{Name: "LABELS", Wide: true},
 		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "IP-FAMILIES", Wide: true},
 		HeaderColumn{Name: "AGE", Time: true},
 	}
 }
 
 // Render renders a K8s resource to screen.
 func (s Service) Render(o interface{}, ns string, r *Row) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
+	var (
+		raw *unstructured.Unstructured
+		eps *ServiceWithEndpoints
+	)
+	switch c := o.(type) {
+	case *ServiceWithEndpoints:
+		raw, eps = c.Raw, c
+	case *unstructured.Unstructured:
+		raw = c
+	default:
 		return fmt.Errorf("Expected Service, but got %T", o)
 	}
 	var svc v1.Service
@@ -50,25 +83,51 @@ func (s Service) Render(o interface{}, ns string, r *Row) error {
 		svc.Namespace,
 		svc.ObjectMeta.Name,
 		string(svc.Spec.Type),
-		toIP(svc.Spec.ClusterIP),
+		strings.Join(clusterIPs(&svc), ","),
 		toIPs(svc.Spec.Type, getSvcExtIPS(&svc)),
 		mapToStr(svc.Spec.Selector),
 		ToPorts(svc.Spec.Ports),
+		asEndpoints(&svc, eps),
 		mapToStr(svc.Labels),
-		asStatus(s.diagnose()),
+		asStatus(s.diagnose(&svc, eps)),
+		asIPFamilies(&svc),
 		toAge(svc.GetCreationTimestamp()),
 	}
 
 	return nil
 }
 
-func (Service) diagnose() error {
+func (Service) diagnose(svc *v1.Service, eps *ServiceWithEndpoints) error {
+	if err := checkIPFamilies(svc); err != nil {
+		return err
+	}
+	if eps == nil || len(svc.Spec.Selector) == 0 || svc.Spec.Type == v1.ServiceTypeExternalName {
+		return nil
+	}
+	if eps.Pods == 0 {
+		return fmt.Errorf("selector matches no pods")
+	}
+	if len(eps.UnmatchedPorts) > 0 {
+		return fmt.Errorf("targetPort %s matches no containerPort", strings.Join(eps.UnmatchedPorts, ","))
+	}
+	if eps.Ready == 0 {
+		return fmt.Errorf("no ready endpoints (%d pods selected)", eps.Pods)
+	}
+
 	return nil
 }
 
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func asEndpoints(svc *v1.Service, eps *ServiceWithEndpoints) string {
+	if eps == nil || svc.Spec.Type == v1.ServiceTypeExternalName {
+		return NAValue
+	}
+
+	return strconv.Itoa(eps.Ready) + "/" + strconv.Itoa(eps.Total)
+}
+
 func toIP(ip string) string {
 	if ip == "" || ip == "None" {
 		return ""
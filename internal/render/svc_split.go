@@ -0,0 +1,101 @@
+package render
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// ServiceSplit renders a service traffic share per backing workload to screen.
+type ServiceSplit struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (ServiceSplit) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if !Happy(ns, h, re.Row) {
+			return ErrColor
+		}
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (ServiceSplit) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "TRACK"},
+		HeaderColumn{Name: "PODS", Align: tview.AlignRight},
+		HeaderColumn{Name: "READY", Align: tview.AlignRight},
+		HeaderColumn{Name: "SHARE", Align: tview.AlignRight},
+		HeaderColumn{Name: "VALID", Wide: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (ServiceSplit) Render(o interface{}, ns string, r *Row) error {
+	s, ok := o.(ServiceSplitRes)
+	if !ok {
+		return fmt.Errorf("expected ServiceSplitRes, but got %T", o)
+	}
+
+	r.ID = client.FQN(s.Namespace, s.GVR+":"+s.Name)
+	r.Fields = Fields{
+		s.GVR,
+		s.Name,
+		s.Track,
+		strconv.Itoa(s.Pods),
+		strconv.Itoa(s.Ready),
+		s.Share(),
+		asStatus(s.diagnose()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ServiceSplitRes represents a workload backing a service.
+type ServiceSplitRes struct {
+	Namespace, Service string
+	GVR, Name          string
+	// Track tracks the labels telling this workload apart from the other backends.
+	Track string
+	// Ready tracks the workload ready endpoints out of all the service ready endpoints.
+	Pods, Ready, TotalReady int
+}
+
+// Share returns the workload rough traffic share.
+func (s ServiceSplitRes) Share() string {
+	if s.TotalReady == 0 {
+		return "0%"
+	}
+
+	return strconv.Itoa(s.Ready*100/s.TotalReady) + "%"
+}
+
+func (s ServiceSplitRes) diagnose() error {
+	if s.Ready == 0 {
+		return fmt.Errorf("no ready endpoints out of %d pods", s.Pods)
+	}
+
+	return nil
+}
+
+// GetObjectKind returns a schema object.
+func (ServiceSplitRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (s ServiceSplitRes) DeepCopyObject() runtime.Object {
+	return s
+}
@@ -16,6 +16,52 @@ func TestServiceRender(t *testing.T) {
 	assert.Equal(t, render.Fields{"default", "dictionary1", "ClusterIP", "10.47.248.116", "", "app=dictionary1", "http:4001►0"}, r.Fields[:7])
 }
 
+func TestServiceEndpointsRender(t *testing.T) {
+	uu := map[string]struct {
+		eps      *render.ServiceWithEndpoints
+		ep, diag string
+	}{
+		"unresolved": {
+			ep: render.NAValue,
+		},
+		"happy": {
+			eps: &render.ServiceWithEndpoints{Ready: 2, Total: 2, Pods: 2},
+			ep:  "2/2",
+		},
+		"no-pods": {
+			eps:  &render.ServiceWithEndpoints{},
+			ep:   "0/0",
+			diag: "selector matches no pods",
+		},
+		"bad-port": {
+			eps:  &render.ServiceWithEndpoints{Pods: 1, UnmatchedPorts: []string{"4001"}},
+			ep:   "0/0",
+			diag: "targetPort 4001 matches no containerPort",
+		},
+		"not-ready": {
+			eps:  &render.ServiceWithEndpoints{Total: 2, Pods: 2},
+			ep:   "0/2",
+			diag: "no ready endpoints (2 pods selected)",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var o interface{} = load(t, "svc")
+			if u.eps != nil {
+				u.eps.Raw = load(t, "svc")
+				o = u.eps
+			}
+			c, r := render.Service{}, render.NewRow(11)
+
+			assert.NoError(t, c.Render(o, "", &r))
+			assert.Equal(t, u.ep, r.Fields[7])
+			assert.Equal(t, u.diag, r.Fields[9])
+		})
+	}
+}
+
 func BenchmarkSvcRender(b *testing.B) {
 	var svc render.Service
 	r := render.NewRow(4)
@@ -11,6 +11,8 @@ type TableData struct {
 	Header    Header
 	RowEvents RowEvents
 	Namespace string
+	// Truncated tracks the total rows count when rows were dropped to fit the view budget.
+	Truncated int
 	mx        sync.RWMutex
 }
 
@@ -79,6 +81,7 @@ func (t *TableData) Clone() *TableData {
 		Header:    t.Header.Clone(),
 		RowEvents: t.RowEvents.Clone(),
 		Namespace: t.Namespace,
+		Truncated: t.Truncated,
 	}
 }
 
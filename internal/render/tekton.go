@@ -0,0 +1,261 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const (
+	// TektonPipelineRunLabel tracks the PipelineRun owning a TaskRun.
+	TektonPipelineRunLabel = "tekton.dev/pipelineRun"
+	// TektonPipelineTaskLabel tracks the pipeline task name of a TaskRun.
+	TektonPipelineTaskLabel = "tekton.dev/pipelineTask"
+	// TektonTaskRunLabel tracks the TaskRun owning a pod.
+	TektonTaskRunLabel = "tekton.dev/taskRun"
+)
+
+type (
+	// TektonRun represents a Tekton PipelineRun or TaskRun.
+	TektonRun struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			PipelineRef *struct {
+				Name string `json:"name,omitempty"`
+			} `json:"pipelineRef,omitempty"`
+			TaskRef *struct {
+				Name string `json:"name,omitempty"`
+			} `json:"taskRef,omitempty"`
+		} `json:"spec"`
+		Status TektonRunStatus `json:"status"`
+	}
+
+	// TektonRunStatus represents a run status.
+	TektonRunStatus struct {
+		Conditions     []TektonCondition `json:"conditions,omitempty"`
+		StartTime      *metav1.Time      `json:"startTime,omitempty"`
+		CompletionTime *metav1.Time      `json:"completionTime,omitempty"`
+		PodName        string            `json:"podName,omitempty"`
+		Steps          []TektonStep      `json:"steps,omitempty"`
+		PipelineSpec   *struct {
+			Tasks   []struct{} `json:"tasks,omitempty"`
+			Finally []struct{} `json:"finally,omitempty"`
+		} `json:"pipelineSpec,omitempty"`
+	}
+
+	// TektonCondition represents a run condition.
+	TektonCondition struct {
+		Type    string `json:"type"`
+		Status  string `json:"status"`
+		Reason  string `json:"reason,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+
+	// TektonStep represents a TaskRun step state.
+	TektonStep struct {
+		Name       string `json:"name"`
+		Container  string `json:"container,omitempty"`
+		Terminated *struct {
+			ExitCode int32  `json:"exitCode"`
+			Reason   string `json:"reason,omitempty"`
+		} `json:"terminated,omitempty"`
+	}
+
+	// TektonTasks tracks a PipelineRun tasks progress.
+	TektonTasks struct {
+		Succeeded, Failed, Running, Total int
+	}
+)
+
+// Succeeded returns the run Succeeded condition if any.
+func (s TektonRunStatus) Succeeded() *TektonCondition {
+	for i := range s.Conditions {
+		if s.Conditions[i].Type == "Succeeded" {
+			return &s.Conditions[i]
+		}
+	}
+
+	return nil
+}
+
+// Duration returns the run duration.
+func (s TektonRunStatus) Duration() string {
+	if s.StartTime == nil {
+		return MissingValue
+	}
+	end := time.Now()
+	if s.CompletionTime != nil {
+		end = s.CompletionTime.Time
+	}
+
+	return duration.HumanDuration(end.Sub(s.StartTime.Time))
+}
+
+// PipelineRunWithTasks represents a PipelineRun along with its TaskRuns progress.
+type PipelineRunWithTasks struct {
+	Raw   *unstructured.Unstructured
+	Tasks TektonTasks
+}
+
+// GetObjectKind returns a schema object.
+func (p *PipelineRunWithTasks) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (p *PipelineRunWithTasks) DeepCopyObject() runtime.Object {
+	return p
+}
+
+// PipelineRun renders a Tekton PipelineRun to screen.
+type PipelineRun struct {
+	Base
+}
+
+// Header returns a header row.
+func (PipelineRun) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PIPELINE"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "TASKS", Align: tview.AlignRight},
+		HeaderColumn{Name: "FAILED", Align: tview.AlignRight},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (p PipelineRun) Render(o interface{}, ns string, r *Row) error {
+	prt, ok := o.(*PipelineRunWithTasks)
+	if !ok {
+		return fmt.Errorf("Expected PipelineRunWithTasks, but got %T", o)
+	}
+	var pr TektonRun
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(prt.Raw.Object, &pr)
+	if err != nil {
+		return err
+	}
+
+	pipeline := MissingValue
+	if pr.Spec.PipelineRef != nil {
+		pipeline = pr.Spec.PipelineRef.Name
+	}
+	total := prt.Tasks.Total
+	if spec := pr.Status.PipelineSpec; spec != nil && len(spec.Tasks)+len(spec.Finally) > total {
+		total = len(spec.Tasks) + len(spec.Finally)
+	}
+
+	r.ID = client.MetaFQN(pr.ObjectMeta)
+	r.Fields = Fields{
+		pr.Namespace,
+		pr.Name,
+		pipeline,
+		tektonStatus(pr.Status.Succeeded()),
+		strconv.Itoa(prt.Tasks.Succeeded) + "/" + strconv.Itoa(total),
+		strconv.Itoa(prt.Tasks.Failed),
+		pr.Status.Duration(),
+		mapToStr(pr.Labels),
+		asStatus(tektonDiagnose(pr.Status.Succeeded())),
+		toAge(pr.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// TaskRun renders a Tekton TaskRun to screen.
+type TaskRun struct {
+	Base
+}
+
+// Header returns a header row.
+func (TaskRun) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "PIPELINERUN"},
+		HeaderColumn{Name: "TASK"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "STEPS", Align: tview.AlignRight},
+		HeaderColumn{Name: "POD"},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (t TaskRun) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected TaskRun, but got %T", o)
+	}
+	var tr TektonRun
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &tr)
+	if err != nil {
+		return err
+	}
+
+	task := tr.Labels[TektonPipelineTaskLabel]
+	if task == "" && tr.Spec.TaskRef != nil {
+		task = tr.Spec.TaskRef.Name
+	}
+	var done int
+	for _, s := range tr.Status.Steps {
+		if s.Terminated != nil {
+			done++
+		}
+	}
+
+	r.ID = client.MetaFQN(tr.ObjectMeta)
+	r.Fields = Fields{
+		tr.Namespace,
+		tr.Name,
+		na(tr.Labels[TektonPipelineRunLabel]),
+		na(task),
+		tektonStatus(tr.Status.Succeeded()),
+		strconv.Itoa(done) + "/" + strconv.Itoa(len(tr.Status.Steps)),
+		na(tr.Status.PodName),
+		tr.Status.Duration(),
+		mapToStr(tr.Labels),
+		asStatus(tektonDiagnose(tr.Status.Succeeded())),
+		toAge(tr.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func tektonStatus(c *TektonCondition) string {
+	if c == nil {
+		return "Pending"
+	}
+
+	return na(c.Reason)
+}
+
+func tektonDiagnose(c *TektonCondition) error {
+	if c == nil || c.Status != "False" {
+		return nil
+	}
+	if c.Message == "" {
+		return errors.New(c.Reason)
+	}
+
+	return errors.New(c.Message)
+}
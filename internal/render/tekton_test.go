@@ -0,0 +1,32 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPipelineRunRender(t *testing.T) {
+	c := render.PipelineRun{}
+	r := render.NewRow(10)
+	o := render.PipelineRunWithTasks{
+		Raw:   load(t, "pipelinerun"),
+		Tasks: render.TektonTasks{Succeeded: 2, Failed: 1, Total: 3},
+	}
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, "ci/build-run-1", r.ID)
+	assert.Equal(t, render.Fields{"ci", "build-run-1", "build", "Failed", "2/4", "1", "2m"}, r.Fields[:7])
+	assert.Equal(t, "Tasks Completed: 3 (Failed: 1, Cancelled 0), Skipped: 1", r.Fields[8])
+}
+
+func TestTaskRunRender(t *testing.T) {
+	c := render.TaskRun{}
+	r := render.NewRow(11)
+
+	assert.NoError(t, c.Render(load(t, "taskrun"), "", &r))
+	assert.Equal(t, "ci/build-run-1-test", r.ID)
+	assert.Equal(t, render.Fields{"ci", "build-run-1-test", "build-run-1", "test", "Failed", "2/3", "build-run-1-test-pod", "90s"}, r.Fields[:8])
+	assert.Equal(t, `"step-unit" exited with code 1`, r.Fields[9])
+}
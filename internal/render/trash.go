@@ -0,0 +1,77 @@
+package render
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// Trash renders a deleted object to screen.
+type Trash struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Trash) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		return tcell.ColorCadetBlue
+	}
+}
+
+// Header returns a header row.
+func (Trash) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "EXPIRES"},
+		HeaderColumn{Name: "DELETED", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Trash) Render(o interface{}, ns string, r *Row) error {
+	t, ok := o.(TrashRes)
+	if !ok {
+		return fmt.Errorf("expected TrashRes, but got %T", o)
+	}
+
+	r.ID = t.ID
+	r.Fields = append(r.Fields,
+		t.GVR,
+		t.Namespace,
+		t.Name,
+		duration.HumanDuration(time.Until(t.ExpiresAt)),
+		toAge(metav1.NewTime(t.DeletedAt)),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// TrashRes represents a deleted object that can be restored.
+type TrashRes struct {
+	ID        string
+	GVR       string
+	Namespace string
+	Name      string
+	DeletedAt time.Time
+	ExpiresAt time.Time
+}
+
+// GetObjectKind returns a schema object.
+func (TrashRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (t TrashRes) DeepCopyObject() runtime.Object {
+	return t
+}
@@ -0,0 +1,88 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Upgrade renders a cluster upgrade readiness finding to screen.
+type Upgrade struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Upgrade) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		col := h.IndexOf("SEVERITY", true)
+		if col < 0 {
+			return DefaultColorer(ns, h, re)
+		}
+		switch strings.TrimSpace(re.Row.Fields[col]) {
+		case "critical":
+			return ErrColor
+		case "warning":
+			return PendingColor
+		default:
+			return StdColor
+		}
+	}
+}
+
+// Header returns a header row.
+func (Upgrade) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "SEVERITY"},
+		HeaderColumn{Name: "CHECK"},
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GVR"},
+		HeaderColumn{Name: "MESSAGE"},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Upgrade) Render(o interface{}, ns string, r *Row) error {
+	c, ok := o.(UpgradeCheck)
+	if !ok {
+		return fmt.Errorf("expected UpgradeCheck, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{c.Check, c.GVR, c.Namespace, c.Name}, ":")
+	r.Fields = append(r.Fields,
+		c.Severity,
+		c.Check,
+		c.Namespace,
+		c.Name,
+		c.GVR,
+		c.Message,
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// UpgradeCheck represents an upgrade readiness finding.
+type UpgradeCheck struct {
+	Severity  string
+	Check     string
+	Namespace string
+	Name      string
+	GVR       string
+	Message   string
+}
+
+// GetObjectKind returns a schema object.
+func (UpgradeCheck) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (c UpgradeCheck) DeepCopyObject() runtime.Object {
+	return c
+}
@@ -0,0 +1,263 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/tview"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// VeleroScheduleLabel tracks the Schedule owning a Backup.
+const VeleroScheduleLabel = "velero.io/schedule-name"
+
+type (
+	// VeleroBackup represents a Velero Backup.
+	VeleroBackup struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			IncludedNamespaces []string `json:"includedNamespaces,omitempty"`
+			StorageLocation    string   `json:"storageLocation,omitempty"`
+		} `json:"spec"`
+		Status VeleroStatus `json:"status"`
+	}
+
+	// VeleroRestore represents a Velero Restore.
+	VeleroRestore struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			BackupName   string `json:"backupName,omitempty"`
+			ScheduleName string `json:"scheduleName,omitempty"`
+		} `json:"spec"`
+		Status VeleroStatus `json:"status"`
+	}
+
+	// VeleroSchedule represents a Velero Schedule.
+	VeleroSchedule struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Schedule string                 `json:"schedule"`
+			Paused   bool                   `json:"paused,omitempty"`
+			Template map[string]interface{} `json:"template"`
+		} `json:"spec"`
+		Status struct {
+			Phase            string       `json:"phase,omitempty"`
+			LastBackup       *metav1.Time `json:"lastBackup,omitempty"`
+			ValidationErrors []string     `json:"validationErrors,omitempty"`
+		} `json:"status"`
+	}
+
+	// VeleroStatus represents a Backup or Restore status.
+	VeleroStatus struct {
+		Phase               string       `json:"phase,omitempty"`
+		Errors              int          `json:"errors,omitempty"`
+		Warnings            int          `json:"warnings,omitempty"`
+		FailureReason       string       `json:"failureReason,omitempty"`
+		ValidationErrors    []string     `json:"validationErrors,omitempty"`
+		StartTimestamp      *metav1.Time `json:"startTimestamp,omitempty"`
+		CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
+		Expiration          *metav1.Time `json:"expiration,omitempty"`
+	}
+)
+
+// Backup renders a Velero Backup to screen.
+type Backup struct {
+	Base
+}
+
+// Header returns a header row.
+func (Backup) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		HeaderColumn{Name: "WARNINGS", Align: tview.AlignRight},
+		HeaderColumn{Name: "SCHEDULE"},
+		HeaderColumn{Name: "NAMESPACES"},
+		HeaderColumn{Name: "LOCATION"},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "EXPIRES"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Backup) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Backup, but got %T", o)
+	}
+	var b VeleroBackup
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &b)
+	if err != nil {
+		return err
+	}
+
+	nss := "*"
+	if len(b.Spec.IncludedNamespaces) > 0 {
+		nss = strings.Join(b.Spec.IncludedNamespaces, ",")
+	}
+	expires := NAValue
+	if b.Status.Expiration != nil {
+		expires = toAge(*b.Status.Expiration)
+	}
+
+	r.ID = client.MetaFQN(b.ObjectMeta)
+	r.Fields = Fields{
+		b.Namespace,
+		b.Name,
+		veleroPhase(b.Status.Phase),
+		strconv.Itoa(b.Status.Errors),
+		strconv.Itoa(b.Status.Warnings),
+		na(b.Labels[VeleroScheduleLabel]),
+		nss,
+		na(b.Spec.StorageLocation),
+		workflowDuration(b.Status.StartTimestamp, b.Status.CompletionTimestamp),
+		expires,
+		mapToStr(b.Labels),
+		asStatus(veleroDiagnose(b.Status)),
+		toAge(b.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Restore renders a Velero Restore to screen.
+type Restore struct {
+	Base
+}
+
+// Header returns a header row.
+func (Restore) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "BACKUP"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "ERRORS", Align: tview.AlignRight},
+		HeaderColumn{Name: "WARNINGS", Align: tview.AlignRight},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Restore) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Restore, but got %T", o)
+	}
+	var rs VeleroRestore
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &rs)
+	if err != nil {
+		return err
+	}
+
+	backup := rs.Spec.BackupName
+	if backup == "" && rs.Spec.ScheduleName != "" {
+		backup = "@" + rs.Spec.ScheduleName
+	}
+
+	r.ID = client.MetaFQN(rs.ObjectMeta)
+	r.Fields = Fields{
+		rs.Namespace,
+		rs.Name,
+		na(backup),
+		veleroPhase(rs.Status.Phase),
+		strconv.Itoa(rs.Status.Errors),
+		strconv.Itoa(rs.Status.Warnings),
+		workflowDuration(rs.Status.StartTimestamp, rs.Status.CompletionTimestamp),
+		mapToStr(rs.Labels),
+		asStatus(veleroDiagnose(rs.Status)),
+		toAge(rs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// Schedule renders a Velero Schedule to screen.
+type Schedule struct {
+	Base
+}
+
+// Header returns a header row.
+func (Schedule) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "SCHEDULE"},
+		HeaderColumn{Name: "PAUSED"},
+		HeaderColumn{Name: "LAST-BACKUP"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Schedule) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Schedule, but got %T", o)
+	}
+	var s VeleroSchedule
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &s)
+	if err != nil {
+		return err
+	}
+
+	last := NAValue
+	if s.Status.LastBackup != nil {
+		last = toAge(*s.Status.LastBackup)
+	}
+
+	r.ID = client.MetaFQN(s.ObjectMeta)
+	r.Fields = Fields{
+		s.Namespace,
+		s.Name,
+		veleroPhase(s.Status.Phase),
+		s.Spec.Schedule,
+		boolToStr(s.Spec.Paused),
+		last,
+		mapToStr(s.Labels),
+		asStatus(veleroDiagnose(VeleroStatus{Phase: s.Status.Phase, ValidationErrors: s.Status.ValidationErrors})),
+		toAge(s.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func veleroPhase(p string) string {
+	if p == "" {
+		return "New"
+	}
+
+	return p
+}
+
+func veleroDiagnose(st VeleroStatus) error {
+	switch {
+	case len(st.ValidationErrors) > 0:
+		return errors.New(strings.Join(st.ValidationErrors, ", "))
+	case st.FailureReason != "":
+		return errors.New(st.FailureReason)
+	case strings.HasPrefix(st.Phase, "Failed") || st.Phase == "PartiallyFailed":
+		return fmt.Errorf("%s with %d error(s)", st.Phase, st.Errors)
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,47 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestBackupRender(t *testing.T) {
+	c := render.Backup{}
+	r := render.NewRow(13)
+
+	assert.NoError(t, c.Render(load(t, "backup"), "", &r))
+	assert.Equal(t, "velero/nightly-20230101020000", r.ID)
+	assert.Equal(t, render.Fields{"velero", "nightly-20230101020000", "PartiallyFailed", "2", "5", "nightly", "default,kube-system", "default", "90s"}, r.Fields[:9])
+	assert.Equal(t, "PartiallyFailed with 2 error(s)", r.Fields[11])
+}
+
+func TestRestoreRender(t *testing.T) {
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"name":              "r1",
+			"namespace":         "velero",
+			"creationTimestamp": "2023-01-01T00:00:00Z",
+		},
+		"spec": map[string]interface{}{
+			"scheduleName": "nightly",
+		},
+	}}
+	c := render.Restore{}
+	r := render.NewRow(10)
+
+	assert.NoError(t, c.Render(&o, "", &r))
+	assert.Equal(t, render.Fields{"velero", "r1", "@nightly", "New", "0", "0", "<none>"}, r.Fields[:7])
+	assert.Equal(t, "", r.Fields[8])
+}
+
+func TestScheduleRender(t *testing.T) {
+	c := render.Schedule{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "schedule"), "", &r))
+	assert.Equal(t, "velero/nightly", r.ID)
+	assert.Equal(t, render.Fields{"velero", "nightly", "Enabled", "0 2 * * *", "false"}, r.Fields[:5])
+}
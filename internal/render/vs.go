@@ -0,0 +1,78 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// VirtualService renders an Istio VirtualService to screen.
+type VirtualService struct {
+	Base
+}
+
+// Header returns a header row.
+func (VirtualService) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "GATEWAYS"},
+		HeaderColumn{Name: "HOSTS"},
+		HeaderColumn{Name: "ROUTES"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (v VirtualService) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected VirtualService, but got %T", o)
+	}
+	var vs IstioVirtualService
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &vs)
+	if err != nil {
+		return err
+	}
+
+	dd := vs.Spec.Destinations()
+	r.ID = client.MetaFQN(vs.ObjectMeta)
+	r.Fields = Fields{
+		vs.Namespace,
+		vs.Name,
+		naStrings(vs.Spec.Gateways),
+		istioHosts(vs.Spec.Hosts, vs.Namespace),
+		istioRoutes(dd),
+		mapToStr(vs.Labels),
+		asStatus(v.diagnose(dd)),
+		toAge(vs.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (VirtualService) diagnose(dd []IstioRouteDestination) error {
+	if len(dd) == 0 {
+		return errors.New("no route destinations")
+	}
+	var total int32
+	for _, d := range dd {
+		total += d.Weight
+	}
+	if total%100 != 0 {
+		return fmt.Errorf("route weights add up to %d%%", total)
+	}
+	for _, d := range dd {
+		if strings.TrimSpace(d.Destination.Host) == "" {
+			return errors.New("route destination is missing a host")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,93 @@
+package render
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/tcell/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// Webhook renders an admission webhook health to screen.
+type Webhook struct {
+	Base
+}
+
+// ColorerFunc colors a resource row.
+func (Webhook) ColorerFunc() ColorerFunc {
+	return func(ns string, h Header, re RowEvent) tcell.Color {
+		if Happy(ns, h, re.Row) {
+			return DefaultColorer(ns, h, re)
+		}
+		col := h.IndexOf("POLICY", true)
+		if col >= 0 && strings.TrimSpace(re.Row.Fields[col]) == "Ignore" {
+			return PendingColor
+		}
+
+		return ErrColor
+	}
+}
+
+// Header returns a header row.
+func (Webhook) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "KIND"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "WEBHOOK"},
+		HeaderColumn{Name: "BACKEND"},
+		HeaderColumn{Name: "POLICY"},
+		HeaderColumn{Name: "CA-EXPIRY"},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (Webhook) Render(o interface{}, ns string, r *Row) error {
+	w, ok := o.(WebhookRes)
+	if !ok {
+		return fmt.Errorf("expected WebhookRes, but got %T", o)
+	}
+
+	r.ID = strings.Join([]string{w.GVR, w.Config, w.Webhook}, ":")
+	r.Fields = append(r.Fields,
+		w.Kind,
+		w.Config,
+		w.Webhook,
+		w.Backend,
+		w.FailurePolicy,
+		na(w.CAExpiry),
+		strings.Join(w.Issues, "; "),
+		toAge(w.Created),
+	)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// WebhookRes represents an admission webhook health.
+type WebhookRes struct {
+	GVR           string
+	Kind          string
+	Config        string
+	Webhook       string
+	Backend       string
+	FailurePolicy string
+	CAExpiry      string
+	Issues        []string
+	Created       metav1.Time
+}
+
+// GetObjectKind returns a schema object.
+func (WebhookRes) GetObjectKind() schema.ObjectKind {
+	return nil
+}
+
+// DeepCopyObject returns a container copy.
+func (w WebhookRes) DeepCopyObject() runtime.Object {
+	return w
+}
@@ -0,0 +1,204 @@
+package render
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// ArgoWorkflowLabel tracks the Workflow owning a step pod.
+const ArgoWorkflowLabel = "workflows.argoproj.io/workflow"
+
+type (
+	// ArgoWorkflow represents an Argo Workflow.
+	ArgoWorkflow struct {
+		metav1.ObjectMeta `json:"metadata"`
+		Spec              struct {
+			Shutdown string `json:"shutdown,omitempty"`
+		} `json:"spec"`
+		Status ArgoWorkflowStatus `json:"status"`
+	}
+
+	// ArgoWorkflowStatus represents a Workflow status.
+	ArgoWorkflowStatus struct {
+		Phase      string                      `json:"phase,omitempty"`
+		Progress   string                      `json:"progress,omitempty"`
+		Message    string                      `json:"message,omitempty"`
+		StartedAt  *metav1.Time                `json:"startedAt,omitempty"`
+		FinishedAt *metav1.Time                `json:"finishedAt,omitempty"`
+		Nodes      map[string]ArgoWorkflowNode `json:"nodes,omitempty"`
+	}
+
+	// ArgoWorkflowNode represents a Workflow node status.
+	ArgoWorkflowNode struct {
+		ID          string       `json:"id"`
+		Name        string       `json:"name"`
+		DisplayName string       `json:"displayName,omitempty"`
+		Type        string       `json:"type"`
+		Phase       string       `json:"phase,omitempty"`
+		Message     string       `json:"message,omitempty"`
+		StartedAt   *metav1.Time `json:"startedAt,omitempty"`
+		FinishedAt  *metav1.Time `json:"finishedAt,omitempty"`
+		Children    []string     `json:"children,omitempty"`
+	}
+)
+
+// IsCompleted checks if a Workflow is done running.
+func (s ArgoWorkflowStatus) IsCompleted() bool {
+	switch s.Phase {
+	case "Succeeded", "Failed", "Error":
+		return true
+	default:
+		return false
+	}
+}
+
+// Tree returns an ASCII representation of the Workflow nodes graph.
+func (w *ArgoWorkflow) Tree() string {
+	root, ok := w.Status.Nodes[w.Name]
+	if !ok {
+		return "No nodes found."
+	}
+
+	var b strings.Builder
+	b.WriteString(workflowNodeLabel(root) + "\n")
+	w.walk(&b, root, "", map[string]struct{}{root.ID: {}})
+
+	return b.String()
+}
+
+func (w *ArgoWorkflow) walk(b *strings.Builder, n ArgoWorkflowNode, indent string, seen map[string]struct{}) {
+	cc := make([]string, 0, len(n.Children))
+	for _, c := range n.Children {
+		if _, ok := seen[c]; !ok {
+			cc = append(cc, c)
+		}
+	}
+	sort.SliceStable(cc, func(i, j int) bool {
+		return startTime(w.Status.Nodes[cc[i]]).Before(startTime(w.Status.Nodes[cc[j]]))
+	})
+	for i, id := range cc {
+		c, ok := w.Status.Nodes[id]
+		if !ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		branch, next := "├─ ", "│  "
+		if i == len(cc)-1 {
+			branch, next = "└─ ", "   "
+		}
+		b.WriteString(indent + branch + workflowNodeLabel(c) + "\n")
+		w.walk(b, c, indent+next, seen)
+	}
+}
+
+// Workflow renders an Argo Workflow to screen.
+type Workflow struct {
+	Base
+}
+
+// Header returns a header row.
+func (Workflow) Header(ns string) Header {
+	return Header{
+		HeaderColumn{Name: "NAMESPACE"},
+		HeaderColumn{Name: "NAME"},
+		HeaderColumn{Name: "STATUS"},
+		HeaderColumn{Name: "PROGRESS"},
+		HeaderColumn{Name: "DURATION"},
+		HeaderColumn{Name: "MESSAGE"},
+		HeaderColumn{Name: "LABELS", Wide: true},
+		HeaderColumn{Name: "VALID", Wide: true},
+		HeaderColumn{Name: "AGE", Time: true},
+	}
+}
+
+// Render renders a K8s resource to screen.
+func (w Workflow) Render(o interface{}, ns string, r *Row) error {
+	raw, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return fmt.Errorf("Expected Workflow, but got %T", o)
+	}
+	var wf ArgoWorkflow
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &wf)
+	if err != nil {
+		return err
+	}
+
+	phase := wf.Status.Phase
+	if phase == "" {
+		phase = "Pending"
+	}
+	if wf.Spec.Shutdown != "" && !wf.Status.IsCompleted() {
+		phase += "(" + wf.Spec.Shutdown + ")"
+	}
+
+	r.ID = client.MetaFQN(wf.ObjectMeta)
+	r.Fields = Fields{
+		wf.Namespace,
+		wf.Name,
+		phase,
+		na(wf.Status.Progress),
+		workflowDuration(wf.Status.StartedAt, wf.Status.FinishedAt),
+		wf.Status.Message,
+		mapToStr(wf.Labels),
+		asStatus(w.diagnose(wf.Status)),
+		toAge(wf.GetCreationTimestamp()),
+	}
+
+	return nil
+}
+
+func (Workflow) diagnose(st ArgoWorkflowStatus) error {
+	if st.Phase != "Failed" && st.Phase != "Error" {
+		return nil
+	}
+	if st.Message == "" {
+		return fmt.Errorf("workflow %s", strings.ToLower(st.Phase))
+	}
+
+	return errors.New(st.Message)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func workflowNodeLabel(n ArgoWorkflowNode) string {
+	name := n.DisplayName
+	if name == "" {
+		name = n.Name
+	}
+	label := fmt.Sprintf("%s [%s] %s %s", name, n.Type, n.Phase, workflowDuration(n.StartedAt, n.FinishedAt))
+	if n.Message != "" {
+		label += " - " + n.Message
+	}
+
+	return label
+}
+
+func workflowDuration(start, end *metav1.Time) string {
+	if start == nil {
+		return MissingValue
+	}
+	t := time.Now()
+	if end != nil {
+		t = end.Time
+	}
+
+	return duration.HumanDuration(t.Sub(start.Time))
+}
+
+func startTime(n ArgoWorkflowNode) time.Time {
+	if n.StartedAt == nil {
+		return time.Time{}
+	}
+
+	return n.StartedAt.Time
+}
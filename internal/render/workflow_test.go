@@ -0,0 +1,31 @@
+package render_test
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestWorkflowRender(t *testing.T) {
+	c := render.Workflow{}
+	r := render.NewRow(9)
+
+	assert.NoError(t, c.Render(load(t, "wf"), "", &r))
+	assert.Equal(t, "argo/dag-diamond", r.ID)
+	assert.Equal(t, render.Fields{"argo", "dag-diamond", "Failed", "2/3", "60s", "child 'dag-diamond-3' failed"}, r.Fields[:6])
+	assert.Equal(t, "child 'dag-diamond-3' failed", r.Fields[7])
+}
+
+func TestWorkflowTree(t *testing.T) {
+	var wf render.ArgoWorkflow
+	assert.NoError(t, runtime.DefaultUnstructuredConverter.FromUnstructured(load(t, "wf").Object, &wf))
+
+	e := `dag-diamond [DAG] Failed 60s
+└─ A [Pod] Succeeded 10s
+   ├─ B [Pod] Succeeded 10s
+   └─ C [Pod] Failed 20s - Error (exit code 1)
+`
+	assert.Equal(t, e, wf.Tree())
+}
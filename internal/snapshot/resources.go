@@ -0,0 +1,82 @@
+package snapshot
+
+import (
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type baselineResource struct {
+	gv  schema.GroupVersion
+	res metav1.APIResource
+}
+
+var (
+	coreGV    = schema.GroupVersion{Version: "v1"}
+	appsGV    = schema.GroupVersion{Group: "apps", Version: "v1"}
+	batchGV   = schema.GroupVersion{Group: "batch", Version: "v1"}
+	netGV     = schema.GroupVersion{Group: "networking.k8s.io", Version: "v1"}
+	rbacGV    = schema.GroupVersion{Group: "rbac.authorization.k8s.io", Version: "v1"}
+	storageGV = schema.GroupVersion{Group: "storage.k8s.io", Version: "v1"}
+
+	// baseline tracks the resources served even when the snapshot holds none.
+	baseline = []baselineResource{
+		{coreGV, res("namespaces", "Namespace", false, "ns")},
+		{coreGV, res("nodes", "Node", false, "no")},
+		{coreGV, res("pods", "Pod", true, "po")},
+		{coreGV, res("services", "Service", true, "svc")},
+		{coreGV, res("endpoints", "Endpoints", true, "ep")},
+		{coreGV, res("events", "Event", true, "ev")},
+		{coreGV, res("configmaps", "ConfigMap", true, "cm")},
+		{coreGV, res("secrets", "Secret", true)},
+		{coreGV, res("serviceaccounts", "ServiceAccount", true, "sa")},
+		{coreGV, res("persistentvolumeclaims", "PersistentVolumeClaim", true, "pvc")},
+		{coreGV, res("persistentvolumes", "PersistentVolume", false, "pv")},
+		{coreGV, res("replicationcontrollers", "ReplicationController", true, "rc")},
+		{coreGV, res("resourcequotas", "ResourceQuota", true, "quota")},
+		{coreGV, res("limitranges", "LimitRange", true, "limits")},
+		{appsGV, res("deployments", "Deployment", true, "deploy")},
+		{appsGV, res("replicasets", "ReplicaSet", true, "rs")},
+		{appsGV, res("statefulsets", "StatefulSet", true, "sts")},
+		{appsGV, res("daemonsets", "DaemonSet", true, "ds")},
+		{batchGV, res("jobs", "Job", true)},
+		{batchGV, res("cronjobs", "CronJob", true, "cj")},
+		{netGV, res("ingresses", "Ingress", true, "ing")},
+		{netGV, res("networkpolicies", "NetworkPolicy", true, "netpol")},
+		{rbacGV, res("roles", "Role", true)},
+		{rbacGV, res("rolebindings", "RoleBinding", true)},
+		{rbacGV, res("clusterroles", "ClusterRole", false)},
+		{rbacGV, res("clusterrolebindings", "ClusterRoleBinding", false)},
+		{storageGV, res("storageclasses", "StorageClass", false, "sc")},
+	}
+
+	// irregulars tracks the resources whose names can not be guessed from their kind.
+	irregulars = map[string]string{
+		"Endpoints":   "endpoints",
+		"PodMetrics":  "pods",
+		"NodeMetrics": "nodes",
+	}
+)
+
+func res(n, kind string, namespaced bool, shortNames ...string) metav1.APIResource {
+	return metav1.APIResource{
+		Name:         n,
+		SingularName: strings.ToLower(kind),
+		Kind:         kind,
+		Namespaced:   namespaced,
+		ShortNames:   shortNames,
+	}
+}
+
+// guessResource derives a resource definition from an object kind.
+func guessResource(gvk schema.GroupVersionKind, namespaced bool) metav1.APIResource {
+	n, ok := irregulars[gvk.Kind]
+	if !ok {
+		plural, _ := meta.UnsafeGuessKindToResource(gvk)
+		n = plural.Resource
+	}
+
+	return res(n, gvk.Kind, namespaced)
+}
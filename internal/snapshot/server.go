@@ -0,0 +1,334 @@
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+const readOnlyMsg = "snapshot is read-only"
+
+// readVerbs tracks the verbs allowed on a snapshot.
+var readVerbs = map[string]struct{}{"get": {}, "list": {}, "watch": {}}
+
+// Server serves a snapshot as a read-only api server.
+type Server struct {
+	snap *Snapshot
+	srv  *http.Server
+}
+
+// NewServer returns a new instance.
+func NewServer(s *Snapshot) *Server {
+	return &Server{snap: s}
+}
+
+// Start starts serving the snapshot on a local port and returns its url.
+func (s *Server) Start() (string, error) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	s.srv = &http.Server{Handler: s, ReadHeaderTimeout: 5 * time.Second}
+	go func() {
+		if err := s.srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Error().Err(err).Msgf("Snapshot server failed")
+		}
+	}()
+
+	return "http://" + l.Addr().String(), nil
+}
+
+// Stop terminates the server.
+func (s *Server) Stop() {
+	if s.srv == nil {
+		return
+	}
+	if err := s.srv.Close(); err != nil {
+		log.Error().Err(err).Msgf("Snapshot server stop failed")
+	}
+}
+
+// ServeHTTP serves the api server read endpoints.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	segs := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.URL.Path == "/version":
+		writeJSON(w, http.StatusOK, s.snap.Version)
+	case r.URL.Path == "/api":
+		writeJSON(w, http.StatusOK, metav1.APIVersions{
+			TypeMeta: metav1.TypeMeta{Kind: "APIVersions"},
+			Versions: []string{"v1"},
+		})
+	case r.URL.Path == "/apis":
+		writeJSON(w, http.StatusOK, s.groups())
+	case segs[0] == "api" && len(segs) >= 2:
+		s.serveGroupVersion(w, r, schema.GroupVersion{Version: segs[1]}, segs[2:])
+	case segs[0] == "apis" && len(segs) >= 3:
+		s.serveGroupVersion(w, r, schema.GroupVersion{Group: segs[1], Version: segs[2]}, segs[3:])
+	default:
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found", r.URL.Path))
+	}
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (s *Server) groups() metav1.APIGroupList {
+	gg := metav1.APIGroupList{TypeMeta: metav1.TypeMeta{Kind: "APIGroupList", APIVersion: "v1"}}
+	index := make(map[string]int)
+	for _, gv := range s.snap.GroupVersions() {
+		if gv.Group == "" {
+			continue
+		}
+		v := metav1.GroupVersionForDiscovery{GroupVersion: gv.String(), Version: gv.Version}
+		i, ok := index[gv.Group]
+		if !ok {
+			index[gv.Group] = len(gg.Groups)
+			gg.Groups = append(gg.Groups, metav1.APIGroup{Name: gv.Group, PreferredVersion: v})
+			i = len(gg.Groups) - 1
+		}
+		gg.Groups[i].Versions = append(gg.Groups[i].Versions, v)
+	}
+
+	return gg
+}
+
+func (s *Server) serveGroupVersion(w http.ResponseWriter, r *http.Request, gv schema.GroupVersion, segs []string) {
+	if gv.Group == authorizationv1.GroupName && len(segs) == 1 && segs[0] == "selfsubjectaccessreviews" {
+		s.review(w, r)
+		return
+	}
+	if r.Method != http.MethodGet {
+		writeStatus(w, http.StatusMethodNotAllowed, metav1.StatusReasonMethodNotAllowed, readOnlyMsg)
+		return
+	}
+	if len(segs) == 0 {
+		rr, ok := s.snap.Resources(gv)
+		if !ok {
+			writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found", gv))
+			return
+		}
+		writeJSON(w, http.StatusOK, metav1.APIResourceList{
+			TypeMeta:     metav1.TypeMeta{Kind: "APIResourceList", APIVersion: "v1"},
+			GroupVersion: gv.String(),
+			APIResources: rr,
+		})
+		return
+	}
+
+	var ns string
+	if len(segs) >= 3 && segs[0] == "namespaces" {
+		ns, segs = segs[1], segs[2:]
+	}
+	gvr := gv.WithResource(segs[0])
+	if _, ok := s.snap.Resource(gvr); !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("resource %s not found", gvr))
+		return
+	}
+
+	switch len(segs) {
+	case 1:
+		s.list(w, r, gvr, ns)
+	case 2:
+		s.get(w, r, gvr, ns, segs[1])
+	case 3:
+		if gvr.Resource == "pods" && segs[2] == "log" {
+			s.logs(w, r, ns, segs[1])
+			return
+		}
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("subresource %s not found", segs[2]))
+	default:
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s not found", r.URL.Path))
+	}
+}
+
+func (s *Server) list(w http.ResponseWriter, r *http.Request, gvr schema.GroupVersionResource, ns string) {
+	if w, _ := strconv.ParseBool(r.URL.Query().Get("watch")); w {
+		s.watch(r)
+		return
+	}
+	lsel, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	fsel, err := fields.ParseSelector(r.URL.Query().Get("fieldSelector"))
+	if err != nil {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+
+	var oo []*unstructured.Unstructured
+	for _, o := range s.snap.List(gvr, ns) {
+		if lsel.Matches(labels.Set(o.GetLabels())) && fsel.Matches(fieldsFor(o, fsel)) {
+			oo = append(oo, o)
+		}
+	}
+	if wantsTable(r) {
+		writeJSON(w, http.StatusOK, toTable(oo))
+		return
+	}
+
+	res, _ := s.snap.Resource(gvr)
+	items := make([]interface{}, 0, len(oo))
+	for _, o := range oo {
+		items = append(items, o.Object)
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{
+		"apiVersion": gvr.GroupVersion().String(),
+		"kind":       res.Kind + "List",
+		"metadata":   map[string]interface{}{"resourceVersion": "1"},
+		"items":      items,
+	})
+}
+
+// watch holds the connection open as a snapshot never changes.
+func (s *Server) watch(r *http.Request) {
+	<-r.Context().Done()
+}
+
+func (s *Server) get(w http.ResponseWriter, r *http.Request, gvr schema.GroupVersionResource, ns, n string) {
+	o, ok := s.snap.Get(gvr, ns, n)
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("%s %q not found", gvr.Resource, n))
+		return
+	}
+	if wantsTable(r) {
+		writeJSON(w, http.StatusOK, toTable([]*unstructured.Unstructured{o}))
+		return
+	}
+	writeJSON(w, http.StatusOK, o.Object)
+}
+
+func (s *Server) logs(w http.ResponseWriter, r *http.Request, ns, po string) {
+	q := r.URL.Query()
+	raw, ok := s.snap.Logs(ns, po, q.Get("container"))
+	if !ok {
+		writeStatus(w, http.StatusNotFound, metav1.StatusReasonNotFound, fmt.Sprintf("no logs for pod %s/%s in snapshot", ns, po))
+		return
+	}
+	if tail, err := strconv.Atoi(q.Get("tailLines")); err == nil && tail >= 0 {
+		raw = tailLines(raw, tail)
+	}
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(raw); err != nil {
+		log.Warn().Err(err).Msgf("Snapshot logs write failed")
+		return
+	}
+	if follow, _ := strconv.ParseBool(q.Get("follow")); follow {
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		<-r.Context().Done()
+	}
+}
+
+// review grants read access and denies any writes.
+func (s *Server) review(w http.ResponseWriter, r *http.Request) {
+	var sar authorizationv1.SelfSubjectAccessReview
+	if err := json.NewDecoder(r.Body).Decode(&sar); err != nil && !errors.Is(err, io.EOF) {
+		writeStatus(w, http.StatusBadRequest, metav1.StatusReasonBadRequest, err.Error())
+		return
+	}
+	sar.TypeMeta = metav1.TypeMeta{Kind: "SelfSubjectAccessReview", APIVersion: authorizationv1.SchemeGroupVersion.String()}
+	if a := sar.Spec.ResourceAttributes; a != nil {
+		_, sar.Status.Allowed = readVerbs[a.Verb]
+	}
+	if !sar.Status.Allowed {
+		sar.Status.Reason = readOnlyMsg
+	}
+	writeJSON(w, http.StatusCreated, sar)
+}
+
+func wantsTable(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), "as=Table")
+}
+
+func toTable(oo []*unstructured.Unstructured) metav1.Table {
+	t := metav1.Table{
+		TypeMeta: metav1.TypeMeta{Kind: "Table", APIVersion: "meta.k8s.io/v1"},
+		ColumnDefinitions: []metav1.TableColumnDefinition{
+			{Name: "Name", Type: "string", Format: "name"},
+			{Name: "Age", Type: "date"},
+		},
+	}
+	for _, o := range oo {
+		raw, err := json.Marshal(o.Object)
+		if err != nil {
+			continue
+		}
+		age := "<unknown>"
+		if ts := o.GetCreationTimestamp(); !ts.IsZero() {
+			age = duration.HumanDuration(time.Since(ts.Time))
+		}
+		t.Rows = append(t.Rows, metav1.TableRow{
+			Cells:  []interface{}{o.GetName(), age},
+			Object: runtime.RawExtension{Raw: raw},
+		})
+	}
+
+	return t
+}
+
+// fieldsFor returns the object field values referenced by a selector.
+func fieldsFor(o *unstructured.Unstructured, sel fields.Selector) fields.Set {
+	set := make(fields.Set)
+	for _, r := range sel.Requirements() {
+		v, ok, _ := unstructured.NestedFieldNoCopy(o.Object, strings.Split(r.Field, ".")...)
+		if !ok {
+			set[r.Field] = ""
+			continue
+		}
+		set[r.Field] = fmt.Sprintf("%v", v)
+	}
+
+	return set
+}
+
+func tailLines(raw []byte, n int) []byte {
+	ll := bytes.SplitAfter(raw, []byte("\n"))
+	if len(ll) > 0 && len(ll[len(ll)-1]) == 0 {
+		ll = ll[:len(ll)-1]
+	}
+	if n >= len(ll) {
+		return raw
+	}
+
+	return bytes.Join(ll[len(ll)-n:], nil)
+}
+
+func writeStatus(w http.ResponseWriter, code int, reason metav1.StatusReason, msg string) {
+	writeJSON(w, code, metav1.Status{
+		TypeMeta: metav1.TypeMeta{Kind: "Status", APIVersion: "v1"},
+		Status:   metav1.StatusFailure,
+		Message:  msg,
+		Reason:   reason,
+		Code:     int32(code),
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Warn().Err(err).Msgf("Snapshot response write failed")
+	}
+}
@@ -0,0 +1,120 @@
+package snapshot_test
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestServerDiscovery(t *testing.T) {
+	srv := newTestServer(t)
+
+	var gg metav1.APIGroupList
+	assert.Equal(t, http.StatusOK, getJSON(t, srv, "/apis", &gg))
+	names := make([]string, 0, len(gg.Groups))
+	for _, g := range gg.Groups {
+		names = append(names, g.Name)
+	}
+	assert.Contains(t, names, "apps")
+
+	var rl metav1.APIResourceList
+	assert.Equal(t, http.StatusOK, getJSON(t, srv, "/apis/apps/v1", &rl))
+	assert.Equal(t, "apps/v1", rl.GroupVersion)
+}
+
+func TestServerList(t *testing.T) {
+	uu := map[string]struct {
+		path  string
+		count int
+	}{
+		"all":      {path: "/api/v1/pods", count: 2},
+		"ns":       {path: "/api/v1/namespaces/default/pods", count: 1},
+		"labels":   {path: "/api/v1/pods?labelSelector=app%3Dblee", count: 1},
+		"fields":   {path: "/api/v1/pods?fieldSelector=spec.nodeName%3Dn2", count: 1},
+		"noMatch":  {path: "/api/v1/pods?fieldSelector=status.phase%3DPending", count: 0},
+		"baseline": {path: "/api/v1/configmaps", count: 0},
+	}
+
+	srv := newTestServer(t)
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			var l unstructured.UnstructuredList
+			assert.Equal(t, http.StatusOK, getJSON(t, srv, u.path, &l.Object))
+			items, _ := l.Object["items"].([]interface{})
+			assert.Equal(t, u.count, len(items))
+		})
+	}
+}
+
+func TestServerGet(t *testing.T) {
+	srv := newTestServer(t)
+
+	var o map[string]interface{}
+	assert.Equal(t, http.StatusOK, getJSON(t, srv, "/apis/apps/v1/namespaces/default/deployments/d1", &o))
+	assert.Equal(t, "Deployment", o["kind"])
+	assert.Equal(t, http.StatusNotFound, getJSON(t, srv, "/apis/apps/v1/namespaces/default/deployments/d2", &o))
+}
+
+func TestServerLogs(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := http.Get(srv.URL + "/api/v1/namespaces/default/pods/p1/log?container=c1&tailLines=2")
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	raw, err := io.ReadAll(resp.Body)
+	assert.Nil(t, err)
+	assert.Equal(t, "line 2\nline 3\n", string(raw))
+}
+
+func TestServerReadOnly(t *testing.T) {
+	srv := newTestServer(t)
+
+	resp, err := http.Post(srv.URL+"/api/v1/namespaces/default/pods", "application/json", strings.NewReader("{}"))
+	assert.Nil(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+
+	uu := map[string]bool{"list": true, "delete": false}
+	for verb, allowed := range uu {
+		body := `{"spec":{"resourceAttributes":{"verb":"` + verb + `","resource":"pods"}}}`
+		resp, err := http.Post(srv.URL+"/apis/authorization.k8s.io/v1/selfsubjectaccessreviews", "application/json", strings.NewReader(body))
+		assert.Nil(t, err)
+		var sar struct {
+			Status struct {
+				Allowed bool `json:"allowed"`
+			} `json:"status"`
+		}
+		assert.Nil(t, json.NewDecoder(resp.Body).Decode(&sar))
+		resp.Body.Close()
+		assert.Equal(t, allowed, sar.Status.Allowed, verb)
+	}
+}
+
+// Helpers...
+
+func newTestServer(t *testing.T) *httptest.Server {
+	s, err := snapshot.Load("testdata/dump.txt")
+	assert.Nil(t, err)
+	srv := httptest.NewServer(snapshot.NewServer(s))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func getJSON(t *testing.T, srv *httptest.Server, path string, v interface{}) int {
+	resp, err := http.Get(srv.URL + path)
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(v))
+
+	return resp.StatusCode
+}
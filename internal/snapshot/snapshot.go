@@ -0,0 +1,392 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/rs/zerolog/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+const (
+	// VersionFile tracks the snapshot cluster version entry.
+	VersionFile = "version.json"
+	// DiscoveryFile tracks the snapshot api resources entry.
+	DiscoveryFile = "discovery.json"
+	// LogsFile tracks a container logs entry ie ns/pod/container/logs.txt.
+	LogsFile = "logs.txt"
+)
+
+const snapshotVersion = "snapshot"
+
+var (
+	startLogsRX = regexp.MustCompile(`^==== START logs for container (\S+) of pod (\S+)/(\S+) ====$`)
+	endLogsRX   = regexp.MustCompile(`^==== END logs for container \S+ of pod \S+ ====$`)
+)
+
+// Snapshot represents a read-only cluster state loaded from a dump.
+type Snapshot struct {
+	Name    string
+	Version version.Info
+
+	resources map[schema.GroupVersion][]metav1.APIResource
+	kinds     map[schema.GroupVersionKind]metav1.APIResource
+	objects   map[schema.GroupVersionResource][]*unstructured.Unstructured
+	logs      map[string][]byte
+	pending   []*unstructured.Unstructured
+}
+
+// Load loads a snapshot from a `kubectl cluster-info dump` output file or
+// directory or from a k9s snapshot archive.
+func Load(p string) (*Snapshot, error) {
+	fi, err := os.Stat(p)
+	if err != nil {
+		return nil, err
+	}
+	s := newSnapshot(strings.TrimSuffix(strings.TrimSuffix(filepath.Base(p), ".tgz"), ".tar.gz"))
+
+	switch {
+	case fi.IsDir():
+		err = s.loadDir(p)
+	case strings.HasSuffix(p, ".tar.gz") || strings.HasSuffix(p, ".tgz"):
+		err = s.loadArchive(p)
+	default:
+		var raw []byte
+		if raw, err = os.ReadFile(p); err == nil {
+			err = s.loadDump(raw)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(s.pending) == 0 {
+		return nil, fmt.Errorf("no resources found in snapshot %s", p)
+	}
+	s.index()
+
+	return s, nil
+}
+
+// GroupVersions returns all the snapshot group versions.
+func (s *Snapshot) GroupVersions() []schema.GroupVersion {
+	gg := make([]schema.GroupVersion, 0, len(s.resources))
+	for gv := range s.resources {
+		gg = append(gg, gv)
+	}
+	sort.Slice(gg, func(i, j int) bool {
+		return gg[i].String() < gg[j].String()
+	})
+
+	return gg
+}
+
+// Resources returns the resources for a given group version.
+func (s *Snapshot) Resources(gv schema.GroupVersion) ([]metav1.APIResource, bool) {
+	rr, ok := s.resources[gv]
+
+	return rr, ok
+}
+
+// Resource returns a given resource definition.
+func (s *Snapshot) Resource(gvr schema.GroupVersionResource) (metav1.APIResource, bool) {
+	for _, r := range s.resources[gvr.GroupVersion()] {
+		if r.Name == gvr.Resource {
+			return r, true
+		}
+	}
+
+	return metav1.APIResource{}, false
+}
+
+// List returns all the objects of a given resource in a namespace. An empty
+// namespace returns all objects.
+func (s *Snapshot) List(gvr schema.GroupVersionResource, ns string) []*unstructured.Unstructured {
+	oo := make([]*unstructured.Unstructured, 0, len(s.objects[gvr]))
+	for _, o := range s.objects[gvr] {
+		if ns == "" || o.GetNamespace() == ns {
+			oo = append(oo, o)
+		}
+	}
+
+	return oo
+}
+
+// Get returns a given object.
+func (s *Snapshot) Get(gvr schema.GroupVersionResource, ns, n string) (*unstructured.Unstructured, bool) {
+	for _, o := range s.objects[gvr] {
+		if o.GetNamespace() == ns && o.GetName() == n {
+			return o, true
+		}
+	}
+
+	return nil, false
+}
+
+// Logs returns a pod container logs.
+func (s *Snapshot) Logs(ns, po, co string) ([]byte, bool) {
+	if co != "" {
+		if l, ok := s.logs[path.Join(ns, po, co)]; ok {
+			return l, true
+		}
+	}
+	l, ok := s.logs[path.Join(ns, po)]
+
+	return l, ok
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func newSnapshot(n string) *Snapshot {
+	return &Snapshot{
+		Name:      n,
+		Version:   version.Info{GitVersion: snapshotVersion},
+		resources: make(map[schema.GroupVersion][]metav1.APIResource),
+		kinds:     make(map[schema.GroupVersionKind]metav1.APIResource),
+		objects:   make(map[schema.GroupVersionResource][]*unstructured.Unstructured),
+		logs:      make(map[string][]byte),
+	}
+}
+
+func (s *Snapshot) loadDir(dir string) error {
+	return filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil {
+			return err
+		}
+		raw, err := os.ReadFile(p)
+		if err != nil {
+			return err
+		}
+
+		return s.loadEntry(filepath.ToSlash(rel), raw)
+	})
+}
+
+func (s *Snapshot) loadArchive(p string) error {
+	f, err := os.Open(p)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := f.Close(); err != nil {
+			log.Error().Err(err).Msgf("Closing snapshot %s", p)
+		}
+	}()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	tr := tar.NewReader(gz)
+	for {
+		h, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if h.Typeflag != tar.TypeReg {
+			continue
+		}
+		raw, err := io.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+		if err := s.loadEntry(path.Clean(h.Name), raw); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Snapshot) loadEntry(p string, raw []byte) error {
+	switch base := path.Base(p); {
+	case base == VersionFile:
+		return json.Unmarshal(raw, &s.Version)
+	case base == DiscoveryFile:
+		var ll []metav1.APIResourceList
+		if err := json.Unmarshal(raw, &ll); err != nil {
+			return fmt.Errorf("invalid discovery %s: %w", p, err)
+		}
+		for _, l := range ll {
+			gv, err := schema.ParseGroupVersion(l.GroupVersion)
+			if err != nil {
+				return err
+			}
+			for _, r := range l.APIResources {
+				s.addResource(gv, r)
+			}
+		}
+		return nil
+	case base == LogsFile:
+		s.logs[path.Dir(p)] = raw
+		return nil
+	case strings.HasSuffix(base, ".json"), strings.HasSuffix(base, ".yaml"), strings.HasSuffix(base, ".yml"):
+		if err := s.loadDump(raw); err != nil {
+			return fmt.Errorf("invalid manifest %s: %w", p, err)
+		}
+	}
+
+	return nil
+}
+
+// loadDump decodes a stream of manifests and extracts inline container logs.
+func (s *Snapshot) loadDump(raw []byte) error {
+	var (
+		docs bytes.Buffer
+		logs *bytes.Buffer
+		key  string
+	)
+	sc := bufio.NewScanner(bytes.NewReader(raw))
+	sc.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+	for sc.Scan() {
+		line := sc.Text()
+		if m := startLogsRX.FindStringSubmatch(line); len(m) == 4 {
+			key, logs = path.Join(m[2], m[3], m[1]), new(bytes.Buffer)
+			continue
+		}
+		if logs != nil {
+			if endLogsRX.MatchString(line) {
+				s.logs[key], logs = logs.Bytes(), nil
+				continue
+			}
+			logs.WriteString(line + "\n")
+			continue
+		}
+		docs.WriteString(line + "\n")
+	}
+	if err := sc.Err(); err != nil {
+		return err
+	}
+
+	d := yaml.NewYAMLOrJSONDecoder(&docs, 4096)
+	for {
+		var m map[string]interface{}
+		if err := d.Decode(&m); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if m != nil {
+			s.addObject(&unstructured.Unstructured{Object: m})
+		}
+	}
+}
+
+func (s *Snapshot) addObject(o *unstructured.Unstructured) {
+	kind := o.GetKind()
+	if items, ok := o.Object["items"].([]interface{}); ok && strings.HasSuffix(kind, "List") {
+		for _, i := range items {
+			m, ok := i.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			item := &unstructured.Unstructured{Object: m}
+			if item.GetKind() == "" {
+				item.SetKind(strings.TrimSuffix(kind, "List"))
+			}
+			if item.GetAPIVersion() == "" {
+				item.SetAPIVersion(o.GetAPIVersion())
+			}
+			s.addObject(item)
+		}
+		return
+	}
+	if kind == "" || kind == "Status" || o.GetName() == "" {
+		return
+	}
+	s.pending = append(s.pending, o)
+}
+
+func (s *Snapshot) addResource(gv schema.GroupVersion, r metav1.APIResource) {
+	gvk := gv.WithKind(r.Kind)
+	if _, ok := s.kinds[gvk]; ok || strings.Contains(r.Name, "/") {
+		return
+	}
+	if len(r.Verbs) == 0 {
+		r.Verbs = metav1.Verbs{"get", "list", "watch"}
+	}
+	s.kinds[gvk] = r
+	s.resources[gv] = append(s.resources[gv], r)
+}
+
+// index resolves the objects resources, adds the baseline resources and the
+// namespaces referenced by objects.
+func (s *Snapshot) index() {
+	for _, o := range s.pending {
+		gvk := o.GroupVersionKind()
+		r, ok := s.kinds[gvk]
+		if !ok {
+			r = guessResource(gvk, o.GetNamespace() != "")
+			s.addResource(gvk.GroupVersion(), r)
+		}
+		gvr := gvk.GroupVersion().WithResource(r.Name)
+		s.objects[gvr] = append(s.objects[gvr], o)
+	}
+	s.pending = nil
+	if s.Version.GitVersion == snapshotVersion {
+		s.guessVersion()
+	}
+	for _, b := range baseline {
+		s.addResource(b.gv, b.res)
+	}
+
+	nsGVR := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	known := make(map[string]struct{})
+	for _, o := range s.objects[nsGVR] {
+		known[o.GetName()] = struct{}{}
+	}
+	var nn []string
+	for _, oo := range s.objects {
+		for _, o := range oo {
+			ns := o.GetNamespace()
+			if _, ok := known[ns]; ns == "" || ok {
+				continue
+			}
+			known[ns] = struct{}{}
+			nn = append(nn, ns)
+		}
+	}
+	sort.Strings(nn)
+	for _, n := range nn {
+		o := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Namespace",
+			"metadata":   map[string]interface{}{"name": n},
+			"status":     map[string]interface{}{"phase": "Active"},
+		}}
+		s.objects[nsGVR] = append(s.objects[nsGVR], o)
+	}
+}
+
+// guessVersion derives the cluster version from the nodes kubelet version.
+func (s *Snapshot) guessVersion() {
+	for _, o := range s.objects[schema.GroupVersionResource{Version: "v1", Resource: "nodes"}] {
+		v, ok, _ := unstructured.NestedString(o.Object, "status", "nodeInfo", "kubeletVersion")
+		if ok && v != "" {
+			s.Version.GitVersion = v
+			return
+		}
+	}
+}
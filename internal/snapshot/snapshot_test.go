@@ -0,0 +1,80 @@
+package snapshot_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/derailed/k9s/internal/snapshot"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+func TestLoadDump(t *testing.T) {
+	s, err := snapshot.Load("testdata/dump.txt")
+	assert.Nil(t, err)
+	assert.Equal(t, "v1.26.1", s.Version.GitVersion)
+
+	pods := schema.GroupVersionResource{Version: "v1", Resource: "pods"}
+	assert.Equal(t, 2, len(s.List(pods, "")))
+	assert.Equal(t, 1, len(s.List(pods, "default")))
+	po, ok := s.Get(pods, "default", "p1")
+	assert.True(t, ok)
+	assert.Equal(t, "Pod", po.GetKind())
+
+	dps := schema.GroupVersionResource{Group: "apps", Version: "v1", Resource: "deployments"}
+	assert.Equal(t, 1, len(s.List(dps, "")))
+
+	nss := schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+	assert.Equal(t, 2, len(s.List(nss, "")))
+
+	r, ok := s.Resource(schema.GroupVersionResource{Group: "batch", Version: "v1", Resource: "cronjobs"})
+	assert.True(t, ok)
+	assert.Equal(t, []string{"cj"}, r.ShortNames)
+
+	l, ok := s.Logs("default", "p1", "c1")
+	assert.True(t, ok)
+	assert.Equal(t, "line 1\nline 2\nline 3\n", string(l))
+	_, ok = s.Logs("default", "p1", "c2")
+	assert.False(t, ok)
+}
+
+func TestLoadEmpty(t *testing.T) {
+	_, err := snapshot.Load("testdata/empty.yaml")
+	assert.NotNil(t, err)
+}
+
+func TestWriterRoundTrip(t *testing.T) {
+	dir := t.TempDir()
+	p := filepath.Join(dir, "fred.tar.gz")
+	f, err := os.Create(p)
+	assert.Nil(t, err)
+
+	w := snapshot.NewWriter(f)
+	assert.Nil(t, w.WriteVersion(&version.Info{GitVersion: "v1.26.1"}))
+	assert.Nil(t, w.WriteDiscovery([]*metav1.APIResourceList{
+		{
+			GroupVersion: "fred.io/v1",
+			APIResources: []metav1.APIResource{{Name: "blees", Kind: "Blee", Namespaced: true}},
+		},
+	}))
+	blees := schema.GroupVersionResource{Group: "fred.io", Version: "v1", Resource: "blees"}
+	o := unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "fred.io/v1",
+		"kind":       "Blee",
+		"metadata":   map[string]interface{}{"name": "b1", "namespace": "ns1"},
+	}}
+	assert.Nil(t, w.WriteObjects(blees, "Blee", "ns1", []unstructured.Unstructured{o}))
+	assert.Nil(t, w.Close())
+	assert.Nil(t, f.Close())
+
+	s, err := snapshot.Load(p)
+	assert.Nil(t, err)
+	assert.Equal(t, "fred", s.Name)
+	assert.Equal(t, "v1.26.1", s.Version.GitVersion)
+	_, ok := s.Get(blees, "ns1", "b1")
+	assert.True(t, ok)
+}
@@ -0,0 +1,97 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/version"
+)
+
+// ClusterDir tracks the archive directory holding cluster scoped resources.
+const ClusterDir = "_cluster"
+
+// Writer writes a snapshot archive that can be browsed offline.
+type Writer struct {
+	gz  *gzip.Writer
+	tw  *tar.Writer
+	now time.Time
+}
+
+// NewWriter returns a new instance.
+func NewWriter(w io.Writer) *Writer {
+	gz := gzip.NewWriter(w)
+
+	return &Writer{gz: gz, tw: tar.NewWriter(gz), now: time.Now()}
+}
+
+// WriteVersion writes the cluster version.
+func (w *Writer) WriteVersion(v *version.Info) error {
+	return w.writeJSON(VersionFile, v)
+}
+
+// WriteDiscovery writes the api resources.
+func (w *Writer) WriteDiscovery(ll []*metav1.APIResourceList) error {
+	return w.writeJSON(DiscoveryFile, ll)
+}
+
+// WriteObjects writes a resource objects for a namespace as a list.
+func (w *Writer) WriteObjects(gvr schema.GroupVersionResource, kind, ns string, oo []unstructured.Unstructured) error {
+	if ns == "" {
+		ns = ClusterDir
+	}
+	n := gvr.Resource
+	if gvr.Group != "" {
+		n += "." + gvr.Group
+	}
+	items := make([]interface{}, 0, len(oo))
+	for _, o := range oo {
+		items = append(items, o.Object)
+	}
+
+	return w.writeJSON(path.Join(ns, n+".json"), map[string]interface{}{
+		"apiVersion": gvr.GroupVersion().String(),
+		"kind":       kind + "List",
+		"items":      items,
+	})
+}
+
+// Close flushes the archive.
+func (w *Writer) Close() error {
+	if err := w.tw.Close(); err != nil {
+		return err
+	}
+
+	return w.gz.Close()
+}
+
+func (w *Writer) writeJSON(p string, v interface{}) error {
+	raw, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return w.write(p, raw)
+}
+
+func (w *Writer) write(p string, raw []byte) error {
+	h := tar.Header{
+		Name:    strings.TrimPrefix(path.Clean(p), "/"),
+		Mode:    0600,
+		Size:    int64(len(raw)),
+		ModTime: w.now,
+	}
+	if err := w.tw.WriteHeader(&h); err != nil {
+		return err
+	}
+	_, err := w.tw.Write(raw)
+
+	return err
+}
@@ -22,6 +22,7 @@ type Component struct {
 	seriesColors               []tcell.Color
 	dimmed                     tcell.Style
 	id, legend                 string
+	mode                       GaugeMode
 	blur                       func(tcell.Key)
 	mx                         sync.RWMutex
 }
@@ -31,6 +32,7 @@ func NewComponent(id string) *Component {
 	return &Component{
 		Box:          tview.NewBox(),
 		id:           id,
+		mode:         GaugeBlocks,
 		noColor:      tcell.ColorDefault,
 		seriesColors: []tcell.Color{tview.Styles.PrimaryTextColor, tview.Styles.FocusColor},
 		dimmed:       tcell.StyleDefault.Background(tview.Styles.PrimitiveBackgroundColor).Foreground(tcell.ColorGray).Dim(true),
@@ -49,6 +51,13 @@ func (c *Component) SetBackgroundColor(color tcell.Color) {
 	c.dimmed = c.dimmed.Background(color)
 }
 
+// SetGaugeMode sets the chars used to render the component.
+func (c *Component) SetGaugeMode(m GaugeMode) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.mode = m
+}
+
 // ID returns the component ID.
 func (c *Component) ID() string {
 	return c.id
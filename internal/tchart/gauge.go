@@ -76,7 +76,7 @@ func (g *Gauge) Draw(sc tcell.Screen) {
 	mid := image.Point{X: rect.Min.X + rect.Dx()/2, Y: rect.Min.Y + rect.Dy()/2 - 1}
 	style := tcell.StyleDefault.Background(g.bgColor)
 	style = style.Foreground(tcell.ColorYellow)
-	sc.SetContent(mid.X, mid.Y, '⠔', nil, style)
+	sc.SetContent(mid.X, mid.Y, g.mode.ascii('⠔'), nil, style)
 
 	max := g.data.MaxDigits()
 	if max < g.resolution {
@@ -108,7 +108,7 @@ func (g *Gauge) drawNum(sc tcell.Screen, o image.Point, n number, style tcell.St
 	c1, _ := g.colorForSeries()
 	if n.ok {
 		style = style.Foreground(c1)
-		printDelta(sc, n.delta, o, style)
+		printDelta(sc, n.delta, o, style, g.mode)
 	}
 
 	dm, significant := NewDotMatrix(), n.val == 0
@@ -126,7 +126,7 @@ func (g *Gauge) drawNum(sc tcell.Screen, o image.Point, n number, style tcell.St
 	}
 	if !n.ok {
 		o.X++
-		printDelta(sc, n.delta, o, style)
+		printDelta(sc, n.delta, o, style, g.mode)
 	}
 }
 
@@ -135,9 +135,9 @@ func (g *Gauge) drawDial(sc tcell.Screen, m Matrix, o image.Point, style tcell.S
 		for c := 0; c < len(m[r]); c++ {
 			dot := m[r][c]
 			if dot == dots[0] {
-				sc.SetContent(o.X+c, o.Y+r, dots[1], nil, g.dimmed)
+				sc.SetContent(o.X+c, o.Y+r, g.mode.ascii(dots[1]), nil, g.dimmed)
 			} else {
-				sc.SetContent(o.X+c, o.Y+r, dot, nil, style)
+				sc.SetContent(o.X+c, o.Y+r, g.mode.ascii(dot), nil, style)
 			}
 		}
 	}
@@ -162,13 +162,13 @@ func computeDelta(d1, d2 int64) delta {
 	}
 }
 
-func printDelta(sc tcell.Screen, d delta, o image.Point, s tcell.Style) {
+func printDelta(sc tcell.Screen, d delta, o image.Point, s tcell.Style, m GaugeMode) {
 	s = s.Dim(false)
 	// nolint:exhaustive
 	switch d {
 	case DeltaLess:
-		sc.SetContent(o.X-1, o.Y+1, '↓', nil, s)
+		sc.SetContent(o.X-1, o.Y+1, m.ascii('↓'), nil, s)
 	case DeltaMore:
-		sc.SetContent(o.X-1, o.Y+1, '↑', nil, s)
+		sc.SetContent(o.X-1, o.Y+1, m.ascii('↑'), nil, s)
 	}
 }
@@ -0,0 +1,183 @@
+package tchart
+
+import (
+	"os"
+	"runtime"
+	"strings"
+)
+
+// GaugeMode represents a gauge rendering mode.
+type GaugeMode int
+
+const (
+	// GaugeASCII renders gauges using plain ascii chars.
+	GaugeASCII GaugeMode = iota
+
+	// GaugeBlocks renders gauges using unicode eighth blocks.
+	GaugeBlocks
+
+	// GaugeBraille renders gauges using braille dots.
+	GaugeBraille
+)
+
+// Gauge mode names.
+const (
+	GaugeAutoName    = "auto"
+	GaugeASCIIName   = "ascii"
+	GaugeBlocksName  = "blocks"
+	GaugeBrailleName = "braille"
+)
+
+var (
+	asciiSparks   = []rune{'.', ':', '-', '=', '+', '*', '%', '#'}
+	brailleSparks = []rune{'⡀', '⣀', '⣄', '⣤', '⣦', '⣶', '⣷', '⣿'}
+	blockBars     = []rune{'▏', '▎', '▍', '▌', '▋', '▊', '▉', '█'}
+)
+
+// ParseGaugeMode returns the gauge mode for a given name. Unknown names and auto
+// detect whether the terminal supports unicode.
+func ParseGaugeMode(s string) GaugeMode {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case GaugeASCIIName:
+		return GaugeASCII
+	case GaugeBlocksName:
+		return GaugeBlocks
+	case GaugeBrailleName:
+		return GaugeBraille
+	default:
+		return detectGaugeMode(os.Getenv, runtime.GOOS)
+	}
+}
+
+// PercentBar renders a percentage as a bar of a given width.
+func PercentBar(perc, width int, m GaugeMode) string {
+	if width <= 0 {
+		return ""
+	}
+	if perc < 0 {
+		perc = 0
+	}
+	if perc > 100 {
+		perc = 100
+	}
+
+	var (
+		steps = m.barSteps()
+		units = (perc*width*steps + 50) / 100
+		b     strings.Builder
+	)
+	for i := 0; i < width; i++ {
+		switch {
+		case units >= steps:
+			b.WriteRune(m.barRune(steps))
+			units -= steps
+		case units > 0:
+			b.WriteRune(m.barRune(units))
+			units = 0
+		default:
+			b.WriteRune(m.barRune(0))
+		}
+	}
+
+	return b.String()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func (m GaugeMode) barSteps() int {
+	switch m {
+	case GaugeBlocks:
+		return len(blockBars)
+	case GaugeBraille:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// barRune returns the bar cell for a given number of filled steps.
+func (m GaugeMode) barRune(n int) rune {
+	switch m {
+	case GaugeBlocks:
+		if n == 0 {
+			return ' '
+		}
+		return blockBars[n-1]
+	case GaugeBraille:
+		return []rune{'⣀', '⡇', '⣿'}[n]
+	default:
+		if n == 0 {
+			return '.'
+		}
+		return '#'
+	}
+}
+
+// spark converts a sparkline block to the mode equivalent.
+func (m GaugeMode) spark(r rune) rune {
+	var set []rune
+	switch m {
+	case GaugeASCII:
+		set = asciiSparks
+	case GaugeBraille:
+		set = brailleSparks
+	default:
+		return r
+	}
+	for i, s := range sparks {
+		if s == r {
+			return set[i]
+		}
+	}
+
+	return r
+}
+
+// ascii converts a dial rune to its ascii equivalent.
+func (m GaugeMode) ascii(r rune) rune {
+	if m != GaugeASCII {
+		return r
+	}
+	switch r {
+	case h, lh, rh:
+		return '-'
+	case v, hv, lv:
+		return '|'
+	case tl, tr, bl, br, teeL, teeR:
+		return '+'
+	case '⠂', '⠔':
+		return ':'
+	case '▤', '▥':
+		return '#'
+	case '↑':
+		return '^'
+	case '↓':
+		return 'v'
+	default:
+		return r
+	}
+}
+
+// detectGaugeMode checks whether the terminal is likely to render unicode.
+func detectGaugeMode(getenv func(string) string, goos string) GaugeMode {
+	switch strings.ToLower(getenv("TERM")) {
+	case "dumb", "linux", "vt100", "vt220":
+		return GaugeASCII
+	}
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		l := strings.ToLower(getenv(env))
+		if l == "" {
+			continue
+		}
+		if strings.Contains(l, "utf-8") || strings.Contains(l, "utf8") {
+			return GaugeBlocks
+		}
+		return GaugeASCII
+	}
+	if goos == "windows" && getenv("WT_SESSION") != "" {
+		return GaugeBlocks
+	}
+
+	return GaugeASCII
+}
@@ -0,0 +1,73 @@
+package tchart
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDetectGaugeMode(t *testing.T) {
+	uu := map[string]struct {
+		env  map[string]string
+		goos string
+		e    GaugeMode
+	}{
+		"none":     {goos: "linux", e: GaugeASCII},
+		"utf8":     {env: map[string]string{"LANG": "en_US.UTF-8"}, goos: "linux", e: GaugeBlocks},
+		"lcAll":    {env: map[string]string{"LC_ALL": "C", "LANG": "en_US.UTF-8"}, goos: "darwin", e: GaugeASCII},
+		"ctype":    {env: map[string]string{"LC_CTYPE": "fr_FR.utf8"}, goos: "linux", e: GaugeBlocks},
+		"console":  {env: map[string]string{"TERM": "linux", "LANG": "en_US.UTF-8"}, goos: "linux", e: GaugeASCII},
+		"terminal": {env: map[string]string{"WT_SESSION": "1"}, goos: "windows", e: GaugeBlocks},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			getenv := func(k string) string { return u.env[k] }
+			assert.Equal(t, u.e, detectGaugeMode(getenv, u.goos))
+		})
+	}
+}
+
+func TestParseGaugeMode(t *testing.T) {
+	assert.Equal(t, GaugeASCII, ParseGaugeMode("ascii"))
+	assert.Equal(t, GaugeBlocks, ParseGaugeMode(" Blocks"))
+	assert.Equal(t, GaugeBraille, ParseGaugeMode("braille"))
+}
+
+func TestPercentBar(t *testing.T) {
+	uu := map[string]struct {
+		perc, width int
+		m           GaugeMode
+		e           string
+	}{
+		"zero":         {perc: 0, width: 4, m: GaugeBlocks, e: "    "},
+		"full":         {perc: 100, width: 4, m: GaugeBlocks, e: "████"},
+		"partial":      {perc: 30, width: 4, m: GaugeBlocks, e: "█▎  "},
+		"over":         {perc: 150, width: 2, m: GaugeBlocks, e: "██"},
+		"under":        {perc: -5, width: 2, m: GaugeASCII, e: ".."},
+		"ascii":        {perc: 50, width: 4, m: GaugeASCII, e: "##.."},
+		"braille":      {perc: 40, width: 4, m: GaugeBraille, e: "⣿⡇⣀⣀"},
+		"brailleFull":  {perc: 100, width: 2, m: GaugeBraille, e: "⣿⣿"},
+		"noWidth":      {perc: 50, m: GaugeBlocks},
+		"blocksRounds": {perc: 1, width: 10, m: GaugeBlocks, e: "▏         "},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, PercentBar(u.perc, u.width, u.m))
+		})
+	}
+}
+
+func TestGaugeModeRunes(t *testing.T) {
+	assert.Equal(t, '▃', GaugeBlocks.spark('▃'))
+	assert.Equal(t, '-', GaugeASCII.spark('▃'))
+	assert.Equal(t, '⣄', GaugeBraille.spark('▃'))
+
+	assert.Equal(t, h, GaugeBlocks.ascii(h))
+	assert.Equal(t, '-', GaugeASCII.ascii(h))
+	assert.Equal(t, '+', GaugeASCII.ascii(tl))
+	assert.Equal(t, '^', GaugeASCII.ascii('↑'))
+}
@@ -122,14 +122,14 @@ func (s *SparkLine) drawBlock(r image.Rectangle, screen tcell.Screen, x, y int,
 
 	zeroY := r.Max.Y - r.Dy()
 	for i := 0; i < b.full; i++ {
-		screen.SetContent(x, y, sparks[len(sparks)-1], nil, style)
+		screen.SetContent(x, y, s.mode.spark(sparks[len(sparks)-1]), nil, style)
 		y--
 		if y <= zeroY {
 			break
 		}
 	}
 	if b.partial != 0 {
-		screen.SetContent(x, y, b.partial, nil, style)
+		screen.SetContent(x, y, s.mode.spark(b.partial), nil, style)
 	}
 }
 
@@ -0,0 +1,238 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	tracesPath     = "/v1/traces"
+	exportInterval = 5 * time.Second
+	exportTimeout  = 10 * time.Second
+	maxBatch       = 512
+	queueSize      = 2048
+
+	// OTLP span kind and status codes.
+	spanKindInternal = 1
+	statusError      = 2
+)
+
+// exporter batches spans and posts them to an OTLP/HTTP collector using the json encoding.
+type exporter struct {
+	opts  Options
+	url   string
+	queue chan *Span
+	done  chan struct{}
+	wg    sync.WaitGroup
+	once  sync.Once
+}
+
+func newExporter(opts Options) *exporter {
+	return &exporter{
+		opts:  opts,
+		url:   opts.Endpoint + tracesPath,
+		queue: make(chan *Span, queueSize),
+		done:  make(chan struct{}),
+	}
+}
+
+// enqueue queues a span for export. Spans are dropped when the queue is full so tracing
+// never stalls the ui.
+func (e *exporter) enqueue(s *Span) {
+	select {
+	case e.queue <- s:
+	default:
+	}
+}
+
+func (e *exporter) start() {
+	e.wg.Add(1)
+	go e.run()
+}
+
+func (e *exporter) run() {
+	defer e.wg.Done()
+
+	ticker := time.NewTicker(exportInterval)
+	defer ticker.Stop()
+	batch := make([]*Span, 0, maxBatch)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := e.export(batch); err != nil {
+			log.Warn().Err(err).Msgf("Exporting %d spans failed", len(batch))
+		}
+		batch = batch[:0]
+	}
+	for {
+		select {
+		case s := <-e.queue:
+			batch = append(batch, s)
+			if len(batch) >= maxBatch {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case <-e.done:
+			for {
+				select {
+				case s := <-e.queue:
+					batch = append(batch, s)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+func (e *exporter) shutdown() {
+	e.once.Do(func() {
+		close(e.done)
+	})
+	e.wg.Wait()
+}
+
+func (e *exporter) export(ss []*Span) error {
+	raw, err := json.Marshal(e.payload(ss))
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), exportTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, e.url, bytes.NewReader(raw))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range e.opts.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("collector %s responded %s", e.url, resp.Status)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// OTLP json encoding...
+
+type (
+	otlpTraces struct {
+		ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+	}
+
+	otlpResourceSpans struct {
+		Resource   otlpResource     `json:"resource"`
+		ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+	}
+
+	otlpResource struct {
+		Attributes []otlpAttr `json:"attributes"`
+	}
+
+	otlpScopeSpans struct {
+		Scope otlpScope  `json:"scope"`
+		Spans []otlpSpan `json:"spans"`
+	}
+
+	otlpScope struct {
+		Name    string `json:"name"`
+		Version string `json:"version,omitempty"`
+	}
+
+	otlpSpan struct {
+		TraceID      string     `json:"traceId"`
+		SpanID       string     `json:"spanId"`
+		ParentSpanID string     `json:"parentSpanId,omitempty"`
+		Name         string     `json:"name"`
+		Kind         int        `json:"kind"`
+		Start        string     `json:"startTimeUnixNano"`
+		End          string     `json:"endTimeUnixNano"`
+		Attributes   []otlpAttr `json:"attributes,omitempty"`
+		Status       otlpStatus `json:"status"`
+	}
+
+	otlpStatus struct {
+		Code    int    `json:"code,omitempty"`
+		Message string `json:"message,omitempty"`
+	}
+
+	otlpAttr struct {
+		Key   string    `json:"key"`
+		Value otlpValue `json:"value"`
+	}
+
+	otlpValue struct {
+		StringValue string `json:"stringValue"`
+	}
+)
+
+func (e *exporter) payload(ss []*Span) otlpTraces {
+	spans := make([]otlpSpan, 0, len(ss))
+	for _, s := range ss {
+		o := otlpSpan{
+			TraceID:      s.traceID,
+			SpanID:       s.spanID,
+			ParentSpanID: s.parentID,
+			Name:         s.name,
+			Kind:         spanKindInternal,
+			Start:        strconv.FormatInt(s.start.UnixNano(), 10),
+			End:          strconv.FormatInt(s.end.UnixNano(), 10),
+			Attributes:   otlpAttrs(s.attrs),
+		}
+		if s.err != "" {
+			o.Status = otlpStatus{Code: statusError, Message: s.err}
+		}
+		spans = append(spans, o)
+	}
+
+	return otlpTraces{
+		ResourceSpans: []otlpResourceSpans{
+			{
+				Resource: otlpResource{
+					Attributes: otlpAttrs([]Attr{
+						String("service.name", e.opts.ServiceName),
+						String("service.version", e.opts.Version),
+					}),
+				},
+				ScopeSpans: []otlpScopeSpans{
+					{
+						Scope: otlpScope{Name: "github.com/derailed/k9s", Version: e.opts.Version},
+						Spans: spans,
+					},
+				},
+			},
+		},
+	}
+}
+
+func otlpAttrs(aa []Attr) []otlpAttr {
+	if len(aa) == 0 {
+		return nil
+	}
+	oo := make([]otlpAttr, 0, len(aa))
+	for _, a := range aa {
+		oo = append(oo, otlpAttr{Key: a.Key, Value: otlpValue{StringValue: a.Value}})
+	}
+
+	return oo
+}
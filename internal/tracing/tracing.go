@@ -0,0 +1,136 @@
+// Package tracing records k9s operations as OpenTelemetry spans and exports them to an
+// OTLP/HTTP collector.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+)
+
+type spanKey struct{}
+
+var (
+	active *exporter
+	mx     sync.RWMutex
+)
+
+// Options tracks the tracing options.
+type Options struct {
+	Endpoint    string
+	ServiceName string
+	Version     string
+	Headers     map[string]string
+}
+
+// Attr represents a span attribute.
+type Attr struct {
+	Key, Value string
+}
+
+// String returns a string attribute.
+func String(k, v string) Attr {
+	return Attr{Key: k, Value: v}
+}
+
+// Int returns an integer attribute.
+func Int(k string, v int) Attr {
+	return Attr{Key: k, Value: strconv.Itoa(v)}
+}
+
+// Init starts exporting spans. The returned function flushes pending spans and stops
+// the export.
+func Init(opts Options) func() {
+	e := newExporter(opts)
+	e.start()
+
+	mx.Lock()
+	active = e
+	mx.Unlock()
+
+	return func() {
+		mx.Lock()
+		active = nil
+		mx.Unlock()
+		e.shutdown()
+	}
+}
+
+// Enabled checks if spans are being exported.
+func Enabled() bool {
+	mx.RLock()
+	defer mx.RUnlock()
+
+	return active != nil
+}
+
+// Span represents a timed operation. A nil span is a no op.
+type Span struct {
+	traceID, spanID, parentID string
+	name                      string
+	start, end                time.Time
+	attrs                     []Attr
+	err                       string
+	exporter                  *exporter
+}
+
+// Start starts a span as a child of the context span if any. Spans are only recorded
+// when tracing is enabled.
+func Start(ctx context.Context, name string, attrs ...Attr) (context.Context, *Span) {
+	mx.RLock()
+	e := active
+	mx.RUnlock()
+	if e == nil {
+		return ctx, nil
+	}
+
+	s := Span{
+		name:     name,
+		start:    time.Now(),
+		spanID:   newID(8),
+		attrs:    attrs,
+		exporter: e,
+	}
+	if p, ok := ctx.Value(spanKey{}).(*Span); ok && p != nil {
+		s.traceID, s.parentID = p.traceID, p.spanID
+	} else {
+		s.traceID = newID(16)
+	}
+
+	return context.WithValue(ctx, spanKey{}, &s), &s
+}
+
+// SetAttrs adds attributes to the span.
+func (s *Span) SetAttrs(attrs ...Attr) {
+	if s == nil {
+		return
+	}
+	s.attrs = append(s.attrs, attrs...)
+}
+
+// RecordError flags the span as failed.
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.err = err.Error()
+}
+
+// End completes the span and queues it for export.
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.end = time.Now()
+	s.exporter.enqueue(s)
+}
+
+func newID(n int) string {
+	b := make([]byte, n)
+	_, _ = rand.Read(b)
+
+	return hex.EncodeToString(b)
+}
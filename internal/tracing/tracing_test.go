@@ -0,0 +1,81 @@
+package tracing_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/derailed/k9s/internal/tracing"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStartDisabled(t *testing.T) {
+	assert.False(t, tracing.Enabled())
+
+	ctx, s := tracing.Start(context.Background(), "fred")
+	assert.Nil(t, s)
+	assert.Equal(t, context.Background(), ctx)
+	s.SetAttrs(tracing.String("a", "b"))
+	s.RecordError(errors.New("boom"))
+	s.End()
+}
+
+func TestExport(t *testing.T) {
+	var (
+		mx      sync.Mutex
+		payload map[string]interface{}
+		header  string
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mx.Lock()
+		defer mx.Unlock()
+		assert.Equal(t, "/v1/traces", r.URL.Path)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		header = r.Header.Get("X-Token")
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&payload))
+	}))
+	defer srv.Close()
+
+	stop := tracing.Init(tracing.Options{
+		Endpoint:    srv.URL,
+		ServiceName: "k9s",
+		Version:     "v0.27.4",
+		Headers:     map[string]string{"X-Token": "blee"},
+	})
+	assert.True(t, tracing.Enabled())
+
+	ctx, parent := tracing.Start(context.Background(), "table.refresh", tracing.String("gvr", "v1/pods"))
+	_, child := tracing.Start(ctx, "dao.list")
+	child.SetAttrs(tracing.Int("count", 10))
+	child.RecordError(errors.New("boom"))
+	child.End()
+	parent.End()
+	stop()
+	assert.False(t, tracing.Enabled())
+
+	mx.Lock()
+	defer mx.Unlock()
+	assert.Equal(t, "blee", header)
+	rs := payload["resourceSpans"].([]interface{})[0].(map[string]interface{})
+	res := rs["resource"].(map[string]interface{})["attributes"].([]interface{})
+	assert.Equal(t, "service.name", res[0].(map[string]interface{})["key"])
+
+	ss := rs["scopeSpans"].([]interface{})[0].(map[string]interface{})["spans"].([]interface{})
+	assert.Equal(t, 2, len(ss))
+	c, p := ss[0].(map[string]interface{}), ss[1].(map[string]interface{})
+	assert.Equal(t, "dao.list", c["name"])
+	assert.Equal(t, "table.refresh", p["name"])
+	assert.Equal(t, p["traceId"], c["traceId"])
+	assert.Equal(t, p["spanId"], c["parentSpanId"])
+	assert.Len(t, p["traceId"], 32)
+	assert.Len(t, p["spanId"], 16)
+	assert.Nil(t, p["parentSpanId"])
+	assert.Equal(t, map[string]interface{}{"code": float64(2), "message": "boom"}, c["status"])
+	assert.Equal(t, []interface{}{
+		map[string]interface{}{"key": "count", "value": map[string]interface{}{"stringValue": "10"}},
+	}, c["attributes"])
+}
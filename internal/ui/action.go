@@ -1,9 +1,11 @@
 package ui
 
 import (
+	"context"
 	"sort"
 
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
 )
@@ -26,12 +28,29 @@ type (
 
 // NewKeyAction returns a new keyboard action.
 func NewKeyAction(d string, a ActionHandler, display bool) KeyAction {
-	return KeyAction{Description: d, Action: a, Visible: display}
+	return KeyAction{Description: d, Action: tracedAction(d, a), Visible: display}
 }
 
 // NewSharedKeyAction returns a new shared keyboard action.
 func NewSharedKeyAction(d string, a ActionHandler, display bool) KeyAction {
-	return KeyAction{Description: d, Action: a, Visible: display, Shared: true}
+	return KeyAction{Description: d, Action: tracedAction(d, a), Visible: display, Shared: true}
+}
+
+// tracedAction records a span for each action invocation.
+func tracedAction(d string, a ActionHandler) ActionHandler {
+	if a == nil {
+		return nil
+	}
+
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		_, span := tracing.Start(context.Background(), "action", tracing.String("action", d))
+		defer span.End()
+		if evt != nil {
+			span.SetAttrs(tracing.String("key", evt.Name()))
+		}
+
+		return a(evt)
+	}
 }
 
 // Add sets up keyboard action listener.
@@ -0,0 +1,21 @@
+package dialog
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBannerDialog(t *testing.T) {
+	p := ui.NewPages()
+
+	ShowBanner(config.Dialog{}, p, "Notice", "Change freeze in effect", func() {})
+
+	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
+	assert.NotNil(t, d)
+	dismiss(p)
+	assert.Nil(t, p.GetPrimitive(dialogKey))
+}
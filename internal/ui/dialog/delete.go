@@ -2,6 +2,7 @@ package dialog
 
 import (
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tview"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -25,7 +26,7 @@ var propagationOptions []string = []string{
 }
 
 // ShowDelete pops a resource deletion dialog.
-func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) {
+func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, cancel cancelFunc) *tview.ModalForm {
 	propagation, force := "", false
 	f := tview.NewForm()
 	f.SetItemPadding(0)
@@ -45,11 +46,11 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 	f.AddCheckbox("Force:", force, func(_ string, checked bool) {
 		force = checked
 	})
-	f.AddButton("Cancel", func() {
+	f.AddButton(i18n.T("Cancel"), func() {
 		dismiss(pages)
 		cancel()
 	})
-	f.AddButton("OK", func() {
+	f.AddButton(i18n.T("OK"), func() {
 		switch propagation {
 		case noDeletePropagation:
 			ok(nil, force)
@@ -78,4 +79,6 @@ func ShowDelete(styles config.Dialog, pages *ui.Pages, msg string, ok okFunc, ca
 	})
 	pages.AddPage(dialogKey, confirm, false, false)
 	pages.ShowPage(dialogKey)
+
+	return confirm
 }
@@ -20,10 +20,11 @@ func TestDeleteDialog(t *testing.T) {
 	caFunc := func() {
 		assert.True(t, true)
 	}
-	ShowDelete(config.Dialog{}, p, "Yo", okFunc, caFunc)
+	m := ShowDelete(config.Dialog{}, p, "Yo", okFunc, caFunc)
 
 	d := p.GetPrimitive(dialogKey).(*tview.ModalForm)
 	assert.NotNil(t, d)
+	assert.Equal(t, d, m)
 
 	dismiss(p)
 	assert.Nil(t, p.GetPrimitive(dialogKey))
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
@@ -19,7 +20,7 @@ func ShowError(styles config.Dialog, pages *ui.Pages, msg string) {
 		SetButtonTextColor(styles.ButtonFgColor.Color()).
 		SetLabelColor(styles.LabelFgColor.Color()).
 		SetFieldTextColor(tcell.ColorIndianRed)
-	f.AddButton("Dismiss", func() {
+	f.AddButton(i18n.T("Dismiss"), func() {
 		dismiss(pages)
 	})
 	if b := f.GetButton(0); b != nil {
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -16,6 +17,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
+	v1 "k8s.io/api/core/v1"
 )
 
 type (
@@ -46,6 +48,9 @@ type Table struct {
 	wide        bool
 	toast       bool
 	hasMetrics  bool
+	rewind      string
+	stale       time.Duration
+	ipFamily    string
 }
 
 // NewTable returns a new table view.
@@ -111,6 +116,23 @@ func (t *Table) ToggleToast() {
 	t.Refresh()
 }
 
+// CycleIPFamily cycles the ip family filter through all, IPv4 and IPv6 addresses and
+// returns the active family.
+func (t *Table) CycleIPFamily() string {
+	switch t.ipFamily {
+	case "":
+		t.ipFamily = string(v1.IPv4Protocol)
+	case string(v1.IPv4Protocol):
+		t.ipFamily = string(v1.IPv6Protocol)
+	default:
+		t.ipFamily = ""
+	}
+	t.Refresh()
+	t.UpdateTitle()
+
+	return t.ipFamily
+}
+
 // ToggleWide toggles wide col display.
 func (t *Table) ToggleWide() {
 	t.wide = !t.wide
@@ -380,6 +402,9 @@ func (t *Table) filtered(data *render.TableData) *render.TableData {
 	if t.toast {
 		filtered = filterToast(data)
 	}
+	if t.ipFamily != "" {
+		filtered = filterIPFamily(filtered, t.ipFamily)
+	}
 	if t.cmdBuff.Empty() || IsLabelSelector(t.cmdBuff.GetText()) {
 		return filtered
 	}
@@ -412,6 +437,24 @@ func (t *Table) ShowDeleted() {
 	}
 }
 
+// SetRewind flags the table as displaying a past state. An empty mark resumes the live title.
+func (t *Table) SetRewind(mark string) {
+	if t.rewind == mark {
+		return
+	}
+	t.rewind = mark
+	t.UpdateTitle()
+}
+
+// SetStale flags the table data as outdated for a given duration. A zero age clears the flag.
+func (t *Table) SetStale(age time.Duration) {
+	if t.stale == age {
+		return
+	}
+	t.stale = age
+	t.UpdateTitle()
+}
+
 // UpdateTitle refreshes the table title.
 func (t *Table) UpdateTitle() {
 	t.SetTitle(t.styleTitle())
@@ -446,6 +489,15 @@ func (t *Table) styleTitle() string {
 	} else {
 		title = SkinTitle(fmt.Sprintf(NSTitleFmt, base, ns, rc), t.styles.Frame())
 	}
+	if t.rewind != "" {
+		title += SkinTitle(fmt.Sprintf(RewindFmt, t.rewind), t.styles.Frame())
+	}
+	if t.ipFamily != "" {
+		title += SkinTitle(fmt.Sprintf(IPFamilyFmt, t.ipFamily), t.styles.Frame())
+	}
+	if t.stale > 0 {
+		title += SkinTitle(fmt.Sprintf(StaleFmt, int(t.stale.Seconds())), t.styles.Frame())
+	}
 
 	buff := t.cmdBuff.GetText()
 	if buff == "" {
@@ -20,6 +20,15 @@ const (
 	// SearchFmt represents a filter view title.
 	SearchFmt = "<[filter:bg:r]/%s[fg:bg:-]> "
 
+	// RewindFmt represents a rewound view title.
+	RewindFmt = "<[filter:bg:r]rewind@%s[fg:bg:-]> "
+
+	// IPFamilyFmt represents an ip family filtered view title.
+	IPFamilyFmt = "<[filter:bg:r]%s[fg:bg:-]> "
+
+	// StaleFmt represents an outdated view title.
+	StaleFmt = "<[error:bg:r]STALE (%ds)[fg:bg:-]> "
+
 	// NSTitleFmt represents a namespaced view title.
 	NSTitleFmt = "[fg:bg:b] %s([hilite:bg:b]%s[fg:bg:-])[fg:bg:-][[count:bg:b]%d[fg:bg:-]][fg:bg:-] "
 
@@ -103,6 +112,7 @@ func SkinTitle(fmat string, style config.Frame) string {
 	fmat = strings.Replace(fmat, "[key", "["+style.Menu.NumKeyColor.String(), 1)
 	fmat = strings.Replace(fmat, "[filter", "["+style.Title.FilterColor.String(), 1)
 	fmat = strings.Replace(fmat, "[count", "["+style.Title.CounterColor.String(), 1)
+	fmat = strings.Replace(fmat, "[error", "["+style.Status.ErrorColor.String(), 1)
 	fmat = strings.Replace(fmat, ":bg:", ":"+bgColor.String()+":", -1)
 
 	return fmat
@@ -148,6 +158,40 @@ func filterToast(data *render.TableData) *render.TableData {
 	return &toast
 }
 
+// ipColumns tracks the columns holding ip addresses.
+var ipColumns = []string{"IP", "CLUSTER-IP"}
+
+// filterIPFamily keeps the rows with ip addresses in a given family and hides the other
+// addresses.
+func filterIPFamily(data *render.TableData, family string) *render.TableData {
+	ipX := -1
+	for _, c := range ipColumns {
+		if ipX = data.Header.IndexOf(c, true); ipX != -1 {
+			break
+		}
+	}
+	if ipX == -1 {
+		return data
+	}
+
+	filtered := render.TableData{
+		Header:    data.Header,
+		RowEvents: make(render.RowEvents, 0, len(data.RowEvents)),
+		Namespace: data.Namespace,
+	}
+	for _, re := range data.RowEvents {
+		ips := render.FilterIPs(re.Row.Fields[ipX], family)
+		if ips == "" {
+			continue
+		}
+		re = re.Clone()
+		re.Row.Fields[ipX] = ips
+		filtered.RowEvents = append(filtered.RowEvents, re)
+	}
+
+	return &filtered
+}
+
 func rxFilter(q string, inverse bool, data *render.TableData) (*render.TableData, error) {
 	if inverse {
 		q = q[1:]
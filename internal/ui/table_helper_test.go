@@ -3,6 +3,7 @@ package ui
 import (
 	"testing"
 
+	"github.com/derailed/k9s/internal/render"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -40,3 +41,38 @@ func TestTrimLabelSelector(t *testing.T) {
 		})
 	}
 }
+
+func TestFilterIPFamily(t *testing.T) {
+	data := render.TableData{
+		Header: render.Header{
+			render.HeaderColumn{Name: "NAME"},
+			render.HeaderColumn{Name: "IP"},
+		},
+		RowEvents: render.RowEvents{
+			{Row: render.Row{ID: "p1", Fields: render.Fields{"p1", "10.0.0.1,fd00::1"}}},
+			{Row: render.Row{ID: "p2", Fields: render.Fields{"p2", "10.0.0.2"}}},
+			{Row: render.Row{ID: "p3", Fields: render.Fields{"p3", "fd00::3"}}},
+			{Row: render.Row{ID: "p4", Fields: render.Fields{"p4", "n/a"}}},
+		},
+	}
+
+	uu := map[string]struct {
+		family string
+		e      map[string]string
+	}{
+		"v4": {family: "IPv4", e: map[string]string{"p1": "10.0.0.1", "p2": "10.0.0.2"}},
+		"v6": {family: "IPv6", e: map[string]string{"p1": "fd00::1", "p3": "fd00::3"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := filterIPFamily(&data, u.family)
+			assert.Equal(t, len(u.e), len(f.RowEvents))
+			for _, re := range f.RowEvents {
+				assert.Equal(t, u.e[re.Row.ID], re.Row.Fields[1])
+			}
+			assert.Equal(t, "10.0.0.1,fd00::1", data.RowEvents[0].Row.Fields[1])
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+)
+
+// admissionReport renders the changes applied to a dry-run workload pod on admission.
+func admissionReport(r dao.AdmissionPreview) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "object: %s\n", r.Path)
+	fmt.Fprintf(&b, "dry-run pod: %s\n\n", r.Pod)
+	if len(r.Changes) == 0 {
+		b.WriteString("No changes applied on admission.\n")
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "changes (%d): submitted → admitted (webhooks and api server defaults)\n", len(r.Changes))
+	for _, c := range r.Changes {
+		fmt.Fprintf(&b, "  %s\n", tview.Escape(c.String()))
+	}
+
+	return b.String()
+}
@@ -0,0 +1,63 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// FlowSchema represents an API Priority and Fairness FlowSchema viewer.
+type FlowSchema struct {
+	ResourceViewer
+}
+
+// NewFlowSchema returns a new viewer.
+func NewFlowSchema(gvr client.GVR) ResourceViewer {
+	f := FlowSchema{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.AddBindKeysFn(f.bindKeys)
+	f.GetTable().SetEnterFn(f.showPriorityLevel)
+
+	return &f
+}
+
+func (f *FlowSchema) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Precedence", f.GetTable().SortColCmd("PRECEDENCE", true), false),
+		ui.KeyShiftQ: ui.NewKeyAction("Sort InQueue", f.GetTable().SortColCmd("INQUEUE", false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rejected", f.GetTable().SortColCmd("REJECTED", false), false),
+	})
+}
+
+// showPriorityLevel navigates to the priority level the flow schema assigns requests to.
+func (f *FlowSchema) showPriorityLevel(app *App, _ ui.Tabular, _, _ string) {
+	row, _ := f.GetTable().GetSelection()
+	pl := ui.TrimCell(f.GetTable().SelectTable, row, 1)
+	if pl == "" {
+		return
+	}
+	app.gotoResource(f.GVR().G()+"/"+f.GVR().V()+"/prioritylevelconfigurations", pl, false)
+}
+
+// PriorityLevel represents an API Priority and Fairness PriorityLevelConfiguration viewer.
+type PriorityLevel struct {
+	ResourceViewer
+}
+
+// NewPriorityLevel returns a new viewer.
+func NewPriorityLevel(gvr client.GVR) ResourceViewer {
+	p := PriorityLevel{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *PriorityLevel) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Sort Utilization", p.GetTable().SortColCmd("%UTIL", false), false),
+		ui.KeyShiftQ: ui.NewKeyAction("Sort InQueue", p.GetTable().SortColCmd("INQUEUE", false), false),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Rejected", p.GetTable().SortColCmd("REJECTED", false), false),
+	})
+}
@@ -0,0 +1,64 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// APIHealth represents the api server health dashboard.
+type APIHealth struct {
+	ResourceViewer
+}
+
+// NewAPIHealth returns a new viewer.
+func NewAPIHealth(gvr client.GVR) ResourceViewer {
+	a := APIHealth{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	a.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	a.GetTable().SetSortCol("SECTION", true)
+	a.GetTable().SetDecorateFn(a.decorateRows)
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+// Init initializes the view.
+func (a *APIHealth) Init(ctx context.Context) error {
+	if err := a.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	a.GetTable().GetModel().SetRefreshRate(5 * time.Second)
+
+	return nil
+}
+
+func (a *APIHealth) decorateRows(data *render.TableData) {
+	var failing int
+	for _, re := range data.RowEvents {
+		if !render.Happy(client.ClusterScope, data.Header, re.Row) {
+			failing++
+		}
+	}
+	if failing == 0 {
+		a.GetTable().Extras = "Healthy"
+		return
+	}
+	a.GetTable().Extras = fmt.Sprintf("Failing %d", failing)
+}
+
+func (a *APIHealth) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Section", a.GetTable().SortColCmd("SECTION", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Check", a.GetTable().SortColCmd("CHECK", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("Sort Valid", a.GetTable().SortColCmd("VALID", false), false),
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"os/signal"
 	"runtime"
+	"runtime/debug"
 	"sort"
 	"strings"
 	"sync/atomic"
@@ -17,7 +18,11 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/history"
+	"github.com/derailed/k9s/internal/i18n"
+	"github.com/derailed/k9s/internal/metrics"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/k9s/internal/watch"
@@ -33,6 +38,7 @@ var ExitStatus = ""
 const (
 	splashDelay      = 1 * time.Second
 	clusterRefresh   = 15 * time.Second
+	minHeaderHeight  = 7
 	clusterInfoWidth = 50
 	clusterInfoPad   = 15
 )
@@ -46,6 +52,12 @@ type App struct {
 	factory       *watch.Factory
 	cancelFn      context.CancelFunc
 	clusterModel  *model.ClusterInfo
+	lint          *model.Lint
+	debugNetPols  *debugNetPols
+	shadow        *history.Store
+	title         *termTitle
+	stopTracing   func()
+	stopMetrics   func()
 	cmdHistory    *model.History
 	filterHistory *model.History
 	conRetry      int32
@@ -61,6 +73,7 @@ func NewApp(cfg *config.Config) *App {
 		cmdHistory:    model.NewHistory(model.MaxHistory),
 		filterHistory: model.NewHistory(model.MaxHistory),
 		Content:       NewPageStack(),
+		debugNetPols:  newDebugNetPols(),
 	}
 
 	a.Views()["statusIndicator"] = ui.NewStatusIndicator(a.App, a.Styles)
@@ -85,6 +98,9 @@ func (a *App) Init(version string, rate int) error {
 	a.Content.Stack.AddListener(a.Crumbs())
 	a.Content.Stack.AddListener(a.Menu())
 
+	if err := i18n.Load(a.Config.K9s.Language, config.K9sLocalesDir()); err != nil {
+		log.Warn().Err(err).Msgf("Unable to load locale %q. Using %s", a.Config.K9s.Language, i18n.DefaultLang)
+	}
 	a.App.Init()
 	a.SetInputCapture(a.keyboard)
 	a.bindKeys()
@@ -99,8 +115,28 @@ func (a *App) Init(version string, rate int) error {
 		return fmt.Errorf("Invalid namespace %s", ns)
 	}
 	a.initFactory(ns)
+	a.initShadow()
+	a.initMetricsProvider()
+	a.lint = model.NewLint(a.factory)
+	go purgeDebugNetPols(a)
+	if t := a.Config.K9s.ActiveTracing(); t.Enable {
+		a.stopTracing = tracing.Init(tracing.Options{
+			Endpoint:    t.Endpoint,
+			ServiceName: t.ServiceName,
+			Version:     a.version,
+			Headers:     t.Headers,
+		})
+	}
+	if m := a.Config.K9s.ActiveMetricsEndpoint(); m.Enable {
+		if a.stopMetrics, err = metrics.Serve(m.Address); err != nil {
+			log.Error().Err(err).Msgf("Unable to serve k9s metrics on %s", m.Address)
+		}
+	}
+	a.title = newTermTitle(a)
+	a.Content.Stack.AddListener(a.title)
 
 	a.clusterModel = model.NewClusterInfo(a.factory, a.version, a.Config.K9s.SkipLatestRevCheck)
+	a.clusterModel.SetHeaderLines(a.Config.K9s.ActiveHeader().Lines)
 	a.clusterModel.AddListener(a.clusterInfo())
 	a.clusterModel.AddListener(a.statusIndicator())
 	if a.Conn().ConnectionOK() {
@@ -214,7 +250,7 @@ func (a *App) toggleHeader(header, logo bool) {
 	}
 	if a.showHeader {
 		flex.RemoveItemAtIndex(0)
-		flex.AddItemAtIndex(0, a.buildHeader(), 7, 1, false)
+		flex.AddItemAtIndex(0, a.buildHeader(), a.headerHeight(), 1, false)
 	} else {
 		flex.RemoveItemAtIndex(0)
 		flex.AddItemAtIndex(0, a.statusIndicator(), 1, 1, false)
@@ -236,6 +272,14 @@ func (a *App) toggleCrumbs(flag bool) {
 	}
 }
 
+func (a *App) headerHeight() int {
+	if rows := a.Config.K9s.ActiveHeader().Rows(); rows > minHeaderHeight {
+		return rows
+	}
+
+	return minHeaderHeight
+}
+
 func (a *App) buildHeader() tview.Primitive {
 	header := tview.NewFlex()
 	header.SetBackgroundColor(a.Styles.BgColor())
@@ -276,6 +320,10 @@ func (a *App) Resume() {
 	ctx, a.cancelFn = context.WithCancel(context.Background())
 
 	go a.clusterUpdater(ctx)
+	go a.staleUpdater(ctx)
+	if a.Config.K9s.ActiveCluster().FeatureGates.Sanitizer {
+		go a.lint.Watch(ctx)
+	}
 	if err := a.StylesWatcher(ctx, a); err != nil {
 		log.Warn().Err(err).Msgf("Styles watcher failed")
 	}
@@ -375,6 +423,7 @@ func (a *App) switchNS(ns string) error {
 	if err := a.Config.Save(); err != nil {
 		return err
 	}
+	a.title.refresh()
 
 	return a.factory.SetActiveNS(ns)
 }
@@ -431,10 +480,15 @@ func (a *App) switchContext(name string, loadPods bool) error {
 			log.Error().Err(err).Msg("config save failed!")
 		}
 
+		a.initShadow()
+		a.initMetricsProvider()
+		a.lint.Reset()
+		go purgeDebugNetPols(a)
 		a.Flash().Infof("Switching context to %s", name)
 		a.ReloadStyles(name)
 		a.gotoResource(v, "", true)
 		a.clusterModel.Reset(a.factory)
+		showBanner(a, false)
 	}
 
 	return nil
@@ -445,6 +499,37 @@ func (a *App) initFactory(ns string) {
 	a.factory.Start(ns)
 }
 
+// initShadow records the observed objects revisions for the active cluster if enabled.
+func (a *App) initShadow() {
+	prev := a.shadow
+	a.shadow = nil
+	if h := a.Config.K9s.ActiveHistory(); h.Enable {
+		a.shadow = history.NewStore(config.HistoryDir(a.Config.K9s.CurrentCluster), h.MaxRevisions)
+		a.factory.SetRecorder(a.shadow)
+	} else {
+		a.factory.SetRecorder(nil)
+	}
+	if prev != nil {
+		prev.Stop()
+	}
+}
+
+// initMetricsProvider sources the active cluster usage metrics from prometheus if configured.
+func (a *App) initMetricsProvider() {
+	m := a.Config.K9s.ActiveMetricsProvider()
+	if !m.IsPrometheus() {
+		client.SetMetricsProvider(nil)
+		return
+	}
+	p, err := client.NewPrometheus(m.Address, m.Headers)
+	if err != nil {
+		log.Error().Err(err).Msgf("Unable to use prometheus metrics from %s. Using metrics-server", m.Address)
+		client.SetMetricsProvider(nil)
+		return
+	}
+	client.SetMetricsProvider(p)
+}
+
 // BailOut exists the application.
 func (a *App) BailOut() {
 	defer func() {
@@ -456,12 +541,31 @@ func (a *App) BailOut() {
 	if err := nukeK9sShell(a); err != nil {
 		log.Error().Err(err).Msgf("nuking k9s shell pod")
 	}
+	a.debugNetPols.removeAll(a.factory)
 	a.factory.Terminate()
+	if a.shadow != nil {
+		a.shadow.Stop()
+	}
+	if a.title != nil {
+		a.title.close()
+	}
+	if a.stopTracing != nil {
+		a.stopTracing()
+	}
+	if a.stopMetrics != nil {
+		a.stopMetrics()
+	}
 	a.App.BailOut()
 }
 
-// Run starts the application loop.
-func (a *App) Run() error {
+// Run starts the application loop. A panic in the loop is reported as a Crash once
+// the terminal is restored.
+func (a *App) Run() (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = a.crash(p, debug.Stack())
+		}
+	}()
 	a.Resume()
 
 	go func() {
@@ -469,6 +573,7 @@ func (a *App) Run() error {
 		a.QueueUpdateDraw(func() {
 			a.Main.SwitchToPage("main")
 		})
+		showBanner(a, false)
 	}()
 
 	if err := a.command.defaultCmd(); err != nil {
@@ -0,0 +1,182 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+// Application represents an Argo CD Application viewer.
+type Application struct {
+	ResourceViewer
+}
+
+// NewApplication returns a new viewer.
+func NewApplication(gvr client.GVR) ResourceViewer {
+	a := Application{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	a.AddBindKeysFn(a.bindKeys)
+
+	return &a
+}
+
+func (a *Application) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyX:      ui.NewKeyAction("Diff", a.diffCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Sync", a.GetTable().SortColCmd("SYNC", true), false),
+		ui.KeyShiftH: ui.NewKeyAction("Sort Health", a.GetTable().SortColCmd("HEALTH", true), false),
+	})
+	if a.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyS:      ui.NewKeyAction("Sync", a.syncCmd, true),
+		ui.KeyR:      ui.NewKeyAction("Refresh", a.refreshAppCmd(false), true),
+		ui.KeyShiftR: ui.NewKeyAction("Hard Refresh", a.refreshAppCmd(true), true),
+	})
+}
+
+func (a *Application) syncCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := a.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	a.Stop()
+	defer a.Start()
+	msg := fmt.Sprintf("Sync application %s?", path)
+	dialog.ShowConfirm(a.App().Styles.Dialog(), a.App().Content.Pages, "Confirm Sync", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), a.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.Application
+		res.Init(a.App().factory, a.GVR())
+		if err := res.Sync(ctx, path); err != nil {
+			a.App().Flash().Err(err)
+			return
+		}
+		a.App().Flash().Infof("Sync requested for application %s", path)
+	}, func() {})
+
+	return nil
+}
+
+func (a *Application) refreshAppCmd(hard bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := a.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), a.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.Application
+		res.Init(a.App().factory, a.GVR())
+		if err := res.Refresh(ctx, path, hard); err != nil {
+			a.App().Flash().Err(err)
+			return nil
+		}
+		a.App().Flash().Infof("Refresh requested for application %s", path)
+
+		return nil
+	}
+}
+
+func (a *Application) diffCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := a.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Application
+	res.Init(a.App().factory, a.GVR())
+	app, err := res.GetInstance(path)
+	if err != nil {
+		a.App().Flash().Err(err)
+		return nil
+	}
+
+	a.App().Flash().Infof("Computing diff for application %s...", path)
+	go func() {
+		diff, err := argoCDDiff(a.App(), app)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Argo CD diff failed for %s", path)
+		}
+		a.App().QueueUpdateDraw(func() {
+			details := NewDetails(a.App(), "Diff", path, true).Update(argoCDDriftReport(app, diff))
+			if err := a.App().inject(details, false); err != nil {
+				a.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// argoCDDiff shells out to the argocd cli to compute the live vs desired diff.
+func argoCDDiff(a *App, app *render.ArgoCDApplication) (string, error) {
+	bin, err := exec.LookPath("argocd")
+	if errors.Is(err, exec.ErrDot) {
+		return "", fmt.Errorf("argocd command must not be in the current working directory: %w", err)
+	}
+	if err != nil {
+		return "", fmt.Errorf("argocd command is not in your path: %w", err)
+	}
+	args := []string{
+		"app", "diff", app.Name,
+		"--app-namespace", app.Namespace,
+		"--core",
+		"--kube-context", a.Config.K9s.CurrentContext,
+	}
+	out, err := oneShoot(shellOpts{binary: bin, args: args})
+	// argocd exits with 1 when a diff is found.
+	if e, ok := err.(*exec.ExitError); ok && e.ExitCode() == 1 {
+		return out, nil
+	}
+
+	return out, err
+}
+
+func argoCDDriftReport(app *render.ArgoCDApplication, diff string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Sync:     %s\n", app.Status.Sync.Status)
+	fmt.Fprintf(&b, "Health:   %s\n", app.Status.Health.Status)
+	fmt.Fprintf(&b, "Revision: %s\n\n", app.Status.Sync.Revision)
+
+	rr := app.Status.OutOfSync()
+	if len(rr) == 0 {
+		b.WriteString("No drift detected.\n")
+	} else {
+		fmt.Fprintf(&b, "Out of sync resources (%d):\n", len(rr))
+		for _, r := range rr {
+			fmt.Fprintf(&b, "  %s %s\n", argoCDKind(r), client.FQN(r.Namespace, r.Name))
+		}
+	}
+	if diff != "" {
+		b.WriteString("\n" + diff + "\n")
+	}
+
+	return tview.Escape(b.String())
+}
+
+func argoCDKind(r render.ArgoCDResourceStatus) string {
+	if r.Group == "" {
+		return r.Kind
+	}
+
+	return r.Kind + "." + r.Group
+}
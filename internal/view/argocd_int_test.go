@@ -0,0 +1,31 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArgoCDDriftReport(t *testing.T) {
+	var app render.ArgoCDApplication
+	app.Status.Sync.Status, app.Status.Health.Status = "OutOfSync", "Healthy"
+	app.Status.Resources = []render.ArgoCDResourceStatus{
+		{Kind: "Service", Namespace: "ns1", Name: "svc1", Status: "Synced"},
+		{Group: "apps", Kind: "Deployment", Namespace: "ns1", Name: "dp1", Status: "OutOfSync"},
+		{Kind: "Namespace", Name: "ns1", Status: "OutOfSync"},
+	}
+
+	e := `Sync:     OutOfSync
+Health:   Healthy
+Revision: 
+
+Out of sync resources (2):
+  Deployment.apps ns1/dp1
+  Namespace ns1
+
+-- diff --
+args: [--foo[]
+`
+	assert.Equal(t, e, argoCDDriftReport(&app, "-- diff --\nargs: [--foo]"))
+}
@@ -0,0 +1,38 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+// showBanner displays the banner of the active cluster if any. When forced, the banner
+// is shown even if disabled and missing banners are reported.
+func showBanner(app *App, force bool) {
+	cfg := app.Config.K9s.ActiveCluster().ActiveBanner()
+	if (cfg.Disable && !force) || !app.ConOK() {
+		return
+	}
+
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		b, err := dao.ClusterBanner(ctx, app.Conn(), cfg.ConfigMap, cfg.Message)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Cluster banner %s unavailable", cfg.ConfigMap)
+		}
+		app.QueueUpdateDraw(func() {
+			if b.IsEmpty() {
+				if force {
+					app.Flash().Info("No banner configured for this cluster")
+				}
+				return
+			}
+			dialog.ShowBanner(app.Styles.Dialog(), app.Content.Pages, b.Title, b.Message, func() {
+				app.Flash().Info("Banner acknowledged. Use :banner to show it again")
+			})
+		})
+	}()
+}
@@ -2,8 +2,10 @@ package view
 
 import (
 	"context"
+	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/derailed/k9s/internal"
@@ -29,10 +31,17 @@ func NewBenchmark(gvr client.GVR) ResourceViewer {
 	b.GetTable().SetSortCol(ageCol, true)
 	b.SetContextFn(b.benchContext)
 	b.GetTable().SetEnterFn(b.viewBench)
+	b.AddBindKeysFn(b.bindKeys)
 
 	return &b
 }
 
+func (b *Benchmark) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyD: ui.NewKeyAction("Compare", b.compareCmd, true),
+	})
+}
+
 func (b *Benchmark) benchContext(ctx context.Context) context.Context {
 	return context.WithValue(ctx, internal.KeyDir, benchDir(b.App().Config))
 }
@@ -50,6 +59,37 @@ func (b *Benchmark) viewBench(app *App, model ui.Tabular, gvr, path string) {
 	}
 }
 
+func (b *Benchmark) compareCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	prev, err := perf.PreviousRun(path)
+	if err != nil {
+		b.App().Flash().Err(err)
+		return nil
+	}
+	curr, err := os.ReadFile(path)
+	if err != nil {
+		b.App().Flash().Errf("Unable to load bench file %s", err)
+		return nil
+	}
+	last, err := os.ReadFile(prev)
+	if err != nil {
+		b.App().Flash().Errf("Unable to load bench file %s", err)
+		return nil
+	}
+
+	deltas := perf.NewReport(string(curr)).Compare(perf.NewReport(string(last)))
+	details := NewDetails(b.App(), "Compare", fileToSubject(path), false).Update(benchDeltas(path, prev, deltas))
+	if err := b.App().inject(details, false); err != nil {
+		b.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Benchmark) benchFile() string {
 	r := b.GetTable().GetSelectedRowIndex()
 	return ui.TrimCell(b.GetTable().SelectTable, r, 7)
@@ -64,6 +104,31 @@ func fileToSubject(path string) string {
 	return ee[0] + "/" + ee[1]
 }
 
+func benchDeltas(curr, prev string, dd []perf.Delta) string {
+	var buff strings.Builder
+	fmt.Fprintf(&buff, "[aqua::b]Latest:[white::-]   %s\n", filepath.Base(curr))
+	fmt.Fprintf(&buff, "[aqua::b]Previous:[white::-] %s\n\n", filepath.Base(prev))
+	fmt.Fprintf(&buff, "[aqua::b]%-14s %12s %12s %10s[white::-]\n", "METRIC", "PREVIOUS", "LATEST", "DELTA")
+	for _, d := range dd {
+		color := "green"
+		if d.Regressed() {
+			color = "orangered"
+		}
+		if d.Prev == d.Curr {
+			color = "white"
+		}
+		fmt.Fprintf(&buff, "%-14s %12s %12s [%s::]%+9.2f%%[white::]\n",
+			d.Metric,
+			strconv.FormatFloat(d.Prev, 'f', -1, 64),
+			strconv.FormatFloat(d.Curr, 'f', -1, 64),
+			color,
+			d.Percent(),
+		)
+	}
+
+	return buff.String()
+}
+
 func benchDir(cfg *config.Config) string {
 	return filepath.Join(perf.K9sBenchDir, cfg.K9s.CurrentContextDir())
 }
@@ -2,7 +2,6 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -14,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -21,6 +21,7 @@ import (
 	"github.com/derailed/tcell/v2"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
 // Browser represents a generic resource browser.
@@ -33,6 +34,7 @@ type Browser struct {
 	contextFn  ContextFunc
 	cancelFn   context.CancelFunc
 	mx         sync.RWMutex
+	truncated  int
 }
 
 // NewBrowser returns a new browser.
@@ -118,10 +120,12 @@ func (b *Browser) suggestFilter() model.SuggestionFunc {
 
 func (b *Browser) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		tcell.KeyEscape: ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
-		tcell.KeyEnter:  ui.NewSharedKeyAction("Filter", b.filterCmd, false),
-		tcell.KeyHelp:   ui.NewSharedKeyAction("Help", b.helpCmd, false),
-		ui.KeyV:         ui.NewSharedKeyAction("Zob", b.blahCmd, true),
+		tcell.KeyEscape:    ui.NewSharedKeyAction("Filter Reset", b.resetCmd, false),
+		tcell.KeyEnter:     ui.NewSharedKeyAction("Filter", b.filterCmd, false),
+		tcell.KeyHelp:      ui.NewSharedKeyAction("Help", b.helpCmd, false),
+		ui.KeyV:            ui.NewSharedKeyAction("Zob", b.blahCmd, true),
+		ui.KeyLeftBracket:  ui.NewSharedKeyAction("Rewind", b.rewindCmd(1), false),
+		ui.KeyRightBracket: ui.NewSharedKeyAction("Forward", b.rewindCmd(-1), false),
 	})
 }
 
@@ -142,6 +146,7 @@ func (b *Browser) Start() {
 	}
 
 	b.Stop()
+	b.markRefreshed()
 	b.GetModel().AddListener(b)
 	b.Table.Start()
 	b.CmdBuff().AddListener(b)
@@ -237,13 +242,32 @@ func (b *Browser) TableDataChanged(data *render.TableData) {
 	if !b.app.ConOK() || cancel == nil || !b.app.IsRunning() {
 		return
 	}
+	b.markRefreshed()
 
 	b.app.QueueUpdateDraw(func() {
 		b.refreshActions()
 		b.Update(data, b.app.Conn().HasMetrics())
+		b.warnTruncated(data.Truncated)
+		if r, ok := b.GetModel().(rewinder); ok && !r.IsRewound() {
+			b.SetRewind("")
+		}
 	})
 }
 
+// warnTruncated notifies once whenever rows start being dropped to fit the view budget.
+// Rows are capped before the view sorts or filters them, so the warning spells out
+// which subset is shown.
+func (b *Browser) warnTruncated(total int) {
+	if total == b.truncated {
+		return
+	}
+	b.truncated = total
+	if total == 0 {
+		return
+	}
+	b.app.Flash().Warnf(truncatedRowsFmt, b.app.Config.K9s.ActiveBudget().MaxRows, total, b.GVR().R())
+}
+
 // TableLoadFailed notifies view something went south.
 func (b *Browser) TableLoadFailed(err error) {
 	b.app.QueueUpdateDraw(func() {
@@ -268,6 +292,78 @@ func (b *Browser) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) changeLogCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if err := b.app.inject(NewChangeLog(b.app, b.GVR().String(), path), false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) managedFieldsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showManagedFields(b.app, b.GVR().String(), path)
+
+	return nil
+}
+
+func (b *Browser) historyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showHistory(b.app, b.GVR().String(), path)
+
+	return nil
+}
+
+func (b *Browser) driftCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	r, err := dao.ConfigDrift(b.app.factory, b.GVR().String(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(b.app, "Drift", path, true).Update(driftReport(r))
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (b *Browser) admissionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), b.app.Conn().Config().CallTimeout())
+	defer cancel()
+	r, err := dao.PreviewAdmission(ctx, b.app.factory, b.GVR().String(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(b.app, "Admission", path, true).Update(admissionReport(r))
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Browser) helpCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if b.CmdBuff().InCmdMode() {
 		return nil
@@ -321,6 +417,25 @@ func (b *Browser) enterCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) rewindCmd(steps int) ui.ActionHandler {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		r, ok := b.GetModel().(rewinder)
+		if !ok {
+			return evt
+		}
+		snap, rewound := r.Rewind(steps)
+		if !rewound {
+			b.SetRewind("")
+			b.app.Flash().Info("Viewing live state")
+			return nil
+		}
+		b.SetRewind(snap.At.Format("15:04:05"))
+		b.app.Flash().Infof("Viewing state from %s ago", duration.HumanDuration(time.Since(snap.At)))
+
+		return nil
+	}
+}
+
 func (b *Browser) refreshCmd(*tcell.EventKey) *tcell.EventKey {
 	b.app.Flash().Info("Refreshing...")
 	b.refresh()
@@ -337,9 +452,9 @@ func (b *Browser) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	b.Stop()
 	defer b.Start()
 	{
-		msg := fmt.Sprintf("Delete %s %s?", b.GVR().R(), selections[0])
+		msg := i18n.Tf("Delete %s %s?", b.GVR().R(), selections[0])
 		if len(selections) > 1 {
-			msg = fmt.Sprintf("Delete %d marked %s?", len(selections), b.GVR())
+			msg = i18n.Tf("Delete %d marked %s?", len(selections), b.GVR())
 		}
 		if !dao.IsK8sMeta(b.meta) {
 			b.simpleDelete(selections, msg)
@@ -373,6 +488,21 @@ func (b *Browser) blahCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) lintCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	issues := b.app.lint.Issues(b.GVR().String(), path)
+	details := NewDetails(b.app, "Lint", path, true).Update(lintReport(b.app.lint.Grade(b.GVR().String(), path), issues))
+	if err := b.app.inject(details, false); err != nil {
+		b.app.Flash().Err(err)
+	}
+
+	return nil
+}
+
 func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -383,6 +513,36 @@ func (b *Browser) describeCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (b *Browser) relatedCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showRelated(b.app, b.GVR().String(), path)
+
+	return nil
+}
+
+func (b *Browser) ownerCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showOwner(b.app, b.GVR().String(), path)
+
+	return nil
+}
+
+func (b *Browser) childrenCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showChildren(b.app, b.GVR().String(), path)
+
+	return nil
+}
+
 func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := b.GetSelectedItem()
 	if path == "" {
@@ -402,17 +562,7 @@ func (b *Browser) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	b.Stop()
 	defer b.Start()
-	{
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, b.GVR().FQN(n))
-		if ns != client.AllNamespaces {
-			args = append(args, "-n", ns)
-		}
-		if !runK(b.app, shellOpts{clear: true, args: args}) {
-			b.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	editResource(b.app, b.GVR(), path)
 
 	return evt
 }
@@ -482,6 +632,14 @@ func (b *Browser) defaultContext() context.Context {
 		ctx = context.WithValue(ctx, internal.KeyLabels, ui.TrimLabelSelector(b.CmdBuff().GetText()))
 	}
 	ctx = context.WithValue(ctx, internal.KeyNamespace, client.CleanseNamespace(b.App().Config.ActiveNamespace()))
+	if b.app.lint != nil {
+		ctx = context.WithValue(ctx, internal.KeyLint, b.app.lint)
+	}
+	ctx = context.WithValue(ctx, internal.KeyMaxRows, b.app.Config.K9s.ActiveBudget().MaxRows)
+	ctx = context.WithValue(ctx, internal.KeyMaxHistory, b.app.Config.K9s.ActiveBudget().MaxHistoryRows)
+	if cc := b.app.Config.K9s.ActiveCustomColumns(b.GVR().String()); len(cc) > 0 {
+		ctx = context.WithValue(ctx, internal.KeyColumns, model.NewCustomColumns(cc))
+	}
 
 	return ctx
 }
@@ -506,11 +664,31 @@ func (b *Browser) refreshActions() {
 				aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", b.deleteCmd, true)
 			}
 		}
+		if dao.IsK8sMeta(b.meta) {
+			aa[tcell.KeyCtrlN] = ui.NewKeyAction("Finalizers", b.finalizersCmd, true)
+		}
 	}
 
 	if !dao.IsK9sMeta(b.meta) {
 		aa[ui.KeyY] = ui.NewKeyAction("YAML", b.viewCmd, true)
+		aa[ui.KeyShiftY] = ui.NewKeyAction("Watch Changes", b.changeLogCmd, true)
+		aa[tcell.KeyCtrlY] = ui.NewKeyAction("Managed Fields", b.managedFieldsCmd, true)
+		aa[tcell.KeyCtrlP] = ui.NewKeyAction("Drift", b.driftCmd, true)
+		if b.app.Config.K9s.ActiveHistory().Enable {
+			aa[tcell.KeyCtrlV] = ui.NewKeyAction("History", b.historyCmd, true)
+		}
 		aa[ui.KeyD] = ui.NewKeyAction("Describe", b.describeCmd, true)
+		aa[ui.KeyW] = ui.NewKeyAction("Related", b.relatedCmd, true)
+		aa[ui.KeyO] = ui.NewKeyAction("Owner", b.ownerCmd, true)
+		if _, ok := dao.ChildrenGVR(b.GVR().String()); ok {
+			aa[tcell.KeyCtrlO] = ui.NewKeyAction("Children", b.childrenCmd, true)
+		}
+		if dao.CanPreviewAdmission(b.GVR().String()) {
+			aa[ui.KeyShiftW] = ui.NewKeyAction("Admission Preview", b.admissionCmd, true)
+		}
+	}
+	if b.app.lint.IsScanned(b.GVR().String()) {
+		aa[ui.KeyZ] = ui.NewKeyAction("Lint", b.lintCmd, true)
 	}
 
 	pluginActions(b, aa)
@@ -554,7 +732,10 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 				b.app.Flash().Errf("Invalid nuker %T", b.accessor)
 				continue
 			}
-			if err := nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace); err != nil {
+			err := deleteStashed(b.app, b.GVR().String(), sel, func() error {
+				return nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace)
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
@@ -566,7 +747,8 @@ func (b *Browser) simpleDelete(selections []string, msg string) {
 }
 
 func (b *Browser) resourceDelete(selections []string, msg string) {
-	dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
+	ctx, cancel := context.WithCancel(context.Background())
+	confirm := dialog.ShowDelete(b.app.Styles.Dialog(), b.app.Content.Pages, msg, func(propagation *metav1.DeletionPropagation, force bool) {
 		b.ShowDeleted()
 		if len(selections) > 1 {
 			b.app.Flash().Infof("Delete %d marked %s", len(selections), b.GVR())
@@ -578,7 +760,10 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			if force {
 				grace = dao.ForceGrace
 			}
-			if err := b.GetModel().Delete(b.defaultContext(), sel, propagation, grace); err != nil {
+			err := deleteStashed(b.app, b.GVR().String(), sel, func() error {
+				return b.GetModel().Delete(b.defaultContext(), sel, propagation, grace)
+			})
+			if err != nil {
 				b.app.Flash().Errf("Delete failed with `%s", err)
 			} else {
 				b.app.factory.DeleteForwarder(sel)
@@ -586,5 +771,6 @@ func (b *Browser) resourceDelete(selections []string, msg string) {
 			b.GetTable().DeleteMark(sel)
 		}
 		b.refresh()
-	}, func() {})
+	}, func() { cancel() })
+	previewCascade(ctx, b.app, confirm, b.GVR().String(), msg, selections)
 }
@@ -0,0 +1,59 @@
+package view
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestBrowserActionsNotShadowed(t *testing.T) {
+	uu := map[string]struct {
+		gvr    client.GVR
+		viewFn func(client.GVR) ResourceViewer
+	}{
+		"pods":     {gvr: client.NewGVR("v1/pods"), viewFn: NewPod},
+		"services": {gvr: client.NewGVR("v1/services"), viewFn: NewService},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			meta := metav1.APIResource{Name: u.gvr.R(), Namespaced: true, Verbs: []string{"get", "list", "watch", "edit", "delete"}}
+
+			b := NewBrowser(u.gvr).(*Browser)
+			assert.Nil(t, b.Init(makeContext()))
+			b.meta = meta
+			b.refreshActions()
+
+			v := u.viewFn(u.gvr)
+			assert.Nil(t, v.Init(makeContext()))
+			vb := browserOf(t, v)
+			vb.meta = meta
+			vb.refreshActions()
+
+			aa := v.Actions()
+			for key, a := range b.Actions() {
+				va, ok := aa[key]
+				assert.True(t, ok, "missing browser action %q", a.Description)
+				assert.Equal(t, a.Description, va.Description, "browser action %q shadowed", a.Description)
+			}
+		})
+	}
+}
+
+// browserOf unwraps a viewer extenders down to its browser.
+func browserOf(t *testing.T, v ResourceViewer) *Browser {
+	for {
+		if b, ok := v.(*Browser); ok {
+			return b
+		}
+		f := reflect.Indirect(reflect.ValueOf(v)).FieldByName("ResourceViewer")
+		if !f.IsValid() {
+			t.Fatalf("no browser found on %T", v)
+		}
+		v = f.Interface().(ResourceViewer)
+	}
+}
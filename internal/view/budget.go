@@ -0,0 +1,50 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	truncatedRowsFmt = "Showing the first %d of %d %s by namespace/name. Sorts and filters only apply to those. Use a namespace or label selector to narrow the view"
+	truncatedTextFmt = "\n... output truncated at %d bytes. Raise budget.maxTextBytes to see more"
+)
+
+// truncateText caps a text to a byte budget.
+func truncateText(s string, max int) (string, bool) {
+	if max <= 0 || len(s) <= max {
+		return s, false
+	}
+
+	return cutText(s, max) + fmt.Sprintf(truncatedTextFmt, max), true
+}
+
+// truncateLines caps text lines to a byte budget.
+func truncateLines(ll []string, max int) ([]string, bool) {
+	if max <= 0 {
+		return ll, false
+	}
+	var size int
+	for i, l := range ll {
+		if size+len(l) > max {
+			out := append(make([]string, 0, i+2), ll[:i]...)
+			if keep := max - size; keep > 0 {
+				out = append(out, cutText(l, keep))
+			}
+			return append(out, strings.TrimPrefix(fmt.Sprintf(truncatedTextFmt, max), "\n")), true
+		}
+		size += len(l) + 1
+	}
+
+	return ll, false
+}
+
+// cutText cuts a text to n bytes without splitting runes.
+func cutText(s string, n int) string {
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+
+	return s[:n]
+}
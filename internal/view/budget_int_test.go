@@ -0,0 +1,55 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTruncateText(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+		max  int
+		ok   bool
+	}{
+		"none":   {s: "blee", e: "blee"},
+		"within": {s: "blee", max: 4, e: "blee"},
+		"over":   {s: "blee", max: 2, e: "bl\n... output truncated at 2 bytes. Raise budget.maxTextBytes to see more", ok: true},
+		"runes":  {s: "héllo", max: 2, e: "h\n... output truncated at 2 bytes. Raise budget.maxTextBytes to see more", ok: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			s, ok := truncateText(u.s, u.max)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, s)
+		})
+	}
+}
+
+func TestTruncateLines(t *testing.T) {
+	ll := []string{"fred", "blee", "zorg"}
+
+	uu := map[string]struct {
+		max int
+		e   []string
+		ok  bool
+	}{
+		"none":     {e: ll},
+		"within":   {max: 14, e: ll},
+		"partial":  {max: 7, e: []string{"fred", "bl", "... output truncated at 7 bytes. Raise budget.maxTextBytes to see more"}, ok: true},
+		"boundary": {max: 5, e: []string{"fred", "... output truncated at 5 bytes. Raise budget.maxTextBytes to see more"}, ok: true},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			rr, ok := truncateLines(ll, u.max)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, rr)
+			assert.Equal(t, "fred blee zorg", strings.Join(ll, " "))
+		})
+	}
+}
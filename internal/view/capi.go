@@ -0,0 +1,138 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+const capiMachineGVR = "cluster.x-k8s.io/v1beta1/machines"
+
+// Cluster represents a Cluster API Cluster viewer.
+type Cluster struct {
+	ResourceViewer
+}
+
+// NewCluster returns a new viewer.
+func NewCluster(gvr client.GVR) ResourceViewer {
+	c := Cluster{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetEnterFn(c.showMachines)
+
+	return &c
+}
+
+func (c *Cluster) showMachines(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showMachines(app, path, render.CAPIClusterLabel+"="+n)
+}
+
+// MachineDeployment represents a Cluster API MachineDeployment viewer.
+type MachineDeployment struct {
+	ResourceViewer
+}
+
+// NewMachineDeployment returns a new viewer.
+func NewMachineDeployment(gvr client.GVR) ResourceViewer {
+	m := MachineDeployment{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetEnterFn(m.showMachines)
+
+	return &m
+}
+
+func (m *MachineDeployment) showMachines(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showMachines(app, path, render.CAPIDeploymentLabel+"="+n)
+}
+
+// Machine represents a Cluster API Machine viewer.
+type Machine struct {
+	ResourceViewer
+}
+
+// NewMachine returns a new viewer.
+func NewMachine(gvr client.GVR) ResourceViewer {
+	m := Machine{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.AddBindKeysFn(m.bindKeys)
+	m.GetTable().SetEnterFn(m.showNode)
+
+	return &m
+}
+
+func (m *Machine) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Phase", m.GetTable().SortColCmd("PHASE", true), false),
+		ui.KeyShiftN: ui.NewKeyAction("Sort Node", m.GetTable().SortColCmd("NODE", true), false),
+	})
+	if m.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Remediate", m.remediateCmd, true),
+	})
+}
+
+func (m *Machine) showNode(app *App, _ ui.Tabular, _, path string) {
+	var res dao.Machine
+	res.Init(app.factory, m.GVR())
+	mx, err := res.GetInstance(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	node := mx.NodeName()
+	if node == "" {
+		app.Flash().Warnf("Machine %s is not linked to a node yet", path)
+		return
+	}
+
+	app.gotoResource("nodes", client.FQN(client.ClusterScope, node), false)
+}
+
+func (m *Machine) remediateCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := m.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	m.Stop()
+	defer m.Start()
+	msg := fmt.Sprintf("Delete machine %s and let its owner provision a replacement?", path)
+	dialog.ShowConfirm(m.App().Styles.Dialog(), m.App().Content.Pages, "Confirm Remediate", msg, func() {
+		var res dao.Machine
+		res.Init(m.App().factory, m.GVR())
+		if err := res.Remediate(context.Background(), path); err != nil {
+			m.App().Flash().Err(err)
+			return
+		}
+		m.App().Flash().Infof("Remediation requested for machine %s", path)
+	}, func() {})
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func showMachines(app *App, path, labelSel string) {
+	v := NewMachine(client.NewGVR(capiMachineGVR))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, labelSel)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
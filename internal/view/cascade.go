@@ -0,0 +1,106 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// cascadeListMax tracks the max number of dependents listed by name.
+	cascadeListMax = 5
+
+	// cascadeKindsMax tracks the max number of dependent kinds summarized.
+	cascadeKindsMax = 6
+
+	cascadePending = "\n\nLooking up dependents..."
+	cascadeUnknown = "\n\nUnless orphaned, may also delete an unknown number of dependents."
+)
+
+// previewCascade looks up the resources deleted along with the given selections in the
+// background and appends them to the delete dialog message once known.
+func previewCascade(ctx context.Context, app *App, confirm *tview.ModalForm, gvr, msg string, selections []string) {
+	if !app.ConOK() {
+		return
+	}
+	confirm.SetText(msg + cascadePending)
+	go func() {
+		s := cascadeMsg(ctx, app, gvr, selections)
+		if ctx.Err() != nil {
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			confirm.SetText(msg + s)
+		})
+	}()
+}
+
+// cascadeMsg returns a preview of the resources deleted along with the given selections.
+func cascadeMsg(ctx context.Context, app *App, gvr string, selections []string) string {
+	var c dao.Cascade
+	for _, sel := range selections {
+		cc, err := dao.FindCascade(ctx, app.factory, gvr, sel)
+		if err != nil {
+			log.Warn().Err(err).Msgf("Cascade preview failed for %s", sel)
+			return cascadeUnknown
+		}
+		c.Refs, c.Partial = append(c.Refs, cc.Refs...), c.Partial || cc.Partial
+	}
+
+	return cascadeSummary(c)
+}
+
+// cascadeSummary lists dependents by name when only a few, otherwise counts them by kind.
+// Incomplete lookups only report a lower bound.
+func cascadeSummary(c dao.Cascade) string {
+	refs := c.Refs
+	if len(refs) == 0 {
+		if c.Partial {
+			return cascadeUnknown
+		}
+		return ""
+	}
+
+	var b strings.Builder
+	if c.Partial {
+		fmt.Fprintf(&b, "\n\nUnless orphaned, also deletes at least %d dependent(s) (partial lookup):", len(refs))
+	} else {
+		fmt.Fprintf(&b, "\n\nUnless orphaned, also deletes %d dependent(s):", len(refs))
+	}
+	if len(refs) <= cascadeListMax {
+		for _, r := range refs {
+			fmt.Fprintf(&b, "\n%s%s %s", strings.Repeat("  ", r.Depth), client.NewGVR(r.GVR).R(), r.FQN)
+		}
+		return b.String()
+	}
+
+	counts := make(map[string]int)
+	for _, r := range refs {
+		counts[client.NewGVR(r.GVR).R()]++
+	}
+	kk := make([]string, 0, len(counts))
+	for k := range counts {
+		kk = append(kk, k)
+	}
+	sort.Slice(kk, func(i, j int) bool {
+		if counts[kk[i]] != counts[kk[j]] {
+			return counts[kk[i]] > counts[kk[j]]
+		}
+		return kk[i] < kk[j]
+	})
+	for i, k := range kk {
+		if i == cascadeKindsMax {
+			fmt.Fprintf(&b, "\n  ...%d more kind(s)", len(kk)-i)
+			break
+		}
+		fmt.Fprintf(&b, "\n  %d %s", counts[k], k)
+	}
+
+	return b.String()
+}
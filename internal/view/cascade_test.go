@@ -0,0 +1,54 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCascadeSummary(t *testing.T) {
+	many := make([]dao.CascadeRef, 0, 9)
+	for i := 0; i < 7; i++ {
+		many = append(many, dao.CascadeRef{GVR: "v1/pods", FQN: "default/p", Depth: 1})
+	}
+	many = append(many,
+		dao.CascadeRef{GVR: "v1/secrets", FQN: "default/s1", Depth: 1},
+		dao.CascadeRef{GVR: "apps/v1/replicasets", FQN: "default/rs1", Depth: 1},
+	)
+
+	uu := map[string]struct {
+		refs    []dao.CascadeRef
+		partial bool
+		e       string
+	}{
+		"none": {},
+		"unknown": {
+			partial: true,
+			e:       cascadeUnknown,
+		},
+		"partial": {
+			refs:    []dao.CascadeRef{{GVR: "v1/pods", FQN: "default/p1", Depth: 1}},
+			partial: true,
+			e:       "\n\nUnless orphaned, also deletes at least 1 dependent(s) (partial lookup):\n  pods default/p1",
+		},
+		"few": {
+			refs: []dao.CascadeRef{
+				{GVR: "apps/v1/replicasets", FQN: "default/rs1", Depth: 1},
+				{GVR: "v1/pods", FQN: "default/p1", Depth: 2},
+			},
+			e: "\n\nUnless orphaned, also deletes 2 dependent(s):\n  replicasets default/rs1\n    pods default/p1",
+		},
+		"many": {
+			refs: many,
+			e:    "\n\nUnless orphaned, also deletes 9 dependent(s):\n  7 pods\n  1 replicasets\n  1 secrets",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, cascadeSummary(dao.Cascade{Refs: u.refs, Partial: u.partial}))
+		})
+	}
+}
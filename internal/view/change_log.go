@@ -0,0 +1,108 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	changeLogRate    = time.Second
+	changeLogEntries = 500
+)
+
+// ChangeLog tracks field level updates on a single resource.
+type ChangeLog struct {
+	*Details
+
+	gvr, path string
+	entries   []string
+	cancel    context.CancelFunc
+}
+
+// NewChangeLog returns a new change log viewer.
+func NewChangeLog(app *App, gvr, path string) *ChangeLog {
+	return &ChangeLog{
+		Details: NewDetails(app, "Changes", path, true),
+		gvr:     gvr,
+		path:    path,
+	}
+}
+
+// Start starts tracking the resource changes.
+func (c *ChangeLog) Start() {
+	if c.cancel != nil {
+		c.cancel()
+	}
+
+	var ctx context.Context
+	ctx, c.cancel = context.WithCancel(context.Background())
+	go c.watch(ctx)
+}
+
+// Stop terminates the changes tracking.
+func (c *ChangeLog) Stop() {
+	if c.cancel != nil {
+		c.cancel()
+		c.cancel = nil
+	}
+	c.Details.Stop()
+}
+
+func (c *ChangeLog) watch(ctx context.Context) {
+	var prev *unstructured.Unstructured
+	for {
+		o, err := c.app.factory.Get(c.gvr, c.path, true, labels.Everything())
+		var cur *unstructured.Unstructured
+		if err == nil {
+			cur, _ = o.(*unstructured.Unstructured)
+		}
+		if ee := c.track(prev, cur, time.Now()); len(ee) > 0 {
+			report := c.report()
+			c.app.QueueUpdateDraw(func() {
+				c.Update(report)
+			})
+		}
+		prev = cur
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(changeLogRate):
+		}
+	}
+}
+
+// track records the changes between two revisions and returns the new entries.
+func (c *ChangeLog) track(prev, cur *unstructured.Unstructured, at time.Time) []string {
+	stamp := at.Format("15:04:05")
+	var ee []string
+	switch {
+	case cur == nil && prev == nil:
+		return nil
+	case cur == nil:
+		ee = append(ee, fmt.Sprintf("%s deleted", stamp))
+	case prev == nil:
+		ee = append(ee, fmt.Sprintf("%s watching %s (rv=%s)", stamp, c.path, cur.GetResourceVersion()))
+	case prev.GetResourceVersion() != cur.GetResourceVersion():
+		for _, f := range dao.FieldChanges(prev.Object, cur.Object) {
+			ee = append(ee, fmt.Sprintf("%s rv=%s %s", stamp, cur.GetResourceVersion(), f))
+		}
+	}
+	c.entries = append(c.entries, ee...)
+	if len(c.entries) > changeLogEntries {
+		c.entries = c.entries[len(c.entries)-changeLogEntries:]
+	}
+
+	return ee
+}
+
+func (c *ChangeLog) report() string {
+	return tview.Escape(strings.Join(c.entries, "\n")) + "\n"
+}
@@ -0,0 +1,27 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestChangeLogTrack(t *testing.T) {
+	c := ChangeLog{path: "default/fred"}
+	at := time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC)
+	rev := func(rv string, replicas int64) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"metadata": map[string]interface{}{"name": "fred", "resourceVersion": rv},
+			"spec":     map[string]interface{}{"replicas": replicas},
+		}}
+	}
+
+	assert.Equal(t, []string{"10:00:00 watching default/fred (rv=1)"}, c.track(nil, rev("1", 1), at))
+	assert.Empty(t, c.track(rev("1", 1), rev("1", 1), at))
+	assert.Equal(t, []string{"10:00:00 rv=2 spec.replicas: 1 → 3"}, c.track(rev("1", 1), rev("2", 3), at))
+	assert.Equal(t, []string{"10:00:00 deleted"}, c.track(rev("2", 3), nil, at))
+	assert.Empty(t, c.track(nil, nil, at))
+	assert.Len(t, c.entries, 3)
+}
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Churn represents the watch activity per resource.
+type Churn struct {
+	ResourceViewer
+}
+
+// NewChurn returns a new viewer.
+func NewChurn(gvr client.GVR) ResourceViewer {
+	c := Churn{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	c.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	c.GetTable().SetSortCol("RATE/S", false)
+	c.GetTable().SetDecorateFn(c.decorateRows)
+	c.AddBindKeysFn(c.bindKeys)
+
+	return &c
+}
+
+// Init initializes the view.
+func (c *Churn) Init(ctx context.Context) error {
+	if err := c.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	c.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (c *Churn) decorateRows(data *render.TableData) {
+	var thrashing int
+	for _, re := range data.RowEvents {
+		if !render.Happy(client.ClusterScope, data.Header, re.Row) {
+			thrashing++
+		}
+	}
+	c.GetTable().Extras = fmt.Sprintf("Watches %d, Thrashing %d", len(data.RowEvents), thrashing)
+}
+
+func (c *Churn) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", c.gotoCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", c.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftO:   ui.NewKeyAction("Sort Objects", c.GetTable().SortColCmd("OBJECTS", false), false),
+		ui.KeyShiftU:   ui.NewKeyAction("Sort Updates", c.GetTable().SortColCmd("UPDATES", false), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Rate", c.GetTable().SortColCmd("RATE/S", false), false),
+	})
+}
+
+func (c *Churn) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := c.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	c.App().gotoResource(ui.TrimCell(c.GetTable().SelectTable, row, 0), "", false)
+
+	return nil
+}
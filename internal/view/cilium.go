@@ -0,0 +1,34 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// CiliumEndpoint represents a CiliumEndpoint viewer.
+type CiliumEndpoint struct {
+	ResourceViewer
+}
+
+// NewCiliumEndpoint returns a new viewer.
+func NewCiliumEndpoint(gvr client.GVR) ResourceViewer {
+	c := CiliumEndpoint{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.AddBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(c.showPod)
+
+	return &c
+}
+
+func (c *CiliumEndpoint) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftD: ui.NewKeyAction("Sort Drops", c.GetTable().SortColCmd("DROPS", false), false),
+		ui.KeyShiftI: ui.NewKeyAction("Sort Identity", c.GetTable().SortColCmd("IDENTITY", false), false),
+	})
+}
+
+// showPod navigates to the pod backing the endpoint.
+func (c *CiliumEndpoint) showPod(app *App, _ ui.Tabular, _, path string) {
+	app.gotoResource("pods", path, false)
+}
@@ -2,17 +2,22 @@ package view
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/tchart"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
 )
 
+// headerGaugeWidth tracks the width of the header cpu/mem gauges.
+const headerGaugeWidth = 10
+
 var _ model.ClusterInfoListener = (*ClusterInfo)(nil)
 
 // ClusterInfo represents a cluster info view.
@@ -21,6 +26,9 @@ type ClusterInfo struct {
 
 	app    *App
 	styles *config.Styles
+
+	// notifiedRev tracks the latest k9s release the user was notified about.
+	notifiedRev string
 }
 
 // NewClusterInfo returns a new cluster info view.
@@ -61,9 +69,17 @@ func (c *ClusterInfo) hasMetrics() bool {
 }
 
 func (c *ClusterInfo) layout() {
-	for row, section := range []string{"Context", "Cluster", "User", "K9s Rev", "K8s Rev", "CPU", "MEM"} {
-		c.SetCell(row, 0, c.sectionCell(section))
+	h := c.app.Config.K9s.ActiveHeader()
+	row := 0
+	for _, f := range h.Fields {
+		c.SetCell(row, 0, c.sectionCell(config.HeaderFields[f]))
+		c.SetCell(row, 1, c.infoCell(render.NAValue))
+		row++
+	}
+	for _, l := range h.Lines {
+		c.SetCell(row, 0, c.sectionCell(l.Name))
 		c.SetCell(row, 1, c.infoCell(render.NAValue))
+		row++
 	}
 }
 
@@ -99,30 +115,67 @@ func (c *ClusterInfo) ClusterInfoUpdated(data model.ClusterMeta) {
 
 // ClusterInfoChanged notifies the cluster meta was changed.
 func (c *ClusterInfo) ClusterInfoChanged(prev, curr model.ClusterMeta) {
+	if curr.K9sLatest != "" && curr.K9sLatest != c.notifiedRev {
+		c.notifiedRev = curr.K9sLatest
+		c.app.Flash().Infof("K9s %s is available. Use :update to upgrade", curr.K9sLatest)
+	}
 	c.app.QueueUpdateDraw(func() {
 		c.Clear()
 		c.layout()
-		row := c.setCell(0, curr.Context)
-		row = c.setCell(row, curr.Cluster)
-		row = c.setCell(row, curr.User)
-		if curr.K9sLatest != "" {
-			row = c.setCell(row, fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest))
-		} else {
-			row = c.setCell(row, curr.K9sVer)
+		mx := c.hasMetrics()
+		row := 0
+		for _, f := range c.app.Config.K9s.ActiveHeader().Fields {
+			row = c.setCell(row, c.fieldValue(f, prev, curr, mx))
+		}
+		for _, l := range curr.Lines {
+			row = c.setCell(row, tview.Escape(l))
 		}
-		row = c.setCell(row, curr.K8sVer)
-		if c.hasMetrics() {
-			row = c.setCell(row, ui.AsPercDelta(prev.Cpu, curr.Cpu))
-			_ = c.setCell(row, ui.AsPercDelta(prev.Mem, curr.Mem))
+		if mx {
 			c.setDefCon(curr.Cpu, curr.Mem)
-		} else {
-			row = c.setCell(row, "[orangered::b]n/a")
-			_ = c.setCell(row, "[orangered::b]n/a")
 		}
 		c.updateStyle()
 	})
 }
 
+func (c *ClusterInfo) fieldValue(f string, prev, curr model.ClusterMeta, mx bool) string {
+	switch f {
+	case config.HeaderContext:
+		return curr.Context
+	case config.HeaderCluster:
+		return curr.Cluster
+	case config.HeaderUser:
+		return curr.User
+	case config.HeaderK9s:
+		if curr.K9sLatest != "" {
+			return fmt.Sprintf("%s ⚡️[cadetblue::b]%s", curr.K9sVer, curr.K9sLatest)
+		}
+		return curr.K9sVer
+	case config.HeaderK8s:
+		return curr.K8sVer
+	case config.HeaderCPU:
+		if !mx {
+			return "[orangered::b]n/a"
+		}
+		return c.percGauge(prev.Cpu, curr.Cpu)
+	case config.HeaderMEM:
+		if !mx {
+			return "[orangered::b]n/a"
+		}
+		return c.percGauge(prev.Mem, curr.Mem)
+	case config.HeaderAPI:
+		return apiStatsValue(curr.API)
+	default:
+		return render.NAValue
+	}
+}
+
+// percGauge renders a percentage along with its gauge.
+func (c *ClusterInfo) percGauge(prev, curr int) string {
+	bar := tchart.PercentBar(curr, headerGaugeWidth, tchart.ParseGaugeMode(c.app.Config.K9s.Gauges))
+
+	return ui.AsPercDelta(prev, curr) + " " + bar
+}
+
 const defconFmt = "%s %s level!"
 
 func (c *ClusterInfo) setDefCon(cpu, mem int) {
@@ -157,6 +210,24 @@ func (c *ClusterInfo) updateStyle() {
 // ----------------------------------------------------------------------------
 // Helpers...
 
+func apiStatsValue(s client.APIStatsSnapshot) string {
+	if s.Requests == 0 {
+		return render.NAValue
+	}
+	v := fmt.Sprintf("%s p95 %s", s.P50.Round(time.Millisecond), s.P95.Round(time.Millisecond))
+	if s.Errors > 0 {
+		v += fmt.Sprintf(" %d err", s.Errors)
+	}
+	if s.Throttled > 0 {
+		v += fmt.Sprintf(" %d 429", s.Throttled)
+	}
+	if s.IsDegraded() {
+		return "[red::b]" + v
+	}
+
+	return v
+}
+
 func flashLevel(l config.SeverityLevel) model.FlashLevel {
 	// nolint:exhaustive
 	switch l {
@@ -18,7 +18,7 @@ type ConfigMap struct {
 // NewConfigMap returns a new viewer.
 func NewConfigMap(gvr client.GVR) ResourceViewer {
 	s := ConfigMap{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewFormEditExtender(NewBrowser(gvr)),
 	}
 	s.AddBindKeysFn(s.bindKeys)
 
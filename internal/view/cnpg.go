@@ -0,0 +1,100 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// PGCluster represents a CloudNativePG Cluster viewer.
+type PGCluster struct {
+	ResourceViewer
+}
+
+// NewPGCluster returns a new viewer.
+func NewPGCluster(gvr client.GVR) ResourceViewer {
+	p := PGCluster{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.AddBindKeysFn(p.bindKeys)
+	p.GetTable().SetEnterFn(p.showInstances)
+
+	return &p
+}
+
+func (p *PGCluster) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftL: ui.NewKeyAction("Sort Lag", p.GetTable().SortColCmd("LAG", false), false),
+		ui.KeyShiftB: ui.NewKeyAction("Sort Last Backup", p.GetTable().SortColCmd("LAST-BACKUP", true), false),
+	})
+	if p.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Switchover", p.switchoverCmd, true),
+		ui.KeyB: ui.NewKeyAction("Backup Now", p.backupCmd, true),
+	})
+}
+
+// showInstances lists the cluster instance pods.
+func (p *PGCluster) showInstances(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showPodsWithLabels(app, path, map[string]string{render.CNPGClusterLabel: n})
+}
+
+func (p *PGCluster) switchoverCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.PGCluster
+	res.Init(p.App().factory, p.GVR())
+	target, err := res.SwitchoverTarget(context.Background(), path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+
+	p.Stop()
+	defer p.Start()
+	msg := fmt.Sprintf("Switchover cluster %s to replica %s?", path, target)
+	dialog.ShowConfirm(p.App().Styles.Dialog(), p.App().Content.Pages, "Confirm Switchover", msg, func() {
+		if err := res.Switchover(context.Background(), path, target); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Switching over cluster %s to %s", path, target)
+	}, func() {})
+
+	return nil
+}
+
+func (p *PGCluster) backupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	p.Stop()
+	defer p.Start()
+	msg := fmt.Sprintf("Create an on-demand backup of cluster %s?", path)
+	dialog.ShowConfirm(p.App().Styles.Dialog(), p.App().Content.Pages, "Confirm Backup", msg, func() {
+		var res dao.PGCluster
+		res.Init(p.App().factory, p.GVR())
+		fqn, err := res.Backup(context.Background(), path)
+		if err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.App().Flash().Infof("Backup %s created", fqn)
+	}, func() {})
+
+	return nil
+}
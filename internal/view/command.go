@@ -1,16 +1,20 @@
 package view
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"net"
 	"regexp"
 	"runtime/debug"
 	"strings"
 	"sync"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/rs/zerolog/log"
 )
 
@@ -70,6 +74,7 @@ func allowedXRay(gvr client.GVR) bool {
 		"apps/v1/daemonsets",
 		"apps/v1/statefulsets",
 		"apps/v1/replicasets",
+		"networking.k8s.io/v1/ingresses",
 	}
 	for _, g := range gg {
 		if g == gvr.String() {
@@ -108,8 +113,57 @@ func (c *Command) xrayCmd(cmd string) error {
 	return c.exec(cmd, "xrays", x, true)
 }
 
+func (c *Command) explainCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	var gvr client.GVR
+	if len(tokens) > 1 {
+		g, ok := c.alias.AsGVR(tokens[1])
+		if !ok {
+			return fmt.Errorf("`%s` command not found", cmd)
+		}
+		gvr = g
+	} else {
+		v, ok := c.app.Content.Top().(ResourceViewer)
+		if !ok {
+			return errors.New("You must specify a resource")
+		}
+		gvr = v.GVR()
+	}
+	meta, err := dao.MetaAccess.MetaFor(gvr)
+	if err != nil {
+		return err
+	}
+	if dao.IsK9sMeta(meta) {
+		return fmt.Errorf("no schema available for %s", gvr.R())
+	}
+
+	return c.app.inject(NewExplain(c.app, gvr), false)
+}
+
+func (c *Command) ipCmd(cmd string) error {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return errors.New("You must specify an IP address")
+	}
+	if net.ParseIP(tokens[1]) == nil {
+		return fmt.Errorf("`%s` is not a valid IP address", tokens[1])
+	}
+
+	v := NewRelated(client.NewGVR("ips"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, tokens[1])
+	})
+
+	return c.app.inject(v, false)
+}
+
 // Exec the Command by showing associated display.
-func (c *Command) run(cmd, path string, clearStack bool) error {
+func (c *Command) run(cmd, path string, clearStack bool) (err error) {
+	_, span := tracing.Start(context.Background(), "command", tracing.String("command", cmd))
+	defer func() {
+		span.RecordError(err)
+		span.End()
+	}()
 	if c.specialCmd(cmd, path) {
 		return nil
 	}
@@ -193,6 +247,49 @@ func (c *Command) specialCmd(cmd, path string) bool {
 			c.app.Flash().Err(err)
 		}
 		return true
+	case "explain":
+		if err := c.explainCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "ip":
+		if err := c.ipCmd(cmd); err != nil {
+			c.app.Flash().Err(err)
+		}
+		return true
+	case "dns":
+		if c.app.Config.K9s.IsReadOnly() {
+			c.app.Flash().Warn("DNS lookups are disabled in read-only mode")
+			return true
+		}
+		opts, err := parseDNSCmd(cmd, c.app.Config.ActiveNamespace())
+		if err != nil {
+			c.app.Flash().Err(err)
+			return true
+		}
+		dnsLookup(c.app, opts)
+		return true
+	case "banner":
+		showBanner(c.app, true)
+		return true
+	case "update":
+		selfUpdate(c.app)
+		return true
+	case "undo":
+		if c.app.Config.K9s.IsReadOnly() {
+			c.app.Flash().Warn("Undo is disabled in read-only mode")
+			return true
+		}
+		undoDelete(c.app, "")
+		return true
+	case "snapshot":
+		opts, err := parseSnapshotCmd(cmd, c.app.Config.ActiveNamespace())
+		if err != nil {
+			c.app.Flash().Err(err)
+			return true
+		}
+		snapshotExport(c.app, opts)
+		return true
 	default:
 		if !canRX.MatchString(cmd) {
 			return false
@@ -217,6 +314,9 @@ func (c *Command) viewMetaFor(cmd string) (string, *MetaViewer, error) {
 
 	v, ok := customViewers[gvr]
 	if !ok {
+		if dao.IsCrossplane(gvr) {
+			return gvr.String(), &MetaViewer{viewerFn: NewCrossplane}, nil
+		}
 		return gvr.String(), &MetaViewer{viewerFn: NewBrowser}, nil
 	}
 
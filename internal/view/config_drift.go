@@ -0,0 +1,41 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+)
+
+func driftReport(r dao.DriftReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "object: %s\n", r.Path)
+	if r.Source != "" {
+		fmt.Fprintf(&b, "source: %s\n", r.Source)
+	}
+	if r.Basis == "" {
+		b.WriteString("basis: none\n\nNo last applied configuration or GitOps applied fields found.\n")
+		return tview.Escape(b.String())
+	}
+	fmt.Fprintf(&b, "basis: %s\n\n", r.Basis)
+	if !r.Drifted() {
+		b.WriteString("No drift detected.\n")
+		return tview.Escape(b.String())
+	}
+
+	if len(r.Changes) > 0 {
+		fmt.Fprintf(&b, "drift (%d): applied → live\n", len(r.Changes))
+		for _, c := range r.Changes {
+			fmt.Fprintf(&b, "  %s\n", c.String())
+		}
+	}
+	if len(r.Overrides) > 0 {
+		fmt.Fprintf(&b, "overridden (%d): field claimed by\n", len(r.Overrides))
+		for _, o := range r.Overrides {
+			fmt.Fprintf(&b, "  %s: %s\n", o.Field, strings.Join(o.Conflicts, ","))
+		}
+	}
+
+	return tview.Escape(b.String())
+}
@@ -0,0 +1,25 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDriftReportEscaped(t *testing.T) {
+	r := dao.DriftReport{
+		Path:      "default/cm[red]",
+		Source:    "argocd[app]",
+		Basis:     "last-applied",
+		Changes:   []dao.FieldChange{{Path: "data.k[0]", Old: "a", New: "b"}},
+		Overrides: []render.ManagedFieldRes{{Field: "spec.replicas", Conflicts: []string{"hpa[ctrl]"}}},
+	}
+
+	s := driftReport(r)
+	assert.Contains(t, s, "object: default/cm[red[]")
+	assert.Contains(t, s, "source: argocd[app[]")
+	assert.Contains(t, s, "data.k[0[]: a → b")
+	assert.Contains(t, s, "spec.replicas: hpa[ctrl[]")
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -12,6 +13,7 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 )
@@ -71,6 +73,7 @@ func (c *Container) bindKeys(aa ui.KeyActions) {
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", c.showPFCmd, true),
 		ui.KeyShiftF: ui.NewKeyAction("PortForward", c.portFwdCmd, true),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", c.GetTable().SortColCmd("RESTARTS", false), false),
+		ui.KeyShiftV: ui.NewKeyAction("Resolved Env", c.resolvedEnvCmd, true),
 	})
 	aa.Add(resourceSorters(c.GetTable()))
 }
@@ -183,6 +186,43 @@ func (c *Container) portFwdCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (c *Container) resolvedEnvCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	ee, err := dao.ResolvedEnv(c.App().factory, c.GetTable().Path, path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(c.App(), "Resolved Env", c.GetTable().Path+":"+path, true).Update(resolvedEnvReport(ee))
+	if err := c.App().inject(details, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// resolvedEnvReport lists a container env vars along with their sources.
+func resolvedEnvReport(ee []dao.EnvVar) string {
+	if len(ee) == 0 {
+		return "[gray::]No environment variables defined.\n"
+	}
+
+	var buff strings.Builder
+	for _, e := range ee {
+		if e.Missing {
+			fmt.Fprintf(&buff, "[orangered::b]%s[white::-]=%s [gray::]# %s (missing)\n", e.Name, tview.Escape(e.Value), tview.Escape(e.Source))
+			continue
+		}
+		fmt.Fprintf(&buff, "[aqua::b]%s[white::-]=%s [gray::]# %s\n", e.Name, tview.Escape(e.Value), tview.Escape(e.Source))
+	}
+
+	return buff.String()
+}
+
 func checkRunningStatus(co string, ss []v1.ContainerStatus) error {
 	var cs *v1.ContainerStatus
 	for i := range ss {
@@ -52,6 +52,8 @@ func useContext(app *App, name string) error {
 	if !ok {
 		return errors.New("Expecting a switchable resource")
 	}
+	// Pending debug network policies belong to the cluster being left.
+	app.debugNetPols.removeAll(app.factory)
 	if err := switcher.Switch(name); err != nil {
 		log.Error().Err(err).Msgf("Context switch failed")
 		return err
@@ -0,0 +1,55 @@
+package view
+
+import (
+	"strings"
+
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const cordonKey = "cordon"
+
+// CordonFunc represents a cordon callback function.
+type CordonFunc func(v ResourceViewer, path, reason string)
+
+// ShowCordon pops a node cordon dialog.
+func ShowCordon(view ResourceViewer, path, reason string, okFn CordonFunc) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	f.AddInputField("Reason:", reason, 40, nil, func(v string) {
+		reason = strings.TrimSpace(v)
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissCordon(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissCordon(view, pages)
+		okFn(view, path, reason)
+	})
+
+	modal := tview.NewModalForm("<Cordon>", f)
+	modal.SetText("Cordon " + path + "?")
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissCordon(view, pages)
+	})
+
+	pages.AddPage(cordonKey, modal, false, true)
+	pages.ShowPage(cordonKey)
+	view.App().SetFocus(pages.GetPrimitive(cordonKey))
+}
+
+// DismissCordon dismiss the cordon dialog.
+func DismissCordon(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(cordonKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
@@ -0,0 +1,113 @@
+package view
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/rs/zerolog/log"
+)
+
+// Crash represents an unexpected failure of the app loop.
+type Crash struct {
+	// Panic tracks the recovered panic.
+	Panic interface{}
+
+	// Report tracks the diagnostic bundle location if any.
+	Report string
+
+	// Command tracks the command restoring the view the app crashed on.
+	Command string
+}
+
+// Error returns the crash message.
+func (c *Crash) Error() string {
+	return fmt.Sprintf("k9s crashed: %v", c.Panic)
+}
+
+// crash shuts the app down following a panic and writes out a diagnostic bundle.
+func (a *App) crash(p interface{}, stack []byte) *Crash {
+	log.Error().Msgf("Boom! %v", p)
+	log.Error().Msg(string(stack))
+
+	c := Crash{Panic: p, Command: a.crashCommand()}
+	a.shutdown()
+
+	file, err := config.SaveCrashReport(config.CrashesDir(), a.crashReport(p, stack, c.Command))
+	if err != nil {
+		log.Error().Err(err).Msgf("Saving crash report")
+		return &c
+	}
+	c.Report = file
+
+	return &c
+}
+
+// shutdown stops the app loop and its background activities while guarding against
+// further failures on a damaged app state.
+func (a *App) shutdown() {
+	defer func() {
+		if err := recover(); err != nil {
+			log.Error().Msgf("Shutdown failed %v", err)
+		}
+	}()
+
+	a.Halt()
+	a.Stop()
+	if a.factory != nil {
+		a.factory.Terminate()
+	}
+	if a.shadow != nil {
+		a.shadow.Stop()
+	}
+	if a.title != nil {
+		a.title.close()
+	}
+	if a.stopTracing != nil {
+		a.stopTracing()
+	}
+	if a.stopMetrics != nil {
+		a.stopMetrics()
+	}
+}
+
+func (a *App) crashReport(p interface{}, stack []byte, view string) config.CrashReport {
+	ns := a.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+
+	return config.CrashReport{
+		Time:         time.Now(),
+		Version:      a.version,
+		Platform:     fmt.Sprintf("%s/%s %s", runtime.GOOS, runtime.GOARCH, runtime.Version()),
+		Context:      a.Config.K9s.CurrentContext,
+		Cluster:      a.Config.K9s.CurrentCluster,
+		Namespace:    ns,
+		View:         view,
+		ConfigDigest: config.ConfigDigest(config.K9sConfigFile),
+		Panic:        fmt.Sprintf("%v", p),
+		Stack:        string(stack),
+	}
+}
+
+// crashCommand returns the command restoring the current view.
+func (a *App) crashCommand() (cmd string) {
+	defer func() {
+		if err := recover(); err != nil {
+			cmd = ""
+		}
+	}()
+
+	switch c := a.Content.Top().(type) {
+	case nil:
+		return ""
+	case ResourceViewer:
+		return c.GVR().String()
+	default:
+		return strings.ToLower(c.Name())
+	}
+}
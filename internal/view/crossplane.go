@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// Crossplane represents a Crossplane claim, composite or managed resource viewer.
+type Crossplane struct {
+	ResourceViewer
+}
+
+// NewCrossplane returns a new viewer.
+func NewCrossplane(gvr client.GVR) ResourceViewer {
+	c := Crossplane{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	c.AddBindKeysFn(c.bindKeys)
+	c.GetTable().SetEnterFn(c.showResource)
+
+	return &c
+}
+
+func (c *Crossplane) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT:      ui.NewKeyAction("Tree", c.treeCmd, true),
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", c.GetTable().SortColCmd("READY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Synced", c.GetTable().SortColCmd("SYNCED", true), false),
+	})
+}
+
+// showResource navigates from a claim to its composite or shows the composition tree otherwise.
+func (c *Crossplane) showResource(app *App, _ ui.Tabular, _, path string) {
+	var res dao.Crossplane
+	res.Init(app.factory, c.GVR())
+	gvr, fqn, err := res.ResourceRef(path)
+	if err != nil {
+		c.showTree(path)
+		return
+	}
+
+	app.gotoResource(gvr.R(), fqn, false)
+}
+
+func (c *Crossplane) treeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := c.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	c.showTree(path)
+
+	return nil
+}
+
+func (c *Crossplane) showTree(path string) {
+	var res dao.Crossplane
+	res.Init(c.App().factory, c.GVR())
+	root, err := res.Tree(context.Background(), path)
+	if err != nil {
+		c.App().Flash().Err(err)
+		return
+	}
+	details := NewDetails(c.App(), "Composition", path, true).Update(tview.Escape(root.Tree()))
+	if err := c.App().inject(details, false); err != nil {
+		c.App().Flash().Err(err)
+	}
+}
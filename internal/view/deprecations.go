@@ -0,0 +1,74 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Deprecations represents resources relying on deprecated api versions.
+type Deprecations struct {
+	ResourceViewer
+}
+
+// NewDeprecations returns a new viewer.
+func NewDeprecations(gvr client.GVR) ResourceViewer {
+	d := Deprecations{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().SetSortCol("REMOVED", true)
+	d.GetTable().SetDecorateFn(d.decorateRows)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// Init initializes the view.
+func (d *Deprecations) Init(ctx context.Context) error {
+	if err := d.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	d.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (d *Deprecations) decorateRows(data *render.TableData) {
+	var flagged int
+	for _, re := range data.RowEvents {
+		if !render.Happy(client.ClusterScope, data.Header, re.Row) {
+			flagged++
+		}
+	}
+	d.GetTable().Extras = fmt.Sprintf("Objects %d, Blocking %d", len(data.RowEvents), flagged)
+}
+
+func (d *Deprecations) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", d.gotoCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", d.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Removed", d.GetTable().SortColCmd("REMOVED", true), false),
+		ui.KeyShiftO:   ui.NewKeyAction("Sort Owner", d.GetTable().SortColCmd("OWNER", true), false),
+	})
+}
+
+func (d *Deprecations) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := d.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+
+	t := d.GetTable().SelectTable
+	path := client.FQN(ui.TrimCell(t, row, 0), ui.TrimCell(t, row, 1))
+	d.App().gotoResource(ui.TrimCell(t, row, 2), path, false)
+
+	return nil
+}
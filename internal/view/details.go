@@ -156,6 +156,9 @@ func (d *Details) StylesChanged(s *config.Styles) {
 
 // Update updates the view content.
 func (d *Details) Update(buff string) *Details {
+	if d.app != nil {
+		buff, _ = truncateText(buff, d.app.Config.K9s.ActiveBudget().MaxTextBytes)
+	}
 	d.model.SetText(buff)
 	return d
 }
@@ -0,0 +1,92 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Devices represents the nodes device plugins resources viewer.
+type Devices struct {
+	ResourceViewer
+}
+
+// NewDevices returns a new viewer.
+func NewDevices(gvr client.GVR) ResourceViewer {
+	d := Devices{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	d.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	d.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	d.GetTable().SetDecorateFn(d.decorateRows)
+	d.AddBindKeysFn(d.bindKeys)
+
+	return &d
+}
+
+// Init initializes the view.
+func (d *Devices) Init(ctx context.Context) error {
+	if err := d.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	d.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (d *Devices) decorateRows(data *render.TableData) {
+	var unhealthy int
+	for _, re := range data.RowEvents {
+		if !render.Happy(client.ClusterScope, data.Header, re.Row) {
+			unhealthy++
+		}
+	}
+	d.GetTable().Extras = fmt.Sprintf("Devices %d, Unhealthy %d", len(data.RowEvents), unhealthy)
+}
+
+func (d *Devices) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Node", d.gotoNodeCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Goto Plugin", d.gotoPluginCmd, true),
+		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", d.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Resource", d.GetTable().SortColCmd("RESOURCE", true), false),
+	})
+}
+
+func (d *Devices) gotoNodeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if n := d.selectedCell("NODE"); n != "" {
+		d.App().gotoResource("v1/nodes", n, false)
+	}
+
+	return nil
+}
+
+func (d *Devices) gotoPluginCmd(evt *tcell.EventKey) *tcell.EventKey {
+	po := d.selectedCell("PLUGIN")
+	if po == "" {
+		d.App().Flash().Warn("No device plugin pod running for this resource")
+		return nil
+	}
+	d.App().gotoResource("v1/pods", po, false)
+
+	return nil
+}
+
+func (d *Devices) selectedCell(col string) string {
+	row, _ := d.GetTable().GetSelection()
+	if row == 0 {
+		return ""
+	}
+	idx, ok := d.GetTable().HeaderIndex(col)
+	if !ok {
+		return ""
+	}
+
+	return ui.TrimCell(d.GetTable().SelectTable, row, idx)
+}
@@ -0,0 +1,261 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/util/rand"
+)
+
+// DNSOpts represents a DNS lookup options.
+type DNSOpts struct {
+	Name      string
+	Namespace string
+	Pod       string
+	Container string
+}
+
+// parseDNSCmd parses a `dns name [namespace/pod[:container]]` command.
+func parseDNSCmd(cmd, ns string) (DNSOpts, error) {
+	tokens := strings.Fields(cmd)
+	if len(tokens) < 2 {
+		return DNSOpts{}, errors.New("You must specify a name to resolve")
+	}
+	opts := DNSOpts{Name: tokens[1], Namespace: ns}
+	if !probeHostRX.MatchString(opts.Name) {
+		return opts, fmt.Errorf("invalid name %q", opts.Name)
+	}
+	if client.IsClusterWide(opts.Namespace) {
+		opts.Namespace = client.DefaultNamespace
+	}
+	if len(tokens) < 3 {
+		return opts, nil
+	}
+	path, co, _ := strings.Cut(tokens[2], ":")
+	pns, po := client.Namespaced(path)
+	if po == "" || !probeHostRX.MatchString(po) || (co != "" && !probeHostRX.MatchString(co)) {
+		return opts, fmt.Errorf("invalid pod %q", tokens[2])
+	}
+	if pns != "" {
+		opts.Namespace = pns
+	}
+	opts.Pod, opts.Container = client.FQN(opts.Namespace, po), co
+
+	return opts, nil
+}
+
+// dnsLookup resolves a name from within the cluster and reports against the expected records.
+func dnsLookup(app *App, opts DNSOpts) {
+	via := "pod " + opts.Pod
+	if opts.Pod == "" {
+		via = "transient pod " + netshootImage
+	}
+	app.Flash().Infof("Resolving %s via %s...", opts.Name, via)
+	go func() {
+		res, err := runKu(app, shellOpts{clear: false, args: dnsLookupArgs(opts, dnsScript(opts.Name))})
+		rec, rerr := dao.ExpectedDNSRecord(app.factory, opts.Name, opts.Namespace)
+		if rerr != nil {
+			app.Flash().Err(rerr)
+		}
+		info := dao.CoreDNS(context.Background(), app.factory)
+		app.QueueUpdateDraw(func() {
+			details := NewDetails(app, "DNS", opts.Name, true).Update(dnsReport(opts, via, res, err, rec, info))
+			if err := app.inject(details, false); err != nil {
+				app.Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// dnsScript returns a shell script dumping the resolver config and resolving a name.
+func dnsScript(name string) string {
+	return fmt.Sprintf(`N='%s'
+echo "@@resolv.conf"
+cat /etc/resolv.conf 2>&1
+echo "@@rc $?"
+echo "@@lookup"
+if command -v nslookup >/dev/null 2>&1; then nslookup "$N" 2>&1; else getent hosts "$N" 2>&1; fi
+echo "@@rc $?"
+`, name)
+}
+
+// dnsLookupArgs returns kubectl args to run a lookup script from a pod or a transient pod.
+func dnsLookupArgs(opts DNSOpts, script string) []string {
+	if opts.Pod == "" {
+		return []string{
+			"run", fmt.Sprintf("k9s-dns-%d-%s", os.Getpid(), rand.String(5)), "-n", opts.Namespace,
+			"--image", netshootImage, "--restart", "Never", "--rm", "-i", "--quiet",
+			"--", "sh", "-c", script,
+		}
+	}
+	ns, po := client.Namespaced(opts.Pod)
+	args := []string{"exec", "-n", ns, po}
+	if opts.Container != "" {
+		args = append(args, "-c", opts.Container)
+	}
+
+	return append(args, "--", "sh", "-c", script)
+}
+
+// lookupAddresses extracts the resolved addresses from nslookup or getent output.
+func lookupAddresses(out []string) []string {
+	var (
+		aa     []string
+		inName bool
+		seen   = make(map[string]struct{})
+	)
+	add := func(s string) {
+		if net.ParseIP(s) == nil {
+			return
+		}
+		if _, ok := seen[s]; !ok {
+			seen[s] = struct{}{}
+			aa = append(aa, s)
+		}
+	}
+	for _, l := range out {
+		switch {
+		case strings.HasPrefix(l, "Name:"):
+			inName = true
+		case strings.HasPrefix(l, "Address"):
+			if _, v, ok := strings.Cut(l, ":"); ok && inName {
+				if ff := strings.Fields(v); len(ff) > 0 {
+					add(ff[0])
+				}
+			}
+		case strings.HasPrefix(l, "Server:"):
+			inName = false
+		default:
+			if ff := strings.Fields(l); len(ff) > 1 {
+				add(ff[0])
+			}
+		}
+	}
+	sort.Strings(aa)
+
+	return aa
+}
+
+// dnsMismatch returns the expected addresses that did not resolve and the unexpected ones that did.
+func dnsMismatch(expected, resolved []string) ([]string, []string) {
+	exp, res := make(map[string]struct{}, len(expected)), make(map[string]struct{}, len(resolved))
+	for _, a := range expected {
+		exp[a] = struct{}{}
+	}
+	for _, a := range resolved {
+		res[a] = struct{}{}
+	}
+	var missing, extra []string
+	for _, a := range expected {
+		if _, ok := res[a]; !ok {
+			missing = append(missing, a)
+		}
+	}
+	for _, a := range resolved {
+		if _, ok := exp[a]; !ok {
+			extra = append(extra, a)
+		}
+	}
+
+	return missing, extra
+}
+
+// dnsReport renders the lookup results.
+func dnsReport(opts DNSOpts, via, raw string, err error, rec *dao.DNSRecord, info dao.CoreDNSInfo) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "name: %s\n", opts.Name)
+	fmt.Fprintf(&b, "namespace: %s\n", opts.Namespace)
+	fmt.Fprintf(&b, "via: %s\n", via)
+
+	var resolved []string
+	cc := parseNetProbe(raw)
+	if len(cc) == 0 {
+		if err != nil {
+			fmt.Fprintf(&b, "error: %s\n", err)
+		}
+		b.WriteString("output:\n" + fmtResults(raw) + "\n")
+	}
+	for _, c := range cc {
+		status := "OK"
+		if !c.ok {
+			status = "FAILED"
+		}
+		fmt.Fprintf(&b, "%s:\n  status: %s\n", c.name, status)
+		if c.name == "lookup" {
+			resolved = lookupAddresses(c.out)
+			if len(resolved) > 0 {
+				b.WriteString("  addresses:\n")
+				for _, a := range resolved {
+					b.WriteString("    - " + a + "\n")
+				}
+			}
+		}
+		if len(c.out) > 0 && (c.name != "lookup" || !c.ok || len(resolved) == 0) {
+			b.WriteString("  output:\n")
+			for _, l := range c.out {
+				b.WriteString("    " + l + "\n")
+			}
+		}
+	}
+
+	if rec != nil {
+		fmt.Fprintf(&b, "expected:\n  service: %s\n  kind: %s\n", rec.Service, rec.Kind)
+		if len(rec.Addresses) == 0 {
+			b.WriteString("  addresses: none (no ready endpoints)\n")
+		} else {
+			b.WriteString("  addresses:\n")
+			for _, a := range rec.Addresses {
+				b.WriteString("    - " + a + "\n")
+			}
+		}
+		if rec.Kind != "CNAME" && len(cc) > 0 {
+			missing, extra := dnsMismatch(rec.Addresses, resolved)
+			switch {
+			case len(missing) == 0 && len(extra) == 0:
+				b.WriteString("  match: OK\n")
+			default:
+				b.WriteString("  match: MISMATCH\n")
+				if len(missing) > 0 {
+					fmt.Fprintf(&b, "  unresolved: %s\n", strings.Join(missing, ","))
+				}
+				if len(extra) > 0 {
+					fmt.Fprintf(&b, "  unexpected: %s\n", strings.Join(extra, ","))
+				}
+			}
+		}
+	}
+
+	b.WriteString("coredns:\n")
+	if info.Pod != "" {
+		fmt.Fprintf(&b, "  pod: %s\n", info.Pod)
+	}
+	if len(info.Cache) > 0 {
+		b.WriteString("  cache:\n")
+		for _, k := range []string{"entries", "hits", "misses"} {
+			if v, ok := info.Cache[k]; ok {
+				fmt.Fprintf(&b, "    %s: %d\n", k, v)
+			}
+		}
+	}
+	if info.Corefile == "" {
+		b.WriteString("  corefile: n/a\n")
+		return tview.Escape(b.String())
+	}
+	b.WriteString("  corefile: |\n")
+	for _, l := range strings.Split(strings.TrimRight(info.Corefile, "\n"), "\n") {
+		b.WriteString("    " + l + "\n")
+	}
+
+	return tview.Escape(b.String())
+}
@@ -0,0 +1,113 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseDNSCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, ns string
+		e       DNSOpts
+		err     bool
+	}{
+		"name": {
+			cmd: "dns web", ns: "prod",
+			e: DNSOpts{Name: "web", Namespace: "prod"},
+		},
+		"all-ns": {
+			cmd: "dns web.prod", ns: "",
+			e: DNSOpts{Name: "web.prod", Namespace: "default"},
+		},
+		"pod": {
+			cmd: "dns web fred/p1:c1", ns: "prod",
+			e: DNSOpts{Name: "web", Namespace: "fred", Pod: "fred/p1", Container: "c1"},
+		},
+		"relative-pod": {
+			cmd: "dns web p1", ns: "prod",
+			e: DNSOpts{Name: "web", Namespace: "prod", Pod: "prod/p1"},
+		},
+		"missing": {
+			cmd: "dns", err: true,
+		},
+		"injection": {
+			cmd: "dns web';rm", err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			opts, err := parseDNSCmd(u.cmd, u.ns)
+			assert.Equal(t, u.err, err != nil)
+			if !u.err {
+				assert.Equal(t, u.e, opts)
+			}
+		})
+	}
+}
+
+func TestDNSLookupArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"exec", "-n", "fred", "p1", "-c", "c1", "--", "sh", "-c", "s"},
+		dnsLookupArgs(DNSOpts{Namespace: "fred", Pod: "fred/p1", Container: "c1"}, "s"),
+	)
+	args := dnsLookupArgs(DNSOpts{Namespace: "prod"}, "s")
+	assert.Equal(t, "run", args[0])
+	assert.Contains(t, args, netshootImage)
+	assert.Equal(t, []string{"--", "sh", "-c", "s"}, args[len(args)-4:])
+	assert.NotEqual(t, args[1], dnsLookupArgs(DNSOpts{Namespace: "prod"}, "s")[1])
+}
+
+func TestLookupAddresses(t *testing.T) {
+	uu := map[string]struct {
+		out []string
+		e   []string
+	}{
+		"nslookup": {
+			out: []string{
+				"Server: 10.96.0.10",
+				"Address: 10.96.0.10#53",
+				"Name: db.prod.svc.cluster.local",
+				"Address: 10.0.0.2",
+				"Name: db.prod.svc.cluster.local",
+				"Address: 10.0.0.1",
+			},
+			e: []string{"10.0.0.1", "10.0.0.2"},
+		},
+		"busybox": {
+			out: []string{
+				"Server: 10.96.0.10",
+				"Address 1: 10.96.0.10 kube-dns.kube-system.svc.cluster.local",
+				"Name: web.prod",
+				"Address 1: 10.96.0.12 web.prod.svc.cluster.local",
+			},
+			e: []string{"10.96.0.12"},
+		},
+		"getent": {
+			out: []string{"10.96.0.12      web.prod.svc.cluster.local"},
+			e:   []string{"10.96.0.12"},
+		},
+		"nxdomain": {
+			out: []string{
+				"Server: 10.96.0.10",
+				"Address: 10.96.0.10#53",
+				"** server can't find nope.prod: NXDOMAIN",
+			},
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, lookupAddresses(u.out))
+		})
+	}
+}
+
+func TestDNSMismatch(t *testing.T) {
+	missing, extra := dnsMismatch([]string{"10.0.0.1", "10.0.0.2"}, []string{"10.0.0.2", "10.0.0.9"})
+	assert.Equal(t, []string{"10.0.0.1"}, missing)
+	assert.Equal(t, []string{"10.0.0.9"}, extra)
+}
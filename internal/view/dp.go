@@ -1,11 +1,15 @@
 package view
 
 import (
+	"context"
 	"errors"
+	"fmt"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
 	appsv1 "k8s.io/api/apps/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -23,8 +27,12 @@ func NewDeploy(gvr client.GVR) ResourceViewer {
 	d.ResourceViewer = NewPortForwardExtender(
 		NewRestartExtender(
 			NewScaleExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), d.logOptions),
+				NewResourcesExtender(
+					NewQuickEditExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), d.logOptions),
+						),
+					),
 				),
 			),
 		),
@@ -41,6 +49,51 @@ func (d *Deploy) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftU: ui.NewKeyAction("Sort UpToDate", d.GetTable().SortColCmd(uptodateCol, true), false),
 		ui.KeyShiftL: ui.NewKeyAction("Sort Available", d.GetTable().SortColCmd(availCol, true), false),
 	})
+	if d.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Pause/Resume", d.pauseCmd, true),
+	})
+}
+
+// pauseCmd pauses or resumes the selected deployments rollouts based on the first selection state.
+func (d *Deploy) pauseCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := d.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+	dp, err := d.dp(paths[0])
+	if err != nil {
+		d.App().Flash().Err(err)
+		return nil
+	}
+	pause, action := !dp.Spec.Paused, "Resume"
+	if pause {
+		action = "Pause"
+	}
+
+	d.Stop()
+	defer d.Start()
+	msg := fmt.Sprintf("%s rollout for deployment %s?", action, paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("%s rollouts for %d deployments?", action, len(paths))
+	}
+	dialog.ShowConfirm(d.App().Styles.Dialog(), d.App().Content.Pages, "Confirm "+action, msg, func() {
+		var res dao.Deployment
+		res.Init(d.App().factory, d.GVR())
+		ctx, cancel := context.WithTimeout(context.Background(), d.App().Conn().Config().CallTimeout())
+		defer cancel()
+		for _, path := range paths {
+			if err := res.Pause(ctx, path, pause); err != nil {
+				d.App().Flash().Err(err)
+				return
+			}
+		}
+		d.App().Flash().Infof("%s rollout requested for %d deployments", action, len(paths))
+	}, func() {})
+
+	return nil
 }
 
 func (d *Deploy) logOptions(prev bool) (*dao.LogOptions, error) {
@@ -16,8 +16,12 @@ func NewDaemonSet(gvr client.GVR) ResourceViewer {
 	d := DaemonSet{
 		ResourceViewer: NewPortForwardExtender(
 			NewRestartExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), nil),
+				NewResourcesExtender(
+					NewQuickEditExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), nil),
+						),
+					),
 				),
 			),
 		),
@@ -0,0 +1,252 @@
+package view
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const defaultEditor = "vi"
+
+// editResource edits a resource in the user editor. A draft kept from a previously
+// rejected edit can be resumed.
+func editResource(app *App, gvr client.GVR, path string) {
+	if _, ok := dao.LoadDraft(dao.DraftPath(app.factory, gvr.String(), path)); !ok {
+		runEdit(app, gvr, path, false)
+		return
+	}
+
+	resume := false
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Resume Draft",
+		fmt.Sprintf("A rejected edit of %s was kept.\nOK resumes the draft, Cancel edits the live resource", path),
+		func() {
+			resume = true
+		},
+		func() {
+			runEdit(app, gvr, path, resume)
+		},
+	)
+}
+
+// runEdit submits the edited manifest until it is accepted or left unchanged. Rejected
+// edits are kept as drafts and reopened at the offending line with the error on top.
+func runEdit(app *App, gvr client.GVR, path string, resume bool) {
+	draft := dao.DraftPath(app.factory, gvr.String(), path)
+	orig, err := dao.EditManifest(app.factory, gvr.String(), path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	buff := orig
+	if resume {
+		if d, ok := dao.LoadDraft(draft); ok {
+			buff = d
+		}
+	}
+
+	dir, err := os.MkdirTemp("", "k9s-edit-")
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	defer func() {
+		if err := os.RemoveAll(dir); err != nil {
+			log.Warn().Err(err).Msgf("Removing edit dir %s", dir)
+		}
+	}()
+	_, n := client.Namespaced(path)
+	origFile, file, proposedFile := filepath.Join(dir, "original.yaml"), filepath.Join(dir, n+".yaml"), filepath.Join(dir, "proposed.yaml")
+	if err := writeEditFiles(map[string][]byte{origFile: orig, file: buff}); err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	var (
+		line     int
+		rejected []byte
+	)
+	for {
+		if !editFile(app, file, line) {
+			app.Flash().Err(errors.New("Edit exec failed"))
+			return
+		}
+		edited, err := os.ReadFile(file)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		body := dao.StripEditErrors(edited)
+		if bytes.Equal(body, dao.StripEditErrors(orig)) {
+			app.Flash().Info("Edit cancelled, no changes made")
+			return
+		}
+		if rejected != nil && bytes.Equal(body, rejected) {
+			app.Flash().Warnf("Edit aborted. Draft kept at %s", draft)
+			return
+		}
+		if tool := strings.TrimSpace(app.Config.K9s.ActiveEditor().DiffTool); tool != "" {
+			if err := os.WriteFile(proposedFile, body, 0600); err != nil {
+				app.Flash().Err(err)
+				return
+			}
+			if !previewDiff(app, tool, origFile, proposedFile) {
+				keepDraft(draft, body)
+				app.Flash().Warnf("Edit not submitted. Draft kept at %s", draft)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		err = dao.ApplyEdit(ctx, app.factory, gvr.String(), path, body)
+		cancel()
+		if err == nil {
+			if err := dao.DiscardDraft(draft); err != nil {
+				log.Warn().Err(err).Msgf("Discarding edit draft %s", draft)
+			}
+			app.Flash().Infof("%s %s edited", gvr.R(), path)
+			return
+		}
+
+		keepDraft(draft, body)
+		rejected = body
+		buff, offset := dao.WithEditErrors(body, err)
+		line = 1
+		if l := dao.EditErrorLine(body, err); l > 0 {
+			line = l + offset
+		}
+		if err := os.WriteFile(file, buff, 0600); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+	}
+}
+
+func writeEditFiles(ff map[string][]byte) error {
+	for f, b := range ff {
+		if err := os.WriteFile(f, b, 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func keepDraft(draft string, body []byte) {
+	if err := dao.SaveDraft(draft, body); err != nil {
+		log.Warn().Err(err).Msgf("Saving edit draft %s", draft)
+	}
+}
+
+// editFile opens a file in the user editor, positioned on a given line if any.
+func editFile(app *App, file string, line int) bool {
+	bin, args, err := editorCmd()
+	if err != nil {
+		log.Error().Err(err).Msgf("No editor found")
+		return false
+	}
+
+	return run(app, shellOpts{
+		clear:  true,
+		binary: bin,
+		args:   append(args, editorFileArgs(bin, file, line)...),
+	})
+}
+
+// editorCmd returns the user editor binary and arguments.
+func editorCmd() (string, []string, error) {
+	cmd := defaultEditor
+	for _, env := range []string{"K9S_EDITOR", "KUBE_EDITOR", "EDITOR"} {
+		if e := strings.TrimSpace(os.Getenv(env)); e != "" {
+			cmd = e
+			break
+		}
+	}
+	tokens := strings.Fields(cmd)
+	if len(tokens) == 0 {
+		return "", nil, errors.New("no editor configured")
+	}
+	bin, err := exec.LookPath(tokens[0])
+	if err != nil {
+		return "", nil, err
+	}
+
+	return bin, tokens[1:], nil
+}
+
+// editorFileArgs returns the arguments opening a file at a given line for known editors.
+func editorFileArgs(bin, file string, line int) []string {
+	if line <= 0 {
+		return []string{file}
+	}
+	l := strconv.Itoa(line)
+	switch filepath.Base(bin) {
+	case "vi", "vim", "nvim", "view", "nano", "emacs", "emacsclient", "micro", "kak", "joe", "mg":
+		return []string{"+" + l, file}
+	case "code", "codium", "code-insiders":
+		return []string{"--goto", file + ":" + l}
+	case "subl", "hx", "helix":
+		return []string{file + ":" + l}
+	default:
+		return []string{file}
+	}
+}
+
+// previewDiff shows the pending changes via the configured diff tool and asks for confirmation.
+// Blank diff tools skip the preview.
+func previewDiff(app *App, tool, orig, edited string) bool {
+	cmd, ok := diffToolCmd(tool, orig, edited)
+	if !ok {
+		return true
+	}
+	app.Halt()
+	defer app.Resume()
+
+	var submit bool
+	app.Suspend(func() {
+		clearScreen()
+		cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+		// Most diff tools exit non zero when files differ.
+		var eerr *exec.ExitError
+		if err := cmd.Run(); err != nil && !errors.As(err, &eerr) {
+			fmt.Printf("Diff tool failed: %v\n", err)
+		}
+		fmt.Print("\nSubmit these changes? [y/N] ")
+		submit = confirmed(os.Stdin)
+		clearScreen()
+	})
+
+	return submit
+}
+
+// diffToolCmd builds the diff tool command comparing the original and edited manifests.
+func diffToolCmd(tool, orig, edited string) (*exec.Cmd, bool) {
+	tokens := strings.Fields(tool)
+	if len(tokens) == 0 {
+		return nil, false
+	}
+
+	return exec.Command(tokens[0], append(tokens[1:], orig, edited)...), true
+}
+
+func confirmed(r io.Reader) bool {
+	s, _ := bufio.NewReader(r).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "y", "yes":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,76 @@
+package view
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEditorFileArgs(t *testing.T) {
+	uu := map[string]struct {
+		bin  string
+		line int
+		e    []string
+	}{
+		"noLine": {bin: "/usr/bin/vim", e: []string{"f.yaml"}},
+		"vim":    {bin: "/usr/bin/vim", line: 12, e: []string{"+12", "f.yaml"}},
+		"nano":   {bin: "nano", line: 3, e: []string{"+3", "f.yaml"}},
+		"code":   {bin: "/usr/local/bin/code", line: 3, e: []string{"--goto", "f.yaml:3"}},
+		"hx":     {bin: "hx", line: 3, e: []string{"f.yaml:3"}},
+		"other":  {bin: "ed", line: 3, e: []string{"f.yaml"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, editorFileArgs(u.bin, "f.yaml", u.line))
+		})
+	}
+}
+
+func TestConfirmed(t *testing.T) {
+	uu := map[string]struct {
+		in string
+		e  bool
+	}{
+		"yes":   {in: "y\n", e: true},
+		"full":  {in: " YES \n", e: true},
+		"no":    {in: "n\n"},
+		"blank": {in: "\n"},
+		"eof":   {in: ""},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, confirmed(strings.NewReader(u.in)))
+		})
+	}
+}
+
+func TestDiffToolCmd(t *testing.T) {
+	uu := map[string]struct {
+		tool string
+		ok   bool
+		e    []string
+	}{
+		"empty":     {},
+		"blank":     {tool: "  \t "},
+		"plain":     {tool: "diff", ok: true, e: []string{"diff", "a", "b"}},
+		"withFlags": {tool: " diff -u  --color ", ok: true, e: []string{"diff", "-u", "--color", "a", "b"}},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			cmd, ok := diffToolCmd(u.tool, "a", "b")
+			assert.Equal(t, u.ok, ok)
+			if !u.ok {
+				assert.Nil(t, cmd)
+				return
+			}
+			assert.Equal(t, u.e, cmd.Args)
+		})
+	}
+}
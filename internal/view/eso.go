@@ -0,0 +1,69 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// ExternalSecret represents an ExternalSecret viewer.
+type ExternalSecret struct {
+	ResourceViewer
+}
+
+// NewExternalSecret returns a new viewer.
+func NewExternalSecret(gvr client.GVR) ResourceViewer {
+	e := ExternalSecret{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	e.AddBindKeysFn(e.bindKeys)
+
+	return &e
+}
+
+func (e *ExternalSecret) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", e.GetTable().SortColCmd("READY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Store", e.GetTable().SortColCmd("STORE", true), false),
+	})
+	if e.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Refresh", e.refreshCmd, true),
+	})
+}
+
+func (e *ExternalSecret) refreshCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := e.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+
+	e.Stop()
+	defer e.Start()
+	msg := fmt.Sprintf("Force sync external secret %s?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Force sync %d external secrets?", len(paths))
+	}
+	dialog.ShowConfirm(e.App().Styles.Dialog(), e.App().Content.Pages, "Confirm Refresh", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), e.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.ExternalSecret
+		res.Init(e.App().factory, e.GVR())
+		for _, path := range paths {
+			if err := res.Refresh(ctx, path); err != nil {
+				e.App().Flash().Err(err)
+				return
+			}
+		}
+		e.App().Flash().Infof("Refresh requested for %d %s", len(paths), e.GVR().R())
+	}, func() {})
+
+	return nil
+}
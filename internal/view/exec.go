@@ -28,7 +28,9 @@ import (
 
 const (
 	shellCheck = `command -v bash >/dev/null && exec bash || exec sh`
-	bannerFmt  = "<<K9s-Shell>> Pod: %s | Container: %s \n"
+	// windowsShellCheck favors powershell and falls back to cmd on nano server images.
+	windowsShellCheck = `where /q powershell && (powershell -NoLogo & exit) || cmd`
+	bannerFmt         = "<<K9s-Shell>> Pod: %s | Container: %s \n"
 )
 
 type shellOpts struct {
@@ -229,10 +231,7 @@ func sshIn(a *App, fqn, co string) {
 		args = append(args, cfg.Command...)
 		args = append(args, cfg.Args...)
 	} else {
-		if os == windowsOS {
-			args = append(args, "--", powerShell)
-		}
-		args = append(args, "sh", "-c", shellCheck)
+		args = append(args, shellCommand(os)...)
 	}
 	log.Debug().Msgf("ARGS %#v", args)
 
@@ -0,0 +1,181 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	explainTitle    = "Explain"
+	explainFieldFmt = "[white::b]%s[::] [gray::-]%s[::]"
+	explainReqFmt   = " [orange::b]*[::]"
+)
+
+// Explain represents a resource schema explorer.
+type Explain struct {
+	*tview.Flex
+
+	app  *App
+	gvr  client.GVR
+	tree *ui.Tree
+	doc  *tview.TextView
+}
+
+// NewExplain returns a new schema explorer.
+func NewExplain(app *App, gvr client.GVR) *Explain {
+	return &Explain{
+		Flex: tview.NewFlex(),
+		app:  app,
+		gvr:  gvr,
+		tree: ui.NewTree(),
+		doc:  tview.NewTextView(),
+	}
+}
+
+// Init initializes the view.
+func (e *Explain) Init(ctx context.Context) error {
+	if err := e.tree.Init(ctx); err != nil {
+		return err
+	}
+	e.tree.SetTitle(fmt.Sprintf(" %s(%s) ", explainTitle, e.gvr.R()))
+	e.tree.SetBackgroundColor(e.app.Styles.Xray().BgColor.Color())
+	e.tree.SetBorderColor(e.app.Styles.Frame().Border.FgColor.Color())
+	e.tree.SetBorderFocusColor(e.app.Styles.Frame().Border.FocusColor.Color())
+	e.tree.SetGraphicsColor(e.app.Styles.Xray().GraphicColor.Color())
+	e.tree.SetSelectedFunc(func(n *tview.TreeNode) {
+		n.SetExpanded(!n.IsExpanded())
+	})
+	e.tree.SetChangedFunc(e.showDoc)
+	e.tree.Actions().Add(ui.KeyActions{
+		tcell.KeyEscape: ui.NewKeyAction("Back", e.app.PrevCmd, false),
+	})
+
+	e.doc.SetBorder(true)
+	e.doc.SetBorderPadding(0, 0, 1, 1)
+	e.doc.SetTitle(" Docs ")
+	e.doc.SetTitleColor(tcell.ColorAqua)
+	e.doc.SetDynamicColors(true)
+	e.doc.SetWrap(true)
+	e.doc.SetScrollable(true)
+
+	e.SetDirection(tview.FlexColumn)
+	e.AddItem(e.tree, 0, 3, true)
+	e.AddItem(e.doc, 0, 2, false)
+
+	return nil
+}
+
+// Start loads the resource schema.
+func (e *Explain) Start() {
+	e.tree.SetRoot(tview.NewTreeNode("Loading..."))
+	go e.load()
+}
+
+// Stop terminates the view.
+func (e *Explain) Stop() {}
+
+// Name returns the component name.
+func (e *Explain) Name() string { return explainTitle }
+
+// Hints returns the view hints.
+func (e *Explain) Hints() model.MenuHints {
+	return e.tree.Hints()
+}
+
+// ExtraHints returns additional hints.
+func (e *Explain) ExtraHints() map[string]string {
+	return nil
+}
+
+// InCmdMode checks if prompt is active.
+func (e *Explain) InCmdMode() bool {
+	return false
+}
+
+func (e *Explain) load() {
+	ctx, cancel := context.WithTimeout(context.Background(), e.app.Conn().Config().CallTimeout())
+	defer cancel()
+	s, err := dao.ExplainSchema(ctx, e.app.factory, e.gvr)
+	e.app.QueueUpdateDraw(func() {
+		if err != nil {
+			e.tree.SetRoot(tview.NewTreeNode("No schema available"))
+			e.app.Flash().Err(err)
+			return
+		}
+		root := explainNode(s)
+		root.SetExpanded(true)
+		e.tree.SetRoot(root)
+		e.tree.SetCurrentNode(root)
+		e.showDoc(root)
+	})
+}
+
+func (e *Explain) showDoc(n *tview.TreeNode) {
+	f, ok := n.GetReference().(*dao.SchemaField)
+	if !ok {
+		e.doc.Clear()
+		return
+	}
+	e.doc.SetText(explainDoc(f))
+	e.doc.ScrollToBeginning()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// explainNode converts a schema field into a collapsed tree node.
+func explainNode(f *dao.SchemaField) *tview.TreeNode {
+	n := tview.NewTreeNode(explainLabel(f))
+	n.SetReference(f)
+	n.SetSelectable(true)
+	n.SetExpanded(false)
+	for _, c := range f.Fields {
+		n.AddChild(explainNode(c))
+	}
+
+	return n
+}
+
+func explainLabel(f *dao.SchemaField) string {
+	s := fmt.Sprintf(explainFieldFmt, f.Name, tview.Escape(f.Type))
+	if f.Required {
+		s += explainReqFmt
+	}
+
+	return s
+}
+
+// explainDoc renders a field documentation kubectl explain style.
+func explainDoc(f *dao.SchemaField) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[aqua::b]FIELD:[::]    %s %s\n", f.Name, tview.Escape(f.Type))
+	if f.Required {
+		b.WriteString("[aqua::b]REQUIRED:[::] true\n")
+	}
+	b.WriteString("\n[aqua::b]DESCRIPTION:[::]\n")
+	desc := f.Description
+	if desc == "" {
+		desc = "<empty>"
+	}
+	fmt.Fprintf(&b, "%s\n", tview.Escape(desc))
+	if len(f.Fields) > 0 {
+		b.WriteString("\n[aqua::b]FIELDS:[::]\n")
+		for _, c := range f.Fields {
+			req := ""
+			if c.Required {
+				req = " -required-"
+			}
+			fmt.Fprintf(&b, "  %s %s%s\n", c.Name, tview.Escape(c.Type), req)
+		}
+	}
+
+	return b.String()
+}
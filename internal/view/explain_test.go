@@ -0,0 +1,47 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainLabel(t *testing.T) {
+	uu := map[string]struct {
+		f dao.SchemaField
+		e string
+	}{
+		"plain": {
+			f: dao.SchemaField{Name: "replicas", Type: "integer"},
+			e: "[white::b]replicas[::] [gray::-]integer[::]",
+		},
+		"required": {
+			f: dao.SchemaField{Name: "containers", Type: "[]<Container>", Required: true},
+			e: "[white::b]containers[::] [gray::-][]<Container>[::] [orange::b]*[::]",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, explainLabel(&u.f))
+		})
+	}
+}
+
+func TestExplainNode(t *testing.T) {
+	f := dao.SchemaField{
+		Name: "Pod",
+		Type: "Object",
+		Fields: []*dao.SchemaField{
+			{Name: "spec", Type: "<PodSpec>", Fields: []*dao.SchemaField{{Name: "hostname", Type: "string"}}},
+		},
+	}
+
+	n := explainNode(&f)
+	assert.False(t, n.IsExpanded())
+	assert.Equal(t, &f, n.GetReference())
+	assert.Equal(t, 1, len(n.GetChildren()))
+	assert.Equal(t, 1, len(n.GetChildren()[0].GetChildren()))
+}
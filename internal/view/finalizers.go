@@ -0,0 +1,128 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const finalizersKey = "finalizers"
+
+func (b *Browser) finalizersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	r, err := dao.InspectFinalizers(b.app.factory, b.GVR().String(), path)
+	if err != nil {
+		b.app.Flash().Err(err)
+		return nil
+	}
+	if len(r.Finalizers) == 0 {
+		b.app.Flash().Infof("No finalizers on %s", path)
+		return nil
+	}
+	ShowFinalizers(b, r)
+
+	return nil
+}
+
+// ShowFinalizers pops a dialog listing a resource finalizers. Removal is only
+// offered on terminating resources and requires typing the finalizer name.
+func ShowFinalizers(view ResourceViewer, r dao.FinalizerReport) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	names := make([]string, 0, len(r.Finalizers))
+	for _, fin := range r.Finalizers {
+		names = append(names, fin.Name)
+	}
+	selected, confirm := names[0], ""
+	removable := r.IsTerminating() && !view.App().Config.K9s.IsReadOnly()
+	if removable {
+		f.AddDropDown("Finalizer:", names, 0, func(n string, _ int) {
+			selected = n
+		})
+		f.AddInputField("Type name to confirm:", "", 0, nil, func(v string) {
+			confirm = v
+		})
+	}
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		dismissFinalizers(view, pages)
+	})
+	if removable {
+		f.AddButton("Remove", func() {
+			if confirm != selected {
+				view.App().Flash().Errf("Confirmation does not match finalizer %q", selected)
+				return
+			}
+			dismissFinalizers(view, pages)
+			removeFinalizer(view, r, selected)
+		})
+	}
+
+	modal := tview.NewModalForm("<Finalizers>", f)
+	modal.SetText(finalizersText(r, time.Now()))
+	modal.SetDoneFunc(func(_ int, b string) {
+		dismissFinalizers(view, pages)
+	})
+
+	pages.AddPage(finalizersKey, modal, false, true)
+	pages.ShowPage(finalizersKey)
+	view.App().SetFocus(pages.GetPrimitive(finalizersKey))
+}
+
+func dismissFinalizers(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(finalizersKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func removeFinalizer(view ResourceViewer, r dao.FinalizerReport, finalizer string) {
+	go func() {
+		err := dao.RemoveFinalizer(context.Background(), view.App().factory, r.GVR, r.Path, finalizer)
+		view.App().QueueUpdateDraw(func() {
+			if err != nil {
+				view.App().Flash().Err(err)
+				return
+			}
+			view.App().Flash().Infof("Finalizer %s removed from %s", finalizer, r.Path)
+		})
+	}()
+}
+
+func finalizersText(r dao.FinalizerReport, now time.Time) string {
+	var b strings.Builder
+	if r.IsTerminating() {
+		fmt.Fprintf(&b, "%s terminating for %s\n", r.Path, now.Sub(r.DeletedAt).Truncate(time.Second))
+	} else {
+		fmt.Fprintf(&b, "%s is not terminating\n", r.Path)
+	}
+	for _, f := range r.Finalizers {
+		switch {
+		case f.Controller != "":
+			fmt.Fprintf(&b, "%s: %s %s\n", f.Name, f.Controller, f.Status)
+		case f.IsBlocked():
+			fmt.Fprintf(&b, "%s: controller %s\n", f.Name, f.Status)
+		default:
+			fmt.Fprintf(&b, "%s: %s\n", f.Name, f.Status)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,79 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Flux represents a Flux toolkit resource viewer.
+type Flux struct {
+	ResourceViewer
+}
+
+// NewFlux returns a new viewer.
+func NewFlux(gvr client.GVR) ResourceViewer {
+	f := Flux{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *Flux) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", f.GetTable().SortColCmd("READY", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Suspended", f.GetTable().SortColCmd("SUSPENDED", true), false),
+	})
+	if f.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyS: ui.NewKeyAction("Suspend", f.fluxCmd("Suspend", func(res *dao.Flux, ctx context.Context, path string) error {
+			return res.Suspend(ctx, path, true)
+		}), true),
+		ui.KeyU: ui.NewKeyAction("Resume", f.fluxCmd("Resume", func(res *dao.Flux, ctx context.Context, path string) error {
+			return res.Suspend(ctx, path, false)
+		}), true),
+		ui.KeyR: ui.NewKeyAction("Reconcile", f.fluxCmd("Reconcile", (*dao.Flux).Reconcile), true),
+	})
+}
+
+type fluxActionFn func(*dao.Flux, context.Context, string) error
+
+func (f *Flux) fluxCmd(action string, fn fluxActionFn) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		paths := f.GetTable().GetSelectedItems()
+		if len(paths) == 0 || paths[0] == "" {
+			return evt
+		}
+
+		f.Stop()
+		defer f.Start()
+		msg := fmt.Sprintf("%s %s %s?", action, singularize(f.GVR().R()), paths[0])
+		if len(paths) > 1 {
+			msg = fmt.Sprintf("%s %d %s?", action, len(paths), f.GVR().R())
+		}
+		dialog.ShowConfirm(f.App().Styles.Dialog(), f.App().Content.Pages, "Confirm "+action, msg, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), f.App().Conn().Config().CallTimeout())
+			defer cancel()
+			var res dao.Flux
+			res.Init(f.App().factory, f.GVR())
+			for _, path := range paths {
+				if err := fn(&res, ctx, path); err != nil {
+					f.App().Flash().Err(err)
+					return
+				}
+			}
+			f.App().Flash().Infof("%s requested for %d %s", action, len(paths), f.GVR().R())
+		}, func() {})
+
+		return nil
+	}
+}
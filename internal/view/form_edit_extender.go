@@ -0,0 +1,231 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	formEditKey = "formEdit"
+
+	// formEditMaxFields caps the number of fields a form can display.
+	formEditMaxFields = 25
+)
+
+// FormEditExtender provides for editing a resource via a form generated from its schema.
+type FormEditExtender struct {
+	ResourceViewer
+}
+
+// NewFormEditExtender returns a new extender.
+func NewFormEditExtender(r ResourceViewer) ResourceViewer {
+	f := FormEditExtender{ResourceViewer: r}
+	f.AddBindKeysFn(f.bindKeys)
+
+	return &f
+}
+
+func (f *FormEditExtender) bindKeys(aa ui.KeyActions) {
+	if f.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftE: ui.NewKeyAction("Form Edit", f.formEditCmd, true),
+	})
+}
+
+func (f *FormEditExtender) formEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := f.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	f.Stop()
+	defer f.Start()
+	if err := f.showFormEditDialog(path); err != nil {
+		f.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (f *FormEditExtender) showFormEditDialog(path string) error {
+	ff, err := f.formFields(path)
+	if err != nil {
+		return err
+	}
+	if len(ff) == 0 {
+		return errors.New("no fields to edit")
+	}
+	if len(ff) > formEditMaxFields {
+		f.App().Flash().Warnf("Showing the first %d of %d fields. Use YAML edit for the rest", formEditMaxFields, len(ff))
+		ff = ff[:formEditMaxFields]
+	}
+
+	confirm := tview.NewModalForm("<Form Edit>", f.makeFormEditForm(path, ff))
+	confirm.SetText(fmt.Sprintf("Edit %s %s", singularize(f.GVR().R()), path))
+	confirm.SetDoneFunc(func(int, string) {
+		f.dismissDialog()
+	})
+	f.App().Content.AddPage(formEditKey, confirm, false, false)
+	f.App().Content.ShowPage(formEditKey)
+
+	return nil
+}
+
+func (f *FormEditExtender) formFields(path string) ([]dao.FormField, error) {
+	o, err := f.App().factory.Get(f.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("expecting unstructured but got %T", o)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), f.App().Conn().Config().CallTimeout())
+	defer cancel()
+	s, err := dao.ExplainSchema(ctx, f.App().factory, f.GVR())
+	if err != nil {
+		return nil, err
+	}
+
+	return dao.FormFields(f.GVR(), s, u.Object), nil
+}
+
+func (f *FormEditExtender) makeFormEditForm(path string, ff []dao.FormField) *tview.Form {
+	form := f.makeStyledForm()
+	vv := make(map[string]string, len(ff))
+	for _, fd := range ff {
+		fd, label := fd, fd.Label()
+		vv[label] = fd.Value
+		changed := func(s string) {
+			vv[label] = s
+		}
+		switch fd.Type {
+		case "boolean":
+			opts := boolFormOptions(fd.Required && fd.Value != "")
+			form.AddDropDown(formEditLabel(fd), opts, formOptionIndex(opts, fd.Value), func(s string, _ int) {
+				changed(s)
+			})
+		case "integer":
+			form.AddInputField(formEditLabel(fd), fd.Value, 10, tview.InputFieldInteger, changed)
+		case "number":
+			form.AddInputField(formEditLabel(fd), fd.Value, 10, tview.InputFieldFloat, changed)
+		default:
+			form.AddInputField(formEditLabel(fd), formEscape(fd.Value), 0, nil, func(s string) {
+				changed(formUnescape(s))
+			})
+		}
+	}
+
+	form.AddButton("OK", func() {
+		patch, err := dao.FormPatch(ff, vv)
+		if err != nil {
+			f.App().Flash().Err(err)
+			return
+		}
+		f.dismissDialog()
+		if patch == nil {
+			f.App().Flash().Info("No changes made")
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), f.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.ApplyForm(ctx, f.App().factory, f.GVR().String(), path, patch); err != nil {
+			log.Error().Err(err).Msgf("Form edit on %s failed", path)
+			f.App().Flash().Err(err)
+			return
+		}
+		f.App().Flash().Infof("Updated %s %s", singularize(f.GVR().R()), path)
+	})
+	form.AddButton("Cancel", func() {
+		f.dismissDialog()
+	})
+
+	return form
+}
+
+func (f *FormEditExtender) dismissDialog() {
+	f.App().Content.RemovePage(formEditKey)
+}
+
+func (f *FormEditExtender) makeStyledForm() *tview.Form {
+	form := tview.NewForm()
+	form.SetItemPadding(0)
+	form.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return form
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func formEditLabel(f dao.FormField) string {
+	if f.Required {
+		return f.Label() + "*:"
+	}
+
+	return f.Label() + ":"
+}
+
+func boolFormOptions(required bool) []string {
+	if required {
+		return []string{"true", "false"}
+	}
+
+	return []string{"", "true", "false"}
+}
+
+func formOptionIndex(ss []string, s string) int {
+	for i, v := range ss {
+		if v == s {
+			return i
+		}
+	}
+
+	return 0
+}
+
+// formEscape renders multiline values on a single line.
+func formEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, "\n", `\n`, "\t", `\t`).Replace(s)
+}
+
+// formUnescape reverts formEscape.
+func formUnescape(s string) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\\' || i == len(s)-1 {
+			b.WriteByte(s[i])
+			continue
+		}
+		i++
+		switch s[i] {
+		case 'n':
+			b.WriteByte('\n')
+		case 't':
+			b.WriteByte('\t')
+		case '\\':
+			b.WriteByte('\\')
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(s[i])
+		}
+	}
+
+	return b.String()
+}
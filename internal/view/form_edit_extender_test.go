@@ -0,0 +1,29 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormEscape(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain":     {s: "fred", e: "fred"},
+		"multiline": {s: "a: 1\nb:\t2\n", e: `a: 1\nb:\t2\n`},
+		"backslash": {s: `c:\new`, e: `c:\\new`},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, formEscape(u.s))
+			assert.Equal(t, u.s, formUnescape(u.e))
+		})
+	}
+}
+
+func TestFormUnescapeLenient(t *testing.T) {
+	assert.Equal(t, `a\b\`, formUnescape(`a\b\`))
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
@@ -86,8 +87,8 @@ func (h *Help) computeMaxes(hh model.MenuHints) {
 		if len(hint.Mnemonic) > h.maxKey {
 			h.maxKey = len(hint.Mnemonic)
 		}
-		if len(hint.Description) > h.maxDesc {
-			h.maxDesc = len(hint.Description)
+		if d := i18n.Label(hint.Description); len(d) > h.maxDesc {
+			h.maxDesc = len(d)
 		}
 	}
 	h.maxKey += 2
@@ -290,7 +291,7 @@ func (h *Help) addSection(c int, title string, hh model.MenuHints) {
 		h.maxRows = len(hh)
 	}
 	row := 0
-	h.SetCell(row, c, h.titleCell(title))
+	h.SetCell(row, c, h.titleCell(i18n.T(title)))
 	h.addSpacer(c + 1)
 	row++
 
@@ -298,7 +299,7 @@ func (h *Help) addSection(c int, title string, hh model.MenuHints) {
 		col := c
 		h.SetCell(row, col, padCellWithRef(toMnemonic(hint.Mnemonic), h.maxKey, hint.Mnemonic))
 		col++
-		h.SetCell(row, col, padCell(hint.Description, h.maxDesc))
+		h.SetCell(row, col, padCell(i18n.Label(hint.Description), h.maxDesc))
 		row++
 	}
 
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 
@@ -14,11 +15,15 @@ import (
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
+	popcfg "github.com/derailed/popeye/pkg/config"
 	"github.com/derailed/tcell/v2"
 	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
 )
 
+// lintRootGroup represents a sanitizer issue on the resource itself.
+const lintRootGroup = "__root__"
+
 func clipboardWrite(text string) error {
 	return clipboard.WriteAll(text)
 }
@@ -230,3 +235,53 @@ func decorateCpuMemHeaderRows(app *App, data *render.TableData) {
 		}
 	}
 }
+
+func lintReport(grade string, ii render.Issues) string {
+	var buff strings.Builder
+	fmt.Fprintf(&buff, "[aqua::b]Grade:[white::-] %s\n\n", grade)
+	if len(ii) == 0 {
+		buff.WriteString("[green::]No issues found. Nice!\n")
+		return buff.String()
+	}
+
+	issues := make(render.Issues, len(ii))
+	copy(issues, ii)
+	sort.SliceStable(issues, func(i, j int) bool {
+		return issues[i].Level > issues[j].Level
+	})
+	for _, i := range issues {
+		group := ""
+		if i.Group != lintRootGroup {
+			group = tview.Escape("["+i.Group+"]") + " "
+		}
+		fmt.Fprintf(&buff, "[%s::b]%-5s[white::-] %s%s\n", lintColor(i.Level), lintLevel(i.Level), group, tview.Escape(i.Message))
+	}
+
+	return buff.String()
+}
+
+func lintLevel(l popcfg.Level) string {
+	switch l {
+	case popcfg.OkLevel:
+		return "OK"
+	case popcfg.InfoLevel:
+		return "INFO"
+	case popcfg.WarnLevel:
+		return "WARN"
+	default:
+		return "ERROR"
+	}
+}
+
+func lintColor(l popcfg.Level) string {
+	switch l {
+	case popcfg.OkLevel:
+		return "green"
+	case popcfg.InfoLevel:
+		return "aqua"
+	case popcfg.WarnLevel:
+		return "orange"
+	default:
+		return "orangered"
+	}
+}
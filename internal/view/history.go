@@ -0,0 +1,121 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"sigs.k8s.io/yaml"
+)
+
+// History represents the locally recorded revisions of a given object.
+type History struct {
+	ResourceViewer
+
+	gvr, path string
+}
+
+// NewHistory returns a new viewer.
+func NewHistory(gvr client.GVR) ResourceViewer {
+	h := History{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	h.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	h.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	h.GetTable().SetSortCol("REVISION", false)
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+// Init initializes the view.
+func (h *History) Init(ctx context.Context) error {
+	if err := h.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	h.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (h *History) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("View", h.viewCmd, true),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Revision", h.GetTable().SortColCmd("REVISION", false), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Changes", h.GetTable().SortColCmd("CHANGES", false), false),
+	})
+}
+
+func (h *History) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	rv := h.GetTable().GetSelectedItem()
+	if rv == "" {
+		return evt
+	}
+	rr, err := dao.ObjectRevisions(h.App().factory, h.gvr, h.path)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+	i, cc := dao.RevisionChanges(rr, rv)
+	if i < 0 {
+		h.App().Flash().Errf("Revision %s not found", rv)
+		return nil
+	}
+	raw, err := yaml.Marshal(rr[i].Object)
+	if err != nil {
+		h.App().Flash().Err(err)
+		return nil
+	}
+
+	details := NewDetails(h.App(), "Revision", h.path+"@"+rv, true).Update(revisionReport(rr[i].At.Format(time.RFC3339), i == 0, cc, string(raw)))
+	if err := h.App().inject(details, false); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// revisionReport lists a revision changes followed by its manifest.
+func revisionReport(at string, first bool, cc []dao.FieldChange, manifest string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# Recorded %s\n", at)
+	switch {
+	case first:
+		b.WriteString("# First recorded revision\n")
+	case len(cc) == 0:
+		b.WriteString("# No changes from the previous recorded revision\n")
+	default:
+		b.WriteString("# Changes from the previous recorded revision:\n")
+	}
+	for _, c := range cc {
+		fmt.Fprintf(&b, "#   %s\n", c)
+	}
+	b.WriteString("---\n")
+	b.WriteString(manifest)
+
+	return tview.Escape(b.String())
+}
+
+// showHistory lists the locally recorded revisions of a given object.
+func showHistory(app *App, gvr, path string) {
+	v := NewHistory(client.NewGVR("history"))
+	if h, ok := v.(*History); ok {
+		h.gvr, h.path = gvr, path
+	}
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
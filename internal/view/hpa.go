@@ -0,0 +1,169 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const hpaTuneKey = "hpaTune"
+
+// HorizontalPodAutoscaler represents an HPA viewer.
+type HorizontalPodAutoscaler struct {
+	ResourceViewer
+}
+
+// NewHorizontalPodAutoscaler returns a new viewer.
+func NewHorizontalPodAutoscaler(gvr client.GVR) ResourceViewer {
+	h := HorizontalPodAutoscaler{
+		ResourceViewer: NewFormEditExtender(NewBrowser(gvr)),
+	}
+	h.AddBindKeysFn(h.bindKeys)
+
+	return &h
+}
+
+func (h *HorizontalPodAutoscaler) bindKeys(aa ui.KeyActions) {
+	if h.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Tune", h.tuneCmd, true),
+	})
+}
+
+func (h *HorizontalPodAutoscaler) tuneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := h.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	h.Stop()
+	defer h.Start()
+	if err := h.showTuneDialog(path); err != nil {
+		h.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (h *HorizontalPodAutoscaler) showTuneDialog(path string) error {
+	hpa, err := h.hpa()
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.App().Conn().Config().CallTimeout())
+	defer cancel()
+	t, err := hpa.Tuning(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	confirm := tview.NewModalForm("<Tune>", h.makeTuneForm(hpa, path, t))
+	confirm.SetText(fmt.Sprintf("Tune hpa %s", path))
+	confirm.SetDoneFunc(func(int, string) {
+		h.dismissDialog()
+	})
+	h.App().Content.AddPage(hpaTuneKey, confirm, false, false)
+	h.App().Content.ShowPage(hpaTuneKey)
+
+	return nil
+}
+
+func (h *HorizontalPodAutoscaler) makeTuneForm(hpa *dao.HorizontalPodAutoscaler, path string, t dao.HPATuning) *tview.Form {
+	f := h.makeStyledForm()
+	min, max := strconv.FormatInt(t.Min, 10), strconv.FormatInt(t.Max, 10)
+	f.AddInputField("Min Replicas:", min, 4, tview.InputFieldInteger, func(changed string) {
+		min = changed
+	})
+	f.AddInputField("Max Replicas:", max, 4, tview.InputFieldInteger, func(changed string) {
+		max = changed
+	})
+	targets := make(map[string]string, len(t.Targets))
+	for _, n := range t.TargetNames() {
+		n := n
+		targets[n] = strconv.FormatInt(t.Targets[n], 10)
+		f.AddInputField(strings.ToUpper(n)+" Target %:", targets[n], 4, tview.InputFieldInteger, func(changed string) {
+			targets[n] = changed
+		})
+	}
+
+	f.AddButton("OK", func() {
+		tuned, err := parseTuning(min, max, targets)
+		if err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), h.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := hpa.Tune(ctx, path, tuned); err != nil {
+			h.App().Flash().Err(err)
+			return
+		}
+		h.dismissDialog()
+		h.App().Flash().Infof("HPA %s tuned to %d-%d replicas", path, tuned.Min, tuned.Max)
+	})
+	f.AddButton("Cancel", func() {
+		h.dismissDialog()
+	})
+
+	return f
+}
+
+func (h *HorizontalPodAutoscaler) hpa() (*dao.HorizontalPodAutoscaler, error) {
+	res, err := dao.AccessorFor(h.App().factory, h.GVR())
+	if err != nil {
+		return nil, err
+	}
+	hpa, ok := res.(*dao.HorizontalPodAutoscaler)
+	if !ok {
+		return nil, errors.New("expecting an hpa resource")
+	}
+
+	return hpa, nil
+}
+
+func (h *HorizontalPodAutoscaler) dismissDialog() {
+	h.App().Content.RemovePage(hpaTuneKey)
+}
+
+func (h *HorizontalPodAutoscaler) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
+
+// parseTuning converts the tune form values into HPA settings.
+func parseTuning(min, max string, targets map[string]string) (dao.HPATuning, error) {
+	var (
+		t   = dao.HPATuning{Targets: make(map[string]int64, len(targets))}
+		err error
+	)
+	if t.Min, err = strconv.ParseInt(min, 10, 32); err != nil {
+		return t, fmt.Errorf("invalid min replicas %q", min)
+	}
+	if t.Max, err = strconv.ParseInt(max, 10, 32); err != nil {
+		return t, fmt.Errorf("invalid max replicas %q", max)
+	}
+	for n, v := range targets {
+		if t.Targets[n], err = strconv.ParseInt(v, 10, 32); err != nil {
+			return t, fmt.Errorf("invalid %s target utilization %q", n, v)
+		}
+	}
+
+	return t, t.Validate()
+}
@@ -0,0 +1,49 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTuning(t *testing.T) {
+	uu := map[string]struct {
+		min, max string
+		targets  map[string]string
+		e        dao.HPATuning
+		err      string
+	}{
+		"ok": {
+			min: "2", max: "10",
+			targets: map[string]string{"cpu": "75"},
+			e:       dao.HPATuning{Min: 2, Max: 10, Targets: map[string]int64{"cpu": 75}},
+		},
+		"blank": {
+			min: "", max: "10",
+			err: `invalid min replicas ""`,
+		},
+		"bad-target": {
+			min: "1", max: "3",
+			targets: map[string]string{"memory": "x"},
+			err:     `invalid memory target utilization "x"`,
+		},
+		"inverted": {
+			min: "5", max: "3",
+			err: "max replicas 3 must be greater or equal to min replicas 5",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tu, err := parseTuning(u.min, u.max, u.targets)
+			if u.err != "" {
+				assert.EqualError(t, err, u.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, u.e, tu)
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const httpProbeKey = "httpprobe"
+
+// HTTPProbeOpts represents an HTTP probe options.
+type HTTPProbeOpts struct {
+	URL         string
+	PortForward bool
+}
+
+// HTTPProbeFunc represents an HTTP probe callback function.
+type HTTPProbeFunc func(v ResourceViewer, path string, opts HTTPProbeOpts)
+
+// ShowHTTPProbe pops an HTTP probe dialog. The port-forward option is only offered when canForward is set.
+func ShowHTTPProbe(view ResourceViewer, path string, opts HTTPProbeOpts, canForward bool, okFn HTTPProbeFunc) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	f.AddInputField("URL:", opts.URL, 50, nil, func(v string) {
+		opts.URL = strings.TrimSpace(v)
+	})
+	if canForward {
+		f.AddCheckbox("Port-Forward:", opts.PortForward, func(_ string, v bool) {
+			opts.PortForward = v
+		})
+	}
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissHTTPProbe(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissHTTPProbe(view, pages)
+		okFn(view, path, opts)
+	})
+
+	modal := tview.NewModalForm("<HTTP Probe>", f)
+	modal.SetText(fmt.Sprintf("Send a single request to %s", path))
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissHTTPProbe(view, pages)
+	})
+
+	pages.AddPage(httpProbeKey, modal, false, true)
+	pages.ShowPage(httpProbeKey)
+	view.App().SetFocus(pages.GetPrimitive(httpProbeKey))
+}
+
+// DismissHTTPProbe dismiss the HTTP probe dialog.
+func DismissHTTPProbe(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(httpProbeKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+func httpProbe(v ResourceViewer, path string, opts HTTPProbeOpts) {
+	v.App().Flash().Infof("Probing %s...", opts.URL)
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+		defer cancel()
+
+		var (
+			res dao.HTTPProbeResult
+			err error
+		)
+		if opts.PortForward {
+			res, err = dao.ProbeServiceHTTP(ctx, v.App().factory, path, opts.URL)
+		} else {
+			res, err = dao.ProbeHTTP(ctx, opts.URL)
+		}
+		v.App().QueueUpdateDraw(func() {
+			details := NewDetails(v.App(), "HTTP Probe", path, true).Update(httpProbeReport(res, err, time.Now()))
+			if err := v.App().inject(details, false); err != nil {
+				v.App().Flash().Err(err)
+			}
+		})
+	}()
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func httpProbeReport(r dao.HTTPProbeResult, err error, now time.Time) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "url: %s\n", r.URL)
+	if r.Via != "" {
+		fmt.Fprintf(&b, "via: %s\n", r.Via)
+	}
+	if err != nil {
+		fmt.Fprintf(&b, "error: %s\n", err)
+		return tview.Escape(b.String())
+	}
+	fmt.Fprintf(&b, "status: %s\n", r.Status)
+	fmt.Fprintf(&b, "protocol: %s\n", r.Proto)
+	if r.Location != "" {
+		fmt.Fprintf(&b, "location: %s\n", r.Location)
+	}
+	b.WriteString("latency:\n")
+	for _, l := range []struct {
+		n string
+		d time.Duration
+	}{
+		{"dns", r.DNS},
+		{"connect", r.Connect},
+		{"tls", r.TLS},
+		{"first-byte", r.FirstByte},
+		{"total", r.Total},
+	} {
+		if l.d > 0 {
+			fmt.Fprintf(&b, "  %s: %s\n", l.n, l.d.Round(time.Microsecond*100))
+		}
+	}
+	if r.TLSVersion == "" {
+		return tview.Escape(b.String())
+	}
+
+	b.WriteString("tls:\n")
+	fmt.Fprintf(&b, "  version: %s\n", r.TLSVersion)
+	fmt.Fprintf(&b, "  cipher: %s\n", r.Cipher)
+	verified := "true"
+	if r.VerifyErr != "" {
+		verified = "false (" + r.VerifyErr + ")"
+	}
+	fmt.Fprintf(&b, "  verified: %s\n", verified)
+	if len(r.Certs) == 0 {
+		return tview.Escape(b.String())
+	}
+	b.WriteString("  certificates:\n")
+	for _, c := range r.Certs {
+		fmt.Fprintf(&b, "    - subject: %s\n", c.Subject)
+		fmt.Fprintf(&b, "      issuer: %s\n", c.Issuer)
+		if len(c.DNSNames) > 0 {
+			fmt.Fprintf(&b, "      dns-names: %s\n", strings.Join(c.DNSNames, ","))
+		}
+		expiry := c.NotAfter.Format(time.RFC3339)
+		if c.NotAfter.Before(now) {
+			expiry += " (expired)"
+		}
+		fmt.Fprintf(&b, "      not-after: %s\n", expiry)
+	}
+
+	return tview.Escape(b.String())
+}
@@ -3,6 +3,7 @@ package view
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/derailed/k9s/internal/dao"
@@ -63,14 +64,20 @@ func (s *ImageExtender) bindKeys(aa ui.KeyActions) {
 }
 
 func (s *ImageExtender) setImageCmd(evt *tcell.EventKey) *tcell.EventKey {
-	path := s.GetTable().GetSelectedItem()
-	if path == "" {
+	paths := s.GetTable().GetSelectedItems()
+	if len(paths) == 0 {
 		return nil
 	}
 
 	s.Stop()
 	defer s.Start()
-	if err := s.showImageDialog(path); err != nil {
+	var err error
+	if len(paths) > 1 {
+		err = s.showImageTagDialog(paths)
+	} else {
+		err = s.showImageDialog(paths[0])
+	}
+	if err != nil {
 		s.App().Flash().Err(err)
 	}
 
@@ -137,6 +144,122 @@ func (s *ImageExtender) makeSetImageForm(sel string) (*tview.Form, error) {
 	return f, nil
 }
 
+func (s *ImageExtender) showImageTagDialog(paths []string) error {
+	form, err := s.makeImageTagForm(paths)
+	if err != nil {
+		return err
+	}
+	confirm := tview.NewModalForm("<Set image tag>", form)
+	confirm.SetText(fmt.Sprintf("Set image tag on [%d] %s", len(paths), s.GVR().R()))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(imageKey, confirm, false, false)
+	s.App().Content.ShowPage(imageKey)
+
+	return nil
+}
+
+// makeImageTagForm builds a form to bump a given container image tag across several resources.
+func (s *ImageExtender) makeImageTagForm(sels []string) (*tview.Form, error) {
+	specs := make(map[string]*corev1.PodSpec, len(sels))
+	var names []string
+	seen := make(map[string]struct{})
+	for _, sel := range sels {
+		spec, err := s.getPodSpec(sel)
+		if err != nil {
+			return nil, err
+		}
+		specs[sel] = spec
+		for _, cc := range [][]corev1.Container{spec.InitContainers, spec.Containers} {
+			for _, co := range cc {
+				if _, ok := seen[co.Name]; ok {
+					continue
+				}
+				seen[co.Name] = struct{}{}
+				names = append(names, co.Name)
+			}
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("no containers found on selected %s", s.GVR().R())
+	}
+	sort.Strings(names)
+
+	f := s.makeStyledForm()
+	container, tag := names[0], ""
+	f.AddDropDown("Container:", names, 0, func(co string, _ int) {
+		container = co
+	})
+	f.AddInputField("Tag:", tag, 0, nil, func(changed string) {
+		tag = strings.TrimSpace(changed)
+	})
+
+	f.AddButton("OK", func() {
+		if !dao.ValidImageTag(tag) {
+			s.App().Flash().Errf("Invalid image tag %q", tag)
+			return
+		}
+		defer s.dismissDialog()
+		var updated, skipped int
+		for _, sel := range sels {
+			ii, ok := imageTagSpecs(specs[sel], container, tag)
+			if !ok {
+				skipped++
+				continue
+			}
+			if len(ii) == 0 {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+			err := s.setImages(ctx, sel, ii)
+			cancel()
+			if err != nil {
+				log.Error().Err(err).Msgf("PodSpec %s image update failed", sel)
+				s.App().Flash().Errf("Image update failed on %s: %s", sel, err)
+				return
+			}
+			updated++
+		}
+		msg := fmt.Sprintf("Container %s tag set to %s on [%d] %s", container, tag, updated, s.GVR().R())
+		if skipped > 0 {
+			msg += fmt.Sprintf(" ([%d] skipped without container %s)", skipped, container)
+		}
+		s.App().Flash().Info(msg)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	return f, nil
+}
+
+// imageTagSpecs returns the image updates to retag a given container. Returns false if
+// the pod spec does not have such container.
+func imageTagSpecs(spec *corev1.PodSpec, container, tag string) (dao.ImageSpecs, bool) {
+	for _, co := range spec.InitContainers {
+		if co.Name == container {
+			return imageTagSpec(co, tag, true), true
+		}
+	}
+	for _, co := range spec.Containers {
+		if co.Name == container {
+			return imageTagSpec(co, tag, false), true
+		}
+	}
+
+	return nil, false
+}
+
+func imageTagSpec(co corev1.Container, tag string, init bool) dao.ImageSpecs {
+	img := dao.WithImageTag(co.Image, tag)
+	if img == co.Image {
+		return nil
+	}
+
+	return dao.ImageSpecs{{Name: co.Name, DockerImage: img, Init: init}}
+}
+
 func (s *ImageExtender) dismissDialog() {
 	s.App().Content.RemovePage(imageKey)
 }
@@ -0,0 +1,52 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestImageTagSpecs(t *testing.T) {
+	spec := corev1.PodSpec{
+		InitContainers: []corev1.Container{{Name: "init", Image: "busybox:1.35"}},
+		Containers: []corev1.Container{
+			{Name: "app", Image: "registry:5000/team/app:v1"},
+			{Name: "sidecar", Image: "envoy:v1"},
+		},
+	}
+
+	uu := map[string]struct {
+		container, tag string
+		e              dao.ImageSpecs
+		ok             bool
+	}{
+		"container": {
+			container: "app", tag: "v2",
+			e:  dao.ImageSpecs{{Name: "app", DockerImage: "registry:5000/team/app:v2"}},
+			ok: true,
+		},
+		"init": {
+			container: "init", tag: "1.36",
+			e:  dao.ImageSpecs{{Name: "init", DockerImage: "busybox:1.36", Init: true}},
+			ok: true,
+		},
+		"unchanged": {
+			container: "sidecar", tag: "v1",
+			ok: true,
+		},
+		"missing": {
+			container: "fred", tag: "v1",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			ii, ok := imageTagSpecs(&spec, u.container, u.tag)
+			assert.Equal(t, u.ok, ok)
+			assert.Equal(t, u.e, ii)
+		})
+	}
+}
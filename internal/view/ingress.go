@@ -0,0 +1,52 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ingress represents an ingress viewer.
+type Ingress struct {
+	ResourceViewer
+}
+
+// NewIngress returns a new viewer.
+func NewIngress(gvr client.GVR) ResourceViewer {
+	i := Ingress{ResourceViewer: NewBrowser(gvr)}
+	i.AddBindKeysFn(i.bindKeys)
+
+	return &i
+}
+
+func (i *Ingress) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("HTTP Probe", i.probeCmd, true),
+	})
+}
+
+func (i *Ingress) probeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := i.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	o, err := i.App().factory.Get(i.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		i.App().Flash().Err(err)
+		return nil
+	}
+	var ing netv1.Ingress
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &ing)
+	if err != nil {
+		i.App().Flash().Err(err)
+		return nil
+	}
+	ShowHTTPProbe(i, path, HTTPProbeOpts{URL: dao.IngressProbeURL(&ing)}, false, httpProbe)
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/applog"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/rs/zerolog"
+)
+
+const k9sLogsRefresh = time.Second
+
+// k9sLogLevels tracks the levels cycled through by the level filter.
+var k9sLogLevels = []zerolog.Level{
+	zerolog.TraceLevel,
+	zerolog.DebugLevel,
+	zerolog.InfoLevel,
+	zerolog.WarnLevel,
+	zerolog.ErrorLevel,
+}
+
+// K9sLogs represents the k9s session logs viewer.
+type K9sLogs struct {
+	ResourceViewer
+
+	level zerolog.Level
+}
+
+// NewK9sLogs returns a new viewer.
+func NewK9sLogs(gvr client.GVR) ResourceViewer {
+	l := K9sLogs{
+		ResourceViewer: NewBrowser(gvr),
+		level:          zerolog.GlobalLevel(),
+	}
+	l.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	l.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	l.GetTable().SetSortCol("SEQ", false)
+	l.GetTable().SetDecorateFn(l.decorateRows)
+	l.SetContextFn(l.levelContext)
+	l.AddBindKeysFn(l.bindKeys)
+
+	return &l
+}
+
+// Init initializes the view.
+func (l *K9sLogs) Init(ctx context.Context) error {
+	if err := l.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	l.GetTable().GetModel().SetRefreshRate(k9sLogsRefresh)
+
+	return nil
+}
+
+func (l *K9sLogs) levelContext(ctx context.Context) context.Context {
+	return context.WithValue(ctx, internal.KeyLogLevel, l.level)
+}
+
+func (l *K9sLogs) decorateRows(data *render.TableData) {
+	l.GetTable().Extras = fmt.Sprintf("%s+ %d", l.level, len(data.RowEvents))
+}
+
+func (l *K9sLogs) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Level", l.levelCmd, true),
+		ui.KeyShiftC: ui.NewKeyAction("Clear", l.clearCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Time", l.GetTable().SortColCmd("SEQ", false), false),
+		ui.KeyShiftL: ui.NewKeyAction("Sort Level", l.GetTable().SortColCmd("LEVEL", true), false),
+	})
+}
+
+// levelCmd cycles the level filter. Filtering below the session log level lowers it so
+// the matching entries get recorded.
+func (l *K9sLogs) levelCmd(evt *tcell.EventKey) *tcell.EventKey {
+	l.level = nextLogLevel(l.level)
+	if l.level < zerolog.GlobalLevel() {
+		zerolog.SetGlobalLevel(l.level)
+		l.App().Flash().Infof("Session log level lowered to %s", l.level)
+	} else {
+		l.App().Flash().Infof("Showing %s entries and above", l.level)
+	}
+	l.Start()
+
+	return nil
+}
+
+func (l *K9sLogs) clearCmd(evt *tcell.EventKey) *tcell.EventKey {
+	applog.Recent().Clear()
+	l.App().Flash().Info("K9s logs cleared")
+	l.Start()
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func nextLogLevel(l zerolog.Level) zerolog.Level {
+	for i, v := range k9sLogLevels {
+		if v == l {
+			return k9sLogLevels[(i+1)%len(k9sLogLevels)]
+		}
+	}
+
+	return k9sLogLevels[0]
+}
@@ -0,0 +1,163 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+)
+
+// NodePool represents a Karpenter NodePool viewer.
+type NodePool struct {
+	ResourceViewer
+}
+
+// NewNodePool returns a new viewer.
+func NewNodePool(gvr client.GVR) ResourceViewer {
+	n := NodePool{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetEnterFn(n.showNodeClaims)
+
+	return &n
+}
+
+func (n *NodePool) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftN: ui.NewKeyAction("Sort Nodes", n.GetTable().SortColCmd("NODES", false), false),
+	})
+}
+
+func (n *NodePool) showNodeClaims(app *App, _ ui.Tabular, _, path string) {
+	var res dao.NodePool
+	res.Init(app.factory, n.GVR())
+
+	_, name := client.Namespaced(path)
+	v := NewNodeClaim(res.NodeClaimsGVR())
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, render.KarpenterNodePoolLabel+"="+name)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// NodeClaim represents a Karpenter NodeClaim viewer.
+type NodeClaim struct {
+	ResourceViewer
+}
+
+// NewNodeClaim returns a new viewer.
+func NewNodeClaim(gvr client.GVR) ResourceViewer {
+	n := NodeClaim{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.AddBindKeysFn(n.bindKeys)
+	n.GetTable().SetEnterFn(n.showNode)
+
+	return &n
+}
+
+func (n *NodeClaim) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyP:      ui.NewKeyAction("Show Pods", n.podsCmd, true),
+		ui.KeyT:      ui.NewKeyAction("Timeline", n.timelineCmd, true),
+		ui.KeyShiftT: ui.NewKeyAction("Sort Type", n.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", n.GetTable().SortColCmd("CAPACITY", true), false),
+		ui.KeyShiftD: ui.NewKeyAction("Sort Disruption", n.GetTable().SortColCmd("DISRUPTION", true), false),
+	})
+}
+
+func (n *NodeClaim) nodeName(app *App, path string) (string, bool) {
+	var res dao.NodeClaim
+	res.Init(app.factory, n.GVR())
+	nc, err := res.GetInstance(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return "", false
+	}
+	if nc.Status.NodeName == "" {
+		app.Flash().Warnf("NodeClaim %s is not registered with a node yet", path)
+		return "", false
+	}
+
+	return nc.Status.NodeName, true
+}
+
+func (n *NodeClaim) showNode(app *App, _ ui.Tabular, _, path string) {
+	if node, ok := n.nodeName(app, path); ok {
+		app.gotoResource("nodes", client.FQN(client.ClusterScope, node), false)
+	}
+}
+
+func (n *NodeClaim) podsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if node, ok := n.nodeName(n.App(), path); ok {
+		showPods(n.App(), path, "", "spec.nodeName="+node)
+	}
+
+	return nil
+}
+
+func (n *NodeClaim) timelineCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.NodeClaim
+	res.Init(n.App().factory, n.GVR())
+	ee, err := res.Events(path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Timeline", path, true).Update(karpenterEventsReport(ee, time.Now()))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+func karpenterEventsReport(ee []v1.Event, now time.Time) string {
+	if len(ee) == 0 {
+		return "No events found for this NodeClaim or its node."
+	}
+
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tOBJECT\tMESSAGE")
+	for _, e := range ee {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s/%s\t%s\n",
+			duration.HumanDuration(now.Sub(e.LastTimestamp.Time)),
+			e.Type,
+			e.Reason,
+			e.InvolvedObject.Kind,
+			e.InvolvedObject.Name,
+			e.Message,
+		)
+	}
+	_ = w.Flush()
+
+	return tview.Escape(b.String())
+}
@@ -0,0 +1,28 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestKarpenterEventsReport(t *testing.T) {
+	now := time.Date(2023, 1, 1, 1, 0, 0, 0, time.UTC)
+	ee := []v1.Event{
+		{
+			InvolvedObject: v1.ObjectReference{Kind: "Node", Name: "n1"},
+			Type:           v1.EventTypeNormal,
+			Reason:         "DisruptionTerminating",
+			Message:        "Disrupting Node: Underutilized [red]",
+			LastTimestamp:  metav1.NewTime(now.Add(-5 * time.Minute)),
+		},
+	}
+
+	assert.Equal(t, "No events found for this NodeClaim or its node.", karpenterEventsReport(nil, now))
+	assert.Equal(t, `LAST SEEN  TYPE    REASON                 OBJECT   MESSAGE
+5m         Normal  DisruptionTerminating  Node/n1  Disrupting Node: Underutilized [red[]
+`, karpenterEventsReport(ee, now))
+}
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// ScaledObject represents a KEDA ScaledObject or ScaledJob viewer.
+type ScaledObject struct {
+	ResourceViewer
+}
+
+// NewScaledObject returns a new viewer.
+func NewScaledObject(gvr client.GVR) ResourceViewer {
+	s := ScaledObject{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ScaledObject) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Paused", s.GetTable().SortColCmd("PAUSED", true), false),
+	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Pause", s.pauseCmd(true), true),
+		ui.KeyU: ui.NewKeyAction("Resume", s.pauseCmd(false), true),
+	})
+}
+
+func (s *ScaledObject) pauseCmd(pause bool) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		paths := s.GetTable().GetSelectedItems()
+		if len(paths) == 0 || paths[0] == "" {
+			return evt
+		}
+
+		action := "Resume"
+		if pause {
+			action = "Pause"
+		}
+		s.Stop()
+		defer s.Start()
+		msg := fmt.Sprintf("%s autoscaling for %s %s?", action, singularize(s.GVR().R()), paths[0])
+		if len(paths) > 1 {
+			msg = fmt.Sprintf("%s autoscaling for %d %s?", action, len(paths), s.GVR().R())
+		}
+		dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, "Confirm "+action, msg, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+			defer cancel()
+			for _, path := range paths {
+				if err := s.pause(ctx, path, pause); err != nil {
+					s.App().Flash().Err(err)
+					return
+				}
+			}
+			s.App().Flash().Infof("%s autoscaling requested for %d %s", action, len(paths), s.GVR().R())
+		}, func() {})
+
+		return nil
+	}
+}
+
+func (s *ScaledObject) pause(ctx context.Context, path string, pause bool) error {
+	res, err := dao.AccessorFor(s.App().factory, s.GVR())
+	if err != nil {
+		return err
+	}
+	p, ok := res.(dao.Pausable)
+	if !ok {
+		return errors.New("resource autoscaling can not be paused")
+	}
+
+	return p.Pause(ctx, path, pause)
+}
@@ -0,0 +1,192 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const trafficDialogKey = "traffic"
+
+// KService represents a Knative Service viewer.
+type KService struct {
+	ResourceViewer
+}
+
+// NewKService returns a new viewer.
+func NewKService(gvr client.GVR) ResourceViewer {
+	k := KService{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	k.AddBindKeysFn(k.bindKeys)
+	k.GetTable().SetEnterFn(k.showRevisions)
+
+	return &k
+}
+
+func (k *KService) bindKeys(aa ui.KeyActions) {
+	if k.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Prune Revisions", k.pruneCmd, true),
+	})
+}
+
+func (k *KService) showRevisions(app *App, _ ui.Tabular, _, path string) {
+	var res dao.KService
+	res.Init(app.factory, k.GVR())
+
+	_, n := client.Namespaced(path)
+	v := NewRevision(res.RevisionsGVR())
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, render.KnativeServiceLabel+"="+n)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (k *KService) pruneCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := k.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	k.Stop()
+	defer k.Start()
+	msg := fmt.Sprintf("Delete all revisions of %s no longer receiving traffic?", path)
+	dialog.ShowConfirm(k.App().Styles.Dialog(), k.App().Content.Pages, "Confirm Prune", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), k.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.KService
+		res.Init(k.App().factory, k.GVR())
+		count, err := res.PruneRevisions(ctx, path)
+		if err != nil {
+			k.App().Flash().Err(err)
+			return
+		}
+		k.App().Flash().Infof("Deleted %d revision(s) for service %s", count, path)
+	}, func() {})
+
+	return nil
+}
+
+// Revision represents a Knative Revision viewer.
+type Revision struct {
+	ResourceViewer
+}
+
+// NewRevision returns a new viewer.
+func NewRevision(gvr client.GVR) ResourceViewer {
+	r := Revision{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.AddBindKeysFn(r.bindKeys)
+	r.GetTable().SetSortCol("AGE", true)
+
+	return &r
+}
+
+func (r *Revision) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftT: ui.NewKeyAction("Sort Traffic", r.GetTable().SortColCmd("TRAFFIC", false), false),
+	})
+	if r.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Traffic", r.trafficCmd, true),
+	})
+}
+
+func (r *Revision) trafficCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := r.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	o, err := r.App().factory.Get(r.GVR().String(), path, true, labels.Everything())
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	var rev render.KnativeRevision
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &rev)
+	if err != nil {
+		r.App().Flash().Err(err)
+		return nil
+	}
+	svc, ok := rev.Labels[render.KnativeServiceLabel]
+	if !ok {
+		r.App().Flash().Errf("No owning service found for revision %s", path)
+		return nil
+	}
+
+	r.Stop()
+	defer r.Start()
+	r.showTrafficDialog(client.FQN(rev.Namespace, svc), rev.Name)
+
+	return nil
+}
+
+func (r *Revision) showTrafficDialog(svc, rev string) {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	percent := "100"
+	f.AddInputField("Percent:", percent, 4, func(textToCheck string, lastChar rune) bool {
+		p, err := strconv.Atoi(textToCheck)
+		return err == nil && p >= 0 && p <= 100
+	}, func(changed string) {
+		percent = changed
+	})
+	f.AddButton("OK", func() {
+		defer r.App().Content.RemovePage(trafficDialogKey)
+		p, err := strconv.Atoi(percent)
+		if err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var rd dao.Revision
+		rd.Init(r.App().factory, r.GVR())
+		var res dao.KService
+		res.Init(r.App().factory, rd.ServicesGVR())
+		if err := res.ShiftTraffic(ctx, svc, rev, int64(p)); err != nil {
+			r.App().Flash().Err(err)
+			return
+		}
+		r.App().Flash().Infof("Routing %d%% of %s traffic to %s", p, svc, rev)
+	})
+	f.AddButton("Cancel", func() {
+		r.App().Content.RemovePage(trafficDialogKey)
+	})
+
+	modal := tview.NewModalForm("<Traffic>", f)
+	modal.SetText(fmt.Sprintf("Shift %s traffic to %s", svc, rev))
+	modal.SetDoneFunc(func(int, string) {
+		r.App().Content.RemovePage(trafficDialogKey)
+	})
+	r.App().Content.AddPage(trafficDialogKey, modal, false, false)
+	r.App().Content.ShowPage(trafficDialogKey)
+}
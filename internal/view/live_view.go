@@ -101,6 +101,7 @@ func (v *LiveView) ResourceChanged(lines []string, matches fuzzy.Matches) {
 
 		v.text.SetTextAlign(tview.AlignLeft)
 		v.maxRegions = len(matches)
+		lines, _ = truncateLines(lines, v.app.Config.K9s.ActiveBudget().MaxTextBytes)
 		var ll []string
 		if len(matches) == 0 {
 			ll = lines
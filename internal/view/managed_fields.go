@@ -0,0 +1,59 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ManagedFields represents the field managers of a given object.
+type ManagedFields struct {
+	ResourceViewer
+}
+
+// NewManagedFields returns a new viewer.
+func NewManagedFields(gvr client.GVR) ResourceViewer {
+	m := ManagedFields{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	m.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	m.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	m.AddBindKeysFn(m.bindKeys)
+
+	return &m
+}
+
+// Init initializes the view.
+func (m *ManagedFields) Init(ctx context.Context) error {
+	if err := m.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	m.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (m *ManagedFields) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftF: ui.NewKeyAction("Sort Field", m.GetTable().SortColCmd("FIELD", true), false),
+		ui.KeyShiftM: ui.NewKeyAction("Sort Manager", m.GetTable().SortColCmd("MANAGER", true), false),
+		ui.KeyShiftC: ui.NewKeyAction("Sort Conflicts", m.GetTable().SortColCmd("CONFLICTS", false), false),
+	})
+}
+
+// showManagedFields lists the fields managers of a given object.
+func showManagedFields(app *App, gvr, path string) {
+	v := NewManagedFields(client.NewGVR("managedfields"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
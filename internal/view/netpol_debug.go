@@ -0,0 +1,176 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	debugNetPolKey           = "debugNetPol"
+	debugNetPolTTL           = 15 * time.Minute
+	debugNetPolRemoveTimeout = 2 * time.Second
+)
+
+// debugNetPols tracks the debug network policies injected during this session.
+type debugNetPols struct {
+	timers map[string]*time.Timer
+	mx     sync.Mutex
+}
+
+func newDebugNetPols() *debugNetPols {
+	return &debugNetPols{timers: make(map[string]*time.Timer)}
+}
+
+// track schedules a policy removal once its ttl elapsed.
+func (d *debugNetPols) track(app *App, path string, ttl time.Duration) {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	d.timers[path] = time.AfterFunc(ttl, func() {
+		if d.untrack(path) {
+			expireDebugNetPol(app, path)
+		}
+	})
+}
+
+func (d *debugNetPols) untrack(path string) bool {
+	d.mx.Lock()
+	defer d.mx.Unlock()
+
+	t, ok := d.timers[path]
+	if ok {
+		t.Stop()
+		delete(d.timers, path)
+	}
+
+	return ok
+}
+
+// removeAll removes all pending policies ie when leaving a cluster.
+func (d *debugNetPols) removeAll(f dao.Factory) {
+	d.mx.Lock()
+	pp := make([]string, 0, len(d.timers))
+	for path, t := range d.timers {
+		t.Stop()
+		pp = append(pp, path)
+	}
+	d.timers = make(map[string]*time.Timer)
+	d.mx.Unlock()
+
+	for _, path := range pp {
+		ctx, cancel := context.WithTimeout(context.Background(), debugNetPolRemoveTimeout)
+		if err := dao.RemoveDebugNetPol(ctx, f, path); err != nil {
+			log.Warn().Err(err).Msgf("Removing debug network policy %s", path)
+		}
+		cancel()
+	}
+}
+
+// purgeDebugNetPols removes the debug network policies left behind by earlier sessions.
+func purgeDebugNetPols(app *App) {
+	ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+	defer cancel()
+
+	n, err := dao.PurgeDebugNetPols(ctx, app.factory, client.AllNamespaces, time.Now())
+	if err != nil {
+		log.Warn().Err(err).Msgf("Purging expired debug network policies")
+		return
+	}
+	if n > 0 {
+		log.Info().Msgf("Purged %d expired debug network policies", n)
+	}
+}
+
+// injectDebugNetPol prompts for a ttl and traffic direction, then opens up the traffic
+// of the target pod. An empty target opens up the whole namespace.
+func injectDebugNetPol(app *App, ns, target string) {
+	spec := dao.DebugNetPolSpec{
+		Namespace: ns,
+		Target:    target,
+		Direction: dao.NetPolBoth,
+		TTL:       debugNetPolTTL,
+	}
+	ttl := spec.TTL.String()
+
+	f := makeDebugNetPolForm()
+	dd := make([]string, 0, len(dao.NetPolDirections))
+	for _, d := range dao.NetPolDirections {
+		dd = append(dd, string(d))
+	}
+	f.AddDropDown("Allow:", dd, 0, func(d string, _ int) {
+		spec.Direction = dao.NetPolDirection(d)
+	})
+	f.AddInputField("TTL:", ttl, 10, nil, func(changed string) {
+		ttl = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		d, err := time.ParseDuration(ttl)
+		if err != nil {
+			app.Flash().Errf("Invalid ttl %q", ttl)
+			return
+		}
+		spec.TTL = d
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		path, err := dao.InjectDebugNetPol(ctx, app.factory, spec)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		dismissDebugNetPolDialog(app)
+		app.debugNetPols.track(app, path, spec.TTL)
+		app.Flash().Infof("Network policy %s allows all %s traffic for %s", path, spec.Direction, spec.TTL)
+	})
+	f.AddButton("Cancel", func() {
+		dismissDebugNetPolDialog(app)
+	})
+
+	scope := fmt.Sprintf("pod %s/%s", ns, target)
+	if target == "" {
+		scope = fmt.Sprintf("all pods in namespace %s", ns)
+	}
+	confirm := tview.NewModalForm("<Bypass NetPol>", f)
+	confirm.SetText(fmt.Sprintf("Temporarily allow all traffic for %s.\nThe policy is removed once the ttl expires", scope))
+	confirm.SetDoneFunc(func(int, string) {
+		dismissDebugNetPolDialog(app)
+	})
+	app.Content.AddPage(debugNetPolKey, confirm, false, false)
+	app.Content.ShowPage(debugNetPolKey)
+}
+
+// expireDebugNetPol removes an injected network policy once its ttl elapsed. Pending
+// policies are removed on exit or context switch, expired leftovers are purged on startup.
+func expireDebugNetPol(app *App, path string) {
+	if err := dao.RemoveDebugNetPol(context.Background(), app.factory, path); err != nil {
+		log.Warn().Err(err).Msgf("Removing debug network policy %s", path)
+		return
+	}
+	app.QueueUpdateDraw(func() {
+		app.Flash().Infof("Debug network policy %s expired and was removed", path)
+	})
+}
+
+func dismissDebugNetPolDialog(app *App) {
+	app.Content.RemovePage(debugNetPolKey)
+}
+
+func makeDebugNetPolForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
@@ -0,0 +1,255 @@
+package view
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tview"
+)
+
+const (
+	netProbeKey   = "netprobe"
+	netshootImage = "nicolaka/netshoot"
+	netProbeMark  = "@@"
+)
+
+var probeHostRX = regexp.MustCompile(`\A[\w.\-:\[\]]+\z`)
+
+// NetProbeOpts represents a connectivity test options.
+type NetProbeOpts struct {
+	Target    string
+	Container string
+	Ephemeral bool
+}
+
+// NetProbeFunc represents a connectivity test callback function.
+type NetProbeFunc func(v ResourceViewer, path string, opts NetProbeOpts)
+
+// ShowNetProbe pops a connectivity test dialog.
+func ShowNetProbe(view ResourceViewer, path string, cc []string, okFn NetProbeFunc) {
+	styles := view.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	var opts NetProbeOpts
+	if len(cc) > 0 {
+		opts.Container = cc[0]
+	}
+	f.AddInputField("Target:", "", 40, nil, func(v string) {
+		opts.Target = strings.TrimSpace(v)
+	})
+	f.AddDropDown("Container:", cc, 0, func(co string, _ int) {
+		opts.Container = co
+	})
+	f.AddCheckbox("Use netshoot:", false, func(_ string, v bool) {
+		opts.Ephemeral = v
+	})
+
+	pages := view.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		DismissNetProbe(view, pages)
+	})
+	f.AddButton("OK", func() {
+		DismissNetProbe(view, pages)
+		okFn(view, path, opts)
+	})
+
+	modal := tview.NewModalForm("<Connectivity Test>", f)
+	modal.SetText(fmt.Sprintf("Test connectivity from %s to host:port or URL", path))
+	modal.SetDoneFunc(func(_ int, b string) {
+		DismissNetProbe(view, pages)
+	})
+
+	pages.AddPage(netProbeKey, modal, false, true)
+	pages.ShowPage(netProbeKey)
+	view.App().SetFocus(pages.GetPrimitive(netProbeKey))
+}
+
+// DismissNetProbe dismiss the connectivity test dialog.
+func DismissNetProbe(v ResourceViewer, p *ui.Pages) {
+	p.RemovePage(netProbeKey)
+	v.App().SetFocus(p.CurrentPage().Item)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+type probeTarget struct {
+	host, port, url string
+}
+
+// parseProbeTarget parses either a host:port or an http(s) URL.
+func parseProbeTarget(s string) (probeTarget, error) {
+	var t probeTarget
+	if strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://") {
+		u, err := url.Parse(s)
+		if err != nil {
+			return t, err
+		}
+		t.host, t.port, t.url = u.Hostname(), u.Port(), u.String()
+		if t.port == "" {
+			t.port = "80"
+			if u.Scheme == "https" {
+				t.port = "443"
+			}
+		}
+	} else {
+		h, p, err := net.SplitHostPort(s)
+		if err != nil {
+			return t, fmt.Errorf("target must be host:port or an http(s) URL: %w", err)
+		}
+		t.host, t.port = h, p
+		scheme := "http"
+		if p == "443" {
+			scheme = "https"
+		}
+		t.url = scheme + "://" + net.JoinHostPort(h, p)
+	}
+	if !probeHostRX.MatchString(t.host) {
+		return t, fmt.Errorf("invalid target host %q", t.host)
+	}
+	if _, err := strconv.ParseUint(t.port, 10, 16); err != nil {
+		return t, fmt.Errorf("invalid target port %q", t.port)
+	}
+	if strings.Contains(t.url, "'") {
+		return t, fmt.Errorf("invalid target url %q", t.url)
+	}
+
+	return t, nil
+}
+
+// netProbeScript returns a shell script checking DNS, TCP and HTTP for a target.
+func netProbeScript(t probeTarget) string {
+	return fmt.Sprintf(`H='%s'; P='%s'; U='%s'
+echo "@@dns"
+if command -v getent >/dev/null 2>&1; then getent hosts "$H"; else nslookup "$H" 2>&1; fi
+echo "@@rc $?"
+echo "@@tcp"
+if command -v nc >/dev/null 2>&1; then nc -z -w 3 "$H" "$P" 2>&1; elif command -v bash >/dev/null 2>&1; then bash -c "</dev/tcp/$H/$P" 2>&1; else echo "no nc or bash available"; false; fi
+echo "@@rc $?"
+echo "@@http"
+if command -v curl >/dev/null 2>&1; then curl -sk -o /dev/null -m 5 -w "%%{http_code}" "$U" 2>&1; elif command -v wget >/dev/null 2>&1; then wget -S -q -T 5 -O /dev/null "$U" 2>&1 | grep "HTTP/" | tail -1; else echo "no curl or wget available"; false; fi
+rc=$?; echo; echo "@@rc $rc"
+`, t.host, t.port, t.url)
+}
+
+// netProbeArgs returns kubectl args to run a probe script from a pod.
+func netProbeArgs(path string, opts NetProbeOpts, script string) []string {
+	ns, po := client.Namespaced(path)
+	args := make([]string, 0, 15)
+	if opts.Ephemeral {
+		args = append(args, "debug", "-n", ns, po, "--image", netshootImage, "--attach", "--quiet")
+		if opts.Container != "" {
+			args = append(args, "--target", opts.Container)
+		}
+	} else {
+		args = append(args, "exec", "-n", ns, po)
+		if opts.Container != "" {
+			args = append(args, "-c", opts.Container)
+		}
+	}
+
+	return append(args, "--", "sh", "-c", script)
+}
+
+type probeCheck struct {
+	name string
+	ok   bool
+	out  []string
+}
+
+// parseNetProbe extracts the probe checks from a script output.
+func parseNetProbe(raw string) []probeCheck {
+	var (
+		cc  []probeCheck
+		cur *probeCheck
+	)
+	for _, l := range strings.Split(raw, "\n") {
+		l = strings.TrimRight(l, "\r")
+		switch {
+		case strings.HasPrefix(l, netProbeMark+"rc "):
+			if cur != nil {
+				cur.ok = strings.TrimPrefix(l, netProbeMark+"rc ") == "0"
+				cc, cur = append(cc, *cur), nil
+			}
+		case strings.HasPrefix(l, netProbeMark):
+			cur = &probeCheck{name: strings.TrimPrefix(l, netProbeMark)}
+		case cur != nil && strings.TrimSpace(l) != "":
+			cur.out = append(cur.out, strings.TrimSpace(l))
+		}
+	}
+
+	return cc
+}
+
+// httpStatus extracts an HTTP status code from a curl or wget output.
+func httpStatus(out []string) string {
+	if len(out) == 0 {
+		return ""
+	}
+	l := out[len(out)-1]
+	if strings.HasPrefix(l, "HTTP/") {
+		if ff := strings.Fields(l); len(ff) > 1 {
+			return ff[1]
+		}
+	}
+	if _, err := strconv.Atoi(l); err != nil || l == "000" {
+		return ""
+	}
+
+	return l
+}
+
+// netProbeReport renders the probe results.
+func netProbeReport(t probeTarget, opts NetProbeOpts, raw string, err error) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "target: %s\n", net.JoinHostPort(t.host, t.port))
+	if opts.Ephemeral {
+		fmt.Fprintf(&b, "via: ephemeral container %s\n", netshootImage)
+	} else {
+		fmt.Fprintf(&b, "via: exec container %s\n", opts.Container)
+	}
+
+	cc := parseNetProbe(raw)
+	if len(cc) == 0 {
+		if err != nil {
+			fmt.Fprintf(&b, "error: %s\n", err)
+		}
+		b.WriteString("output:\n" + fmtResults(raw) + "\n")
+		return tview.Escape(b.String())
+	}
+	for _, c := range cc {
+		status := "OK"
+		if !c.ok {
+			status = "FAILED"
+		}
+		if c.name == "http" {
+			if code := httpStatus(c.out); code != "" {
+				status = code
+			} else {
+				status = "FAILED"
+			}
+		}
+		fmt.Fprintf(&b, "%s:\n  status: %s\n", c.name, status)
+		if len(c.out) > 0 && (c.name != "http" || status == "FAILED") {
+			b.WriteString("  output:\n")
+			for _, l := range c.out {
+				b.WriteString("    " + l + "\n")
+			}
+		}
+	}
+
+	return tview.Escape(b.String())
+}
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseProbeTarget(t *testing.T) {
+	uu := map[string]struct {
+		s   string
+		e   probeTarget
+		err bool
+	}{
+		"host-port": {
+			s: "fred.default.svc:8080",
+			e: probeTarget{host: "fred.default.svc", port: "8080", url: "http://fred.default.svc:8080"},
+		},
+		"tls": {
+			s: "10.0.0.1:443",
+			e: probeTarget{host: "10.0.0.1", port: "443", url: "https://10.0.0.1:443"},
+		},
+		"url": {
+			s: "https://fred/healthz",
+			e: probeTarget{host: "fred", port: "443", url: "https://fred/healthz"},
+		},
+		"no-port": {
+			s:   "fred",
+			err: true,
+		},
+		"injection": {
+			s:   "fred;rm -rf /:80",
+			err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			tg, err := parseProbeTarget(u.s)
+			assert.Equal(t, u.err, err != nil)
+			if err == nil {
+				assert.Equal(t, u.e, tg)
+			}
+		})
+	}
+}
+
+func TestNetProbeReport(t *testing.T) {
+	raw := `@@dns
+10.96.0.10      fred.default.svc.cluster.local
+@@rc 0
+@@tcp
+@@rc 0
+@@http
+HTTP/1.1 503 Service Unavailable
+@@rc 1
+`
+	tg := probeTarget{host: "fred", port: "80", url: "http://fred:80"}
+	e := `target: fred:80
+via: exec container c1
+dns:
+  status: OK
+  output:
+    10.96.0.10      fred.default.svc.cluster.local
+tcp:
+  status: OK
+http:
+  status: 503
+`
+	assert.Equal(t, e, netProbeReport(tg, NetProbeOpts{Container: "c1"}, raw, nil))
+}
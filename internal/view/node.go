@@ -3,13 +3,16 @@ package view
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"github.com/rs/zerolog/log"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -32,7 +35,7 @@ func NewNode(gvr client.GVR) ResourceViewer {
 
 func (n *Node) bindDangerousKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
-		ui.KeyC: ui.NewKeyAction("Cordon", n.toggleCordonCmd(true), true),
+		ui.KeyC: ui.NewKeyAction("Cordon", n.cordonCmd, true),
 		ui.KeyU: ui.NewKeyAction("Uncordon", n.toggleCordonCmd(false), true),
 		ui.KeyR: ui.NewKeyAction("Drain", n.drainCmd, true),
 	})
@@ -53,6 +56,7 @@ func (n *Node) bindKeys(aa ui.KeyActions) {
 
 	aa.Add(ui.KeyActions{
 		ui.KeyY:      ui.NewKeyAction("YAML", n.yamlCmd, true),
+		ui.KeyI:      ui.NewKeyAction("Drain Impact", n.drainImpactCmd, true),
 		ui.KeyShiftC: ui.NewKeyAction("Sort CPU", n.GetTable().SortColCmd(cpuCol, false), false),
 		ui.KeyShiftM: ui.NewKeyAction("Sort MEM", n.GetTable().SortColCmd(memCol, false), false),
 		ui.KeyShift0: ui.NewKeyAction("Sort Pods", n.GetTable().SortColCmd("PODS", false), false),
@@ -106,6 +110,89 @@ func drainNode(v ResourceViewer, path string, opts dao.DrainOptions) {
 	}
 }
 
+func (n *Node) drainImpactCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	_, node := client.Namespaced(path)
+	sim, err := dao.SimulateDrain(n.App().factory, node)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(n.App(), "Drain Impact", path, true).Update(drainImpactReport(sim))
+	if err := n.App().inject(details, false); err != nil {
+		n.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func drainImpactReport(sim dao.DrainSimulation) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "node: %s\n", sim.Node)
+	fmt.Fprintf(&b, "evicted: %d\n", sim.Evicted)
+	fmt.Fprintf(&b, "skipped:\n  daemonsets: %d\n  static: %d\n", sim.DaemonSets, sim.StaticPods)
+	fmt.Fprintf(&b, "risks: %d\n", sim.Risks())
+	if len(sim.Impacts) == 0 {
+		return tview.Escape(b.String())
+	}
+	b.WriteString("impacts:\n")
+	for _, i := range sim.Impacts {
+		fmt.Fprintf(&b, "  - resource: %s %s\n", client.NewGVR(i.GVR).R(), i.FQN)
+		fmt.Fprintf(&b, "    evicted: %d\n", i.Evicted)
+		if i.Desired > 0 {
+			fmt.Fprintf(&b, "    ready: %d/%d\n", i.Ready, i.Desired)
+		}
+		if i.IsRisky() {
+			fmt.Fprintf(&b, "    risk: %s\n", i.Issue)
+		}
+	}
+
+	return tview.Escape(b.String())
+}
+
+func (n *Node) cordonCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	no, err := dao.FetchNode(context.Background(), n.App().factory, path)
+	if err != nil {
+		n.App().Flash().Err(err)
+		return nil
+	}
+	ShowCordon(n, path, no.Annotations[render.CordonReasonAnnotation], func(v ResourceViewer, path, reason string) {
+		m, err := n.maintainer()
+		if err != nil {
+			n.App().Flash().Err(err)
+			return
+		}
+		if err := m.Cordon(path, reason); err != nil {
+			n.App().Flash().Err(err)
+		}
+		n.Refresh()
+	})
+
+	return nil
+}
+
+func (n *Node) maintainer() (dao.NodeMaintainer, error) {
+	res, err := dao.AccessorFor(n.App().factory, n.GVR())
+	if err != nil {
+		return nil, err
+	}
+	m, ok := res.(dao.NodeMaintainer)
+	if !ok {
+		return nil, fmt.Errorf("expecting a maintainer for %q", n.GVR())
+	}
+
+	return m, nil
+}
+
 func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.EventKey {
 	return func(evt *tcell.EventKey) *tcell.EventKey {
 		path := n.GetTable().GetSelectedItem()
@@ -121,16 +208,11 @@ func (n *Node) toggleCordonCmd(cordon bool) func(evt *tcell.EventKey) *tcell.Eve
 		}
 		msg += path + "?"
 		dialog.ShowConfirm(n.App().Styles.Dialog(), n.App().Content.Pages, title, msg, func() {
-			res, err := dao.AccessorFor(n.App().factory, n.GVR())
+			m, err := n.maintainer()
 			if err != nil {
 				n.App().Flash().Err(err)
 				return
 			}
-			m, ok := res.(dao.NodeMaintainer)
-			if !ok {
-				n.App().Flash().Err(fmt.Errorf("expecting a maintainer for %q", n.GVR()))
-				return
-			}
 			if err := m.ToggleCordon(path, cordon); err != nil {
 				n.App().Flash().Err(err)
 			}
@@ -34,13 +34,69 @@ func NewNamespace(gvr client.GVR) ResourceViewer {
 func (n *Namespace) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyU:      ui.NewKeyAction("Use", n.useNsCmd, true),
+		ui.KeyV:      ui.NewKeyAction("Overview", n.overviewCmd, true),
+		ui.KeyB:      ui.NewKeyAction("Blockers", n.blockersCmd, true),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", n.GetTable().SortColCmd(statusCol, true), false),
 	})
+	if !n.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyShiftB: ui.NewKeyAction("Bypass NetPol", n.bypassNetPolCmd, true),
+		})
+	}
 }
 
 func (n *Namespace) switchNs(app *App, model ui.Tabular, gvr, path string) {
 	n.useNamespace(path)
 	app.gotoResource("pods", "", false)
+	if app.Config.K9s.NamespaceOverview {
+		n.showOverview(path)
+	}
+}
+
+func (n *Namespace) overviewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	n.showOverview(path)
+
+	return nil
+}
+
+func (n *Namespace) blockersCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	showNamespaceBlockers(n.App(), ns)
+
+	return nil
+}
+
+func (n *Namespace) bypassNetPolCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := n.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+	_, ns := client.Namespaced(path)
+	if client.IsAllNamespaces(ns) {
+		return nil
+	}
+	injectDebugNetPol(n.App(), ns, "")
+
+	return nil
+}
+
+// showOverview stacks a namespace summary on top of the current view.
+func (n *Namespace) showOverview(fqn string) {
+	_, ns := client.Namespaced(fqn)
+	if client.IsAllNamespaces(ns) {
+		return
+	}
+	if err := n.App().inject(NewNamespaceOverview(n.App(), ns), false); err != nil {
+		n.App().Flash().Err(err)
+	}
 }
 
 func (n *Namespace) useNsCmd(evt *tcell.EventKey) *tcell.EventKey {
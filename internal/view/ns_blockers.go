@@ -0,0 +1,83 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// NamespaceBlockers represents the resources holding up a namespace termination.
+type NamespaceBlockers struct {
+	ResourceViewer
+}
+
+// NewNamespaceBlockers returns a new viewer.
+func NewNamespaceBlockers(gvr client.GVR) ResourceViewer {
+	n := NamespaceBlockers{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	n.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	n.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	n.GetTable().SetSortCol("GVR", true)
+	n.GetTable().SetDecorateFn(n.decorateRows)
+	n.AddBindKeysFn(n.bindKeys)
+
+	return &n
+}
+
+// Init initializes the view.
+func (n *NamespaceBlockers) Init(ctx context.Context) error {
+	if err := n.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	n.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (n *NamespaceBlockers) decorateRows(data *render.TableData) {
+	n.GetTable().Extras = fmt.Sprintf("Blockers %d", len(data.RowEvents))
+}
+
+func (n *NamespaceBlockers) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", n.gotoCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", n.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Reason", n.GetTable().SortColCmd("REASON", true), false),
+	})
+}
+
+func (n *NamespaceBlockers) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := n.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+
+	name := ui.TrimCell(n.GetTable().SelectTable, row, 1)
+	if name == "" {
+		return evt
+	}
+	ns := ui.TrimCell(n.GetTable().SelectTable, row, 0)
+	gvr := ui.TrimCell(n.GetTable().SelectTable, row, 2)
+	n.App().gotoResource(gvr, client.FQN(ns, name), false)
+
+	return nil
+}
+
+// showNamespaceBlockers lists the resources holding up a namespace deletion.
+func showNamespaceBlockers(app *App, ns string) {
+	v := NewNamespaceBlockers(client.NewGVR("nsblockers"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, ns)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,91 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/tview"
+)
+
+const (
+	overviewWarningsSince = time.Hour
+	overviewMaxWarnings   = 10
+)
+
+// NamespaceOverview summarizes a namespace health before drilling into its pods.
+type NamespaceOverview struct {
+	*Details
+
+	ns string
+}
+
+// NewNamespaceOverview returns a new namespace overview.
+func NewNamespaceOverview(app *App, ns string) *NamespaceOverview {
+	return &NamespaceOverview{
+		Details: NewDetails(app, "Overview", ns, true),
+		ns:      ns,
+	}
+}
+
+// Start loads the namespace summary.
+func (n *NamespaceOverview) Start() {
+	n.Update("Loading...")
+	go n.load()
+}
+
+func (n *NamespaceOverview) load() {
+	s, err := dao.SummarizeNamespace(n.app.factory, n.ns, time.Now().Add(-overviewWarningsSince))
+	if err == nil && n.app.Conn() != nil {
+		mx, _ := client.DialMetrics(n.app.Conn()).FetchPodsMetrics(context.Background(), n.ns)
+		s.TopCPU, s.TopMem = dao.TopConsumers(mx)
+	}
+	n.app.QueueUpdateDraw(func() {
+		if err != nil {
+			n.app.Flash().Err(err)
+			return
+		}
+		n.Update(namespaceReport(s))
+	})
+}
+
+func namespaceReport(s dao.NamespaceSummary) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "namespace: %s\n", s.Namespace)
+	b.WriteString("workloads:\n")
+	for _, w := range s.Workloads {
+		fmt.Fprintf(&b, "  %s: %d/%d healthy\n", path.Base(w.GVR), w.Healthy, w.Total)
+	}
+	if len(s.Quotas) > 0 {
+		b.WriteString("quotas:\n")
+		for _, q := range s.Quotas {
+			fmt.Fprintf(&b, "  %s/%s: %s/%s (%d%%)\n", q.Quota, q.Resource, q.Used, q.Hard, q.Perc)
+		}
+	}
+	fmt.Fprintf(&b, "warnings (last %s): %d\n", overviewWarningsSince, len(s.Warnings))
+	for i, w := range s.Warnings {
+		if i == overviewMaxWarnings {
+			fmt.Fprintf(&b, "  - ... %d more\n", len(s.Warnings)-i)
+			break
+		}
+		fmt.Fprintf(&b, "  - %s %s (x%d): %s\n", w.Object, w.Reason, w.Count, w.Message)
+	}
+	if len(s.TopCPU) > 0 {
+		b.WriteString("top cpu:\n")
+		for _, c := range s.TopCPU {
+			fmt.Fprintf(&b, "  %s: %dm\n", c.Pod, c.CPU)
+		}
+	}
+	if len(s.TopMem) > 0 {
+		b.WriteString("top memory:\n")
+		for _, c := range s.TopMem {
+			fmt.Fprintf(&b, "  %s: %dMi\n", c.Pod, c.Mem/(1024*1024))
+		}
+	}
+
+	return tview.Escape(b.String())
+}
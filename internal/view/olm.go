@@ -0,0 +1,139 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Subscription represents an OLM Subscription viewer.
+type Subscription struct {
+	ResourceViewer
+}
+
+// NewSubscription returns a new viewer.
+func NewSubscription(gvr client.GVR) ResourceViewer {
+	s := Subscription{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(s.showCSV)
+
+	return &s
+}
+
+func (s *Subscription) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftU: ui.NewKeyAction("Sort Upgrade", s.GetTable().SortColCmd("UPGRADE", true), false),
+		ui.KeyShiftS: ui.NewKeyAction("Sort State", s.GetTable().SortColCmd("STATE", true), false),
+	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyA: ui.NewKeyAction("Approve Upgrade", s.approveCmd, true),
+	})
+}
+
+func (s *Subscription) showCSV(app *App, _ ui.Tabular, _, path string) {
+	var res dao.Subscription
+	res.Init(app.factory, s.GVR())
+	sub, err := res.GetInstance(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if sub.Status.InstalledCSV == "" {
+		app.Flash().Warnf("No CSV installed yet for subscription %s", path)
+		return
+	}
+
+	app.gotoResource(res.CSVsGVR().R(), client.FQN(sub.Namespace, sub.Status.InstalledCSV), false)
+}
+
+func (s *Subscription) approveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	s.Stop()
+	defer s.Start()
+	msg := fmt.Sprintf("Approve pending install plan for subscription %s?", path)
+	dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, "Confirm Approve", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.Subscription
+		res.Init(s.App().factory, s.GVR())
+		plan, err := res.ApproveUpgrade(ctx, path)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Install plan %s approved", plan)
+	}, func() {})
+
+	return nil
+}
+
+// InstallPlan represents an OLM InstallPlan viewer.
+type InstallPlan struct {
+	ResourceViewer
+}
+
+// NewInstallPlan returns a new viewer.
+func NewInstallPlan(gvr client.GVR) ResourceViewer {
+	i := InstallPlan{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	i.AddBindKeysFn(i.bindKeys)
+	i.GetTable().SetSortCol("AGE", true)
+
+	return &i
+}
+
+func (i *InstallPlan) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftP: ui.NewKeyAction("Sort Phase", i.GetTable().SortColCmd("PHASE", true), false),
+	})
+	if i.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyA: ui.NewKeyAction("Approve", i.approveCmd, true),
+	})
+}
+
+func (i *InstallPlan) approveCmd(evt *tcell.EventKey) *tcell.EventKey {
+	paths := i.GetTable().GetSelectedItems()
+	if len(paths) == 0 || paths[0] == "" {
+		return evt
+	}
+
+	i.Stop()
+	defer i.Start()
+	msg := fmt.Sprintf("Approve install plan %s?", paths[0])
+	if len(paths) > 1 {
+		msg = fmt.Sprintf("Approve %d install plans?", len(paths))
+	}
+	dialog.ShowConfirm(i.App().Styles.Dialog(), i.App().Content.Pages, "Confirm Approve", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), i.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var res dao.InstallPlan
+		res.Init(i.App().factory, i.GVR())
+		for _, path := range paths {
+			if err := res.Approve(ctx, path); err != nil {
+				i.App().Flash().Err(err)
+				return
+			}
+		}
+		i.App().Flash().Infof("Approved %d install plan(s)", len(paths))
+	}, func() {})
+
+	return nil
+}
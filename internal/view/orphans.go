@@ -0,0 +1,113 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Orphans represents a garbage resources report.
+type Orphans struct {
+	ResourceViewer
+}
+
+// NewOrphans returns a new viewer.
+func NewOrphans(gvr client.GVR) ResourceViewer {
+	o := Orphans{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	o.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	o.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	o.GetTable().SetSortCol("GVR", true)
+	o.GetTable().SetDecorateFn(o.decorateRows)
+	o.AddBindKeysFn(o.bindKeys)
+
+	return &o
+}
+
+func (o *Orphans) decorateRows(data *render.TableData) {
+	o.GetTable().Extras = fmt.Sprintf("Orphans %d", len(data.RowEvents))
+}
+
+func (o *Orphans) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	if _, ok := aa[tcell.KeyCtrlD]; ok {
+		aa[tcell.KeyCtrlD] = ui.NewKeyAction("Delete", o.deleteCmd, true)
+	}
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", o.gotoCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", o.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Reason", o.GetTable().SortColCmd("REASON", true), false),
+	})
+}
+
+func (o *Orphans) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	tokens := strings.SplitN(o.GetTable().GetSelectedItem(), ":", 2)
+	if len(tokens) != 2 {
+		return evt
+	}
+	o.App().gotoResource(tokens[0], tokens[1], false)
+
+	return nil
+}
+
+// deleteCmd confirms the deletion of the selected orphans, listing each one since
+// orphans are heuristic guesses.
+func (o *Orphans) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
+	selections := o.GetTable().GetSelectedItems()
+	if len(selections) == 0 {
+		return evt
+	}
+
+	accessor, err := dao.AccessorFor(o.App().factory, o.GVR())
+	if err != nil {
+		o.App().Flash().Err(err)
+		return nil
+	}
+	nuker, ok := accessor.(dao.Nuker)
+	if !ok {
+		o.App().Flash().Errf("Invalid nuker %T", accessor)
+		return nil
+	}
+
+	o.Stop()
+	defer o.Start()
+	dialog.ShowConfirm(o.App().Styles.Dialog(), o.App().Content.Pages, "Confirm Delete", orphansDeleteMsg(selections), func() {
+		o.App().Flash().Infof("Delete %d orphan(s)", len(selections))
+		for _, sel := range selections {
+			err := deleteStashed(o.App(), o.GVR().String(), sel, func() error {
+				return nuker.Delete(context.Background(), sel, nil, dao.DefaultGrace)
+			})
+			if err != nil {
+				o.App().Flash().Errf("Delete failed with `%s", err)
+			}
+			o.GetTable().DeleteMark(sel)
+		}
+		o.Refresh()
+	}, func() {})
+
+	return nil
+}
+
+// orphansDeleteMsg lists every orphan up for deletion.
+func orphansDeleteMsg(selections []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "Delete %d orphan(s)?\n", len(selections))
+	for _, sel := range selections {
+		gvr, fqn, ok := strings.Cut(sel, ":")
+		if !ok {
+			fmt.Fprintf(&b, "\n%s", sel)
+			continue
+		}
+		fmt.Fprintf(&b, "\n%s %s", client.NewGVR(gvr).R(), fqn)
+	}
+
+	return b.String()
+}
@@ -0,0 +1,14 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrphansDeleteMsg(t *testing.T) {
+	assert.Equal(t,
+		"Delete 2 orphan(s)?\n\nconfigmaps default/cm1\nsecrets ns1/s1",
+		orphansDeleteMsg([]string{"v1/configmaps:default/cm1", "v1/secrets:ns1/s1"}),
+	)
+}
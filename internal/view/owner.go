@@ -0,0 +1,57 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// showOwner navigates to the controller owning a given resource.
+func showOwner(app *App, gvr, path string) {
+	ogvr, fqn, err := dao.OwnerFor(app.factory, gvr, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+
+	app.gotoResource(ogvr.String(), fqn, false)
+}
+
+// showChildren lists the resources managed by a given controller.
+func showChildren(app *App, gvr, path string) {
+	cgvr, ok := dao.ChildrenGVR(gvr)
+	if !ok {
+		return
+	}
+	o, err := app.factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		app.Flash().Errf("expecting *unstructured.Unstructured but got `%T", o)
+		return
+	}
+
+	v := viewerFor(cgvr)
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyUID, string(u.GetUID()))
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func viewerFor(gvr client.GVR) ResourceViewer {
+	if v, ok := customViewers[gvr]; ok && v.viewerFn != nil {
+		return v.viewerFn(gvr)
+	}
+
+	return NewBrowser(gvr)
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/perf"
 	"github.com/derailed/k9s/internal/ui"
@@ -150,7 +151,7 @@ func (p *PortForward) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 	} else {
 		h, err := pfToHuman(selections[0])
 		if err == nil {
-			msg = fmt.Sprintf("Delete %s %s?", p.GVR().R(), h)
+			msg = i18n.Tf("Delete %s %s?", p.GVR().R(), h)
 		} else {
 			p.App().Flash().Err(err)
 			return nil
@@ -4,6 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -12,20 +15,17 @@ import (
 	"github.com/derailed/k9s/internal/render"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
 	"github.com/fatih/color"
 	"github.com/rs/zerolog/log"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 )
 
-const (
-	windowsOS      = "windows"
-	powerShell     = "powershell"
-	osBetaSelector = "beta.kubernetes.io/os"
-	osSelector     = "kubernetes.io/os"
-)
+const windowsOS = "windows"
 
 // Pod represents a pod viewer.
 type Pod struct {
@@ -64,6 +64,8 @@ func (p *Pod) bindDangerousKeys(aa ui.KeyActions) {
 		tcell.KeyCtrlK: ui.NewKeyAction("Kill", p.killCmd, true),
 		ui.KeyS:        ui.NewKeyAction("Shell", p.shellCmd, true),
 		ui.KeyA:        ui.NewKeyAction("Attach", p.attachCmd, true),
+		ui.KeyT:        ui.NewKeyAction("Net Test", p.netProbeCmd, true),
+		ui.KeyShiftB:   ui.NewKeyAction("Bypass NetPol", p.bypassNetPolCmd, true),
 	})
 }
 
@@ -75,11 +77,15 @@ func (p *Pod) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyN:      ui.NewKeyAction("Show Node", p.showNode, true),
 		ui.KeyF:      ui.NewKeyAction("Show PortForward", p.showPFCmd, true),
+		ui.KeyX:      ui.NewKeyAction("Explain Scheduling", p.explainSchedulingCmd, true),
+		ui.KeyR:      ui.NewKeyAction("Crash History", p.crashHistoryCmd, true),
+		ui.KeyM:      ui.NewKeyAction("Volumes", p.volumesCmd, true),
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", p.GetTable().SortColCmd(readyCol, true), false),
 		ui.KeyShiftT: ui.NewKeyAction("Sort Restart", p.GetTable().SortColCmd("RESTARTS", false), false),
 		ui.KeyShiftS: ui.NewKeyAction("Sort Status", p.GetTable().SortColCmd(statusCol, true), false),
 		ui.KeyShiftI: ui.NewKeyAction("Sort IP", p.GetTable().SortColCmd("IP", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyShiftV: ui.NewKeyAction("IP Family", p.GetTable().ipFamilyCmd, false),
 	})
 	aa.Add(resourceSorters(p.GetTable()))
 }
@@ -230,6 +236,215 @@ func (p *Pod) shellCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (p *Pod) explainSchedulingCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	r, err := dao.ExplainScheduling(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Scheduling", path, true).Update(schedulingReport(r))
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func schedulingReport(r dao.SchedulingReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "pod: %s\n", r.Pod)
+	fmt.Fprintf(&b, "phase: %s\n", r.Phase)
+	if r.NodeName != "" {
+		fmt.Fprintf(&b, "scheduled: %s\n", r.NodeName)
+	}
+	if len(r.Requests) > 0 {
+		kk := make([]string, 0, len(r.Requests))
+		for k := range r.Requests {
+			kk = append(kk, string(k))
+		}
+		sort.Strings(kk)
+		b.WriteString("requests:\n")
+		for _, k := range kk {
+			q := r.Requests[v1.ResourceName(k)]
+			fmt.Fprintf(&b, "  %s: %s\n", k, q.String())
+		}
+	}
+	if len(r.Blockers) > 0 {
+		b.WriteString("blockers:\n")
+		for _, bl := range r.Blockers {
+			fmt.Fprintf(&b, "  - %s\n", bl)
+		}
+	}
+	if len(r.Events) > 0 {
+		b.WriteString("events:\n")
+		for _, e := range r.Events {
+			fmt.Fprintf(&b, "  - %s\n", e)
+		}
+	}
+	fmt.Fprintf(&b, "fits: %d/%d nodes\n", r.Fits(), len(r.Nodes))
+	if len(r.Nodes) == 0 {
+		return tview.Escape(b.String())
+	}
+	b.WriteString("nodes:\n")
+	for _, n := range r.Nodes {
+		if n.Fits() {
+			fmt.Fprintf(&b, "  %s: fits\n", n.Node)
+			continue
+		}
+		fmt.Fprintf(&b, "  %s:\n", n.Node)
+		for _, reason := range n.Reasons {
+			fmt.Fprintf(&b, "    - %s\n", reason)
+		}
+	}
+
+	return tview.Escape(b.String())
+}
+
+func (p *Pod) volumesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showPodVolumes(p.App(), path)
+
+	return nil
+}
+
+func (p *Pod) crashHistoryCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	r, err := dao.TerminationHistory(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(p.App(), "Crash History", path, true).Update(terminationReport(r))
+	if err := p.App().inject(details, false); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func terminationReport(r dao.TerminationReport) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "pod: %s\n", r.Pod)
+	if pp := r.Patterns(); len(pp) > 0 {
+		b.WriteString("patterns:\n")
+		for _, p := range pp {
+			fmt.Fprintf(&b, "  - %s\n", p)
+		}
+	}
+	b.WriteString("containers:\n")
+	for _, c := range r.Containers {
+		fmt.Fprintf(&b, "  %s:\n", c.Name)
+		if c.Init {
+			b.WriteString("    init: true\n")
+		}
+		fmt.Fprintf(&b, "    state: %s\n", c.State)
+		fmt.Fprintf(&b, "    restarts: %d\n", c.Restarts)
+		if c.BackOff != "" {
+			fmt.Fprintf(&b, "    backoff: %s\n", c.BackOff)
+		}
+		if c.Current != nil {
+			b.WriteString("    terminated:\n")
+			writeTermination(&b, c.Current)
+		}
+		if c.Last != nil {
+			b.WriteString("    lastTermination:\n")
+			writeTermination(&b, c.Last)
+		}
+	}
+	if len(r.Events) == 0 {
+		return tview.Escape(b.String())
+	}
+	b.WriteString("events:\n")
+	for _, e := range r.Events {
+		fmt.Fprintf(&b, "  - %s %s (x%d, %s ago): %s\n", e.Last.Format(time.RFC3339), e.Reason, e.Count, duration.HumanDuration(time.Since(e.Last)), e.Message)
+	}
+
+	return tview.Escape(b.String())
+}
+
+func writeTermination(b *strings.Builder, t *dao.Termination) {
+	fmt.Fprintf(b, "      exitCode: %d (%s)\n", t.ExitCode, dao.ExitCodeHint(t.ExitCode))
+	if t.Signal != 0 {
+		fmt.Fprintf(b, "      signal: %d\n", t.Signal)
+	}
+	fmt.Fprintf(b, "      reason: %s\n", t.Reason)
+	fmt.Fprintf(b, "      oomKilled: %t\n", t.IsOOMKilled())
+	if !t.StartedAt.IsZero() {
+		fmt.Fprintf(b, "      startedAt: %s\n", t.StartedAt.Format(time.RFC3339))
+	}
+	if !t.FinishedAt.IsZero() {
+		fmt.Fprintf(b, "      finishedAt: %s\n", t.FinishedAt.Format(time.RFC3339))
+	}
+	if d := t.Ran(); d > 0 {
+		fmt.Fprintf(b, "      ran: %s\n", duration.HumanDuration(d))
+	}
+	if t.Message != "" {
+		fmt.Fprintf(b, "      message: %s\n", strings.Join(strings.Fields(t.Message), " "))
+	}
+}
+
+func (p *Pod) netProbeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	if !podIsRunning(p.App().factory, path) {
+		p.App().Flash().Errf("%s is not in a running state", path)
+		return nil
+	}
+	pod, err := fetchPod(p.App().factory, path)
+	if err != nil {
+		p.App().Flash().Err(err)
+		return nil
+	}
+	ShowNetProbe(p, path, fetchContainers(pod.Spec, false), netProbe)
+
+	return nil
+}
+
+func (p *Pod) bypassNetPolCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	ns, n := client.Namespaced(path)
+	injectDebugNetPol(p.App(), ns, n)
+
+	return nil
+}
+
+func netProbe(v ResourceViewer, path string, opts NetProbeOpts) {
+	t, err := parseProbeTarget(opts.Target)
+	if err != nil {
+		v.App().Flash().Err(err)
+		return
+	}
+
+	v.App().Flash().Infof("Testing connectivity from %s to %s...", path, opts.Target)
+	go func() {
+		args := netProbeArgs(path, opts, netProbeScript(t))
+		res, err := runKu(v.App(), shellOpts{clear: false, args: args})
+		v.App().QueueUpdateDraw(func() {
+			details := NewDetails(v.App(), "Connectivity Test", path, true).Update(netProbeReport(t, opts, res, err))
+			if err := v.App().inject(details, false); err != nil {
+				v.App().Flash().Err(err)
+			}
+		})
+	}()
+}
+
 func (p *Pod) attachCmd(evt *tcell.EventKey) *tcell.EventKey {
 	path := p.GetTable().GetSelectedItem()
 	if path == "" {
@@ -339,10 +554,18 @@ func attachIn(a *App, path, co string) {
 
 func computeShellArgs(path, co string, kcfg *string, os string) []string {
 	args := buildShellArgs("exec", path, co, kcfg)
+	args = append(args, "--")
+
+	return append(args, shellCommand(os)...)
+}
+
+// shellCommand returns the default shell command for a given container os.
+func shellCommand(os string) []string {
 	if os == windowsOS {
-		return append(args, "--", powerShell)
+		return []string{"cmd", "/c", windowsShellCheck}
 	}
-	return append(args, "--", "sh", "-c", shellCheck)
+
+	return []string{"sh", "-c", shellCheck}
 }
 
 func buildShellArgs(cmd, path, co string, kcfg *string) []string {
@@ -407,20 +630,29 @@ func podIsRunning(f dao.Factory, path string) bool {
 	return re.Phase(po) == render.Running
 }
 
+// getPodOS returns the operating system a pod requires or the one of the node it runs on.
 func getPodOS(f dao.Factory, fqn string) (string, error) {
 	po, err := fetchPod(f, fqn)
 	if err != nil {
 		return "", err
 	}
-	if os, ok := po.Spec.NodeSelector[osBetaSelector]; ok {
+	if os := render.PodOS(po); os != "" {
 		return os, nil
 	}
-	os, ok := po.Spec.NodeSelector[osSelector]
-	if !ok {
+	if po.Spec.NodeName == "" {
 		return "", fmt.Errorf("no os information available")
 	}
+	o, err := f.Get("v1/nodes", client.FQN(client.ClusterScope, po.Spec.NodeName), true, labels.Everything())
+	if err != nil {
+		return "", err
+	}
+	var no v1.Node
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &no)
+	if err != nil {
+		return "", err
+	}
 
-	return os, nil
+	return render.NodeOS(&no), nil
 }
 
 func resourceSorters(t *Table) ui.KeyActions {
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -48,7 +49,7 @@ func TestComputeShellArgs(t *testing.T) {
 			"c1",
 			windowsOS,
 			&empty,
-			"exec -it -n fred blee -c c1 -- powershell",
+			"exec -it -n fred blee -c c1 -- cmd /c " + windowsShellCheck,
 		},
 	}
 
@@ -103,3 +104,19 @@ func TestComputeShellArgs(t *testing.T) {
 // 		})
 // 	}
 // }
+
+func TestSchedulingReportEscaped(t *testing.T) {
+	r := dao.SchedulingReport{
+		Pod:    "default/p1",
+		Phase:  "Pending",
+		Events: []string{"0/3 nodes are available: 3 node(s) had untolerated taint {[red]: }"},
+	}
+
+	e := `pod: default/p1
+phase: Pending
+events:
+  - 0/3 nodes are available: 3 node(s) had untolerated taint {[red[]: }
+fits: 0/0 nodes
+`
+	assert.Equal(t, e, schedulingReport(r))
+}
@@ -0,0 +1,99 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// PodVolumes represents a pod volumes and mounts viewer.
+type PodVolumes struct {
+	ResourceViewer
+
+	pod string
+}
+
+// NewPodVolumes returns a new viewer.
+func NewPodVolumes(gvr client.GVR) ResourceViewer {
+	v := PodVolumes{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	v.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	v.AddBindKeysFn(v.bindKeys)
+
+	return &v
+}
+
+// Init initializes the view.
+func (v *PodVolumes) Init(ctx context.Context) error {
+	if err := v.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	v.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (v *PodVolumes) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, ui.KeyShiftN, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Source", v.gotoSourceCmd, true),
+		ui.KeyP:        ui.NewKeyAction("Goto PV", v.gotoPVCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort Volume", v.GetTable().SortColCmd("VOLUME", true), false),
+		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", v.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Container", v.GetTable().SortColCmd("CONTAINER", true), false),
+	})
+}
+
+func (v *PodVolumes) gotoSourceCmd(evt *tcell.EventKey) *tcell.EventKey {
+	gvr, src := v.selectedCell("GVR"), v.selectedCell("SOURCE")
+	if gvr == "" || src == "" {
+		v.App().Flash().Warn("Volume is not backed by a kubernetes resource")
+		return nil
+	}
+	ns, _ := client.Namespaced(v.pod)
+	v.App().gotoResource(gvr, client.FQN(ns, src), false)
+
+	return nil
+}
+
+func (v *PodVolumes) gotoPVCmd(evt *tcell.EventKey) *tcell.EventKey {
+	pv := v.selectedCell("PV")
+	if pv == "" {
+		v.App().Flash().Warn("Volume is not backed by a bound persistent volume")
+		return nil
+	}
+	v.App().gotoResource("v1/persistentvolumes", pv, false)
+
+	return nil
+}
+
+func (v *PodVolumes) selectedCell(col string) string {
+	row, _ := v.GetTable().GetSelection()
+	if row == 0 {
+		return ""
+	}
+	idx, ok := v.GetTable().HeaderIndex(col)
+	if !ok {
+		return ""
+	}
+
+	return ui.TrimCell(v.GetTable().SelectTable, row, idx)
+}
+
+// showPodVolumes lists a pod volumes along with their mounts.
+func showPodVolumes(app *App, path string) {
+	v := NewPodVolumes(client.NewGVR("podvolumes"))
+	v.(*PodVolumes).pod = path
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
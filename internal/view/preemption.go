@@ -0,0 +1,74 @@
+package view
+
+import (
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Preemptions represents a pods preemption audit viewer.
+type Preemptions struct {
+	ResourceViewer
+}
+
+// NewPreemptions returns a new viewer.
+func NewPreemptions(gvr client.GVR) ResourceViewer {
+	p := Preemptions{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	p.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	p.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	p.GetTable().SetSortCol("NAMESPACE", true)
+	p.GetTable().SetDecorateFn(p.decorateRows)
+	p.AddBindKeysFn(p.bindKeys)
+
+	return &p
+}
+
+func (p *Preemptions) decorateRows(data *render.TableData) {
+	var (
+		count int
+		nss   = make(map[string]struct{})
+	)
+	col := data.IndexOfHeader("NAMESPACE")
+	for _, re := range data.RowEvents {
+		count++
+		if col >= 0 {
+			nss[re.Row.Fields[col]] = struct{}{}
+		}
+	}
+	p.GetTable().Extras = fmt.Sprintf("Preemptions %d, Namespaces %d", count, len(nss))
+}
+
+func (p *Preemptions) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto Preemptor", p.gotoPreemptorCmd, true),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Preemptor", p.GetTable().SortColCmd("PREEMPTOR", true), false),
+		ui.KeyShiftO:   ui.NewKeyAction("Sort Node", p.GetTable().SortColCmd("NODE", true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Count", p.GetTable().SortColCmd("COUNT", false), false),
+	})
+}
+
+func (p *Preemptions) gotoPreemptorCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := p.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+	idx, ok := p.GetTable().HeaderIndex("PREEMPTOR")
+	if !ok {
+		return nil
+	}
+	po := ui.TrimCell(p.GetTable().SelectTable, row, idx)
+	if po == "" || po == render.MissingValue {
+		p.App().Flash().Warn("Preempting pod is unknown")
+		return nil
+	}
+	p.App().gotoResource("v1/pods", po, false)
+
+	return nil
+}
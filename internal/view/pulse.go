@@ -345,6 +345,7 @@ func (p *Pulse) makeSP(loc image.Point, span image.Point, gvr string) *tchart.Sp
 	s.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
 	s.SetInputCapture(p.keyboard)
 	s.SetMultiSeries(true)
+	s.SetGaugeMode(tchart.ParseGaugeMode(p.app.Config.K9s.Gauges))
 	p.AddItem(s, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
 	return s
@@ -362,6 +363,7 @@ func (p *Pulse) makeGA(loc image.Point, span image.Point, gvr string) *tchart.Ga
 	}
 	g.SetLegend(fmt.Sprintf(" %s ", cases.Title(language.Und, cases.NoLower).String(client.NewGVR(gvr).R())))
 	g.SetInputCapture(p.keyboard)
+	g.SetGaugeMode(tchart.ParseGaugeMode(p.app.Config.K9s.Gauges))
 	p.AddItem(g, loc.X, loc.Y, span.X, span.Y, 0, 0, true)
 
 	return g
@@ -1,9 +1,23 @@
 package view
 
 import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	pvcResizeKey     = "pvcResize"
+	pvcResizePoll    = 3 * time.Second
+	pvcResizeTimeout = 10 * time.Minute
 )
 
 // PersistentVolumeClaim represents a PVC custom viewer.
@@ -17,6 +31,7 @@ func NewPersistentVolumeClaim(gvr client.GVR) ResourceViewer {
 		ResourceViewer: NewBrowser(gvr),
 	}
 	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetEnterFn(v.showVolume)
 
 	return &v
 }
@@ -28,9 +43,163 @@ func (p *PersistentVolumeClaim) bindKeys(aa ui.KeyActions) {
 		ui.KeyShiftV: ui.NewKeyAction("Sort Volume", p.GetTable().SortColCmd("VOLUME", true), false),
 		ui.KeyShiftO: ui.NewKeyAction("Sort StorageClass", p.GetTable().SortColCmd("STORAGECLASS", true), false),
 		ui.KeyShiftC: ui.NewKeyAction("Sort Capacity", p.GetTable().SortColCmd("CAPACITY", true), false),
+		ui.KeyShiftU: ui.NewKeyAction("Sort Usage", p.GetTable().SortColCmd("%USED", false), false),
+	})
+	if p.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Resize", p.resizeCmd, true),
+		ui.KeyS: ui.NewKeyAction("Snapshot", p.snapshotCmd, true),
+	})
+}
+
+func (p *PersistentVolumeClaim) snapshotCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	p.Stop()
+	defer p.Start()
+	if err := snapshotPVC(p.App(), path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) resizeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := p.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	p.Stop()
+	defer p.Start()
+	if err := p.showResizeDialog(path); err != nil {
+		p.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) showResizeDialog(path string) error {
+	var res dao.PersistentVolumeClaim
+	res.Init(p.App().factory, p.GVR())
+	size, err := res.Size(path)
+	if err != nil {
+		return err
+	}
+
+	f := p.makeStyledForm()
+	f.AddInputField("Size:", size, 10, nil, func(changed string) {
+		size = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), p.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := res.Resize(ctx, path, size); err != nil {
+			p.App().Flash().Err(err)
+			return
+		}
+		p.dismissDialog()
+		p.App().Flash().Infof("Resize of PVC %s to %s requested", path, size)
+		go trackResize(p.App(), &res, path)
+	})
+	f.AddButton("Cancel", func() {
+		p.dismissDialog()
 	})
+
+	confirm := tview.NewModalForm("<Resize>", f)
+	confirm.SetText(fmt.Sprintf("Resize PVC %s", path))
+	confirm.SetDoneFunc(func(int, string) {
+		p.dismissDialog()
+	})
+	p.App().Content.AddPage(pvcResizeKey, confirm, false, false)
+	p.App().Content.ShowPage(pvcResizeKey)
+
+	return nil
+}
+
+func (p *PersistentVolumeClaim) dismissDialog() {
+	p.App().Content.RemovePage(pvcResizeKey)
+}
+
+func (p *PersistentVolumeClaim) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
 }
 
 func (p *PersistentVolumeClaim) refCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return scanRefs(evt, p.App(), p.GetTable(), "v1/persistentvolumeclaims")
 }
+
+// trackResize reports a claim expansion progress until it completes or times out.
+func trackResize(app *App, res *dao.PersistentVolumeClaim, path string) {
+	var last dao.ResizePhase
+	ticker := time.NewTicker(pvcResizePoll)
+	defer ticker.Stop()
+	timeout := time.After(pvcResizeTimeout)
+	for {
+		select {
+		case <-timeout:
+			app.QueueUpdateDraw(func() {
+				app.Flash().Warnf("Resize of PVC %s did not complete after %s", path, pvcResizeTimeout)
+			})
+			return
+		case <-ticker.C:
+			st, err := res.ResizeStatus(path)
+			if err != nil {
+				log.Warn().Err(err).Msgf("Resize status for pvc %s", path)
+				continue
+			}
+			if st.Phase == last {
+				continue
+			}
+			last = st.Phase
+			app.QueueUpdateDraw(func() {
+				reportResize(app, path, st)
+			})
+			if st.Phase == dao.ResizeDone {
+				return
+			}
+		}
+	}
+}
+
+func reportResize(app *App, path string, st dao.ResizeStatus) {
+	switch st.Phase {
+	case dao.ResizeDone:
+		app.Flash().Infof("PVC %s resized to %s", path, st.Capacity)
+	case dao.ResizeFileSystemPending:
+		app.Flash().Warnf("PVC %s volume expanded to %s. File system resize pending until a pod mounts it", path, st.Requested)
+	default:
+		app.Flash().Infof("PVC %s resize %s (%s -> %s)", path, st.Phase, st.Capacity, st.Requested)
+	}
+}
+
+// showVolume navigates to the volume backing the claim.
+func (p *PersistentVolumeClaim) showVolume(app *App, _ ui.Tabular, _, path string) {
+	var res dao.PersistentVolumeClaim
+	res.Init(app.factory, p.GVR())
+	gvr, fqn, err := res.Volume(path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	ns, _ := client.Namespaced(fqn)
+	if client.IsClusterScoped(ns) {
+		app.gotoResource(gvr.String(), fqn, false)
+		return
+	}
+
+	app.gotoResource(gvr.String()+" "+ns, fqn, false)
+}
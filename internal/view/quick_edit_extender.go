@@ -0,0 +1,182 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	quickEditKey   = "quickEdit"
+	quickEditValue = "Value:"
+)
+
+// QuickEditExtender provides for editing a single env var or ConfigMap key of a workload.
+type QuickEditExtender struct {
+	ResourceViewer
+}
+
+// NewQuickEditExtender returns a new extender.
+func NewQuickEditExtender(r ResourceViewer) ResourceViewer {
+	q := QuickEditExtender{ResourceViewer: r}
+	q.AddBindKeysFn(q.bindKeys)
+
+	return &q
+}
+
+func (q *QuickEditExtender) bindKeys(aa ui.KeyActions) {
+	if q.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftE: ui.NewKeyAction("Quick Edit", q.quickEditCmd, true),
+	})
+}
+
+func (q *QuickEditExtender) quickEditCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := q.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	q.Stop()
+	defer q.Start()
+	if err := q.showQuickEditDialog(path); err != nil {
+		q.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (q *QuickEditExtender) showQuickEditDialog(path string) error {
+	form, err := q.makeQuickEditForm(path)
+	if err != nil {
+		return err
+	}
+	confirm := tview.NewModalForm("<Quick Edit>", form)
+	confirm.SetText(fmt.Sprintf("Edit %s %s", singularize(q.GVR().R()), path))
+	confirm.SetDoneFunc(func(int, string) {
+		q.dismissDialog()
+	})
+	q.App().Content.AddPage(quickEditKey, confirm, false, false)
+	q.App().Content.ShowPage(quickEditKey)
+
+	return nil
+}
+
+func (q *QuickEditExtender) makeQuickEditForm(path string) (*tview.Form, error) {
+	res, err := dao.AccessorFor(q.App().factory, q.GVR())
+	if err != nil {
+		return nil, err
+	}
+	ps, ok := res.(dao.ContainsPodSpec)
+	if !ok {
+		return nil, fmt.Errorf("expecting a ContainsPodSpec for %q but got %T", q.GVR(), res)
+	}
+	spec, err := ps.GetPodSpec(path)
+	if err != nil {
+		return nil, err
+	}
+	ns, _ := client.Namespaced(path)
+	qq := dao.QuickEdits(q.App().factory, ns, spec)
+	if len(qq) == 0 {
+		return nil, errors.New("no env vars or configmap keys to edit")
+	}
+	labels := make([]string, 0, len(qq))
+	for _, e := range qq {
+		labels = append(labels, e.String())
+	}
+
+	f := q.makeStyledForm()
+	sel, value := qq[0], qq[0].Value
+	f.AddDropDown("Target:", labels, 0, func(_ string, idx int) {
+		if idx < 0 || idx >= len(qq) {
+			return
+		}
+		sel, value = qq[idx], qq[idx].Value
+		if field, ok := f.GetFormItemByLabel(quickEditValue).(*tview.InputField); ok {
+			field.SetText(value)
+		}
+	})
+	f.AddInputField(quickEditValue, value, 0, nil, func(changed string) {
+		value = changed
+	})
+
+	f.AddButton("OK", func() {
+		q.dismissDialog()
+		if value == sel.Value {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), q.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.ApplyQuickEdit(ctx, q.App().factory, q.GVR().String(), path, sel, value); err != nil {
+			log.Error().Err(err).Msgf("Quick edit %s on %s failed", sel, path)
+			q.App().Flash().Err(err)
+			return
+		}
+		q.App().Flash().Infof("Updated %s on %s", sel, path)
+		if sel.Kind == dao.ConfigMapKeyEdit {
+			q.offerRestart(path)
+			return
+		}
+		offerRolloutWatch(q.App(), q.GVR().String(), path)
+	})
+	f.AddButton("Cancel", func() {
+		q.dismissDialog()
+	})
+
+	return f, nil
+}
+
+// offerRestart offers to restart a workload so it picks up a ConfigMap change.
+func (q *QuickEditExtender) offerRestart(path string) {
+	res, err := dao.AccessorFor(q.App().factory, q.GVR())
+	if err != nil {
+		q.App().Flash().Err(err)
+		return
+	}
+	r, ok := res.(dao.Restartable)
+	if !ok {
+		return
+	}
+	var restarted bool
+	msg := fmt.Sprintf("Restart %s %s to pick up the configmap change?", singularize(q.GVR().R()), path)
+	dialog.ShowConfirm(q.App().Styles.Dialog(), q.App().Content.Pages, "Confirm Restart", msg, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), q.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := r.Restart(ctx, path); err != nil {
+			q.App().Flash().Err(err)
+			return
+		}
+		q.App().Flash().Infof("Restart in progress for `%s...", path)
+		restarted = true
+	}, func() {
+		if restarted {
+			offerRolloutWatch(q.App(), q.GVR().String(), path)
+		}
+	})
+}
+
+func (q *QuickEditExtender) dismissDialog() {
+	q.App().Content.RemovePage(quickEditKey)
+}
+
+func (q *QuickEditExtender) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
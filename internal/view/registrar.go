@@ -12,8 +12,25 @@ func loadCustomViewers() MetaViewers {
 	appsViewers(m)
 	rbacViewers(m)
 	batchViewers(m)
+	autoscalingViewers(m)
+	snapshotViewers(m)
 	extViewers(m)
 	helmViewers(m)
+	istioViewers(m)
+	argoViewers(m)
+	fluxViewers(m)
+	tektonViewers(m)
+	knativeViewers(m)
+	kedaViewers(m)
+	veleroViewers(m)
+	capiViewers(m)
+	karpenterViewers(m)
+	strimziViewers(m)
+	esoViewers(m)
+	olmViewers(m)
+	ciliumViewers(m)
+	cnpgViewers(m)
+	apfViewers(m)
 
 	return m
 }
@@ -24,6 +41,160 @@ func helmViewers(vv MetaViewers) {
 	}
 }
 
+func istioViewers(vv MetaViewers) {
+	for _, v := range []string{"v1beta1", "v1"} {
+		vv[client.NewGVR("networking.istio.io/"+v+"/virtualservices")] = MetaViewer{
+			viewerFn: NewVirtualService,
+		}
+	}
+}
+
+func argoViewers(vv MetaViewers) {
+	vv[client.NewGVR("argoproj.io/v1alpha1/applications")] = MetaViewer{
+		viewerFn: NewApplication,
+	}
+	vv[client.NewGVR("argoproj.io/v1alpha1/rollouts")] = MetaViewer{
+		viewerFn: NewRollout,
+	}
+	vv[client.NewGVR("argoproj.io/v1alpha1/workflows")] = MetaViewer{
+		viewerFn: NewWorkflow,
+	}
+}
+
+func fluxViewers(vv MetaViewers) {
+	vv[client.NewGVR("kustomize.toolkit.fluxcd.io/v1/kustomizations")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("kustomize.toolkit.fluxcd.io/v1beta2/kustomizations")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("helm.toolkit.fluxcd.io/v2/helmreleases")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("helm.toolkit.fluxcd.io/v2beta2/helmreleases")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("helm.toolkit.fluxcd.io/v2beta1/helmreleases")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("source.toolkit.fluxcd.io/v1/gitrepositories")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+	vv[client.NewGVR("source.toolkit.fluxcd.io/v1beta2/gitrepositories")] = MetaViewer{
+		viewerFn: NewFlux,
+	}
+}
+
+func tektonViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("tekton.dev/"+v+"/pipelineruns")] = MetaViewer{
+			viewerFn: NewPipelineRun,
+		}
+		vv[client.NewGVR("tekton.dev/"+v+"/taskruns")] = MetaViewer{
+			viewerFn: NewTaskRun,
+		}
+	}
+}
+
+func knativeViewers(vv MetaViewers) {
+	vv[client.NewGVR("serving.knative.dev/v1/services")] = MetaViewer{
+		viewerFn: NewKService,
+	}
+	vv[client.NewGVR("serving.knative.dev/v1/revisions")] = MetaViewer{
+		viewerFn: NewRevision,
+	}
+}
+
+func kedaViewers(vv MetaViewers) {
+	vv[client.NewGVR("keda.sh/v1alpha1/scaledobjects")] = MetaViewer{
+		viewerFn: NewScaledObject,
+	}
+	vv[client.NewGVR("keda.sh/v1alpha1/scaledjobs")] = MetaViewer{
+		viewerFn: NewScaledObject,
+	}
+}
+
+func veleroViewers(vv MetaViewers) {
+	vv[client.NewGVR("velero.io/v1/backups")] = MetaViewer{
+		viewerFn: NewBackup,
+	}
+	vv[client.NewGVR("velero.io/v1/schedules")] = MetaViewer{
+		viewerFn: NewSchedule,
+	}
+}
+
+func capiViewers(vv MetaViewers) {
+	vv[client.NewGVR("cluster.x-k8s.io/v1beta1/clusters")] = MetaViewer{
+		viewerFn: NewCluster,
+	}
+	vv[client.NewGVR("cluster.x-k8s.io/v1beta1/machinedeployments")] = MetaViewer{
+		viewerFn: NewMachineDeployment,
+	}
+	vv[client.NewGVR("cluster.x-k8s.io/v1beta1/machines")] = MetaViewer{
+		viewerFn: NewMachine,
+	}
+}
+
+func karpenterViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta1"} {
+		vv[client.NewGVR("karpenter.sh/"+v+"/nodepools")] = MetaViewer{
+			viewerFn: NewNodePool,
+		}
+		vv[client.NewGVR("karpenter.sh/"+v+"/nodeclaims")] = MetaViewer{
+			viewerFn: NewNodeClaim,
+		}
+	}
+}
+
+func strimziViewers(vv MetaViewers) {
+	vv[client.NewGVR("kafka.strimzi.io/v1beta2/kafkas")] = MetaViewer{
+		viewerFn: NewKafka,
+	}
+	vv[client.NewGVR("kafka.strimzi.io/v1beta2/kafkatopics")] = MetaViewer{
+		viewerFn: NewKafkaTopic,
+	}
+}
+
+func esoViewers(vv MetaViewers) {
+	for _, v := range []string{"v1beta1", "v1"} {
+		vv[client.NewGVR("external-secrets.io/"+v+"/externalsecrets")] = MetaViewer{
+			viewerFn: NewExternalSecret,
+		}
+	}
+}
+
+func olmViewers(vv MetaViewers) {
+	vv[client.NewGVR("operators.coreos.com/v1alpha1/subscriptions")] = MetaViewer{
+		viewerFn: NewSubscription,
+	}
+	vv[client.NewGVR("operators.coreos.com/v1alpha1/installplans")] = MetaViewer{
+		viewerFn: NewInstallPlan,
+	}
+}
+
+func ciliumViewers(vv MetaViewers) {
+	vv[client.NewGVR("cilium.io/v2/ciliumendpoints")] = MetaViewer{
+		viewerFn: NewCiliumEndpoint,
+	}
+}
+
+func cnpgViewers(vv MetaViewers) {
+	vv[client.NewGVR("postgresql.cnpg.io/v1/clusters")] = MetaViewer{
+		viewerFn: NewPGCluster,
+	}
+}
+
+func apfViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v1beta3", "v1beta2"} {
+		vv[client.NewGVR("flowcontrol.apiserver.k8s.io/"+v+"/flowschemas")] = MetaViewer{
+			viewerFn: NewFlowSchema,
+		}
+		vv[client.NewGVR("flowcontrol.apiserver.k8s.io/"+v+"/prioritylevelconfigurations")] = MetaViewer{
+			viewerFn: NewPriorityLevel,
+		}
+	}
+}
+
 func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/namespaces")] = MetaViewer{
 		viewerFn: NewNamespace,
@@ -37,6 +208,9 @@ func coreViewers(vv MetaViewers) {
 	vv[client.NewGVR("v1/services")] = MetaViewer{
 		viewerFn: NewService,
 	}
+	vv[client.NewGVR("networking.k8s.io/v1/ingresses")] = MetaViewer{
+		viewerFn: NewIngress,
+	}
 	vv[client.NewGVR("v1/nodes")] = MetaViewer{
 		viewerFn: NewNode,
 	}
@@ -83,6 +257,57 @@ func miscViewers(vv MetaViewers) {
 	vv[client.NewGVR("references")] = MetaViewer{
 		viewerFn: NewReference,
 	}
+	vv[client.NewGVR("related")] = MetaViewer{
+		viewerFn: NewRelated,
+	}
+	vv[client.NewGVR("podvolumes")] = MetaViewer{
+		viewerFn: NewPodVolumes,
+	}
+	vv[client.NewGVR("preemptions")] = MetaViewer{
+		viewerFn: NewPreemptions,
+	}
+	vv[client.NewGVR("devices")] = MetaViewer{
+		viewerFn: NewDevices,
+	}
+	vv[client.NewGVR("svcsplits")] = MetaViewer{
+		viewerFn: NewServiceSplits,
+	}
+	vv[client.NewGVR("ips")] = MetaViewer{
+		viewerFn: NewRelated,
+	}
+	vv[client.NewGVR("apihealth")] = MetaViewer{
+		viewerFn: NewAPIHealth,
+	}
+	vv[client.NewGVR("deprecations")] = MetaViewer{
+		viewerFn: NewDeprecations,
+	}
+	vv[client.NewGVR("upgrade")] = MetaViewer{
+		viewerFn: NewUpgrade,
+	}
+	vv[client.NewGVR("k9slogs")] = MetaViewer{
+		viewerFn: NewK9sLogs,
+	}
+	vv[client.NewGVR("churn")] = MetaViewer{
+		viewerFn: NewChurn,
+	}
+	vv[client.NewGVR("orphans")] = MetaViewer{
+		viewerFn: NewOrphans,
+	}
+	vv[client.NewGVR("nsblockers")] = MetaViewer{
+		viewerFn: NewNamespaceBlockers,
+	}
+	vv[client.NewGVR("webhooks")] = MetaViewer{
+		viewerFn: NewWebhooks,
+	}
+	vv[client.NewGVR("managedfields")] = MetaViewer{
+		viewerFn: NewManagedFields,
+	}
+	vv[client.NewGVR("history")] = MetaViewer{
+		viewerFn: NewHistory,
+	}
+	vv[client.NewGVR("trash")] = MetaViewer{
+		viewerFn: NewTrash,
+	}
 	vv[client.NewGVR("pulses")] = MetaViewer{
 		viewerFn: NewPulse,
 	}
@@ -112,6 +337,23 @@ func appsViewers(vv MetaViewers) {
 	}
 }
 
+func autoscalingViewers(vv MetaViewers) {
+	for _, v := range []string{"v1", "v2", "v2beta2"} {
+		vv[client.NewGVR("autoscaling/"+v+"/horizontalpodautoscalers")] = MetaViewer{
+			viewerFn: NewHorizontalPodAutoscaler,
+		}
+	}
+}
+
+func snapshotViewers(vv MetaViewers) {
+	vv[client.NewGVR("snapshot.storage.k8s.io/v1/volumesnapshots")] = MetaViewer{
+		viewerFn: NewVolumeSnapshot,
+	}
+	vv[client.NewGVR("snapshot.storage.k8s.io/v1/volumesnapshotcontents")] = MetaViewer{
+		viewerFn: NewVolumeSnapshotContent,
+	}
+}
+
 func rbacViewers(vv MetaViewers) {
 	vv[client.NewGVR("rbac")] = MetaViewer{
 		enterFn: showRules,
@@ -0,0 +1,72 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Related represents resources related to a given object.
+type Related struct {
+	ResourceViewer
+}
+
+// NewRelated returns a new viewer.
+func NewRelated(gvr client.GVR) ResourceViewer {
+	r := Related{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	r.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	r.AddBindKeysFn(r.bindKeys)
+
+	return &r
+}
+
+// Init initializes the view.
+func (r *Related) Init(ctx context.Context) error {
+	if err := r.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	r.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (r *Related) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", r.gotoCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", r.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Relation", r.GetTable().SortColCmd("RELATION", true), false),
+	})
+}
+
+func (r *Related) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := r.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+
+	path := r.GetTable().GetSelectedItem()
+	gvr := ui.TrimCell(r.GetTable().SelectTable, row, 2)
+	r.App().gotoResource(gvr, path, false)
+
+	return nil
+}
+
+// showRelated lists the resources related to a given object.
+func showRelated(app *App, gvr, path string) {
+	v := NewRelated(client.NewGVR("related"))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyGVR, gvr)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -0,0 +1,202 @@
+package view
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	v1 "k8s.io/api/core/v1"
+)
+
+const resourcesKey = "resources"
+
+// resourceField represents a container request or limit form field.
+type resourceField struct {
+	label string
+	name  v1.ResourceName
+	limit bool
+}
+
+var resourceFields = []resourceField{
+	{label: "CPU Request:", name: v1.ResourceCPU},
+	{label: "CPU Limit:", name: v1.ResourceCPU, limit: true},
+	{label: "MEM Request:", name: v1.ResourceMemory},
+	{label: "MEM Limit:", name: v1.ResourceMemory, limit: true},
+}
+
+func (r resourceField) valueOf(co dao.ContainerResources) string {
+	rl := co.Requests
+	if r.limit {
+		rl = co.Limits
+	}
+	if q, ok := rl[r.name]; ok {
+		return q.String()
+	}
+
+	return ""
+}
+
+// ResourcesExtender provides for right-sizing workload containers requests and limits.
+type ResourcesExtender struct {
+	ResourceViewer
+}
+
+// NewResourcesExtender returns a new extender.
+func NewResourcesExtender(r ResourceViewer) ResourceViewer {
+	s := ResourcesExtender{ResourceViewer: r}
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+func (s *ResourcesExtender) bindKeys(aa ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftQ: ui.NewKeyAction("Resources", s.resourcesCmd, true),
+	})
+}
+
+func (s *ResourcesExtender) resourcesCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return nil
+	}
+
+	s.Stop()
+	defer s.Start()
+	if err := s.showResourcesDialog(path); err != nil {
+		s.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+func (s *ResourcesExtender) showResourcesDialog(path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	cc, err := dao.WorkloadResources(ctx, s.App().factory, s.GVR().String(), path)
+	if err != nil {
+		return err
+	}
+	if len(cc) == 0 {
+		return errors.New("no containers found")
+	}
+
+	confirm := tview.NewModalForm("<Resources>", s.makeResourcesForm(path, cc))
+	confirm.SetText(fmt.Sprintf("Resources %s %s\n%s", singularize(s.GVR().R()), path, usageSummary(cc)))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(resourcesKey, confirm, false, false)
+	s.App().Content.ShowPage(resourcesKey)
+
+	return nil
+}
+
+func (s *ResourcesExtender) makeResourcesForm(path string, cc []dao.ContainerResources) *tview.Form {
+	f := s.makeStyledForm()
+	names := make([]string, 0, len(cc))
+	for _, co := range cc {
+		names = append(names, co.Name)
+	}
+	sel := cc[0]
+	values := make([]string, len(resourceFields))
+	f.AddDropDown("Container:", names, 0, func(_ string, idx int) {
+		if idx < 0 || idx >= len(cc) {
+			return
+		}
+		sel = cc[idx]
+		for i, r := range resourceFields {
+			if field, ok := f.GetFormItemByLabel(r.label).(*tview.InputField); ok {
+				field.SetText(r.valueOf(sel))
+			}
+			values[i] = r.valueOf(sel)
+		}
+	})
+	for i, r := range resourceFields {
+		i := i
+		values[i] = r.valueOf(sel)
+		f.AddInputField(r.label, values[i], 10, nil, func(changed string) {
+			values[i] = strings.TrimSpace(changed)
+		})
+	}
+
+	f.AddButton("OK", func() {
+		rs := dao.ResourceSettings{
+			Requests: make(map[v1.ResourceName]string),
+			Limits:   make(map[v1.ResourceName]string),
+		}
+		var changed bool
+		for i, r := range resourceFields {
+			if values[i] == r.valueOf(sel) {
+				continue
+			}
+			changed = true
+			if r.limit {
+				rs.Limits[r.name] = values[i]
+			} else {
+				rs.Requests[r.name] = values[i]
+			}
+		}
+		if !changed {
+			s.dismissDialog()
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.SetContainerResources(ctx, s.App().factory, s.GVR().String(), path, sel, rs); err != nil {
+			log.Error().Err(err).Msgf("Resources update on %s failed", path)
+			s.App().Flash().Err(err)
+			return
+		}
+		s.dismissDialog()
+		s.App().Flash().Infof("Container %s resources updated on %s", sel.Name, path)
+		offerRolloutWatch(s.App(), s.GVR().String(), path)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	return f
+}
+
+func (s *ResourcesExtender) dismissDialog() {
+	s.App().Content.RemovePage(resourcesKey)
+}
+
+func (s *ResourcesExtender) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
+
+// usageSummary lists the containers peak usage across the workload pods.
+func usageSummary(cc []dao.ContainerResources) string {
+	ss := make([]string, 0, len(cc))
+	for _, co := range cc {
+		if co.Usage == nil {
+			continue
+		}
+		ss = append(ss, fmt.Sprintf("%s cpu:%dm mem:%dMi", co.Name, co.Usage.Cpu().MilliValue(), client.ToMB(co.Usage.Memory().Value())))
+	}
+	if len(ss) == 0 {
+		return "Peak usage: n/a"
+	}
+
+	return "Peak usage: " + strings.Join(ss, ", ")
+}
@@ -0,0 +1,42 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+func TestUsageSummary(t *testing.T) {
+	uu := map[string]struct {
+		cc []dao.ContainerResources
+		e  string
+	}{
+		"none": {
+			cc: []dao.ContainerResources{{Name: "app"}},
+			e:  "Peak usage: n/a",
+		},
+		"usage": {
+			cc: []dao.ContainerResources{
+				{
+					Name: "app",
+					Usage: v1.ResourceList{
+						v1.ResourceCPU:    resource.MustParse("120m"),
+						v1.ResourceMemory: resource.MustParse("230Mi"),
+					},
+				},
+				{Name: "sidecar"},
+			},
+			e: "Peak usage: app cpu:120m mem:230Mi",
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, usageSummary(u.cc))
+		})
+	}
+}
@@ -47,6 +47,7 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 	if len(paths) > 1 {
 		msg = fmt.Sprintf("Restart %d %s?", len(paths), r.GVR().R())
 	}
+	var restarted bool
 	dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm Restart", msg, func() {
 		ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
 		defer cancel()
@@ -55,9 +56,14 @@ func (r *RestartExtender) restartCmd(evt *tcell.EventKey) *tcell.EventKey {
 				r.App().Flash().Err(err)
 			} else {
 				r.App().Flash().Infof("Restart in progress for `%s...", path)
+				restarted = true
 			}
 		}
-	}, func() {})
+	}, func() {
+		if restarted && len(paths) == 1 {
+			offerRolloutWatch(r.App(), r.GVR().String(), paths[0])
+		}
+	})
 
 	return nil
 }
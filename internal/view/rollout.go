@@ -0,0 +1,84 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+)
+
+// Rollout represents an Argo Rollout viewer.
+type Rollout struct {
+	ResourceViewer
+}
+
+// NewRollout returns a new viewer.
+func NewRollout(gvr client.GVR) ResourceViewer {
+	r := Rollout{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	r.AddBindKeysFn(r.bindKeys)
+	r.GetTable().SetEnterFn(r.showPods)
+
+	return &r
+}
+
+func (r *Rollout) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", r.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftW: ui.NewKeyAction("Sort Weight", r.GetTable().SortColCmd("WEIGHT", false), false),
+	})
+	if r.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyP: ui.NewKeyAction("Promote", r.rolloutCmd("Promote", (*dao.Rollout).Promote), true),
+		ui.KeyA: ui.NewKeyAction("Abort", r.rolloutCmd("Abort", (*dao.Rollout).Abort), true),
+		ui.KeyT: ui.NewKeyAction("Retry", r.rolloutCmd("Retry", (*dao.Rollout).Retry), true),
+	})
+}
+
+func (r *Rollout) showPods(a *App, _ ui.Tabular, _, path string) {
+	var res dao.Rollout
+	res.Init(a.factory, r.GVR())
+
+	ro, err := res.GetInstance(path)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	showPodsFromSelector(a, path, ro.Spec.Selector)
+}
+
+type rolloutActionFn func(*dao.Rollout, context.Context, string) error
+
+func (r *Rollout) rolloutCmd(action string, f rolloutActionFn) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := r.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		r.Stop()
+		defer r.Start()
+		msg := fmt.Sprintf("%s rollout %s?", action, path)
+		dialog.ShowConfirm(r.App().Styles.Dialog(), r.App().Content.Pages, "Confirm "+action, msg, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), r.App().Conn().Config().CallTimeout())
+			defer cancel()
+			var res dao.Rollout
+			res.Init(r.App().factory, r.GVR())
+			if err := f(&res, ctx, path); err != nil {
+				r.App().Flash().Err(err)
+				return
+			}
+			r.App().Flash().Infof("%s requested for rollout %s", action, path)
+		}, func() {})
+
+		return nil
+	}
+}
@@ -0,0 +1,136 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	rolloutWatchRate    = 2 * time.Second
+	rolloutWatchTimeout = 10 * time.Minute
+)
+
+// RolloutWatch tracks a deployment rollout until it completes or times out.
+type RolloutWatch struct {
+	*Details
+
+	path    string
+	started time.Time
+	cancel  context.CancelFunc
+}
+
+// NewRolloutWatch returns a new rollout watcher.
+func NewRolloutWatch(app *App, path string) *RolloutWatch {
+	return &RolloutWatch{
+		Details: NewDetails(app, "Rollout", path, true),
+		path:    path,
+	}
+}
+
+// Start starts tracking the rollout.
+func (r *RolloutWatch) Start() {
+	if r.cancel != nil {
+		r.cancel()
+	}
+	if r.started.IsZero() {
+		r.started = time.Now()
+	}
+
+	var ctx context.Context
+	ctx, r.cancel = context.WithCancel(context.Background())
+	go r.watch(ctx)
+}
+
+// Stop terminates the rollout tracking.
+func (r *RolloutWatch) Stop() {
+	if r.cancel != nil {
+		r.cancel()
+		r.cancel = nil
+	}
+	r.Details.Stop()
+}
+
+func (r *RolloutWatch) watch(ctx context.Context) {
+	for {
+		p, err := dao.DeploymentRollout(r.app.factory, r.path)
+		elapsed := time.Since(r.started)
+		r.app.QueueUpdateDraw(func() {
+			if err != nil {
+				r.app.Flash().Err(err)
+				return
+			}
+			r.Update(rolloutReport(p, elapsed))
+		})
+		switch {
+		case err != nil, p.Done, p.Failed != "":
+			return
+		case elapsed > rolloutWatchTimeout:
+			log.Warn().Msgf("Rollout watch timed out for %s", r.path)
+			return
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(rolloutWatchRate):
+		}
+	}
+}
+
+// offerRolloutWatch proposes to track a deployment rollout once an action was issued.
+func offerRolloutWatch(app *App, gvr, path string) {
+	if gvr != "apps/v1/deployments" {
+		return
+	}
+
+	msg := fmt.Sprintf("Watch rollout for deployment %s?", path)
+	dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Watch Rollout", msg, func() {
+		if err := app.inject(NewRolloutWatch(app, path), false); err != nil {
+			app.Flash().Err(err)
+		}
+	}, func() {})
+}
+
+func rolloutReport(p dao.RolloutProgress, elapsed time.Duration) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "deployment: %s\n", p.Path)
+	fmt.Fprintf(&b, "revision: %s\n", p.Revision)
+	if p.NewRS != "" {
+		fmt.Fprintf(&b, "replicaset: %s\n", p.NewRS)
+	}
+	status := "progressing"
+	switch {
+	case p.Done:
+		status = "complete"
+	case p.Failed != "":
+		status = "failed"
+	case elapsed > rolloutWatchTimeout:
+		status = "timed out"
+	}
+	fmt.Fprintf(&b, "status: %s\n", status)
+	if p.Failed != "" {
+		fmt.Fprintf(&b, "reason: %s\n", p.Failed)
+	}
+	fmt.Fprintf(&b, "elapsed: %s\n", elapsed.Truncate(time.Second))
+	fmt.Fprintf(&b, "replicas:\n  desired: %d\n  updated: %d\n  ready: %d\n  available: %d\n  total: %d\n",
+		p.Desired, p.Updated, p.Ready, p.Available, p.Total)
+	if len(p.Failing) == 0 {
+		return tview.Escape(b.String())
+	}
+	b.WriteString("failing:\n")
+	for _, f := range p.Failing {
+		fmt.Fprintf(&b, "  - pod: %s\n    reason: %s\n", f.FQN, f.Reason)
+		if f.Event != "" {
+			fmt.Fprintf(&b, "    event: %s\n", f.Event)
+		}
+	}
+
+	return tview.Escape(b.String())
+}
@@ -65,6 +65,9 @@ func (r *ReplicaSet) rollbackCmd(evt *tcell.EventKey) *tcell.EventKey {
 			r.App().Flash().Err(err)
 		} else {
 			r.App().Flash().Infof("%s successfully rolled back", path)
+			if gvr, fqn, err := dao.OwnerFor(r.App().factory, r.GVR().String(), path); err == nil {
+				offerRolloutWatch(r.App(), gvr.String(), fqn)
+			}
 		}
 		r.Refresh()
 	})
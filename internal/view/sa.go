@@ -2,12 +2,24 @@ package view
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const (
+	saKubeconfigKey  = "saKubeconfig"
+	saTokenExpiry    = 24 * time.Hour
+	saTokenMinExpiry = 10 * time.Minute
 )
 
 // ServiceAccount represents a serviceaccount viewer.
@@ -31,6 +43,89 @@ func (s *ServiceAccount) bindKeys(aa ui.KeyActions) {
 		ui.KeyU:        ui.NewKeyAction("UsedBy", s.refCmd, true),
 		tcell.KeyEnter: ui.NewKeyAction("Rules", s.policyCmd, true),
 	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyG: ui.NewKeyAction("Gen Kubeconfig", s.kubeconfigCmd, true),
+	})
+}
+
+func (s *ServiceAccount) kubeconfigCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	s.Stop()
+	defer s.Start()
+	ns, n := client.Namespaced(path)
+	file := filepath.Join(s.App().Config.K9s.GetScreenDumpDir(), s.App().Config.K9s.CurrentContextDir(), fmt.Sprintf("%s-%s-kubeconfig.yaml", ns, n))
+	expiry := saTokenExpiry.String()
+
+	f := s.makeStyledForm()
+	f.AddInputField("Path:", file, 0, nil, func(changed string) {
+		file = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Expiry:", expiry, 10, nil, func(changed string) {
+		expiry = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		d, err := time.ParseDuration(expiry)
+		if err != nil || d < saTokenMinExpiry {
+			s.App().Flash().Errf("Invalid expiry %q. Must be at least %s", expiry, saTokenMinExpiry)
+			return
+		}
+		if err := s.writeKubeconfig(path, file, d); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.dismissDialog()
+		s.App().Flash().Infof("Kubeconfig for %s valid for %s saved to %s", path, d, file)
+	})
+	f.AddButton("Cancel", func() {
+		s.dismissDialog()
+	})
+
+	confirm := tview.NewModalForm("<Kubeconfig>", f)
+	confirm.SetText(fmt.Sprintf("Generate a kubeconfig for service account %s", path))
+	confirm.SetDoneFunc(func(int, string) {
+		s.dismissDialog()
+	})
+	s.App().Content.AddPage(saKubeconfigKey, confirm, false, false)
+	s.App().Content.ShowPage(saKubeconfigKey)
+
+	return nil
+}
+
+func (s *ServiceAccount) writeKubeconfig(path, file string, expiry time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+	defer cancel()
+	raw, err := dao.ServiceAccountKubeconfig(ctx, s.App().Conn(), path, expiry)
+	if err != nil {
+		return err
+	}
+	if err := ensureDir(filepath.Dir(file)); err != nil {
+		return err
+	}
+
+	return os.WriteFile(file, raw, 0600)
+}
+
+func (s *ServiceAccount) dismissDialog() {
+	s.App().Content.RemovePage(saKubeconfigKey)
+}
+
+func (s *ServiceAccount) makeStyledForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
 }
 
 func (s *ServiceAccount) subjectCtx(ctx context.Context) context.Context {
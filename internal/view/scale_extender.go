@@ -118,6 +118,9 @@ func (s *ScaleExtender) makeScaleForm(sels []string) (*tview.Form, error) {
 		} else {
 			s.App().Flash().Infof("%s %s scaled successfully", s.GVR().R(), sels[0])
 		}
+		if len(sels) == 1 {
+			offerRolloutWatch(s.App(), s.GVR().String(), sels[0])
+		}
 	})
 
 	f.AddButton("Cancel", func() {
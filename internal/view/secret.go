@@ -19,7 +19,7 @@ type Secret struct {
 // NewSecret returns a new viewer.
 func NewSecret(gvr client.GVR) ResourceViewer {
 	s := Secret{
-		ResourceViewer: NewBrowser(gvr),
+		ResourceViewer: NewFormEditExtender(NewBrowser(gvr)),
 	}
 	s.AddBindKeysFn(s.bindKeys)
 
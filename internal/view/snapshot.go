@@ -0,0 +1,95 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/rs/zerolog/log"
+)
+
+const snapshotTimeout = 5 * time.Minute
+
+// parseSnapshotCmd parses a `snapshot [namespace|all] [-e] [-m]` command.
+func parseSnapshotCmd(cmd, ns string) (dao.SnapshotOpts, error) {
+	opts := dao.SnapshotOpts{Namespace: ns}
+	var nsSet bool
+	for _, t := range strings.Fields(cmd)[1:] {
+		switch t {
+		case "-e", "--events":
+			opts.Events = true
+		case "-m", "--metrics":
+			opts.Metrics = true
+		default:
+			if strings.HasPrefix(t, "-") || nsSet {
+				return opts, fmt.Errorf("invalid snapshot option %q", t)
+			}
+			opts.Namespace, nsSet = t, true
+		}
+	}
+	if client.IsClusterWide(opts.Namespace) {
+		opts.Namespace = client.AllNamespaces
+	}
+
+	return opts, nil
+}
+
+// snapshotExport exports the cluster resources into an archive browsable via k9s --snapshot.
+func snapshotExport(app *App, opts dao.SnapshotOpts) {
+	scope := "all namespaces"
+	if client.IsNamespaced(opts.Namespace) {
+		scope = "namespace " + opts.Namespace
+	}
+	app.Flash().Infof("Exporting snapshot for %s...", scope)
+	go func() {
+		path, stats, err := saveSnapshot(app, opts)
+		app.QueueUpdateDraw(func() {
+			if err != nil {
+				app.Flash().Errf("Snapshot export failed: %s", err)
+				return
+			}
+			msg := fmt.Sprintf("Snapshot saved %d objects from %d resources to %s", stats.Objects, stats.Resources, path)
+			if len(stats.Skipped) > 0 {
+				msg += fmt.Sprintf(" (%d resources skipped)", len(stats.Skipped))
+			}
+			app.Flash().Info(msg)
+		})
+	}()
+}
+
+func saveSnapshot(app *App, opts dao.SnapshotOpts) (string, dao.SnapshotStats, error) {
+	var stats dao.SnapshotStats
+	dir := filepath.Join(app.Config.K9s.GetScreenDumpDir(), app.Config.K9s.CurrentContextDir())
+	if err := ensureDir(dir); err != nil {
+		return "", stats, err
+	}
+	scope := opts.Namespace
+	if client.IsAllNamespaces(scope) {
+		scope = client.NamespaceAll
+	}
+	path := filepath.Join(dir, fmt.Sprintf("snapshot-%s-%d.tar.gz", config.SanitizeFilename(scope), time.Now().Unix()))
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return "", stats, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), snapshotTimeout)
+	defer cancel()
+	stats, err = dao.ExportSnapshot(ctx, app.factory, file, opts)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		if rerr := os.Remove(path); rerr != nil {
+			log.Warn().Err(rerr).Msgf("Removing snapshot %s", path)
+		}
+		return "", stats, err
+	}
+
+	return path, stats, nil
+}
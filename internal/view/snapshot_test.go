@@ -0,0 +1,50 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSnapshotCmd(t *testing.T) {
+	uu := map[string]struct {
+		cmd, ns string
+		e       dao.SnapshotOpts
+		err     bool
+	}{
+		"active": {
+			cmd: "snapshot", ns: "prod",
+			e: dao.SnapshotOpts{Namespace: "prod"},
+		},
+		"all": {
+			cmd: "snapshot all -e", ns: "prod",
+			e: dao.SnapshotOpts{Events: true},
+		},
+		"cluster-wide": {
+			cmd: "snapshot -m", ns: "",
+			e: dao.SnapshotOpts{Metrics: true},
+		},
+		"ns": {
+			cmd: "snapshot fred --events --metrics", ns: "prod",
+			e: dao.SnapshotOpts{Namespace: "fred", Events: true, Metrics: true},
+		},
+		"bad-flag": {
+			cmd: "snapshot -x", err: true,
+		},
+		"two-ns": {
+			cmd: "snapshot fred blee", err: true,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			opts, err := parseSnapshotCmd(u.cmd, u.ns)
+			assert.Equal(t, u.err, err != nil)
+			if !u.err {
+				assert.Equal(t, u.e, opts)
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package view
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// minStaleAge tracks the minimum age for a view data to be flagged as outdated.
+	minStaleAge = 15 * time.Second
+
+	staleCheckRate = time.Second
+)
+
+// staleUpdater periodically flags the current view when its data is outdated.
+func (a *App) staleUpdater(ctx context.Context) {
+	ticker := time.NewTicker(staleCheckRate)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			v, ok := a.Content.Top().(ResourceViewer)
+			if !ok {
+				continue
+			}
+			if t := v.GetTable(); t != nil {
+				t.checkStale(now)
+			}
+		}
+	}
+}
+
+// markRefreshed records the table data got refreshed.
+func (t *Table) markRefreshed() {
+	atomic.StoreInt64(&t.refreshed, time.Now().UnixNano())
+}
+
+// checkStale updates the table stale indicator.
+func (t *Table) checkStale(now time.Time) {
+	last := atomic.LoadInt64(&t.refreshed)
+	if last == 0 || t.app == nil {
+		return
+	}
+	var lost time.Time
+	if t.app.factory != nil {
+		lost, _ = t.app.factory.WatchFailure(t.GetModel().GetNamespace(), t.GVR().String())
+	}
+	rate := time.Duration(t.app.Config.K9s.GetRefreshRate()) * time.Second
+	age := staleAge(now, time.Unix(0, last), lost, rate).Truncate(time.Second)
+	if age == t.stale {
+		return
+	}
+	t.stale = age
+	t.app.QueueUpdateDraw(func() {
+		t.SetStale(age)
+	})
+}
+
+// staleAge returns how long a view data has been outdated or zero if the data is current.
+// Data is outdated once its watch got lost or it missed several refreshes.
+func staleAge(now, refreshed, lost time.Time, rate time.Duration) time.Duration {
+	if !lost.IsZero() {
+		if age := now.Sub(lost); age > time.Second {
+			return age
+		}
+		return time.Second
+	}
+
+	age, max := now.Sub(refreshed), 3*rate
+	if max < minStaleAge {
+		max = minStaleAge
+	}
+	if age < max {
+		return 0
+	}
+
+	return age
+}
@@ -0,0 +1,56 @@
+package view
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStaleAge(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	uu := map[string]struct {
+		refreshed, lost time.Time
+		rate            time.Duration
+		e               time.Duration
+	}{
+		"fresh": {
+			refreshed: now.Add(-5 * time.Second),
+			rate:      2 * time.Second,
+		},
+		"min": {
+			refreshed: now.Add(-20 * time.Second),
+			rate:      2 * time.Second,
+			e:         20 * time.Second,
+		},
+		"slowRate": {
+			refreshed: now.Add(-20 * time.Second),
+			rate:      10 * time.Second,
+		},
+		"missed": {
+			refreshed: now.Add(-40 * time.Second),
+			rate:      10 * time.Second,
+			e:         40 * time.Second,
+		},
+		"lost": {
+			refreshed: now,
+			lost:      now.Add(-12 * time.Second),
+			rate:      2 * time.Second,
+			e:         12 * time.Second,
+		},
+		"justLost": {
+			refreshed: now,
+			lost:      now,
+			rate:      2 * time.Second,
+			e:         time.Second,
+		},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, staleAge(now, u.refreshed, u.lost, u.rate))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// Kafka represents a Strimzi Kafka cluster viewer.
+type Kafka struct {
+	ResourceViewer
+}
+
+// NewKafka returns a new viewer.
+func NewKafka(gvr client.GVR) ResourceViewer {
+	k := Kafka{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	k.GetTable().SetEnterFn(k.showBrokers)
+
+	return &k
+}
+
+func (k *Kafka) showBrokers(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showPodsWithLabels(app, path, map[string]string{
+		render.StrimziClusterLabel:   n,
+		render.StrimziKindLabel:      "Kafka",
+		render.StrimziComponentLabel: "kafka",
+	})
+}
+
+// KafkaTopic represents a Strimzi KafkaTopic viewer.
+type KafkaTopic struct {
+	ResourceViewer
+}
+
+// NewKafkaTopic returns a new viewer.
+func NewKafkaTopic(gvr client.GVR) ResourceViewer {
+	k := KafkaTopic{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	k.AddBindKeysFn(k.bindKeys)
+
+	return &k
+}
+
+func (k *KafkaTopic) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyShiftL: ui.NewKeyAction("Sort Lag", k.GetTable().SortColCmd("LAG", false), false),
+		ui.KeyShiftP: ui.NewKeyAction("Sort Partitions", k.GetTable().SortColCmd("PARTITIONS", false), false),
+	})
+}
@@ -20,8 +20,12 @@ func NewStatefulSet(gvr client.GVR) ResourceViewer {
 	s.ResourceViewer = NewPortForwardExtender(
 		NewRestartExtender(
 			NewScaleExtender(
-				NewImageExtender(
-					NewLogsExtender(NewBrowser(gvr), s.logOptions),
+				NewResourcesExtender(
+					NewQuickEditExtender(
+						NewImageExtender(
+							NewLogsExtender(NewBrowser(gvr), s.logOptions),
+						),
+					),
 				),
 			),
 		),
@@ -79,6 +83,12 @@ func (s *StatefulSet) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		ui.KeyShiftR: ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd(readyCol, true), false),
 	})
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyT: ui.NewKeyAction("Partition", s.partitionCmd, true),
+	})
 }
 
 func (s *StatefulSet) showPods(app *App, _ ui.Tabular, _, path string) {
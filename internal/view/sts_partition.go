@@ -0,0 +1,88 @@
+package view
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const partitionKey = "partition"
+
+func (s *StatefulSet) partitionCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	partition := "0"
+	if row, _ := s.GetTable().GetSelection(); row > 0 {
+		if col := s.GetTable().GetModel().Peek().Header.IndexOf("PARTITION", true); col >= 0 {
+			if p := ui.TrimCell(s.GetTable().SelectTable, row, col); p != "" && p != "n/a" {
+				partition = p
+			}
+		}
+	}
+	s.showPartition(path, partition)
+
+	return nil
+}
+
+func (s *StatefulSet) showPartition(path, partition string) {
+	styles := s.App().Styles
+
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(styles.BgColor()).
+		SetButtonTextColor(styles.FgColor()).
+		SetLabelColor(styles.K9s.Info.FgColor.Color()).
+		SetFieldTextColor(styles.K9s.Info.SectionColor.Color())
+
+	f.AddInputField("Partition:", partition, 4, func(textToCheck string, _ rune) bool {
+		_, err := strconv.Atoi(textToCheck)
+		return err == nil
+	}, func(v string) {
+		partition = v
+	})
+
+	pages := s.App().Content.Pages
+	f.AddButton("Cancel", func() {
+		s.dismissPartition(pages)
+	})
+	f.AddButton("OK", func() {
+		s.dismissPartition(pages)
+		p, err := strconv.Atoi(partition)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), s.App().Conn().Config().CallTimeout())
+		defer cancel()
+		var sts dao.StatefulSet
+		sts.Init(s.App().factory, s.GVR())
+		if err := sts.SetPartition(ctx, path, int32(p)); err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("StatefulSet %s partition set to %d", path, p)
+	})
+
+	modal := tview.NewModalForm("<Partition>", f)
+	modal.SetText("Set rolling update partition for " + path + "?")
+	modal.SetDoneFunc(func(int, string) {
+		s.dismissPartition(pages)
+	})
+
+	pages.AddPage(partitionKey, modal, false, true)
+	pages.ShowPage(partitionKey)
+	s.App().SetFocus(pages.GetPrimitive(partitionKey))
+}
+
+func (s *StatefulSet) dismissPartition(p *ui.Pages) {
+	p.RemovePage(partitionKey)
+	s.App().SetFocus(p.CurrentPage().Item)
+}
@@ -46,7 +46,10 @@ func NewService(gvr client.GVR) ResourceViewer {
 func (s *Service) bindKeys(aa ui.KeyActions) {
 	aa.Add(ui.KeyActions{
 		tcell.KeyCtrlL: ui.NewKeyAction("Bench Run/Stop", s.toggleBenchCmd, true),
+		ui.KeyT:        ui.NewKeyAction("HTTP Probe", s.probeCmd, true),
 		ui.KeyShiftT:   ui.NewKeyAction("Sort Type", s.GetTable().SortColCmd("TYPE", true), false),
+		ui.KeyShiftV:   ui.NewKeyAction("IP Family", s.GetTable().ipFamilyCmd, false),
+		ui.KeyShiftS:   ui.NewKeyAction("Traffic Split", s.splitCmd, true),
 	})
 }
 
@@ -67,6 +70,36 @@ func (s *Service) showPods(a *App, _ ui.Tabular, gvr, path string) {
 	showPodsWithLabels(a, path, svc.Spec.Selector)
 }
 
+func (s *Service) splitCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	showServiceSplits(s.App(), path)
+
+	return nil
+}
+
+func (s *Service) probeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+	svc, err := fetchService(s.App().factory, path)
+	if err != nil {
+		s.App().Flash().Err(err)
+		return nil
+	}
+	if svc.Spec.Type == v1.ServiceTypeExternalName {
+		s.App().Flash().Warnf("Service %s is an external service", path)
+		return nil
+	}
+	u, direct := dao.ServiceProbeURL(svc)
+	ShowHTTPProbe(s, path, HTTPProbeOpts{URL: u, PortForward: !direct}, true, httpProbe)
+
+	return nil
+}
+
 func (s *Service) checkSvc(svc *v1.Service) error {
 	if svc.Spec.Type != "NodePort" && svc.Spec.Type != "LoadBalancer" {
 		return errors.New("You must select a reachable service")
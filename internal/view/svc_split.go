@@ -0,0 +1,74 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// ServiceSplits represents a service traffic split viewer.
+type ServiceSplits struct {
+	ResourceViewer
+
+	svc string
+}
+
+// NewServiceSplits returns a new viewer.
+func NewServiceSplits(gvr client.GVR) ResourceViewer {
+	s := ServiceSplits{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	s.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	s.AddBindKeysFn(s.bindKeys)
+
+	return &s
+}
+
+// Init initializes the view.
+func (s *ServiceSplits) Init(ctx context.Context) error {
+	if err := s.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	s.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (s *ServiceSplits) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", s.gotoCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", s.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftR:   ui.NewKeyAction("Sort Ready", s.GetTable().SortColCmd("READY", false), false),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Share", s.GetTable().SortColCmd("SHARE", false), false),
+	})
+}
+
+func (s *ServiceSplits) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := s.GetTable().GetSelection()
+	if row == 0 {
+		return nil
+	}
+	gvr, name := ui.TrimCell(s.GetTable().SelectTable, row, 0), ui.TrimCell(s.GetTable().SelectTable, row, 1)
+	ns, _ := client.Namespaced(s.svc)
+	s.App().gotoResource(gvr, client.FQN(ns, name), false)
+
+	return nil
+}
+
+// showServiceSplits lists a service backing workloads along with their traffic share.
+func showServiceSplits(app *App, path string) {
+	v := NewServiceSplits(client.NewGVR("svcsplits"))
+	v.(*ServiceSplits).svc = path
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, path)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
@@ -21,6 +21,10 @@ type Table struct {
 	enterFn    EnterFunc
 	envFn      EnvFunc
 	bindKeysFn []BindKeysFunc
+	// refreshed tracks the last data refresh in unix nanos.
+	refreshed int64
+	// stale tracks the displayed outdated data age.
+	stale time.Duration
 }
 
 // NewTable returns a new viewer.
@@ -196,6 +200,16 @@ func (t *Table) toggleFaultCmd(evt *tcell.EventKey) *tcell.EventKey {
 	return nil
 }
 
+func (t *Table) ipFamilyCmd(evt *tcell.EventKey) *tcell.EventKey {
+	if f := t.CycleIPFamily(); f != "" {
+		t.app.Flash().Infof("Showing %s addresses only", f)
+	} else {
+		t.app.Flash().Info("Showing all addresses")
+	}
+
+	return nil
+}
+
 func (t *Table) toggleWideCmd(evt *tcell.EventKey) *tcell.EventKey {
 	t.ToggleWide()
 	return nil
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// PipelineRun represents a Tekton PipelineRun viewer.
+type PipelineRun struct {
+	ResourceViewer
+}
+
+// NewPipelineRun returns a new viewer.
+func NewPipelineRun(gvr client.GVR) ResourceViewer {
+	p := PipelineRun{
+		ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil),
+	}
+	p.GetTable().SetEnterFn(p.showTaskRuns)
+	p.GetTable().SetSortCol("AGE", true)
+
+	return &p
+}
+
+func (p *PipelineRun) showTaskRuns(app *App, _ ui.Tabular, _, path string) {
+	var res dao.PipelineRun
+	res.Init(app.factory, p.GVR())
+
+	_, n := client.Namespaced(path)
+	v := NewTaskRun(res.TaskRunsGVR())
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, render.TektonPipelineRunLabel+"="+n)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+// TaskRun represents a Tekton TaskRun viewer.
+type TaskRun struct {
+	ResourceViewer
+}
+
+// NewTaskRun returns a new viewer.
+func NewTaskRun(gvr client.GVR) ResourceViewer {
+	t := TaskRun{
+		ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil),
+	}
+	t.GetTable().SetEnterFn(t.showSteps)
+	t.GetTable().SetSortCol("AGE", true)
+
+	return &t
+}
+
+func (t *TaskRun) showSteps(app *App, _ ui.Tabular, gvr, path string) {
+	o, err := app.factory.Get(gvr, path, true, labels.Everything())
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	var tr render.TektonRun
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(o.(*unstructured.Unstructured).Object, &tr)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if tr.Status.PodName == "" {
+		app.Flash().Warnf("No pod scheduled yet for TaskRun %s", path)
+		return
+	}
+
+	co := NewContainer(client.NewGVR("containers"))
+	co.SetContextFn(func(ctx context.Context) context.Context {
+		return context.WithValue(ctx, internal.KeyPath, client.FQN(tr.Namespace, tr.Status.PodName))
+	})
+	if err := app.inject(co, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
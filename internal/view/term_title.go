@@ -0,0 +1,135 @@
+package view
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/config"
+	"github.com/derailed/k9s/internal/model"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	termTitleFmt = "k9s %s"
+
+	// xterm title sequences. The title stack restores the previous title on exit.
+	termTitleSeq  = "\033]2;%s\007"
+	termTitlePush = "\033[22;0t"
+	termTitlePop  = "\033[23;0t"
+
+	// tmuxWindowSeq names the tmux window.
+	tmuxWindowSeq = "\033k%s\033\\"
+)
+
+var _ model.StackListener = (*termTitle)(nil)
+
+// termTitle reflects the current session location in the terminal title and
+// records it for terminal status bars.
+type termTitle struct {
+	app   *App
+	out   io.Writer
+	pane  string
+	dir   string
+	title string
+}
+
+func newTermTitle(app *App) *termTitle {
+	t := termTitle{
+		app:  app,
+		out:  os.Stdout,
+		pane: os.Getenv("TMUX_PANE"),
+		dir:  config.SessionsDir(),
+	}
+	if t.enabled() {
+		fmt.Fprint(t.out, termTitlePush)
+	}
+
+	return &t
+}
+
+// StackPushed notifies a new component was pushed.
+func (t *termTitle) StackPushed(c model.Component) {
+	t.update(c)
+}
+
+// StackPopped notifies a component was popped.
+func (t *termTitle) StackPopped(_, top model.Component) {
+	t.update(top)
+}
+
+// StackTop notifies the top component.
+func (t *termTitle) StackTop(c model.Component) {
+	t.update(c)
+}
+
+func (t *termTitle) refresh() {
+	t.update(t.app.Content.Top())
+}
+
+func (t *termTitle) update(c model.Component) {
+	if c == nil {
+		return
+	}
+	s := t.status(c.Name())
+	if err := config.SaveSessionStatus(t.dir, s); err != nil {
+		log.Warn().Err(err).Msgf("Saving session status")
+	}
+	if !t.enabled() {
+		return
+	}
+	title := termTitleSafe(fmt.Sprintf(termTitleFmt, s))
+	if title == t.title {
+		return
+	}
+	t.title = title
+	fmt.Fprintf(t.out, termTitleSeq, title)
+	if t.pane != "" {
+		fmt.Fprintf(t.out, tmuxWindowSeq, title)
+	}
+}
+
+func (t *termTitle) status(view string) config.SessionStatus {
+	ns := t.app.Config.ActiveNamespace()
+	if client.IsAllNamespaces(ns) {
+		ns = client.NamespaceAll
+	}
+
+	return config.SessionStatus{
+		PID:       os.Getpid(),
+		Pane:      t.pane,
+		Context:   t.app.Config.K9s.CurrentContext,
+		Cluster:   t.app.Config.K9s.CurrentCluster,
+		Namespace: ns,
+		View:      strings.ToLower(view),
+		UpdatedAt: time.Now(),
+	}
+}
+
+// close restores the terminal title and clears the session status.
+func (t *termTitle) close() {
+	if err := config.RemoveSessionStatus(t.dir, config.SessionID(t.pane, os.Getpid())); err != nil {
+		log.Warn().Err(err).Msgf("Removing session status")
+	}
+	if t.enabled() {
+		fmt.Fprint(t.out, termTitlePop)
+	}
+}
+
+func (t *termTitle) enabled() bool {
+	return !t.app.Config.K9s.NoTerminalTitle
+}
+
+// termTitleSafe strips control chars so a title cannot break out of its sequence.
+func termTitleSafe(s string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, s)
+}
@@ -0,0 +1,24 @@
+package view
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTermTitleSafe(t *testing.T) {
+	uu := map[string]struct {
+		s, e string
+	}{
+		"plain":   {s: "k9s ctx/ns:pods", e: "k9s ctx/ns:pods"},
+		"escape":  {s: "k9s \033]2;boom\007ctx", e: "k9s ]2;boomctx"},
+		"newline": {s: "k9s\nctx", e: "k9sctx"},
+	}
+
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			assert.Equal(t, u.e, termTitleSafe(u.s))
+		})
+	}
+}
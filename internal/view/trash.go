@@ -0,0 +1,131 @@
+package view
+
+import (
+	"context"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+	"github.com/rs/zerolog/log"
+	"sigs.k8s.io/yaml"
+)
+
+// Trash represents the deleted objects that can still be restored.
+type Trash struct {
+	ResourceViewer
+}
+
+// NewTrash returns a new viewer.
+func NewTrash(gvr client.GVR) ResourceViewer {
+	t := Trash{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	t.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	t.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	t.GetTable().SetSortCol("DELETED", true)
+	t.AddBindKeysFn(t.bindKeys)
+
+	return &t
+}
+
+// Init initializes the view.
+func (t *Trash) Init(ctx context.Context) error {
+	if err := t.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	t.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (t *Trash) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("View", t.viewCmd, true),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", t.GetTable().SortColCmd("GVR", true), false),
+		ui.KeyShiftN:   ui.NewKeyAction("Sort Name", t.GetTable().SortColCmd("NAME", true), false),
+		ui.KeyShiftD:   ui.NewKeyAction("Sort Deleted", t.GetTable().SortColCmd("DELETED", true), false),
+	})
+	if !t.App().Config.K9s.IsReadOnly() {
+		aa.Add(ui.KeyActions{
+			ui.KeyU: ui.NewKeyAction("Undo", t.undoCmd, true),
+		})
+	}
+}
+
+func (t *Trash) viewCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := t.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	ee, err := dao.TrashEntries(dao.TrashDir(t.App().factory), time.Now())
+	if err != nil {
+		t.App().Flash().Err(err)
+		return nil
+	}
+	for _, e := range ee {
+		if e.ID != id {
+			continue
+		}
+		raw, err := yaml.Marshal(e.Object)
+		if err != nil {
+			t.App().Flash().Err(err)
+			return nil
+		}
+		details := NewDetails(t.App(), "Deleted", e.GVR+" "+e.Path(), true).Update(tview.Escape(string(raw)))
+		if err := t.App().inject(details, false); err != nil {
+			t.App().Flash().Err(err)
+		}
+		return nil
+	}
+	t.App().Flash().Errf("Deleted object %s has expired", id)
+
+	return nil
+}
+
+func (t *Trash) undoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	id := t.GetTable().GetSelectedItem()
+	if id == "" {
+		return evt
+	}
+	undoDelete(t.App(), id)
+	t.Refresh()
+
+	return nil
+}
+
+// deleteStashed deletes a resource and stashes its manifest once deleted so it can be undone.
+func deleteStashed(app *App, gvr, path string, del func() error) error {
+	tr := app.Config.K9s.ActiveTrash()
+	var s *dao.Stash
+	if !tr.Disable {
+		var err error
+		if s, err = dao.NewStash(app.factory, gvr, path); err != nil {
+			log.Warn().Err(err).Msgf("Unable to stash %s %s prior to deletion", gvr, path)
+		}
+	}
+	if err := del(); err != nil {
+		return err
+	}
+	if s != nil {
+		if err := s.Save(app.factory, tr.Retention()); err != nil {
+			log.Warn().Err(err).Msgf("Unable to stash deleted %s %s", gvr, path)
+		}
+	}
+
+	return nil
+}
+
+// undoDelete restores a deleted object. An empty id restores the most recently deleted object.
+func undoDelete(app *App, id string) {
+	e, err := dao.RestoreDeleted(context.Background(), app.factory, id)
+	if err != nil {
+		app.Flash().Errf("Undo failed: %s", err)
+		return
+	}
+	app.Flash().Infof("Restored %s %s", client.NewGVR(e.GVR).R(), e.Path())
+}
@@ -119,6 +119,15 @@ type SubjectViewer interface {
 	SetSubject(s string)
 }
 
+// rewinder represents a model that can replay its recent states.
+type rewinder interface {
+	// Rewind steps through the model history.
+	Rewind(steps int) (model.TableSnapshot, bool)
+
+	// IsRewound checks if the model displays a past state.
+	IsRewound() bool
+}
+
 // ViewerFunc returns a viewer matching a given gvr.
 type ViewerFunc func(client.GVR) ResourceViewer
 
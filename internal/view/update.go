@@ -0,0 +1,79 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/derailed/k9s/internal/model"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/rs/zerolog/log"
+)
+
+const updateTimeout = 2 * time.Minute
+
+// selfUpdate upgrades the k9s binary to the latest release upon confirmation.
+func selfUpdate(app *App) {
+	curr := model.NewSemVer(app.version)
+	if curr.String() == model.NewSemVer("").String() {
+		app.Flash().Warn("Self update is not available for development builds")
+		return
+	}
+	exe, err := k9sExecutable()
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	if pm, ok := model.ManagedInstall(exe); ok {
+		app.Flash().Warnf("K9s was installed via %s. Please upgrade using it", pm)
+		return
+	}
+
+	app.Flash().Info("Checking for k9s updates...")
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), updateTimeout)
+		defer cancel()
+		rel, err := model.FetchLatestRelease(ctx)
+		if err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		latest := model.NewSemVer(rel.Version)
+		if curr.IsCurrent(latest) {
+			app.Flash().Infof("K9s %s is up to date", curr)
+			return
+		}
+		app.QueueUpdateDraw(func() {
+			dialog.ShowConfirm(app.Styles.Dialog(), app.Content.Pages, "Update",
+				fmt.Sprintf("Update k9s %s to %s?\n%s will be replaced", curr, latest, exe),
+				func() {
+					go installRelease(app, rel, exe)
+				},
+				func() {},
+			)
+		})
+	}()
+}
+
+func installRelease(app *App, rel *model.Release, exe string) {
+	app.Flash().Infof("Downloading k9s %s...", rel.Version)
+	ctx, cancel := context.WithTimeout(context.Background(), updateTimeout)
+	defer cancel()
+	if err := model.SelfUpdate(ctx, rel, exe); err != nil {
+		log.Error().Err(err).Msgf("Self update to %s failed", rel.Version)
+		app.Flash().Err(err)
+		return
+	}
+	app.Flash().Infof("K9s updated to %s. Restart k9s to use it", rel.Version)
+}
+
+func k9sExecutable() (string, error) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.EvalSymlinks(exe)
+}
@@ -0,0 +1,87 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Upgrade represents a cluster pre-upgrade readiness report.
+type Upgrade struct {
+	ResourceViewer
+}
+
+// NewUpgrade returns a new viewer.
+func NewUpgrade(gvr client.GVR) ResourceViewer {
+	u := Upgrade{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	u.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	u.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	u.GetTable().SetSortCol("SEVERITY", true)
+	u.GetTable().SetDecorateFn(u.decorateRows)
+	u.AddBindKeysFn(u.bindKeys)
+
+	return &u
+}
+
+// Init initializes the view.
+func (u *Upgrade) Init(ctx context.Context) error {
+	if err := u.ResourceViewer.Init(ctx); err != nil {
+		return err
+	}
+	u.GetTable().GetModel().SetNamespace(client.AllNamespaces)
+
+	return nil
+}
+
+func (u *Upgrade) decorateRows(data *render.TableData) {
+	col := data.Header.IndexOf("SEVERITY", true)
+	if col < 0 {
+		return
+	}
+	var critical, warning int
+	for _, re := range data.RowEvents {
+		switch strings.TrimSpace(re.Row.Fields[col]) {
+		case dao.SeverityCritical:
+			critical++
+		case dao.SeverityWarning:
+			warning++
+		}
+	}
+	if critical+warning == 0 {
+		u.GetTable().Extras = "Ready"
+		return
+	}
+	u.GetTable().Extras = fmt.Sprintf("Critical %d, Warning %d", critical, warning)
+}
+
+func (u *Upgrade) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", u.gotoCmd, true),
+		ui.KeyShiftS:   ui.NewKeyAction("Sort Severity", u.GetTable().SortColCmd("SEVERITY", true), false),
+		ui.KeyShiftC:   ui.NewKeyAction("Sort Check", u.GetTable().SortColCmd("CHECK", true), false),
+		ui.KeyShiftV:   ui.NewKeyAction("Sort GVR", u.GetTable().SortColCmd("GVR", true), false),
+	})
+}
+
+func (u *Upgrade) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	row, _ := u.GetTable().GetSelection()
+	if row == 0 {
+		return evt
+	}
+
+	t := u.GetTable().SelectTable
+	path := client.FQN(ui.TrimCell(t, row, 2), ui.TrimCell(t, row, 3))
+	u.App().gotoResource(ui.TrimCell(t, row, 4), path, false)
+
+	return nil
+}
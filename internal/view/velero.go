@@ -0,0 +1,156 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const veleroBackupGVR = "velero.io/v1/backups"
+
+// Backup represents a Velero Backup viewer.
+type Backup struct {
+	ResourceViewer
+}
+
+// NewBackup returns a new viewer.
+func NewBackup(gvr client.GVR) ResourceViewer {
+	b := Backup{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	b.AddBindKeysFn(b.bindKeys)
+	b.GetTable().SetSortCol("AGE", true)
+
+	return &b
+}
+
+func (b *Backup) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyL:      ui.NewKeyAction("Logs", b.logsCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", b.GetTable().SortColCmd("STATUS", true), false),
+		ui.KeyShiftE: ui.NewKeyAction("Sort Errors", b.GetTable().SortColCmd("ERRORS", false), false),
+	})
+	if b.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Restore", b.restoreCmd, true),
+	})
+}
+
+func (b *Backup) logsCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Backup
+	res.Init(b.App().factory, b.GVR())
+	b.App().Flash().Infof("Fetching logs for backup %s...", path)
+	go func() {
+		logs, err := res.Logs(context.Background(), path)
+		b.App().QueueUpdateDraw(func() {
+			if err != nil {
+				b.App().Flash().Err(err)
+				return
+			}
+			details := NewDetails(b.App(), "Logs", path, false).Update(tview.Escape(logs))
+			if err := b.App().inject(details, false); err != nil {
+				b.App().Flash().Err(err)
+			}
+		})
+	}()
+
+	return nil
+}
+
+func (b *Backup) restoreCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := b.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	b.Stop()
+	defer b.Start()
+	msg := fmt.Sprintf("Restore from backup %s?", path)
+	dialog.ShowConfirm(b.App().Styles.Dialog(), b.App().Content.Pages, "Confirm Restore", msg, func() {
+		var res dao.Backup
+		res.Init(b.App().factory, b.GVR())
+		fqn, err := res.Restore(context.Background(), path)
+		if err != nil {
+			b.App().Flash().Err(err)
+			return
+		}
+		b.App().Flash().Infof("Restore %s created", fqn)
+	}, func() {})
+
+	return nil
+}
+
+// Schedule represents a Velero Schedule viewer.
+type Schedule struct {
+	ResourceViewer
+}
+
+// NewSchedule returns a new viewer.
+func NewSchedule(gvr client.GVR) ResourceViewer {
+	s := Schedule{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	s.AddBindKeysFn(s.bindKeys)
+	s.GetTable().SetEnterFn(s.showBackups)
+
+	return &s
+}
+
+func (s *Schedule) bindKeys(aa ui.KeyActions) {
+	if s.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyB: ui.NewKeyAction("Backup Now", s.backupCmd, true),
+	})
+}
+
+func (s *Schedule) showBackups(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	v := NewBackup(client.NewGVR(veleroBackupGVR))
+	v.SetContextFn(func(ctx context.Context) context.Context {
+		ctx = context.WithValue(ctx, internal.KeyPath, path)
+		return context.WithValue(ctx, internal.KeyLabels, render.VeleroScheduleLabel+"="+n)
+	})
+	if err := app.inject(v, false); err != nil {
+		app.Flash().Err(err)
+	}
+}
+
+func (s *Schedule) backupCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := s.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	s.Stop()
+	defer s.Start()
+	msg := fmt.Sprintf("Create an on-demand backup from schedule %s?", path)
+	dialog.ShowConfirm(s.App().Styles.Dialog(), s.App().Content.Pages, "Confirm Backup", msg, func() {
+		var res dao.Schedule
+		res.Init(s.App().factory, s.GVR())
+		fqn, err := res.Backup(context.Background(), path)
+		if err != nil {
+			s.App().Flash().Err(err)
+			return
+		}
+		s.App().Flash().Infof("Backup %s created", fqn)
+	}, func() {})
+
+	return nil
+}
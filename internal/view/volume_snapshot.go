@@ -0,0 +1,187 @@
+package view
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+const volumeSnapshotKey = "volumeSnapshot"
+
+// VolumeSnapshot represents a CSI volume snapshot viewer.
+type VolumeSnapshot struct {
+	ResourceViewer
+}
+
+// NewVolumeSnapshot returns a new viewer.
+func NewVolumeSnapshot(gvr client.GVR) ResourceViewer {
+	v := VolumeSnapshot{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.AddBindKeysFn(v.bindKeys)
+	v.GetTable().SetEnterFn(v.showContent)
+
+	return &v
+}
+
+func (v *VolumeSnapshot) bindKeys(aa ui.KeyActions) {
+	if v.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR: ui.NewKeyAction("Restore", v.restoreCmd, true),
+	})
+}
+
+// showContent navigates to the content bound to the snapshot.
+func (v *VolumeSnapshot) showContent(app *App, _ ui.Tabular, _, path string) {
+	fqn, err := dao.SnapshotContent(app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	app.gotoResource(dao.VolumeSnapshotContentGVR, fqn, false)
+}
+
+func (v *VolumeSnapshot) restoreCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := v.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	v.Stop()
+	defer v.Start()
+	_, n := client.Namespaced(path)
+	name, class, size := n+"-restore", "", ""
+	f := makeSnapshotForm()
+	f.AddInputField("PVC Name:", name, 0, nil, func(changed string) {
+		name = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Storage Class:", class, 0, nil, func(changed string) {
+		class = strings.TrimSpace(changed)
+	})
+	f.AddInputField("Size:", size, 10, nil, func(changed string) {
+		size = strings.TrimSpace(changed)
+	})
+	f.AddButton("OK", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), v.App().Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.RestoreSnapshot(ctx, v.App().factory, path, name, class, size); err != nil {
+			v.App().Flash().Err(err)
+			return
+		}
+		dismissSnapshotDialog(v.App())
+		v.App().Flash().Infof("PVC %s restored from volume snapshot %s", name, path)
+	})
+	f.AddButton("Cancel", func() {
+		dismissSnapshotDialog(v.App())
+	})
+	showSnapshotDialog(v.App(), "<Restore>", fmt.Sprintf("Restore volume snapshot %s into a new PVC.\nBlank class and size default to the source PVC ones", path), f)
+
+	return nil
+}
+
+// VolumeSnapshotContent represents a CSI volume snapshot content viewer.
+type VolumeSnapshotContent struct {
+	ResourceViewer
+}
+
+// NewVolumeSnapshotContent returns a new viewer.
+func NewVolumeSnapshotContent(gvr client.GVR) ResourceViewer {
+	v := VolumeSnapshotContent{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.GetTable().SetEnterFn(v.showSnapshot)
+
+	return &v
+}
+
+// showSnapshot navigates to the snapshot bound to the content.
+func (v *VolumeSnapshotContent) showSnapshot(app *App, _ ui.Tabular, _, path string) {
+	fqn, err := dao.ContentSnapshot(app.factory, path)
+	if err != nil {
+		app.Flash().Err(err)
+		return
+	}
+	ns, _ := client.Namespaced(fqn)
+	app.gotoResource(dao.VolumeSnapshotGVR+" "+ns, fqn, false)
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// snapshotPVC prompts for a snapshot name and class, then snapshots the given claim.
+func snapshotPVC(app *App, path string) error {
+	cc, def, err := dao.SnapshotClasses(app.factory)
+	if err != nil {
+		return err
+	}
+	_, n := client.Namespaced(path)
+	name, class := fmt.Sprintf("%s-%s", n, time.Now().Format("20060102150405")), def
+
+	f := makeSnapshotForm()
+	f.AddInputField("Name:", name, 0, nil, func(changed string) {
+		name = strings.TrimSpace(changed)
+	})
+	if len(cc) > 0 {
+		idx := 0
+		for i, c := range cc {
+			if c == def {
+				idx = i
+			}
+		}
+		class = cc[idx]
+		f.AddDropDown("Class:", cc, idx, func(c string, _ int) {
+			class = c
+		})
+	}
+	f.AddButton("OK", func() {
+		ctx, cancel := context.WithTimeout(context.Background(), app.Conn().Config().CallTimeout())
+		defer cancel()
+		if err := dao.SnapshotPVC(ctx, app.factory, path, name, class); err != nil {
+			app.Flash().Err(err)
+			return
+		}
+		dismissSnapshotDialog(app)
+		app.Flash().Infof("Volume snapshot %s of PVC %s requested", name, path)
+	})
+	f.AddButton("Cancel", func() {
+		dismissSnapshotDialog(app)
+	})
+	showSnapshotDialog(app, "<Snapshot>", fmt.Sprintf("Snapshot PVC %s", path), f)
+
+	return nil
+}
+
+func showSnapshotDialog(app *App, title, msg string, f *tview.Form) {
+	confirm := tview.NewModalForm(title, f)
+	confirm.SetText(msg)
+	confirm.SetDoneFunc(func(int, string) {
+		dismissSnapshotDialog(app)
+	})
+	app.Content.AddPage(volumeSnapshotKey, confirm, false, false)
+	app.Content.ShowPage(volumeSnapshotKey)
+}
+
+func dismissSnapshotDialog(app *App) {
+	app.Content.RemovePage(volumeSnapshotKey)
+}
+
+func makeSnapshotForm() *tview.Form {
+	f := tview.NewForm()
+	f.SetItemPadding(0)
+	f.SetButtonsAlign(tview.AlignCenter).
+		SetButtonBackgroundColor(tview.Styles.PrimitiveBackgroundColor).
+		SetButtonTextColor(tview.Styles.PrimaryTextColor).
+		SetLabelColor(tcell.ColorAqua).
+		SetFieldTextColor(tcell.ColorOrange)
+
+	return f
+}
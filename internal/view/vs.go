@@ -0,0 +1,35 @@
+package view
+
+import (
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/ui"
+)
+
+// VirtualService represents an Istio VirtualService viewer.
+type VirtualService struct {
+	ResourceViewer
+}
+
+// NewVirtualService returns a new viewer.
+func NewVirtualService(gvr client.GVR) ResourceViewer {
+	v := VirtualService{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	v.GetTable().SetEnterFn(v.showPods)
+
+	return &v
+}
+
+func (v *VirtualService) showPods(a *App, _ ui.Tabular, _, path string) {
+	var res dao.VirtualService
+	res.Init(a.factory, v.GVR())
+
+	svc, sel, err := res.BackingPods(path)
+	if err != nil {
+		a.Flash().Err(err)
+		return
+	}
+
+	showPodsWithLabels(a, svc, sel)
+}
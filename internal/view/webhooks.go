@@ -0,0 +1,61 @@
+package view
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/tcell/v2"
+)
+
+// Webhooks represents an admission webhooks health report.
+type Webhooks struct {
+	ResourceViewer
+}
+
+// NewWebhooks returns a new viewer.
+func NewWebhooks(gvr client.GVR) ResourceViewer {
+	w := Webhooks{
+		ResourceViewer: NewBrowser(gvr),
+	}
+	w.GetTable().SetBorderFocusColor(tcell.ColorMediumSpringGreen)
+	w.GetTable().SetSelectedStyle(tcell.StyleDefault.Foreground(tcell.ColorWhite).Background(tcell.ColorMediumSpringGreen).Attributes(tcell.AttrNone))
+	w.GetTable().SetSortCol("NAME", true)
+	w.GetTable().SetDecorateFn(w.decorateRows)
+	w.AddBindKeysFn(w.bindKeys)
+
+	return &w
+}
+
+func (w *Webhooks) decorateRows(data *render.TableData) {
+	var broken int
+	for _, re := range data.RowEvents {
+		if !render.Happy(client.ClusterScope, data.Header, re.Row) {
+			broken++
+		}
+	}
+	w.GetTable().Extras = fmt.Sprintf("Broken %d/%d", broken, len(data.RowEvents))
+}
+
+func (w *Webhooks) bindKeys(aa ui.KeyActions) {
+	aa.Delete(ui.KeyShiftA, tcell.KeyCtrlS, tcell.KeyCtrlSpace, ui.KeySpace)
+	aa.Delete(tcell.KeyCtrlW, tcell.KeyCtrlL, tcell.KeyCtrlZ)
+	aa.Add(ui.KeyActions{
+		tcell.KeyEnter: ui.NewKeyAction("Goto", w.gotoCmd, true),
+		ui.KeyShiftK:   ui.NewKeyAction("Sort Kind", w.GetTable().SortColCmd("KIND", true), false),
+		ui.KeyShiftP:   ui.NewKeyAction("Sort Policy", w.GetTable().SortColCmd("POLICY", true), false),
+		ui.KeyShiftE:   ui.NewKeyAction("Sort CA-Expiry", w.GetTable().SortColCmd("CA-EXPIRY", true), false),
+	})
+}
+
+func (w *Webhooks) gotoCmd(evt *tcell.EventKey) *tcell.EventKey {
+	tokens := strings.SplitN(w.GetTable().GetSelectedItem(), ":", 3)
+	if len(tokens) != 3 {
+		return evt
+	}
+	w.App().gotoResource(tokens[0], tokens[1], false)
+
+	return nil
+}
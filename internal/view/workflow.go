@@ -0,0 +1,99 @@
+package view
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	"github.com/derailed/k9s/internal/ui"
+	"github.com/derailed/k9s/internal/ui/dialog"
+	"github.com/derailed/tcell/v2"
+	"github.com/derailed/tview"
+)
+
+// Workflow represents an Argo Workflow viewer.
+type Workflow struct {
+	ResourceViewer
+}
+
+// NewWorkflow returns a new viewer.
+func NewWorkflow(gvr client.GVR) ResourceViewer {
+	w := Workflow{
+		ResourceViewer: NewLogsExtender(NewBrowser(gvr), nil),
+	}
+	w.AddBindKeysFn(w.bindKeys)
+	w.GetTable().SetEnterFn(w.showPods)
+	w.GetTable().SetSortCol("AGE", true)
+
+	return &w
+}
+
+func (w *Workflow) bindKeys(aa ui.KeyActions) {
+	aa.Add(ui.KeyActions{
+		ui.KeyT:      ui.NewKeyAction("Tree", w.treeCmd, true),
+		ui.KeyShiftS: ui.NewKeyAction("Sort Status", w.GetTable().SortColCmd("STATUS", true), false),
+	})
+	if w.App().Config.K9s.IsReadOnly() {
+		return
+	}
+	aa.Add(ui.KeyActions{
+		ui.KeyR:        ui.NewKeyAction("Retry", w.workflowCmd("Retry", (*dao.Workflow).Retry), true),
+		tcell.KeyCtrlT: ui.NewKeyAction("Terminate", w.workflowCmd("Terminate", (*dao.Workflow).Terminate), true),
+	})
+}
+
+func (w *Workflow) showPods(app *App, _ ui.Tabular, _, path string) {
+	_, n := client.Namespaced(path)
+	showPodsWithLabels(app, path, map[string]string{render.ArgoWorkflowLabel: n})
+}
+
+func (w *Workflow) treeCmd(evt *tcell.EventKey) *tcell.EventKey {
+	path := w.GetTable().GetSelectedItem()
+	if path == "" {
+		return evt
+	}
+
+	var res dao.Workflow
+	res.Init(w.App().factory, w.GVR())
+	wf, err := res.GetInstance(path)
+	if err != nil {
+		w.App().Flash().Err(err)
+		return nil
+	}
+	details := NewDetails(w.App(), "Tree", path, true).Update(tview.Escape(wf.Tree()))
+	if err := w.App().inject(details, false); err != nil {
+		w.App().Flash().Err(err)
+	}
+
+	return nil
+}
+
+type workflowActionFn func(*dao.Workflow, context.Context, string) error
+
+func (w *Workflow) workflowCmd(action string, f workflowActionFn) func(evt *tcell.EventKey) *tcell.EventKey {
+	return func(evt *tcell.EventKey) *tcell.EventKey {
+		path := w.GetTable().GetSelectedItem()
+		if path == "" {
+			return evt
+		}
+
+		w.Stop()
+		defer w.Start()
+		msg := fmt.Sprintf("%s workflow %s?", action, path)
+		dialog.ShowConfirm(w.App().Styles.Dialog(), w.App().Content.Pages, "Confirm "+action, msg, func() {
+			ctx, cancel := context.WithTimeout(context.Background(), w.App().Conn().Config().CallTimeout())
+			defer cancel()
+			var res dao.Workflow
+			res.Init(w.App().factory, w.GVR())
+			if err := f(&res, ctx, path); err != nil {
+				w.App().Flash().Err(err)
+				return
+			}
+			w.App().Flash().Infof("%s requested for workflow %s", action, path)
+		}, func() {})
+
+		return nil
+	}
+}
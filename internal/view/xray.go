@@ -2,7 +2,6 @@ package view
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -12,6 +11,7 @@ import (
 	"github.com/derailed/k9s/internal/client"
 	"github.com/derailed/k9s/internal/config"
 	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/i18n"
 	"github.com/derailed/k9s/internal/model"
 	"github.com/derailed/k9s/internal/ui"
 	"github.com/derailed/k9s/internal/ui/dialog"
@@ -362,7 +362,7 @@ func (x *Xray) deleteCmd(evt *tcell.EventKey) *tcell.EventKey {
 			log.Warn().Msgf("NO meta for %q -- %s", spec.GVR(), err)
 			return nil
 		}
-		x.resourceDelete(gvr, spec, fmt.Sprintf("Delete %s %s?", meta.SingularName, spec.Path()))
+		x.resourceDelete(gvr, spec, i18n.Tf("Delete %s %s?", meta.SingularName, spec.Path()))
 	}
 
 	return nil
@@ -403,20 +403,7 @@ func (x *Xray) editCmd(evt *tcell.EventKey) *tcell.EventKey {
 
 	x.Stop()
 	defer x.Start()
-	{
-		ns, n := client.Namespaced(spec.Path())
-		args := make([]string, 0, 10)
-		args = append(args, "edit")
-		args = append(args, client.NewGVR(spec.GVR()).R())
-		args = append(args, "-n", ns)
-		args = append(args, "--context", x.app.Config.K9s.CurrentContext)
-		if cfg := x.app.Conn().Config().Flags().KubeConfig; cfg != nil && *cfg != "" {
-			args = append(args, "--kubeconfig", *cfg)
-		}
-		if !runK(x.app, shellOpts{args: append(args, n)}) {
-			x.app.Flash().Err(errors.New("Edit exec failed"))
-		}
-	}
+	editResource(x.app, client.NewGVR(spec.GVR()), spec.Path())
 
 	return evt
 }
@@ -676,7 +663,10 @@ func (x *Xray) resourceDelete(gvr client.GVR, spec *xray.NodeSpec, msg string) {
 		if force {
 			grace = dao.ForceGrace
 		}
-		if err := nuker.Delete(context.Background(), spec.Path(), nil, grace); err != nil {
+		err = deleteStashed(x.app, spec.GVR(), spec.Path(), func() error {
+			return nuker.Delete(context.Background(), spec.Path(), nil, grace)
+		})
+		if err != nil {
 			x.app.Flash().Errf("Delete failed with `%s", err)
 		} else {
 			x.app.Flash().Infof("%s `%s deleted successfully", x.GVR(), spec.Path())
@@ -0,0 +1,166 @@
+package watch
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/tools/cache"
+)
+
+// churnWindow tracks the sliding window in seconds used to compute event rates.
+const churnWindow = 60
+
+// ChurnStat represents the watch activity observed on a given resource.
+type ChurnStat struct {
+	GVR       string
+	Namespace string
+	Objects   int
+	Adds      int64
+	Updates   int64
+	Deletes   int64
+	// Rate tracks the events per second over the last minute.
+	Rate  float64
+	Since time.Time
+}
+
+type churnCounter struct {
+	gvr, ns                string
+	store                  cache.Store
+	adds, updates, deletes int64
+	since                  time.Time
+	buckets, stamps        [churnWindow]int64
+}
+
+func (c *churnCounter) record(now time.Time) {
+	sec := now.Unix()
+	i := sec % churnWindow
+	if c.stamps[i] != sec {
+		c.stamps[i], c.buckets[i] = sec, 0
+	}
+	c.buckets[i]++
+}
+
+func (c *churnCounter) rate(now time.Time) float64 {
+	sec := now.Unix()
+	var total int64
+	for i := range c.buckets {
+		if sec-c.stamps[i] < churnWindow {
+			total += c.buckets[i]
+		}
+	}
+	span := now.Sub(c.since).Seconds()
+	if span > churnWindow {
+		span = churnWindow
+	}
+	if span < 1 {
+		span = 1
+	}
+
+	return float64(total) / span
+}
+
+// Churn tracks informers events to surface resources thrashing the api server.
+type Churn struct {
+	counters map[string]*churnCounter
+	mx       sync.Mutex
+}
+
+// NewChurn returns a new watch churn tracker.
+func NewChurn() *Churn {
+	return &Churn{counters: make(map[string]*churnCounter)}
+}
+
+// Track starts recording events for a given informer if not already tracked.
+func (c *Churn) Track(ns, gvr string, inf cache.SharedIndexInformer) {
+	key := ns + ":" + gvr
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	if _, ok := c.counters[key]; ok {
+		return
+	}
+
+	cc := churnCounter{gvr: gvr, ns: ns, store: inf.GetStore(), since: time.Now()}
+	// Skip the initial listing or the replay of existing objects as these are not churn.
+	replay := len(cc.store.ListKeys())
+	count := func(n *int64, add bool) {
+		if !inf.HasSynced() {
+			return
+		}
+		c.mx.Lock()
+		defer c.mx.Unlock()
+		if add && replay > 0 {
+			replay--
+			return
+		}
+		*n++
+		cc.record(time.Now())
+	}
+	_, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(interface{}) { count(&cc.adds, true) },
+		UpdateFunc: func(o, n interface{}) {
+			if !isResync(o, n) {
+				count(&cc.updates, false)
+			}
+		},
+		DeleteFunc: func(interface{}) { count(&cc.deletes, false) },
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Churn tracking failed for %q:%q", ns, gvr)
+		return
+	}
+	c.counters[key] = &cc
+}
+
+// Stats returns the watch activity per resource, busiest first.
+func (c *Churn) Stats() []ChurnStat {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := time.Now()
+	ss := make([]ChurnStat, 0, len(c.counters))
+	for _, cc := range c.counters {
+		ss = append(ss, ChurnStat{
+			GVR:       cc.gvr,
+			Namespace: cc.ns,
+			Objects:   len(cc.store.ListKeys()),
+			Adds:      cc.adds,
+			Updates:   cc.updates,
+			Deletes:   cc.deletes,
+			Rate:      cc.rate(now),
+			Since:     cc.since,
+		})
+	}
+	sort.Slice(ss, func(i, j int) bool {
+		if ss[i].Rate != ss[j].Rate {
+			return ss[i].Rate > ss[j].Rate
+		}
+		return ss[i].GVR+ss[i].Namespace < ss[j].GVR+ss[j].Namespace
+	})
+
+	return ss
+}
+
+// Clear resets all trackers.
+func (c *Churn) Clear() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.counters = make(map[string]*churnCounter)
+}
+
+// isResync checks if an update was issued by an informer resync.
+func isResync(o, n interface{}) bool {
+	om, err := meta.Accessor(o)
+	if err != nil {
+		return false
+	}
+	nm, err := meta.Accessor(n)
+	if err != nil {
+		return false
+	}
+
+	return om.GetResourceVersion() == nm.GetResourceVersion()
+}
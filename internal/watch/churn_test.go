@@ -0,0 +1,30 @@
+package watch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestChurnCounterRate(t *testing.T) {
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	c := churnCounter{since: start}
+	for i := 0; i < 30; i++ {
+		c.record(start.Add(time.Duration(i) * time.Second))
+		c.record(start.Add(time.Duration(i) * time.Second))
+	}
+
+	assert.Equal(t, 2.0, c.rate(start.Add(30*time.Second)))
+	assert.Equal(t, 0.5, c.rate(start.Add(74*time.Second)))
+	assert.Equal(t, 0.0, c.rate(start.Add(5*time.Minute)))
+}
+
+func TestIsResync(t *testing.T) {
+	o := &metav1.ObjectMeta{ResourceVersion: "1"}
+
+	assert.True(t, isResync(o, &metav1.ObjectMeta{ResourceVersion: "1"}))
+	assert.False(t, isResync(o, &metav1.ObjectMeta{ResourceVersion: "2"}))
+	assert.False(t, isResync("fred", o))
+}
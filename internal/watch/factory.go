@@ -1,12 +1,14 @@
 package watch
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/tracing"
 	"github.com/rs/zerolog/log"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,6 +27,9 @@ type Factory struct {
 	client     client.Connection
 	stopChan   chan struct{}
 	forwarders Forwarders
+	churn      *Churn
+	health     *Health
+	recorders  *recorders
 	mx         sync.RWMutex
 }
 
@@ -34,6 +39,9 @@ func NewFactory(client client.Connection) *Factory {
 		client:     client,
 		factories:  make(map[string]di.DynamicSharedInformerFactory),
 		forwarders: NewForwarders(),
+		churn:      NewChurn(),
+		health:     NewHealth(),
+		recorders:  newRecorders(),
 	}
 }
 
@@ -63,6 +71,9 @@ func (f *Factory) Terminate() {
 		delete(f.factories, k)
 	}
 	f.forwarders.DeleteAll()
+	f.churn.Clear()
+	f.health.Clear()
+	f.recorders.clear()
 }
 
 // List returns a resource collection.
@@ -137,6 +148,8 @@ func (f *Factory) waitForCacheSync(ns string) {
 	if !ok {
 		return
 	}
+	_, span := tracing.Start(context.Background(), "watch.sync", tracing.String("namespace", ns))
+	defer span.End()
 
 	// Hang for a sec for the cache to refresh if still not done bail out!
 	c := make(chan struct{})
@@ -208,6 +221,12 @@ func (f *Factory) ForResource(ns, gvr string) (informers.GenericInformer, error)
 		log.Error().Err(fmt.Errorf("MEOW! No informer for %q:%q", ns, gvr))
 		return inf, nil
 	}
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+	f.churn.Track(ns, gvr, inf.Informer())
+	f.health.Track(ns, gvr, inf.Informer())
+	f.recorders.track(ns, gvr, inf.Informer())
 
 	f.mx.RLock()
 	defer f.mx.RUnlock()
@@ -240,6 +259,26 @@ func (f *Factory) ensureFactory(ns string) (di.DynamicSharedInformerFactory, err
 	return f.factories[ns], nil
 }
 
+// Churn returns the watch activity observed on all informers.
+func (f *Factory) Churn() []ChurnStat {
+	return f.churn.Stats()
+}
+
+// WatchFailure returns when a resource watch got lost if it has not recovered yet.
+func (f *Factory) WatchFailure(ns, gvr string) (time.Time, bool) {
+	if client.IsClusterWide(ns) {
+		ns = client.AllNamespaces
+	}
+
+	return f.health.Failure(ns, gvr)
+}
+
+// SetRecorder records the objects revisions observed by the informers. A nil
+// recorder turns recording off.
+func (f *Factory) SetRecorder(r Recorder) {
+	f.recorders.set(r)
+}
+
 // AddForwarder registers a new portforward for a given container.
 func (f *Factory) AddForwarder(pf Forwarder) {
 	f.mx.Lock()
@@ -0,0 +1,117 @@
+package watch
+
+import (
+	"sync"
+	"time"
+
+	"github.com/derailed/k9s/internal/metrics"
+	"github.com/rs/zerolog/log"
+	"k8s.io/client-go/tools/cache"
+)
+
+// watchHealth tracks an informer watch failures.
+type watchHealth struct {
+	gvr, ns string
+	inf     cache.SharedIndexInformer
+	// since tracks when the watch got lost. Zero while the watch is healthy.
+	since time.Time
+	// rev tracks the last synced revision when the watch got lost.
+	rev string
+}
+
+// Health tracks informers watch disconnects and reconnects.
+type Health struct {
+	watches map[string]*watchHealth
+	mx      sync.Mutex
+}
+
+// NewHealth returns a new watch health tracker.
+func NewHealth() *Health {
+	return &Health{watches: make(map[string]*watchHealth)}
+}
+
+// Track starts monitoring a given informer watch if not already tracked. The informer
+// must not be started yet.
+func (h *Health) Track(ns, gvr string, inf cache.SharedIndexInformer) {
+	key := ns + ":" + gvr
+	h.mx.Lock()
+	defer h.mx.Unlock()
+	if _, ok := h.watches[key]; ok {
+		return
+	}
+
+	w := watchHealth{gvr: gvr, ns: ns, inf: inf}
+	err := inf.SetWatchErrorHandler(func(r *cache.Reflector, err error) {
+		h.failed(&w, err)
+		cache.DefaultWatchErrorHandler(r, err)
+	})
+	if err != nil {
+		log.Debug().Err(err).Msgf("Watch health tracking skipped for %q:%q", ns, gvr)
+		return
+	}
+	// A relist replays all objects, so any event tells the watch is back.
+	heal := func() {
+		h.mx.Lock()
+		defer h.mx.Unlock()
+		h.recovered(&w)
+	}
+	_, err = inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(interface{}) { heal() },
+		UpdateFunc: func(interface{}, interface{}) { heal() },
+		DeleteFunc: func(interface{}) { heal() },
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("Watch health tracking failed for %q:%q", ns, gvr)
+		return
+	}
+	h.watches[key] = &w
+}
+
+// Failure returns when a resource watch got lost if it has not recovered yet.
+func (h *Health) Failure(ns, gvr string) (time.Time, bool) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	w, ok := h.watches[ns+":"+gvr]
+	if !ok || w.since.IsZero() {
+		return time.Time{}, false
+	}
+	// Empty collections do not replay any events on relist so check the revision moved.
+	if w.inf.LastSyncResourceVersion() != w.rev {
+		h.recovered(w)
+		return time.Time{}, false
+	}
+
+	return w.since, true
+}
+
+// Clear resets all trackers.
+func (h *Health) Clear() {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	h.watches = make(map[string]*watchHealth)
+}
+
+func (h *Health) failed(w *watchHealth, err error) {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	if !w.since.IsZero() {
+		return
+	}
+	w.since, w.rev = time.Now(), w.inf.LastSyncResourceVersion()
+	log.Warn().Err(err).Str("gvr", w.gvr).Str("ns", w.ns).Msg("Watch lost")
+	metrics.ObserveWatchDisconnect(w.gvr)
+}
+
+// recovered flags a watch as healthy. Caller must hold the lock.
+func (h *Health) recovered(w *watchHealth) {
+	if w.since.IsZero() {
+		return
+	}
+	downtime := time.Since(w.since)
+	w.since, w.rev = time.Time{}, ""
+	log.Info().Str("gvr", w.gvr).Str("ns", w.ns).Dur("downtime", downtime).Msg("Watch reconnected")
+	metrics.ObserveWatchReconnect(w.gvr, downtime)
+}
@@ -0,0 +1,54 @@
+package watch
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+)
+
+func TestHealthFailure(t *testing.T) {
+	h := NewHealth()
+	h.Track("ns1", "v1/pods", newTestInformer())
+	h.Track("ns1", "v1/pods", newTestInformer())
+	assert.Len(t, h.watches, 1)
+
+	_, ok := h.Failure("ns1", "v1/pods")
+	assert.False(t, ok)
+
+	w := h.watches["ns1:v1/pods"]
+	h.failed(w, errors.New("boom"))
+	since, ok := h.Failure("ns1", "v1/pods")
+	assert.True(t, ok)
+	h.failed(w, errors.New("boom"))
+	again, ok := h.Failure("ns1", "v1/pods")
+	assert.True(t, ok)
+	assert.Equal(t, since, again)
+
+	h.mx.Lock()
+	h.recovered(w)
+	h.mx.Unlock()
+	_, ok = h.Failure("ns1", "v1/pods")
+	assert.False(t, ok)
+
+	_, ok = h.Failure("ns2", "v1/pods")
+	assert.False(t, ok)
+}
+
+func newTestInformer() cache.SharedIndexInformer {
+	lw := cache.ListWatch{
+		ListFunc: func(metav1.ListOptions) (runtime.Object, error) {
+			return &unstructured.UnstructuredList{}, nil
+		},
+		WatchFunc: func(metav1.ListOptions) (watch.Interface, error) {
+			return watch.NewFake(), nil
+		},
+	}
+
+	return cache.NewSharedIndexInformer(&lw, &unstructured.Unstructured{}, 0, cache.Indexers{})
+}
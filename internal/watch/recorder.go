@@ -0,0 +1,77 @@
+package watch
+
+import (
+	"sync"
+
+	"github.com/rs/zerolog/log"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/cache"
+)
+
+// Recorder records the objects revisions observed by the informers.
+type Recorder interface {
+	// Record records an object revision for a given resource.
+	Record(gvr string, o *unstructured.Unstructured)
+}
+
+// recorders feeds informers events to the active recorder.
+type recorders struct {
+	recorder Recorder
+	tracked  map[string]struct{}
+	mx       sync.RWMutex
+}
+
+func newRecorders() *recorders {
+	return &recorders{tracked: make(map[string]struct{})}
+}
+
+// set swaps the active recorder. A nil recorder turns recording off.
+func (r *recorders) set(rec Recorder) {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.recorder = rec
+}
+
+func (r *recorders) record(gvr string, o interface{}) {
+	u, ok := o.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+	if r.recorder != nil {
+		r.recorder.Record(gvr, u)
+	}
+}
+
+// track feeds an informer events to the recorder if recording and not already tracked.
+func (r *recorders) track(ns, gvr string, inf cache.SharedIndexInformer) {
+	key := ns + ":" + gvr
+	r.mx.Lock()
+	defer r.mx.Unlock()
+	if _, ok := r.tracked[key]; ok || r.recorder == nil {
+		return
+	}
+
+	_, err := inf.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(o interface{}) { r.record(gvr, o) },
+		UpdateFunc: func(o, n interface{}) {
+			if !isResync(o, n) {
+				r.record(gvr, n)
+			}
+		},
+	})
+	if err != nil {
+		log.Warn().Err(err).Msgf("History recording failed for %q:%q", ns, gvr)
+		return
+	}
+	r.tracked[key] = struct{}{}
+}
+
+func (r *recorders) clear() {
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.tracked = make(map[string]struct{})
+}
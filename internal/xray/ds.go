@@ -16,9 +16,14 @@ type DaemonSet struct{}
 
 // Render renders an xray node.
 func (d *DaemonSet) Render(ctx context.Context, ns string, o interface{}) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	var raw *unstructured.Unstructured
+	switch o := o.(type) {
+	case *render.DaemonSetWithGaps:
+		raw = o.Raw
+	case *unstructured.Unstructured:
+		raw = o
+	default:
+		return fmt.Errorf("Expected DaemonSetWithGaps, but got %T", o)
 	}
 	var ds appsv1.DaemonSet
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ds)
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -41,3 +43,25 @@ func TestDaemonSetRender(t *testing.T) {
 		})
 	}
 }
+
+func TestDaemonSetRenderFromDAO(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"apps/v1/daemonsets": {load(t, "ds")},
+		"v1/pods":            {load(t, "po")},
+	}
+	var a dao.DaemonSet
+	a.Init(f, client.NewGVR("apps/v1/daemonsets"))
+	oo, err := a.List(context.Background(), "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	root := xray.NewTreeNode("daemonsets", "daemonsets")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.DaemonSet
+	assert.Nil(t, re.Render(ctx, "", oo[0]))
+	assert.Equal(t, 1, root.CountChildren())
+	assert.Equal(t, 1, root.Children[0].CountChildren())
+}
@@ -0,0 +1,136 @@
+package xray
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/render"
+	netv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// Ingress represents an xray renderer.
+type Ingress struct{}
+
+// Render renders an xray node.
+func (i *Ingress) Render(ctx context.Context, ns string, o interface{}) error {
+	var raw *unstructured.Unstructured
+	switch o := o.(type) {
+	case *render.IngressWithIssues:
+		raw = o.Raw
+	case *unstructured.Unstructured:
+		raw = o
+	default:
+		return fmt.Errorf("Expected IngressWithIssues, but got %T", o)
+	}
+	var ing netv1.Ingress
+	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ing)
+	if err != nil {
+		return err
+	}
+
+	parent, ok := ctx.Value(KeyParent).(*TreeNode)
+	if !ok {
+		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
+	}
+
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+	}
+
+	root := NewTreeNode("networking.k8s.io/v1/ingresses", client.FQN(ing.Namespace, ing.Name))
+	ctx = context.WithValue(ctx, KeyParent, root)
+	for _, svc := range ingServices(ing.Spec) {
+		if err := i.serviceRef(ctx, f, ns, root, client.FQN(ing.Namespace, svc)); err != nil {
+			return err
+		}
+	}
+	for _, tls := range ing.Spec.TLS {
+		if tls.SecretName != "" {
+			addRef(f, root, "v1/secrets", client.FQN(ing.Namespace, tls.SecretName), nil)
+		}
+	}
+
+	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, ing.Namespace)
+	nsn := parent.Find(gvr, nsID)
+	if nsn == nil {
+		nsn = NewTreeNode(gvr, nsID)
+		parent.Add(nsn)
+	}
+	nsn.Add(root)
+
+	return i.validate(root)
+}
+
+func (*Ingress) serviceRef(ctx context.Context, f dao.Factory, ns string, parent *TreeNode, id string) error {
+	o, err := f.Get("v1/services", id, true, labels.Everything())
+	if err != nil || o == nil {
+		addRef(f, parent, "v1/services", id, nil)
+		return nil
+	}
+
+	var re Service
+	return re.Render(ctx, ns, o)
+}
+
+// validate flags the ingress when any of its backends or certificates is unhealthy.
+func (*Ingress) validate(root *TreeNode) error {
+	root.Extras[StatusKey] = OkStatus
+	var total, ok int
+	for _, c := range root.Children {
+		refs := []*TreeNode{c}
+		if c.GVR == "v1/namespaces" {
+			refs = c.Children
+		}
+		for _, r := range refs {
+			total++
+			if r.Extras[StatusKey] == OkStatus {
+				ok++
+			}
+		}
+	}
+	if ok != total {
+		root.Extras[StatusKey] = ToastStatus
+	}
+	root.Extras[InfoKey] = fmt.Sprintf("%d/%d", ok, total)
+
+	return nil
+}
+
+// ----------------------------------------------------------------------------
+// Helpers...
+
+// ingServices returns the distinct backend services of an ingress.
+func ingServices(spec netv1.IngressSpec) []string {
+	var (
+		ss   []string
+		seen = make(map[string]struct{})
+	)
+	add := func(b *netv1.IngressBackend) {
+		if b == nil || b.Service == nil {
+			return
+		}
+		if _, ok := seen[b.Service.Name]; ok {
+			return
+		}
+		seen[b.Service.Name] = struct{}{}
+		ss = append(ss, b.Service.Name)
+	}
+	add(spec.DefaultBackend)
+	for _, r := range spec.Rules {
+		if r.HTTP == nil {
+			continue
+		}
+		for i := range r.HTTP.Paths {
+			add(&r.HTTP.Paths[i].Backend)
+		}
+	}
+
+	return ss
+}
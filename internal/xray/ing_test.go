@@ -0,0 +1,85 @@
+package xray_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
+	"github.com/derailed/k9s/internal/xray"
+	"github.com/stretchr/testify/assert"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+func TestIngressRender(t *testing.T) {
+	uu := map[string]struct {
+		rows         map[string][]runtime.Object
+		status, info string
+	}{
+		"plain": {
+			rows: map[string][]runtime.Object{
+				"v1/services":  {load(t, "svc")},
+				"v1/endpoints": {load(t, "ep")},
+				"v1/pods":      {load(t, "po")},
+				"v1/secrets":   {load(t, "sa")},
+			},
+			status: xray.OkStatus,
+			info:   "2/2",
+		},
+		"missing-refs": {
+			rows:   map[string][]runtime.Object{},
+			status: xray.ToastStatus,
+			info:   "0/2",
+		},
+	}
+
+	var re xray.Ingress
+	for k := range uu {
+		u := uu[k]
+		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+
+			root := xray.NewTreeNode("ingresses", "ingresses")
+			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+			ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+			assert.Nil(t, re.Render(ctx, "", load(t, "ing")))
+			assert.Equal(t, 1, root.CountChildren())
+			ing := root.Children[0].Children[0]
+			assert.Equal(t, "default/nginx", ing.ID)
+			assert.Equal(t, u.status, ing.Extras[xray.StatusKey])
+			assert.Equal(t, u.info, ing.Extras[xray.InfoKey])
+			assert.NotNil(t, ing.Find("v1/services", "default/nginx"))
+			assert.NotNil(t, ing.Find("v1/secrets", "default/nginx-tls"))
+		})
+	}
+}
+
+func TestIngressRenderFromDAO(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"networking.k8s.io/v1/ingresses": {load(t, "ing")},
+		"v1/services":                    {load(t, "svc")},
+		"v1/endpoints":                   {load(t, "ep")},
+		"v1/pods":                        {load(t, "po")},
+		"v1/secrets":                     {load(t, "sa")},
+	}
+	var a dao.Ingress
+	a.Init(f, client.NewGVR("networking.k8s.io/v1/ingresses"))
+	oo, err := a.List(context.Background(), "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	root := xray.NewTreeNode("ingresses", "ingresses")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Ingress
+	assert.Nil(t, re.Render(ctx, "", oo[0]))
+	assert.Equal(t, 1, root.CountChildren())
+	ing := root.Children[0].Children[0]
+	assert.Equal(t, "default/nginx", ing.ID)
+	assert.Equal(t, xray.OkStatus, ing.Extras[xray.StatusKey])
+}
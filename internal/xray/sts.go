@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/render"
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -16,9 +18,14 @@ type StatefulSet struct{}
 
 // Render renders an xray node.
 func (s *StatefulSet) Render(ctx context.Context, ns string, o interface{}) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	var raw *unstructured.Unstructured
+	switch o := o.(type) {
+	case *render.StatefulSetWithRevisions:
+		raw = o.Raw
+	case *unstructured.Unstructured:
+		raw = o
+	default:
+		return fmt.Errorf("Expected StatefulSetWithRevisions, but got %T", o)
 	}
 	var sts appsv1.StatefulSet
 	err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &sts)
@@ -52,6 +59,13 @@ func (s *StatefulSet) Render(ctx context.Context, ns string, o interface{}) erro
 	if root.IsLeaf() {
 		return nil
 	}
+	if sts.Spec.ServiceName != "" {
+		f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+		if !ok {
+			return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+		}
+		addRef(f, root, "v1/services", client.FQN(sts.Namespace, sts.Spec.ServiceName), nil)
+	}
 
 	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, sts.Namespace)
 	nsn := parent.Find(gvr, nsID)
@@ -74,6 +88,11 @@ func (*StatefulSet) validate(root *TreeNode, sts appsv1.StatefulSet) error {
 	if a != r {
 		root.Extras[StatusKey] = ToastStatus
 	}
+	for _, c := range root.Children {
+		if c.GVR == "v1/services" && c.Extras[StatusKey] != OkStatus {
+			root.Extras[StatusKey] = ToastStatus
+		}
+	}
 	root.Extras[InfoKey] = fmt.Sprintf("%d/%d", a, r)
 
 	return nil
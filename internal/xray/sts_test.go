@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -42,3 +44,25 @@ func TestStatefulSetRender(t *testing.T) {
 		})
 	}
 }
+
+func TestStatefulSetRenderFromDAO(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"apps/v1/statefulsets": {load(t, "sts")},
+		"v1/pods":              {load(t, "po")},
+	}
+	var a dao.StatefulSet
+	a.Init(f, client.NewGVR("apps/v1/statefulsets"))
+	oo, err := a.List(context.Background(), "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	root := xray.NewTreeNode("statefulsets", "statefulsets")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.StatefulSet
+	assert.Nil(t, re.Render(ctx, "", oo[0]))
+	assert.Equal(t, 1, root.CountChildren())
+	assert.Equal(t, 1, root.Children[0].CountChildren())
+}
@@ -3,7 +3,6 @@ package xray
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/derailed/k9s/internal"
 	"github.com/derailed/k9s/internal/client"
@@ -20,9 +19,14 @@ type Service struct{}
 
 // Render renders an xray node.
 func (s *Service) Render(ctx context.Context, ns string, o interface{}) error {
-	raw, ok := o.(*unstructured.Unstructured)
-	if !ok {
-		return fmt.Errorf("Expected Unstructured, but got %T", o)
+	var raw *unstructured.Unstructured
+	switch o := o.(type) {
+	case *render.ServiceWithEndpoints:
+		raw = o.Raw
+	case *unstructured.Unstructured:
+		raw = o
+	default:
+		return fmt.Errorf("Expected ServiceWithEndpoints, but got %T", o)
 	}
 
 	var svc v1.Service
@@ -36,27 +40,24 @@ func (s *Service) Render(ctx context.Context, ns string, o interface{}) error {
 		return fmt.Errorf("Expecting a TreeNode but got %T", ctx.Value(KeyParent))
 	}
 
-	root := NewTreeNode("v1/services", client.FQN(svc.Namespace, svc.Name))
-	oo, err := s.locatePods(ctx, svc.Namespace, svc.Spec.Selector)
-	if err != nil {
-		return err
+	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+	if !ok {
+		return fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
 	}
-	ctx = context.WithValue(ctx, KeyParent, root)
-	var re Pod
-	for _, o := range oo {
-		p, ok := o.(*unstructured.Unstructured)
-		if !ok {
-			return fmt.Errorf("expecting *Unstructured but got %T", o)
-		}
-		if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+
+	root := NewTreeNode("v1/services", client.FQN(svc.Namespace, svc.Name))
+	if svc.Spec.Type != v1.ServiceTypeExternalName {
+		ep := NewTreeNode("v1/endpoints", root.ID)
+		if err := s.endpointsRefs(ctx, f, ns, ep, len(svc.Spec.Selector) > 0); err != nil {
 			return err
 		}
+		root.Add(ep)
+		root.Extras[StatusKey] = OkStatus
+		if ep.Extras[StatusKey] != OkStatus {
+			root.Extras[StatusKey] = ToastStatus
+		}
 	}
-	root.Extras[StatusKey] = OkStatus
 
-	if root.IsLeaf() {
-		return nil
-	}
 	gvr, nsID := "v1/namespaces", client.FQN(client.ClusterScope, svc.Namespace)
 	nsn := parent.Find(gvr, nsID)
 	if nsn == nil {
@@ -68,21 +69,60 @@ func (s *Service) Render(ctx context.Context, ns string, o interface{}) error {
 	return nil
 }
 
-func (s *Service) locatePods(ctx context.Context, ns string, sel map[string]string) ([]runtime.Object, error) {
-	f, ok := ctx.Value(internal.KeyFactory).(dao.Factory)
+// endpointsRefs expands the service endpoints into their backing pods.
+func (*Service) endpointsRefs(ctx context.Context, f dao.Factory, ns string, node *TreeNode, selected bool) error {
+	o, err := f.Get(node.GVR, node.ID, true, labels.Everything())
+	if err != nil || o == nil {
+		if selected {
+			node.Extras[StatusKey] = MissingRefStatus
+		}
+		return nil
+	}
+	raw, ok := o.(*unstructured.Unstructured)
 	if !ok {
-		return nil, fmt.Errorf("Expecting a factory but got %T", ctx.Value(internal.KeyFactory))
+		return fmt.Errorf("expecting *Unstructured but got %T", o)
+	}
+	var ep v1.Endpoints
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(raw.Object, &ep); err != nil {
+		return err
 	}
 
-	ll := make([]string, 0, len(sel))
-	for k, v := range sel {
-		ll = append(ll, fmt.Sprintf("%s=%s", k, v))
+	var (
+		ready, total int
+		re           Pod
+	)
+	ctx = context.WithValue(ctx, KeyParent, node)
+	for _, ss := range ep.Subsets {
+		ready += len(ss.Addresses)
+		total += len(ss.Addresses) + len(ss.NotReadyAddresses)
+		for _, a := range append(ss.Addresses, ss.NotReadyAddresses...) {
+			if a.TargetRef == nil || a.TargetRef.Kind != "Pod" {
+				continue
+			}
+			id := client.FQN(a.TargetRef.Namespace, a.TargetRef.Name)
+			if node.Find("v1/pods", id) != nil {
+				continue
+			}
+			po, err := f.Get("v1/pods", id, true, labels.Everything())
+			if err != nil || po == nil {
+				addRef(f, node, "v1/pods", id, nil)
+				continue
+			}
+			p, ok := po.(*unstructured.Unstructured)
+			if !ok {
+				return fmt.Errorf("expecting *Unstructured but got %T", po)
+			}
+			if err := re.Render(ctx, ns, &render.PodWithMetrics{Raw: p}); err != nil {
+				return err
+			}
+		}
 	}
 
-	fsel, err := labels.ConvertSelectorToLabelsMap(strings.Join(ll, ","))
-	if err != nil {
-		return nil, err
+	node.Extras[StatusKey] = OkStatus
+	if ready != total || (selected && ready == 0) {
+		node.Extras[StatusKey] = ToastStatus
 	}
+	node.Extras[InfoKey] = fmt.Sprintf("%d/%d", ready, total)
 
-	return f.List("v1/pods", ns, false, fsel.AsSelector())
+	return nil
 }
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/derailed/k9s/internal"
+	"github.com/derailed/k9s/internal/client"
+	"github.com/derailed/k9s/internal/dao"
 	"github.com/derailed/k9s/internal/xray"
 	"github.com/stretchr/testify/assert"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -13,24 +15,37 @@ import (
 func TestServiceRender(t *testing.T) {
 	uu := map[string]struct {
 		file           string
+		rows           map[string][]runtime.Object
 		level1, level2 int
-		status         string
+		status, info   string
 	}{
 		"plain": {
-			file:   "svc",
+			file: "svc",
+			rows: map[string][]runtime.Object{
+				"v1/pods":      {load(t, "po")},
+				"v1/endpoints": {load(t, "ep")},
+			},
 			level1: 1,
 			level2: 1,
 			status: xray.OkStatus,
+			info:   "1/1",
+		},
+		"no-endpoints": {
+			file:   "svc",
+			rows:   map[string][]runtime.Object{"v1/pods": {load(t, "po")}},
+			level1: 1,
+			level2: 1,
+			status: xray.ToastStatus,
 		},
 	}
 
 	var re xray.Service
 	for k := range uu {
-		f := makeFactory()
-		f.rows = map[string][]runtime.Object{"v1/pods": {load(t, "po")}}
-
 		u := uu[k]
 		t.Run(k, func(t *testing.T) {
+			f := makeFactory()
+			f.rows = u.rows
+
 			o := load(t, u.file)
 			root := xray.NewTreeNode("services", "services")
 			ctx := context.WithValue(context.Background(), xray.KeyParent, root)
@@ -39,6 +54,35 @@ func TestServiceRender(t *testing.T) {
 			assert.Nil(t, re.Render(ctx, "", o))
 			assert.Equal(t, u.level1, root.CountChildren())
 			assert.Equal(t, u.level2, root.Children[0].CountChildren())
+			svc := root.Children[0].Children[0]
+			assert.Equal(t, u.status, svc.Extras[xray.StatusKey])
+			assert.Equal(t, u.info, svc.Children[0].Extras[xray.InfoKey])
+			if u.info != "" {
+				assert.NotNil(t, svc.Find("v1/pods", "default/nginx"))
+			}
 		})
 	}
 }
+
+func TestServiceRenderFromDAO(t *testing.T) {
+	f := makeFactory()
+	f.rows = map[string][]runtime.Object{
+		"v1/services":  {load(t, "svc")},
+		"v1/pods":      {load(t, "po")},
+		"v1/endpoints": {load(t, "ep")},
+	}
+	var a dao.Service
+	a.Init(f, client.NewGVR("v1/services"))
+	oo, err := a.List(context.Background(), "default")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(oo))
+
+	root := xray.NewTreeNode("services", "services")
+	ctx := context.WithValue(context.Background(), xray.KeyParent, root)
+	ctx = context.WithValue(ctx, internal.KeyFactory, f)
+
+	var re xray.Service
+	assert.Nil(t, re.Render(ctx, "", oo[0]))
+	assert.Equal(t, 1, root.CountChildren())
+	assert.Equal(t, xray.OkStatus, root.Children[0].Children[0].Extras[xray.StatusKey])
+}
@@ -484,6 +484,8 @@ func toEmoji(gvr string) string {
 		return "👩‍"
 	case "rbac.authorization.k8s.io/v1/rolebindings", "rbac.authorization.k8s.io/v1/roles":
 		return "👨🏻‍"
+	case "networking.k8s.io/v1/ingresses":
+		return "🌐"
 	case "networking.k8s.io/v1/networkpolicies":
 		return "📕"
 	case "policy/v1beta1/poddisruptionbudgets":
@@ -524,6 +526,8 @@ func v1Emoji(gvr string) string {
 		return "🚛"
 	case "v1/services":
 		return "💁‍♀️"
+	case "v1/endpoints":
+		return "🔌"
 	case "v1/serviceaccounts":
 		return "💳"
 	case "v1/persistentvolumes":
@@ -561,6 +565,7 @@ func EmojiInfo() map[string]string {
 		"v1/namespaces",
 		"v1/pods",
 		"v1/services",
+		"v1/endpoints",
 		"v1/serviceaccounts",
 		"v1/persistentvolumes",
 		"v1/persistentvolumeclaims",
@@ -569,6 +574,7 @@ func EmojiInfo() map[string]string {
 		"apps/v1/deployments",
 		"apps/v1/statefulsets",
 		"apps/v1/daemonsets",
+		"networking.k8s.io/v1/ingresses",
 	}
 
 	m := make(map[string]string, len(GVRs))